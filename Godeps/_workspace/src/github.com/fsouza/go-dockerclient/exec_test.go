@@ -1,4 +1,4 @@
-// Copyright 2014 go-dockerclient authors. All rights reserved.
+// Copyright 2015 go-dockerclient authors. All rights reserved.
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
@@ -31,6 +31,7 @@ func TestExecCreate(t *testing.T) {
 		AttachStderr: false,
 		Tty:          false,
 		Cmd:          []string{"touch", "/tmp/file"},
+		User:         "a-user",
 	}
 	execObj, err := client.CreateExec(config)
 	if err != nil {
@@ -87,11 +88,9 @@ func TestExecStartDetached(t *testing.T) {
 
 func TestExecStartAndAttach(t *testing.T) {
 	var reader = strings.NewReader("send value")
-	var req http.Request
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Write([]byte{1, 0, 0, 0, 0, 0, 0, 5})
 		w.Write([]byte("hello"))
-		req = *r
 	}))
 	defer server.Close()
 	client, _ := NewClient(server.URL)
@@ -106,7 +105,11 @@ func TestExecStartAndAttach(t *testing.T) {
 		RawTerminal:  true,
 		Success:      success,
 	}
-	go client.StartExec(execID, opts)
+	go func() {
+		if err := client.StartExec(execID, opts); err != nil {
+			t.Error(err)
+		}
+	}()
 	<-success
 }
 
@@ -50,9 +50,14 @@ func removeDNS(record string, etcdClient *etcd.Client) error {
 }
 
 func addDNS(record string, service *kapi.Service, etcdClient *etcd.Client) error {
+	// SkyDNS records carry a single port; publish the first one.
+	if len(service.Spec.Ports) == 0 {
+		return fmt.Errorf("service %q has no ports", service.Name)
+	}
+	port := service.Spec.Ports[0].Port
 	svc := skymsg.Service{
 		Host:     service.Spec.PortalIP,
-		Port:     service.Spec.Port,
+		Port:     port,
 		Priority: 10,
 		Weight:   10,
 		Ttl:      30,
@@ -63,7 +68,7 @@ func addDNS(record string, service *kapi.Service, etcdClient *etcd.Client) error
 	}
 	// Set with no TTL, and hope that kubernetes events are accurate.
 
-	log.Printf("Setting dns record: %v -> %s:%d\n", record, service.Spec.PortalIP, service.Spec.Port)
+	log.Printf("Setting dns record: %v -> %s:%d\n", record, service.Spec.PortalIP, port)
 	_, err = etcdClient.Set(skymsg.Path(record), string(b), uint64(0))
 	return err
 }
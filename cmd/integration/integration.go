@@ -260,8 +260,12 @@ func endpointsSet(c *client.Client, serviceNamespace, serviceID string, endpoint
 			glog.Infof("Error on creating endpoints: %v", err)
 			return false, nil
 		}
-		glog.Infof("endpoints: %v", endpoints.Endpoints)
-		return len(endpoints.Endpoints) == endpointCount, nil
+		count := 0
+		for _, port := range endpoints.Ports {
+			count += len(port.Endpoints)
+		}
+		glog.Infof("endpoints: %v", endpoints.Ports)
+		return count == endpointCount, nil
 	}
 }
 
@@ -331,12 +335,11 @@ func runSelfLinkTestOnNamespace(c *client.Client, namespace string) {
 				},
 			},
 			Spec: api.ServiceSpec{
-				Port: 12345,
+				Ports: []api.ServicePort{{Port: 12345, Protocol: "TCP"}},
 				// This is here because validation requires it.
 				Selector: map[string]string{
 					"foo": "bar",
 				},
-				Protocol:        "TCP",
 				SessionAffinity: "None",
 			},
 		},
@@ -396,12 +399,11 @@ func runAtomicPutTest(c *client.Client) {
 				},
 			},
 			Spec: api.ServiceSpec{
-				Port: 12345,
+				Ports: []api.ServicePort{{Port: 12345, Protocol: "TCP"}},
 				// This is here because validation requires it.
 				Selector: map[string]string{
 					"foo": "bar",
 				},
-				Protocol:        "TCP",
 				SessionAffinity: "None",
 			},
 		},
@@ -498,7 +500,7 @@ func runMasterServiceTest(client *client.Client) {
 		if err != nil {
 			glog.Fatalf("unexpected error listing endpoints for kubernetes service: %v", err)
 		}
-		if len(ep.Endpoints) == 0 {
+		if len(ep.Ports) == 0 || len(ep.Ports[0].Endpoints) == 0 {
 			glog.Fatalf("no endpoints for kubernetes service: %v", ep)
 		}
 	} else {
@@ -515,7 +517,7 @@ func runMasterServiceTest(client *client.Client) {
 		if err != nil {
 			glog.Fatalf("unexpected error listing endpoints for kubernetes service: %v", err)
 		}
-		if len(ep.Endpoints) == 0 {
+		if len(ep.Ports) == 0 || len(ep.Ports[0].Endpoints) == 0 {
 			glog.Fatalf("no endpoints for kubernetes service: %v", ep)
 		}
 	} else {
@@ -566,8 +568,7 @@ func runServiceTest(client *client.Client) {
 			Selector: map[string]string{
 				"name": "thisisalonglabel",
 			},
-			Port:            8080,
-			Protocol:        "TCP",
+			Ports:           []api.ServicePort{{Port: 8080, Protocol: "TCP"}},
 			SessionAffinity: "None",
 		},
 	}
@@ -583,8 +584,7 @@ func runServiceTest(client *client.Client) {
 			Selector: map[string]string{
 				"name": "thisisalonglabel",
 			},
-			Port:            8080,
-			Protocol:        "TCP",
+			Ports:           []api.ServicePort{{Port: 8080, Protocol: "TCP"}},
 			SessionAffinity: "None",
 		},
 	}
@@ -603,8 +603,7 @@ func runServiceTest(client *client.Client) {
 			Selector: map[string]string{
 				"name": "thisisalonglabel",
 			},
-			Port:            8080,
-			Protocol:        "TCP",
+			Ports:           []api.ServicePort{{Port: 8080, Protocol: "TCP"}},
 			SessionAffinity: "None",
 		},
 	}
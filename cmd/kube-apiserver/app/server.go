@@ -21,6 +21,7 @@ package app
 
 import (
 	"crypto/tls"
+	"io"
 	"net"
 	"net/http"
 	"strconv"
@@ -30,6 +31,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/admission"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/apiserver"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/apiserver/audit"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/capabilities"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/cloudprovider"
@@ -58,9 +60,23 @@ type APIServer struct {
 	CloudProvider              string
 	CloudConfigFile            string
 	EventTTL                   time.Duration
+	BasicAuthFile              string
 	TokenAuthFile              string
+	ServiceAccountTokenAuth    bool
+	TokenWebhookURL            string
+	TokenWebhookCAFile         string
+	TokenWebhookCacheTTL       time.Duration
+	TokenWebhookRejectTTL      time.Duration
 	AuthorizationMode          string
 	AuthorizationPolicyFile    string
+	AuthorizationWebhookURL    string
+	AuthorizationWebhookCAFile string
+	AuthorizationAllowTTL      time.Duration
+	AuthorizationDenyTTL       time.Duration
+	AuditLogPath               string
+	AuditLogMaxSize            int
+	AuditLogMaxBackups         int
+	AuditLogBodyResources      util.StringList
 	AdmissionControl           string
 	AdmissionControlConfigFile string
 	EtcdServerList             util.StringList
@@ -74,6 +90,7 @@ type APIServer struct {
 	KubeletConfig              client.KubeletConfig
 	ClusterName                string
 	SyncPodStatus              bool
+	WatchCacheSize             int
 }
 
 // NewAPIServer creates a new APIServer object with default parameters
@@ -88,12 +105,19 @@ func NewAPIServer() *APIServer {
 		SecurePort:             6443,
 		APIPrefix:              "/api",
 		EventTTL:               48 * time.Hour,
+		TokenWebhookCacheTTL:   2 * time.Minute,
+		TokenWebhookRejectTTL:  30 * time.Second,
 		AuthorizationMode:      "AlwaysAllow",
+		AuthorizationAllowTTL:  5 * time.Minute,
+		AuthorizationDenyTTL:   30 * time.Second,
+		AuditLogMaxSize:        100,
+		AuditLogMaxBackups:     5,
 		AdmissionControl:       "AlwaysAdmit",
 		EnableLogsSupport:      true,
 		MasterServiceNamespace: api.NamespaceDefault,
 		ClusterName:            "kubernetes",
 		SyncPodStatus:          true,
+		WatchCacheSize:         1000,
 
 		RuntimeConfig: make(util.ConfigurationMap),
 		KubeletConfig: client.KubeletConfig{
@@ -137,9 +161,23 @@ func (s *APIServer) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&s.CloudProvider, "cloud_provider", s.CloudProvider, "The provider for cloud services.  Empty string for no provider.")
 	fs.StringVar(&s.CloudConfigFile, "cloud_config", s.CloudConfigFile, "The path to the cloud provider configuration file.  Empty string for no configuration file.")
 	fs.DurationVar(&s.EventTTL, "event_ttl", s.EventTTL, "Amount of time to retain events. Default 2 days.")
+	fs.StringVar(&s.BasicAuthFile, "basic_auth_file", s.BasicAuthFile, "If set, the file that will be used to admit requests to the secure port of the API server via http basic authentication. Passwords in the file must be bcrypt hashes. The file is read again when it changes.")
 	fs.StringVar(&s.TokenAuthFile, "token_auth_file", s.TokenAuthFile, "If set, the file that will be used to secure the secure port of the API server via token authentication.")
+	fs.BoolVar(&s.ServiceAccountTokenAuth, "service_account_token_auth", s.ServiceAccountTokenAuth, "If true, the API tokens of service accounts are accepted on the secure port of the API server.")
+	fs.StringVar(&s.TokenWebhookURL, "token_webhook_url", s.TokenWebhookURL, "If set, bearer tokens are POSTed to this https URL to be authenticated on the secure port of the API server.")
+	fs.StringVar(&s.TokenWebhookCAFile, "token_webhook_ca_file", s.TokenWebhookCAFile, "The certificate authority used to verify the certificate of --token_webhook_url. Defaults to the system roots.")
+	fs.DurationVar(&s.TokenWebhookCacheTTL, "token_webhook_cache_ttl", s.TokenWebhookCacheTTL, "How long tokens accepted by --token_webhook_url are remembered.")
+	fs.DurationVar(&s.TokenWebhookRejectTTL, "token_webhook_negative_cache_ttl", s.TokenWebhookRejectTTL, "How long tokens rejected by --token_webhook_url are remembered.")
 	fs.StringVar(&s.AuthorizationMode, "authorization_mode", s.AuthorizationMode, "Selects how to do authorization on the secure port.  One of: "+strings.Join(apiserver.AuthorizationModeChoices, ","))
 	fs.StringVar(&s.AuthorizationPolicyFile, "authorization_policy_file", s.AuthorizationPolicyFile, "File with authorization policy in csv format, used with --authorization_mode=ABAC, on the secure port.")
+	fs.StringVar(&s.AuthorizationWebhookURL, "authorization_webhook_url", s.AuthorizationWebhookURL, "The https URL the attributes of requests are POSTed to for a decision, used with --authorization_mode=Webhook, on the secure port.")
+	fs.StringVar(&s.AuthorizationWebhookCAFile, "authorization_webhook_ca_file", s.AuthorizationWebhookCAFile, "The certificate authority used to verify the certificate of --authorization_webhook_url. Defaults to the system roots.")
+	fs.DurationVar(&s.AuthorizationAllowTTL, "authorization_webhook_cache_allowed_ttl", s.AuthorizationAllowTTL, "How long requests allowed by --authorization_webhook_url are remembered.")
+	fs.DurationVar(&s.AuthorizationDenyTTL, "authorization_webhook_cache_denied_ttl", s.AuthorizationDenyTTL, "How long requests denied by --authorization_webhook_url are remembered.")
+	fs.StringVar(&s.AuditLogPath, "audit_log_path", s.AuditLogPath, "If set, a record of every request to the API server is appended to this file.")
+	fs.IntVar(&s.AuditLogMaxSize, "audit_log_maxsize", s.AuditLogMaxSize, "The size in megabytes the audit log may reach before it is rotated. Zero disables rotation.")
+	fs.IntVar(&s.AuditLogMaxBackups, "audit_log_maxbackups", s.AuditLogMaxBackups, "The number of rotated audit logs to keep.")
+	fs.Var(&s.AuditLogBodyResources, "audit_log_body_resources", "List of resources whose create, update and patch requests are recorded in the audit log with their bodies, comma separated. '*' selects every resource.")
 	fs.StringVar(&s.AdmissionControl, "admission_control", s.AdmissionControl, "Ordered list of plug-ins to do admission control of resources into cluster. Comma-delimited list of: "+strings.Join(admission.GetPlugins(), ", "))
 	fs.StringVar(&s.AdmissionControlConfigFile, "admission_control_config_file", s.AdmissionControlConfigFile, "File with admission control configuration.")
 	fs.Var(&s.EtcdServerList, "etcd_servers", "List of etcd servers to watch (http://ip:port), comma separated. Mutually exclusive with -etcd_config")
@@ -149,6 +187,7 @@ func (s *APIServer) AddFlags(fs *pflag.FlagSet) {
 	fs.Var(&s.PortalNet, "portal_net", "A CIDR notation IP range from which to assign portal IPs. This must not overlap with any IP ranges assigned to nodes for pods.")
 	fs.StringVar(&s.MasterServiceNamespace, "master_service_namespace", s.MasterServiceNamespace, "The namespace from which the kubernetes master services should be injected into pods")
 	fs.BoolVar(&s.SyncPodStatus, "sync_pod_status", s.SyncPodStatus, "If true, periodically fetch pods statuses from kubelets.")
+	fs.IntVar(&s.WatchCacheSize, "watch_cache_size", s.WatchCacheSize, "The number of recent changes to each of pods, controllers, services, endpoints and nodes kept in memory to serve lists and watches without etcd. Zero disables the watch cache.")
 	fs.Var(&s.RuntimeConfig, "runtime_config", "A set of key=value pairs that describe runtime configuration that may be passed to the apiserver.")
 	client.BindKubeletClientConfigFlags(fs, &s.KubeletConfig)
 	fs.StringVar(&s.ClusterName, "cluster_name", s.ClusterName, "The instance prefix for the cluster")
@@ -213,16 +252,41 @@ func (s *APIServer) Run(_ []string) error {
 
 	n := net.IPNet(s.PortalNet)
 
-	authenticator, err := apiserver.NewAuthenticatorFromTokenFile(s.TokenAuthFile)
+	authenticator, err := apiserver.NewAuthenticator(apiserver.AuthenticatorConfig{
+		BasicAuthFile:                s.BasicAuthFile,
+		TokenAuthFile:                s.TokenAuthFile,
+		ServiceAccountTokens:         s.ServiceAccountTokenAuth,
+		KubeClient:                   client,
+		TokenWebhookURL:              s.TokenWebhookURL,
+		TokenWebhookCAFile:           s.TokenWebhookCAFile,
+		TokenWebhookCacheTTL:         s.TokenWebhookCacheTTL,
+		TokenWebhookNegativeCacheTTL: s.TokenWebhookRejectTTL,
+	})
 	if err != nil {
 		glog.Fatalf("Invalid Authentication Config: %v", err)
 	}
 
-	authorizer, err := apiserver.NewAuthorizerFromAuthorizationConfig(s.AuthorizationMode, s.AuthorizationPolicyFile)
+	authorizer, err := apiserver.NewAuthorizerFromAuthorizationConfig(apiserver.AuthorizationConfig{
+		Mode:            s.AuthorizationMode,
+		PolicyFile:      s.AuthorizationPolicyFile,
+		WebhookURL:      s.AuthorizationWebhookURL,
+		WebhookCAFile:   s.AuthorizationWebhookCAFile,
+		WebhookAllowTTL: s.AuthorizationAllowTTL,
+		WebhookDenyTTL:  s.AuthorizationDenyTTL,
+		KubeClient:      client,
+	})
 	if err != nil {
 		glog.Fatalf("Invalid Authorization Config: %v", err)
 	}
 
+	var auditWriter io.Writer
+	if len(s.AuditLogPath) != 0 {
+		auditWriter, err = audit.NewRotatingFile(s.AuditLogPath, int64(s.AuditLogMaxSize)*1024*1024, s.AuditLogMaxBackups)
+		if err != nil {
+			glog.Fatalf("Unable to open audit log %q: %v", s.AuditLogPath, err)
+		}
+	}
+
 	admissionControlPluginNames := strings.Split(s.AdmissionControl, ",")
 	admissionController := admission.NewFromPlugins(client, admissionControlPluginNames, s.AdmissionControlConfigFile)
 
@@ -249,6 +313,9 @@ func (s *APIServer) Run(_ []string) error {
 		MasterServiceNamespace: s.MasterServiceNamespace,
 		ClusterName:            s.ClusterName,
 		SyncPodStatus:          s.SyncPodStatus,
+		WatchCacheSize:         s.WatchCacheSize,
+		AuditWriter:            auditWriter,
+		AuditPolicy:            audit.NewResourcePolicy(s.AuditLogBodyResources...),
 	}
 	m := master.New(config)
 
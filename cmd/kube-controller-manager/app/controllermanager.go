@@ -33,9 +33,13 @@ import (
 	replicationControllerPkg "github.com/GoogleCloudPlatform/kubernetes/pkg/controller"
 	_ "github.com/GoogleCloudPlatform/kubernetes/pkg/healthz"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/master/ports"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/namespace"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/podautoscaler"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/resourcequota"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/service"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/serviceaccount"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/volumeclaimbinder"
 
 	"github.com/golang/glog"
 	"github.com/spf13/pflag"
@@ -43,19 +47,26 @@ import (
 
 // CMServer is the main context object for the controller manager.
 type CMServer struct {
-	Port                    int
-	Address                 util.IP
-	ClientConfig            client.Config
-	CloudProvider           string
-	CloudConfigFile         string
-	MinionRegexp            string
-	NodeSyncPeriod          time.Duration
-	ResourceQuotaSyncPeriod time.Duration
-	RegisterRetryCount      int
-	MachineList             util.StringList
-	SyncNodeList            bool
-	SyncNodeStatus          bool
-	PodEvictionTimeout      time.Duration
+	Port                     int
+	Address                  util.IP
+	ClientConfig             client.Config
+	CloudProvider            string
+	CloudConfigFile          string
+	MinionRegexp             string
+	NodeSyncPeriod           time.Duration
+	ResourceQuotaSyncPeriod  time.Duration
+	NamespaceSyncPeriod      time.Duration
+	ServiceAccountSyncPeriod time.Duration
+	PVClaimBinderSyncPeriod  time.Duration
+	JobSyncPeriod            time.Duration
+	DaemonSyncPeriod         time.Duration
+	DeploymentSyncPeriod     time.Duration
+	AutoscalerSyncPeriod     time.Duration
+	RegisterRetryCount       int
+	MachineList              util.StringList
+	SyncNodeList             bool
+	SyncNodeStatus           bool
+	PodEvictionTimeout       time.Duration
 
 	// TODO: Discover these by pinging the host machines, and rip out these params.
 	NodeMilliCPU int64
@@ -67,16 +78,23 @@ type CMServer struct {
 // NewCMServer creates a new CMServer with a default config.
 func NewCMServer() *CMServer {
 	s := CMServer{
-		Port:                    ports.ControllerManagerPort,
-		Address:                 util.IP(net.ParseIP("127.0.0.1")),
-		NodeSyncPeriod:          10 * time.Second,
-		ResourceQuotaSyncPeriod: 10 * time.Second,
-		RegisterRetryCount:      10,
-		PodEvictionTimeout:      5 * time.Minute,
-		NodeMilliCPU:            1000,
-		NodeMemory:              resource.MustParse("3Gi"),
-		SyncNodeList:            true,
-		SyncNodeStatus:          true,
+		Port:                     ports.ControllerManagerPort,
+		Address:                  util.IP(net.ParseIP("127.0.0.1")),
+		NodeSyncPeriod:           10 * time.Second,
+		ResourceQuotaSyncPeriod:  10 * time.Second,
+		NamespaceSyncPeriod:      1 * time.Minute,
+		ServiceAccountSyncPeriod: 1 * time.Minute,
+		PVClaimBinderSyncPeriod:  10 * time.Second,
+		JobSyncPeriod:            10 * time.Second,
+		DaemonSyncPeriod:         10 * time.Second,
+		DeploymentSyncPeriod:     10 * time.Second,
+		AutoscalerSyncPeriod:     30 * time.Second,
+		RegisterRetryCount:       10,
+		PodEvictionTimeout:       5 * time.Minute,
+		NodeMilliCPU:             1000,
+		NodeMemory:               resource.MustParse("3Gi"),
+		SyncNodeList:             true,
+		SyncNodeStatus:           true,
 		KubeletConfig: client.KubeletConfig{
 			Port:        ports.KubeletPort,
 			EnableHttps: false,
@@ -97,6 +115,13 @@ func (s *CMServer) AddFlags(fs *pflag.FlagSet) {
 		"The period for syncing nodes from cloudprovider. Longer periods will result in "+
 		"fewer calls to cloud provider, but may delay addition of new nodes to cluster.")
 	fs.DurationVar(&s.ResourceQuotaSyncPeriod, "resource_quota_sync_period", s.ResourceQuotaSyncPeriod, "The period for syncing quota usage status in the system")
+	fs.DurationVar(&s.NamespaceSyncPeriod, "namespace_sync_period", s.NamespaceSyncPeriod, "The period for syncing namespace life-cycle updates")
+	fs.DurationVar(&s.ServiceAccountSyncPeriod, "service_account_sync_period", s.ServiceAccountSyncPeriod, "The period for syncing service accounts and their API tokens")
+	fs.DurationVar(&s.PVClaimBinderSyncPeriod, "pvclaimbinder_sync_period", s.PVClaimBinderSyncPeriod, "The period for binding persistent volume claims to persistent volumes")
+	fs.DurationVar(&s.JobSyncPeriod, "job_sync_period", s.JobSyncPeriod, "The period for syncing jobs with the pods that run them")
+	fs.DurationVar(&s.DaemonSyncPeriod, "daemon_sync_period", s.DaemonSyncPeriod, "The period for syncing daemon sets with the pods running on each node")
+	fs.DurationVar(&s.DeploymentSyncPeriod, "deployment_sync_period", s.DeploymentSyncPeriod, "The period for rolling out deployments")
+	fs.DurationVar(&s.AutoscalerSyncPeriod, "autoscaler_sync_period", s.AutoscalerSyncPeriod, "The period for resizing replication controllers to match the CPU usage of their pods")
 	fs.DurationVar(&s.PodEvictionTimeout, "pod_eviction_timeout", s.PodEvictionTimeout, "The grace peroid for deleting pods on failed nodes.")
 	fs.IntVar(&s.RegisterRetryCount, "register_retry_count", s.RegisterRetryCount, ""+
 		"The number of retries for initial node registration.  Retry interval equals node_sync_period.")
@@ -166,6 +191,31 @@ func (s *CMServer) Run(_ []string) error {
 	resourceQuotaManager := resourcequota.NewResourceQuotaManager(kubeClient)
 	resourceQuotaManager.Run(s.ResourceQuotaSyncPeriod)
 
+	namespaceManager := namespace.NewNamespaceManager(kubeClient)
+	namespaceManager.Run(s.NamespaceSyncPeriod)
+
+	serviceAccountManager := serviceaccount.NewServiceAccountManager(kubeClient)
+	serviceAccountManager.Run(s.ServiceAccountSyncPeriod)
+
+	pvClaimBinder := volumeclaimbinder.NewPersistentVolumeClaimBinder(kubeClient)
+	pvClaimBinder.Run(s.PVClaimBinderSyncPeriod)
+
+	jobManager := replicationControllerPkg.NewJobManager(kubeClient)
+	jobManager.Run(s.JobSyncPeriod)
+
+	daemonManager := replicationControllerPkg.NewDaemonManager(kubeClient)
+	daemonManager.Run(s.DaemonSyncPeriod)
+
+	deploymentManager := replicationControllerPkg.NewDeploymentManager(kubeClient)
+	deploymentManager.Run(s.DeploymentSyncPeriod)
+
+	infoGetter, err := client.NewHTTPContainerInfoGetter(&s.KubeletConfig)
+	if err != nil {
+		glog.Fatalf("Failure to start container info getter: %v", err)
+	}
+	horizontalController := podautoscaler.NewHorizontalController(kubeClient, infoGetter)
+	horizontalController.Run(s.AutoscalerSyncPeriod)
+
 	select {}
 	return nil
 }
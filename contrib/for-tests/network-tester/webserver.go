@@ -40,6 +40,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"sync"
 	"time"
 
@@ -217,8 +218,10 @@ func contactOthers(state *State) {
 			time.Sleep(time.Duration(1+rand.Intn(10)) * time.Second)
 		}
 
-		for _, e := range endpoints.Endpoints {
-			contactSingle("http://"+e.IP+":"+string(e.Port), state)
+		for _, port := range endpoints.Ports {
+			for _, e := range port.Endpoints {
+				contactSingle("http://"+e.IP+":"+strconv.Itoa(e.Port), state)
+			}
 		}
 
 		time.Sleep(5 * time.Second)
@@ -37,6 +37,9 @@ const namespaceKey key = 0
 // userKey is the context key for the request user.
 const userKey key = 1
 
+// listPageKey is the context key for the requested page of a list.
+const listPageKey key = 2
+
 // NewContext instantiates a base context object for request flows.
 func NewContext() Context {
 	return context.TODO()
@@ -101,3 +104,21 @@ func UserFrom(ctx Context) (user.Info, bool) {
 	user, ok := ctx.Value(userKey).(user.Info)
 	return user, ok
 }
+
+// ListPage selects a chunk of a list: at most Limit items, or all of them if Limit is zero,
+// following the position recorded in Continue, a token returned with the previous chunk.
+type ListPage struct {
+	Limit    int
+	Continue string
+}
+
+// WithListPage returns a copy of parent in which the list page value is set
+func WithListPage(parent Context, page ListPage) Context {
+	return WithValue(parent, listPageKey, page)
+}
+
+// ListPageFrom returns the value of the list page key on the ctx
+func ListPageFrom(ctx Context) (ListPage, bool) {
+	page, ok := ctx.Value(listPageKey).(ListPage)
+	return page, ok
+}
@@ -66,3 +66,21 @@ func TestValidNamespace(t *testing.T) {
 		t.Errorf("Expected the empty string")
 	}
 }
+
+// TestListPageContext validates that a list page can be get/set on a context object
+func TestListPageContext(t *testing.T) {
+	ctx := api.NewDefaultContext()
+	if _, ok := api.ListPageFrom(ctx); ok {
+		t.Errorf("Should not be ok because there is no list page on the context")
+	}
+
+	page := api.ListPage{Limit: 10, Continue: "token"}
+	ctx = api.WithListPage(ctx, page)
+	result, ok := api.ListPageFrom(ctx)
+	if !ok {
+		t.Errorf("Error getting list page")
+	}
+	if page != result {
+		t.Errorf("Expected: %v, Actual: %v", page, result)
+	}
+}
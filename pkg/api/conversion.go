@@ -45,6 +45,9 @@ func init() {
 			out.Spec.Volumes = in.Volumes
 			out.Spec.RestartPolicy = in.RestartPolicy
 			out.Spec.DNSPolicy = in.DNSPolicy
+			out.Spec.TerminationGracePeriodSeconds = in.TerminationGracePeriodSeconds
+			out.Spec.ServiceAccount = in.ServiceAccount
+			out.Spec.ImagePullSecrets = in.ImagePullSecrets
 			out.Name = in.ID
 			out.UID = in.UUID
 			return nil
@@ -54,6 +57,9 @@ func init() {
 			out.Volumes = in.Spec.Volumes
 			out.RestartPolicy = in.Spec.RestartPolicy
 			out.DNSPolicy = in.Spec.DNSPolicy
+			out.TerminationGracePeriodSeconds = in.Spec.TerminationGracePeriodSeconds
+			out.ServiceAccount = in.Spec.ServiceAccount
+			out.ImagePullSecrets = in.Spec.ImagePullSecrets
 			out.Version = "v1beta2"
 			out.ID = in.Name
 			out.UUID = in.UID
@@ -90,6 +96,7 @@ func init() {
 			out.Name = in.Name
 			out.Namespace = in.Namespace
 			out.CreationTimestamp = in.CreationTimestamp
+			out.DeletionTimestamp = in.DeletionTimestamp
 			return nil
 		},
 
@@ -105,6 +112,9 @@ func init() {
 				return err
 			}
 			out.DNSPolicy = in.DNSPolicy
+			out.TerminationGracePeriodSeconds = in.TerminationGracePeriodSeconds
+			out.ServiceAccount = in.ServiceAccount
+			out.ImagePullSecrets = in.ImagePullSecrets
 			out.Version = "v1beta2"
 			return nil
 		},
@@ -119,6 +129,9 @@ func init() {
 				return err
 			}
 			out.DNSPolicy = in.DNSPolicy
+			out.TerminationGracePeriodSeconds = in.TerminationGracePeriodSeconds
+			out.ServiceAccount = in.ServiceAccount
+			out.ImagePullSecrets = in.ImagePullSecrets
 			return nil
 		},
 	)
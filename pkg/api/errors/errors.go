@@ -219,6 +219,17 @@ func NewTimeoutError(message string) error {
 	}}
 }
 
+// NewExpired returns an error indicating that the requested version of a resource is no longer
+// available and the client must start over from the latest version.
+func NewExpired(message string) error {
+	return &StatusError{api.Status{
+		Status:  api.StatusFailure,
+		Code:    http.StatusGone,
+		Reason:  api.StatusReasonExpired,
+		Message: message,
+	}}
+}
+
 // IsNotFound returns true if the specified error was created by NewNotFoundErr.
 func IsNotFound(err error) bool {
 	return reasonForError(err) == api.StatusReasonNotFound
@@ -262,6 +273,12 @@ func IsServerTimeout(err error) bool {
 	return reasonForError(err) == api.StatusReasonServerTimeout
 }
 
+// IsExpired determines if err is an error which indicates that the requested version of a
+// resource is no longer available.
+func IsExpired(err error) bool {
+	return reasonForError(err) == api.StatusReasonExpired
+}
+
 // IsStatusError determines if err is an API Status error received from the master.
 func IsStatusError(err error) bool {
 	_, ok := err.(*StatusError)
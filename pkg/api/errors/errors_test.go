@@ -52,6 +52,9 @@ func TestErrorNew(t *testing.T) {
 	if IsMethodNotSupported(err) {
 		t.Errorf("expected to not be %s", api.StatusReasonMethodNotAllowed)
 	}
+	if IsExpired(err) {
+		t.Errorf("expected to not be %s", api.StatusReasonExpired)
+	}
 
 	if !IsConflict(NewConflict("test", "2", errors.New("message"))) {
 		t.Errorf("expected to be conflict")
@@ -74,6 +77,9 @@ func TestErrorNew(t *testing.T) {
 	if !IsMethodNotSupported(NewMethodNotSupported("foo", "delete")) {
 		t.Errorf("expected to be %s", api.StatusReasonMethodNotAllowed)
 	}
+	if !IsExpired(NewExpired("reason")) {
+		t.Errorf("expected to be %s", api.StatusReasonExpired)
+	}
 }
 
 func TestNewInvalid(t *testing.T) {
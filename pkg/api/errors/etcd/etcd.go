@@ -32,6 +32,19 @@ func InterpretGetError(err error, kind, name string) error {
 	}
 }
 
+// InterpretListError converts a generic etcd error on a list
+// operation into the appropriate API error.
+func InterpretListError(err error, kind string) error {
+	switch {
+	case err == tools.ErrInvalidContinue:
+		return errors.NewBadRequest(err.Error())
+	case err == tools.ErrContinueExpired:
+		return errors.NewExpired(err.Error())
+	default:
+		return err
+	}
+}
+
 // InterpretCreateError converts a generic etcd error on a create
 // operation into the appropriate API error.
 func InterpretCreateError(err error, kind, name string) error {
@@ -17,7 +17,9 @@ limitations under the License.
 package api
 
 import (
+	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/conversion"
@@ -69,10 +71,65 @@ var standardResources = util.NewStringSet(
 	string(ResourceMemory),
 	string(ResourceCPU),
 	string(ResourcePods),
+	string(ResourceStorage),
 	string(ResourceQuotas),
 	string(ResourceServices),
-	string(ResourceReplicationControllers))
+	string(ResourceReplicationControllers),
+	string(ResourceLimitsCPU),
+	string(ResourceLimitsMemory))
 
 func IsStandardResourceName(str string) bool {
 	return standardResources.Has(str)
 }
+
+var standardFinalizers = util.NewStringSet(
+	string(FinalizerKubernetes))
+
+func IsStandardFinalizerName(str string) bool {
+	return standardFinalizers.Has(str)
+}
+
+// NewDeleteOptions returns a DeleteOptions indicating the resource should
+// be deleted within the specified grace period. Use zero to indicate
+// immediate deletion.
+func NewDeleteOptions(grace int64) *DeleteOptions {
+	return &DeleteOptions{GracePeriodSeconds: &grace}
+}
+
+// ThirdPartyAPIPrefix is the path under which the kinds declared by ThirdPartyResources
+// are served, as <ThirdPartyAPIPrefix>/<group>/<version>.
+const ThirdPartyAPIPrefix = "/thirdparty"
+
+// ThirdPartyResourceKindAndGroup splits the name of a ThirdPartyResource into
+// the kind it declares and the API group of the kind. The first segment of the
+// name is converted from dashed to camel case, so "database-cluster.example.com"
+// declares the kind "DatabaseCluster" in the group "example.com".
+func ThirdPartyResourceKindAndGroup(name string) (kind, group string, err error) {
+	parts := strings.SplitN(name, ".", 2)
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return "", "", fmt.Errorf("third party resource name %q must be of the form <kind>.<group>", name)
+	}
+	for _, word := range strings.Split(parts[0], "-") {
+		if len(word) == 0 {
+			continue
+		}
+		kind += strings.ToUpper(word[:1]) + word[1:]
+	}
+	return kind, parts[1], nil
+}
+
+// ThirdPartyAPIVersion returns the apiVersion objects of a third party kind
+// carry when served under the given group and version.
+func ThirdPartyAPIVersion(group, version string) string {
+	return group + "/" + version
+}
+
+// SplitThirdPartyAPIVersion returns the group and version of a third party
+// apiVersion, or false if apiVersion does not belong to a third party kind.
+func SplitThirdPartyAPIVersion(apiVersion string) (group, version string, ok bool) {
+	i := strings.LastIndex(apiVersion, "/")
+	if i <= 0 || i == len(apiVersion)-1 {
+		return "", "", false
+	}
+	return apiVersion[:i], apiVersion[i+1:], true
+}
@@ -85,3 +85,44 @@ func TestIsStandardResource(t *testing.T) {
 		}
 	}
 }
+
+func TestIsStandardFinalizerName(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output bool
+	}{
+		{"kubernetes", true},
+		{"example.com/finalizer", false},
+		{"blah", false},
+	}
+	for i, tc := range testCases {
+		if IsStandardFinalizerName(tc.input) != tc.output {
+			t.Errorf("case[%d], expected: %t, got: %t", i, tc.output, !tc.output)
+		}
+	}
+}
+
+func TestThirdPartyResourceKindAndGroup(t *testing.T) {
+	tests := []struct {
+		name  string
+		kind  string
+		group string
+		valid bool
+	}{
+		{"database-cluster.example.com", "DatabaseCluster", "example.com", true},
+		{"cron.stable.example.com", "Cron", "stable.example.com", true},
+		{"database", "", "", false},
+		{".example.com", "", "", false},
+		{"database.", "", "", false},
+	}
+	for _, test := range tests {
+		kind, group, err := ThirdPartyResourceKindAndGroup(test.name)
+		if test.valid != (err == nil) {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if kind != test.kind || group != test.group {
+			t.Errorf("%s: expected %s/%s, got %s/%s", test.name, test.group, test.kind, group, kind)
+		}
+	}
+}
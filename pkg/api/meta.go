@@ -25,6 +25,7 @@ import (
 // FillObjectMetaSystemFields populates fields that are managed by the system on ObjectMeta.
 func FillObjectMetaSystemFields(ctx Context, meta *ObjectMeta) {
 	meta.CreationTimestamp = util.Now()
+	meta.DeletionTimestamp = nil
 	meta.UID = util.NewUUID()
 	meta.SelfLink = ""
 }
@@ -48,3 +49,16 @@ func ObjectMetaFor(obj runtime.Object) (*ObjectMeta, error) {
 	}
 	return objectMeta, nil
 }
+
+// ListMetaFor returns a pointer to a provided list's ListMeta.
+func ListMetaFor(obj runtime.Object) (*ListMeta, error) {
+	v, err := conversion.EnforcePtr(obj)
+	if err != nil {
+		return nil, err
+	}
+	var listMeta *ListMeta
+	if err := runtime.FieldPtr(v, "ListMeta", &listMeta); err != nil {
+		return nil, err
+	}
+	return listMeta, nil
+}
@@ -112,7 +112,7 @@ func NewDefaultRESTMapper(versions []string, f VersionInterfacesFunc) *DefaultRE
 }
 
 func (m *DefaultRESTMapper) Add(scope RESTScope, kind string, version string, mixedCase bool) {
-	plural, singular := kindToResource(kind, mixedCase)
+	plural, singular := KindToResource(kind, mixedCase)
 	meta := typeMeta{APIVersion: version, Kind: kind}
 	if _, ok := m.mapping[plural]; !ok {
 		m.mapping[plural] = meta
@@ -126,8 +126,8 @@ func (m *DefaultRESTMapper) Add(scope RESTScope, kind string, version string, mi
 	m.scopes[meta] = scope
 }
 
-// kindToResource converts Kind to a resource name.
-func kindToResource(kind string, mixedCase bool) (plural, singular string) {
+// KindToResource converts Kind to a resource name.
+func KindToResource(kind string, mixedCase bool) (plural, singular string) {
 	if len(kind) == 0 {
 		return
 	}
@@ -225,3 +225,38 @@ func (m *DefaultRESTMapper) RESTMapping(kind string, versions ...string) (*RESTM
 		MetadataAccessor: interfaces.MetadataAccessor,
 	}, nil
 }
+
+// MultiRESTMapper is a RESTMapper that delegates to a list of RESTMappers in
+// order, returning the first successful result. If every mapper fails, the
+// error of the first one is returned.
+type MultiRESTMapper []RESTMapper
+
+// VersionAndKindForResource implements RESTMapper
+func (m MultiRESTMapper) VersionAndKindForResource(resource string) (defaultVersion, kind string, err error) {
+	var firstErr error
+	for _, t := range m {
+		defaultVersion, kind, err = t.VersionAndKindForResource(resource)
+		if err == nil {
+			return defaultVersion, kind, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return "", "", firstErr
+}
+
+// RESTMapping implements RESTMapper
+func (m MultiRESTMapper) RESTMapping(kind string, versions ...string) (*RESTMapping, error) {
+	var firstErr error
+	for _, t := range m {
+		mapping, err := t.RESTMapping(kind, versions...)
+		if err == nil {
+			return mapping, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return nil, firstErr
+}
@@ -110,7 +110,7 @@ func TestKindToResource(t *testing.T) {
 		{Kind: "lowercases", MixedCase: false, Plural: "lowercases", Singular: "lowercases"},
 	}
 	for i, testCase := range testCases {
-		plural, singular := kindToResource(testCase.Kind, testCase.MixedCase)
+		plural, singular := KindToResource(testCase.Kind, testCase.MixedCase)
 		if singular != testCase.Singular || plural != testCase.Plural {
 			t.Errorf("%d: unexpected plural and singular: %s %s", i, plural, singular)
 		}
@@ -233,3 +233,30 @@ func TestRESTMapperReportsErrorOnBadVersion(t *testing.T) {
 		t.Errorf("unexpected non-error")
 	}
 }
+
+func TestMultiRESTMapper(t *testing.T) {
+	first := NewDefaultRESTMapper([]string{"test"}, fakeInterfaces)
+	first.Add(RESTScopeNamespace, "InternalObject", "test", false)
+	second := NewDefaultRESTMapper([]string{"other"}, fakeInterfaces)
+	second.Add(RESTScopeNamespace, "OtherObject", "other", false)
+	mapper := MultiRESTMapper{first, second}
+
+	if v, k, err := mapper.VersionAndKindForResource("otherobjects"); err != nil || v != "other" || k != "OtherObject" {
+		t.Errorf("unexpected result: %s %s %v", v, k, err)
+	}
+	mapping, err := mapper.RESTMapping("InternalObject")
+	if err != nil || mapping.APIVersion != "test" || mapping.Resource != "internalobjects" {
+		t.Errorf("unexpected result: %#v %v", mapping, err)
+	}
+	if _, err := mapper.RESTMapping("OtherObject", "other"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	_, _, err = mapper.VersionAndKindForResource("unknown")
+	if _, _, expected := first.VersionAndKindForResource("unknown"); err == nil || err.Error() != expected.Error() {
+		t.Errorf("expected the error of the first mapper, got %v", err)
+	}
+	if _, err := mapper.RESTMapping("Unknown"); err == nil {
+		t.Errorf("unexpected non-error")
+	}
+}
@@ -46,3 +46,18 @@ func TestHasObjectMetaSystemFieldValues(t *testing.T) {
 		t.Errorf("the resource does have all fields populated, but incorrectly reports it does not")
 	}
 }
+
+func TestListMetaFor(t *testing.T) {
+	list := &api.PodList{ListMeta: api.ListMeta{ResourceVersion: "1"}}
+	listMeta, err := api.ListMetaFor(list)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	listMeta.Continue = "token"
+	if list.ResourceVersion != "1" || list.Continue != "token" {
+		t.Errorf("expected the list meta of the list to be returned, got %#v", list.ListMeta)
+	}
+	if _, err := api.ListMetaFor(&api.Pod{}); err == nil {
+		t.Errorf("expected an error for an object without list meta")
+	}
+}
@@ -44,6 +44,10 @@ func AllFuncs(fns ...ObjectFunc) ObjectFunc {
 	}
 }
 
+// PatchFunc computes the new state of an object from its current state. It may be called
+// more than once if the object changes before the result can be stored.
+type PatchFunc func(existing runtime.Object) (runtime.Object, error)
+
 // rcStrategy implements behavior for Replication Controllers.
 // TODO: move to a replicationcontroller specific package.
 type rcStrategy struct {
@@ -136,7 +140,7 @@ func (nodeStrategy) Validate(obj runtime.Object) errors.ValidationErrorList {
 	return validation.ValidateMinion(node)
 }
 
-// namespaceStrategy implements behavior for nodes
+// namespaceStrategy implements behavior for namespaces
 type namespaceStrategy struct {
 	runtime.ObjectTyper
 	api.NameGenerator
@@ -152,9 +156,24 @@ func (namespaceStrategy) NamespaceScoped() bool {
 }
 
 // ResetBeforeCreate clears fields that are not allowed to be set by end users on creation.
+// Every new namespace starts out Active and carries the kubernetes finalizer, so its
+// content is cleaned up before it is removed.
 func (namespaceStrategy) ResetBeforeCreate(obj runtime.Object) {
-	_ = obj.(*api.Namespace)
-	// Namespace allow *all* fields, including status, to be set.
+	namespace := obj.(*api.Namespace)
+	namespace.Status = api.NamespaceStatus{
+		Phase: api.NamespaceActive,
+	}
+
+	hasKubeFinalizer := false
+	for i := range namespace.Spec.Finalizers {
+		if namespace.Spec.Finalizers[i] == api.FinalizerKubernetes {
+			hasKubeFinalizer = true
+			break
+		}
+	}
+	if !hasKubeFinalizer {
+		namespace.Spec.Finalizers = append(namespace.Spec.Finalizers, api.FinalizerKubernetes)
+	}
 }
 
 // Validate validates a new namespace.
@@ -73,6 +73,11 @@ func FuzzerFor(t *testing.T, version string, src rand.Source) *fuzz.Fuzzer {
 			c.Fuzz(&sec)
 			c.Fuzz(&nsec)
 			j.CreationTimestamp = util.Unix(sec, nsec).Rfc3339Copy()
+			if c.RandBool() {
+				t := util.Time{}
+				c.Fuzz(&t)
+				j.DeletionTimestamp = &t
+			}
 		},
 		func(j *api.ObjectReference, c fuzz.Continue) {
 			// We have to customize the randomization of TypeMetas because their
@@ -87,6 +92,7 @@ func FuzzerFor(t *testing.T, version string, src rand.Source) *fuzz.Fuzzer {
 		func(j *api.ListMeta, c fuzz.Continue) {
 			j.ResourceVersion = strconv.FormatUint(c.RandUint64(), 10)
 			j.SelfLink = c.RandString()
+			j.Continue = c.RandString()
 		},
 		func(j *api.PodPhase, c fuzz.Continue) {
 			statuses := []api.PodPhase{api.PodPending, api.PodRunning, api.PodFailed, api.PodUnknown}
@@ -113,6 +119,46 @@ func FuzzerFor(t *testing.T, version string, src rand.Source) *fuzz.Fuzzer {
 			// only replicas round trips
 			j.Replicas = int(c.RandUint64())
 		},
+		func(j *api.JobSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(j) // fuzz self without calling this function again
+			// defaulted fields must be set for round trip
+			completions := c.Rand.Int()
+			parallelism := c.Rand.Int()
+			j.Completions = &completions
+			j.Parallelism = &parallelism
+			if len(j.Selector) == 0 {
+				j.Selector = map[string]string{"job": c.RandString()}
+			}
+		},
+		func(j *api.DaemonSetSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(j) // fuzz self without calling this function again
+			// a defaulted selector must be set for round trip
+			if len(j.Selector) == 0 {
+				j.Selector = map[string]string{"daemon": c.RandString()}
+			}
+		},
+		func(j *api.DeploymentSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(j) // fuzz self without calling this function again
+			// defaulted fields must be set for round trip
+			if len(j.Selector) == 0 {
+				j.Selector = map[string]string{"deployment": c.RandString()}
+			}
+			if c.RandBool() {
+				j.Strategy.Type = api.RecreateDeploymentStrategyType
+			} else {
+				j.Strategy.Type = api.RollingUpdateDeploymentStrategyType
+				if j.Strategy.RollingUpdate == nil {
+					j.Strategy.RollingUpdate = &api.RollingUpdateDeployment{}
+					c.Fuzz(j.Strategy.RollingUpdate)
+				}
+			}
+		},
+		func(j *api.HorizontalPodAutoscalerSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(j) // fuzz self without calling this function again
+			// defaulted fields must be set for round trip
+			j.MinReplicas = 1 + c.Rand.Intn(10)
+			j.TargetCPUUtilizationPercentage = 1 + c.Rand.Intn(100)
+		},
 		func(j *api.List, c fuzz.Continue) {
 			c.FuzzNoCustom(j) // fuzz self without calling this function again
 			if j.Items == nil {
@@ -156,6 +202,18 @@ func FuzzerFor(t *testing.T, version string, src rand.Source) *fuzz.Fuzzer {
 			//q.Amount.SetScale(inf.Scale(-c.Intn(12)))
 			q.Amount.SetUnscaled(c.Int63n(1000))
 		},
+		func(j *api.ResourceRequirements, c fuzz.Continue) {
+			c.FuzzNoCustom(j) // fuzz self without calling this function again
+			// Requests of a container default to its limits.
+			for name, limit := range j.Limits {
+				if _, found := j.Requests[name]; !found {
+					if j.Requests == nil {
+						j.Requests = api.ResourceList{}
+					}
+					j.Requests[name] = limit
+				}
+			}
+		},
 		func(p *api.PullPolicy, c fuzz.Continue) {
 			policies := []api.PullPolicy{api.PullAlways, api.PullNever, api.PullIfNotPresent}
 			*p = policies[c.Rand.Intn(len(policies))]
@@ -167,7 +225,12 @@ func FuzzerFor(t *testing.T, version string, src rand.Source) *fuzz.Fuzzer {
 		func(vs *api.VolumeSource, c fuzz.Continue) {
 			// Exactly one of the fields should be set.
 			//FIXME: the fuzz can still end up nil.  What if fuzz allowed me to say that?
-			fuzzOneOf(c, &vs.HostPath, &vs.EmptyDir, &vs.GCEPersistentDisk, &vs.GitRepo, &vs.Secret)
+			fuzzOneOf(c, &vs.HostPath, &vs.EmptyDir, &vs.GCEPersistentDisk, &vs.GitRepo, &vs.Secret, &vs.DownwardAPI)
+			if vs.EmptyDir != nil {
+				// Refuzz with the quantity funcs above so that SizeLimit survives
+				// round-tripping to v1beta1/2.
+				c.Fuzz(vs.EmptyDir)
+			}
 		},
 		func(d *api.DNSPolicy, c fuzz.Continue) {
 			policies := []api.DNSPolicy{api.DNSClusterFirst, api.DNSDefault}
@@ -209,11 +272,19 @@ func FuzzerFor(t *testing.T, version string, src rand.Source) *fuzz.Fuzzer {
 		},
 		func(ss *api.ServiceSpec, c fuzz.Continue) {
 			c.FuzzNoCustom(ss) // fuzz self without calling this function again
-			switch ss.ContainerPort.Kind {
+			if len(ss.Ports) == 0 {
+				// services must have at least one port
+				ss.Ports = make([]api.ServicePort, 1)
+				c.Fuzz(&ss.Ports[0])
+			}
+		},
+		func(sp *api.ServicePort, c fuzz.Continue) {
+			c.FuzzNoCustom(sp) // fuzz self without calling this function again
+			switch sp.ContainerPort.Kind {
 			case util.IntstrInt:
-				ss.ContainerPort.IntVal = 1 + ss.ContainerPort.IntVal%65535 // non-zero
+				sp.ContainerPort.IntVal = 1 + sp.ContainerPort.IntVal%65535 // non-zero
 			case util.IntstrString:
-				ss.ContainerPort.StrVal = "x" + ss.ContainerPort.StrVal // non-empty
+				sp.ContainerPort.StrVal = "x" + sp.ContainerPort.StrVal // non-empty
 			}
 		},
 	)
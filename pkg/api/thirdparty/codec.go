@@ -0,0 +1,211 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thirdparty
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+)
+
+// thirdPartyResourceDataCodec encodes and decodes the objects of one third party kind.
+// Objects are kept as the JSON the client provided; only the "metadata" field is
+// interpreted, as standard object metadata. The kind and apiVersion fields are set
+// to those the codec serves on encode. All other objects, such as Status, are
+// handled by the delegate.
+type thirdPartyResourceDataCodec struct {
+	delegate   runtime.Codec
+	kind       string
+	apiVersion string
+}
+
+// NewCodec returns a codec for the objects of the given third party kind, which are
+// served with the given apiVersion.
+func NewCodec(delegate runtime.Codec, kind, apiVersion string) runtime.Codec {
+	return &thirdPartyResourceDataCodec{delegate, kind, apiVersion}
+}
+
+// thirdPartyObject holds the fields of a third party object the apiserver understands.
+type thirdPartyObject struct {
+	Kind       string         `json:"kind,omitempty"`
+	APIVersion string         `json:"apiVersion,omitempty"`
+	ObjectMeta api.ObjectMeta `json:"metadata,omitempty"`
+}
+
+// thirdPartyList is the serialized form of a list of third party objects.
+type thirdPartyList struct {
+	Kind       string            `json:"kind,omitempty"`
+	APIVersion string            `json:"apiVersion,omitempty"`
+	ListMeta   api.ListMeta      `json:"metadata,omitempty"`
+	Items      []json.RawMessage `json:"items"`
+}
+
+func (c *thirdPartyResourceDataCodec) Decode(data []byte) (runtime.Object, error) {
+	var typeMeta api.TypeMeta
+	if err := json.Unmarshal(data, &typeMeta); err != nil {
+		return nil, err
+	}
+	switch typeMeta.Kind {
+	case c.kind:
+		obj := &api.ThirdPartyResourceData{}
+		return obj, c.decodeObject(data, obj)
+	case c.kind + "List":
+		list := &api.ThirdPartyResourceDataList{}
+		return list, c.decodeList(data, list)
+	}
+	return c.delegate.Decode(data)
+}
+
+func (c *thirdPartyResourceDataCodec) DecodeInto(data []byte, obj runtime.Object) error {
+	switch t := obj.(type) {
+	case *api.ThirdPartyResourceData:
+		return c.decodeObject(data, t)
+	case *api.ThirdPartyResourceDataList:
+		return c.decodeList(data, t)
+	}
+	return c.delegate.DecodeInto(data, obj)
+}
+
+func (c *thirdPartyResourceDataCodec) decodeObject(data []byte, obj *api.ThirdPartyResourceData) error {
+	object := thirdPartyObject{}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return err
+	}
+	if len(object.Kind) > 0 && object.Kind != c.kind {
+		return fmt.Errorf("expected an object of kind %q, got %q", c.kind, object.Kind)
+	}
+	obj.TypeMeta = api.TypeMeta{Kind: c.kind, APIVersion: c.apiVersion}
+	obj.ObjectMeta = object.ObjectMeta
+	obj.Data = data
+	return nil
+}
+
+func (c *thirdPartyResourceDataCodec) decodeList(data []byte, list *api.ThirdPartyResourceDataList) error {
+	serialized := thirdPartyList{}
+	if err := json.Unmarshal(data, &serialized); err != nil {
+		return err
+	}
+	if len(serialized.Kind) > 0 && serialized.Kind != c.kind+"List" {
+		return fmt.Errorf("expected a list of kind %q, got %q", c.kind+"List", serialized.Kind)
+	}
+	list.TypeMeta = api.TypeMeta{Kind: c.kind + "List", APIVersion: c.apiVersion}
+	list.ListMeta = serialized.ListMeta
+	list.Items = make([]api.ThirdPartyResourceData, len(serialized.Items))
+	for i := range serialized.Items {
+		if err := c.decodeObject(serialized.Items[i], &list.Items[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *thirdPartyResourceDataCodec) Encode(obj runtime.Object) ([]byte, error) {
+	switch t := obj.(type) {
+	case *api.ThirdPartyResourceData:
+		return c.encodeObject(t)
+	case *api.ThirdPartyResourceDataList:
+		return c.encodeList(t)
+	}
+	return c.delegate.Encode(obj)
+}
+
+func (c *thirdPartyResourceDataCodec) encodeObject(obj *api.ThirdPartyResourceData) ([]byte, error) {
+	// decode into raw messages, so that values the apiserver does not understand
+	// (such as large numbers) are written back unchanged
+	fields := map[string]json.RawMessage{}
+	if len(obj.Data) > 0 {
+		if err := json.Unmarshal(obj.Data, &fields); err != nil {
+			return nil, err
+		}
+	}
+	metadata, err := json.Marshal(&obj.ObjectMeta)
+	if err != nil {
+		return nil, err
+	}
+	fields["metadata"] = metadata
+	fields["kind"], _ = json.Marshal(c.kind)
+	fields["apiVersion"], _ = json.Marshal(c.apiVersion)
+	return json.Marshal(fields)
+}
+
+func (c *thirdPartyResourceDataCodec) encodeList(list *api.ThirdPartyResourceDataList) ([]byte, error) {
+	serialized := thirdPartyList{
+		Kind:       c.kind + "List",
+		APIVersion: c.apiVersion,
+		ListMeta:   list.ListMeta,
+		Items:      make([]json.RawMessage, len(list.Items)),
+	}
+	for i := range list.Items {
+		data, err := c.encodeObject(&list.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		serialized.Items[i] = data
+	}
+	return json.Marshal(&serialized)
+}
+
+// thirdPartyResourceDataTyper reports the kind and apiVersion of the objects of one
+// third party kind, and defers to the delegate for all other objects.
+type thirdPartyResourceDataTyper struct {
+	delegate   runtime.ObjectTyper
+	kind       string
+	apiVersion string
+}
+
+// NewObjectTyper returns an ObjectTyper for the objects of the given third party kind.
+func NewObjectTyper(delegate runtime.ObjectTyper, kind, apiVersion string) runtime.ObjectTyper {
+	return &thirdPartyResourceDataTyper{delegate, kind, apiVersion}
+}
+
+func (t *thirdPartyResourceDataTyper) DataVersionAndKind(data []byte) (version, kind string, err error) {
+	return t.delegate.DataVersionAndKind(data)
+}
+
+func (t *thirdPartyResourceDataTyper) ObjectVersionAndKind(obj runtime.Object) (version, kind string, err error) {
+	switch obj.(type) {
+	case *api.ThirdPartyResourceData:
+		return t.apiVersion, t.kind, nil
+	case *api.ThirdPartyResourceDataList:
+		return t.apiVersion, t.kind + "List", nil
+	}
+	return t.delegate.ObjectVersionAndKind(obj)
+}
+
+// thirdPartyResourceDataCreater instantiates the objects of one third party kind,
+// and defers to the delegate for all other kinds.
+type thirdPartyResourceDataCreater struct {
+	delegate runtime.ObjectCreater
+	kind     string
+}
+
+// NewObjectCreater returns an ObjectCreater for the objects of the given third party kind.
+func NewObjectCreater(delegate runtime.ObjectCreater, kind string) runtime.ObjectCreater {
+	return &thirdPartyResourceDataCreater{delegate, kind}
+}
+
+func (c *thirdPartyResourceDataCreater) New(version, kind string) (runtime.Object, error) {
+	switch kind {
+	case c.kind:
+		return &api.ThirdPartyResourceData{}, nil
+	case c.kind + "List":
+		return &api.ThirdPartyResourceDataList{}, nil
+	}
+	return c.delegate.New(version, kind)
+}
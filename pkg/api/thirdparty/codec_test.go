@@ -0,0 +1,138 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thirdparty
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+)
+
+func TestCodecDecodeObject(t *testing.T) {
+	codec := NewCodec(latest.Codec, "DatabaseCluster", "example.com/v1")
+	data := []byte(`{"kind":"DatabaseCluster","apiVersion":"example.com/v1","metadata":{"name":"db","namespace":"test","labels":{"app":"db"}},"replicas":3}`)
+	obj, err := codec.Decode(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	object, ok := obj.(*api.ThirdPartyResourceData)
+	if !ok {
+		t.Fatalf("unexpected object: %#v", obj)
+	}
+	if object.Name != "db" || object.Namespace != "test" || !reflect.DeepEqual(object.Labels, map[string]string{"app": "db"}) {
+		t.Errorf("unexpected metadata: %#v", object.ObjectMeta)
+	}
+	if object.Kind != "DatabaseCluster" || object.APIVersion != "example.com/v1" {
+		t.Errorf("unexpected type metadata: %#v", object.TypeMeta)
+	}
+	if string(object.Data) != string(data) {
+		t.Errorf("unexpected data: %s", string(object.Data))
+	}
+
+	if err := codec.DecodeInto([]byte(`{"kind":"Other","metadata":{"name":"db"}}`), &api.ThirdPartyResourceData{}); err == nil {
+		t.Errorf("expected an error decoding an object of another kind")
+	}
+	if err := codec.DecodeInto([]byte(`["not","an","object"]`), &api.ThirdPartyResourceData{}); err == nil {
+		t.Errorf("expected an error decoding a non-object")
+	}
+}
+
+func TestCodecEncodeObject(t *testing.T) {
+	codec := NewCodec(latest.Codec, "DatabaseCluster", "example.com/v2")
+	obj := &api.ThirdPartyResourceData{
+		ObjectMeta: api.ObjectMeta{Name: "db", Namespace: "test", ResourceVersion: "10"},
+		Data:       []byte(`{"kind":"DatabaseCluster","apiVersion":"example.com/v1","metadata":{"name":"old"},"size":12345678901234567890}`),
+	}
+	data, err := codec.Encode(obj)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(fields["kind"]) != `"DatabaseCluster"` || string(fields["apiVersion"]) != `"example.com/v2"` {
+		t.Errorf("unexpected type fields: %s", string(data))
+	}
+	if string(fields["size"]) != "12345678901234567890" {
+		t.Errorf("expected data to be preserved: %s", string(data))
+	}
+
+	decoded := &api.ThirdPartyResourceData{}
+	if err := codec.DecodeInto(data, decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decoded.Name != "db" || decoded.Namespace != "test" || decoded.ResourceVersion != "10" {
+		t.Errorf("unexpected metadata: %#v", decoded.ObjectMeta)
+	}
+}
+
+func TestCodecList(t *testing.T) {
+	codec := NewCodec(latest.Codec, "DatabaseCluster", "example.com/v1")
+	list := &api.ThirdPartyResourceDataList{
+		ListMeta: api.ListMeta{ResourceVersion: "5"},
+		Items: []api.ThirdPartyResourceData{
+			{ObjectMeta: api.ObjectMeta{Name: "a"}, Data: []byte(`{"size":1}`)},
+			{ObjectMeta: api.ObjectMeta{Name: "b"}},
+		},
+	}
+	data, err := codec.Encode(list)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj, err := codec.Decode(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	decoded, ok := obj.(*api.ThirdPartyResourceDataList)
+	if !ok {
+		t.Fatalf("unexpected object: %#v", obj)
+	}
+	if decoded.Kind != "DatabaseClusterList" || decoded.ResourceVersion != "5" || len(decoded.Items) != 2 {
+		t.Fatalf("unexpected list: %#v", decoded)
+	}
+	if decoded.Items[0].Name != "a" || decoded.Items[1].Name != "b" {
+		t.Errorf("unexpected items: %#v", decoded.Items)
+	}
+}
+
+func TestCodecDelegates(t *testing.T) {
+	codec := NewCodec(latest.Codec, "DatabaseCluster", "example.com/v1")
+	status := &api.Status{Status: api.StatusFailure, Message: "failed"}
+	data, err := codec.Encode(status)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj, err := codec.Decode(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decoded, ok := obj.(*api.Status); !ok || decoded.Message != "failed" {
+		t.Errorf("unexpected object: %#v", obj)
+	}
+
+	typer := NewObjectTyper(api.Scheme, "DatabaseCluster", "example.com/v1")
+	if version, kind, err := typer.ObjectVersionAndKind(&api.ThirdPartyResourceDataList{}); err != nil || version != "example.com/v1" || kind != "DatabaseClusterList" {
+		t.Errorf("unexpected version and kind: %s %s %v", version, kind, err)
+	}
+	if _, kind, err := typer.ObjectVersionAndKind(status); err != nil || kind != "Status" {
+		t.Errorf("unexpected kind: %s %v", kind, err)
+	}
+}
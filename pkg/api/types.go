@@ -71,6 +71,11 @@ type ListMeta struct {
 	// and values may only be valid for a particular resource or set of resources. Only servers
 	// will generate resource versions.
 	ResourceVersion string `json:"resourceVersion,omitempty"`
+
+	// Continue is set when a list was requested with a limit and more items remain. Passing it
+	// back as the continue parameter returns the next chunk of the list, consistent with the
+	// resource version of the first chunk.
+	Continue string `json:"continue,omitempty"`
 }
 
 // ObjectMeta is metadata that all persisted resources must have, which includes all objects
@@ -120,6 +125,14 @@ type ObjectMeta struct {
 	// Clients may not set this value. It is represented in RFC3339 form and is in UTC.
 	CreationTimestamp util.Time `json:"creationTimestamp,omitempty"`
 
+	// DeletionTimestamp is the time after which this resource will be deleted. It is set by
+	// the server when a graceful deletion is requested and may not be set directly by clients.
+	// Once set, the value may be shortened by a later delete request but never extended or
+	// unset. The resource remains visible until it is deleted, which may happen before this
+	// time. For example, when a pod is deleted with a grace period the Kubelet sends a graceful
+	// termination signal to its containers, and kills them once the grace period expires.
+	DeletionTimestamp *util.Time `json:"deletionTimestamp,omitempty"`
+
 	// Labels are key value pairs that may be used to scope and select individual resources.
 	// Label keys are of the form:
 	//     label-key ::= prefixed-name | name
@@ -180,6 +193,15 @@ type VolumeSource struct {
 	GitRepo *GitRepoVolumeSource `json:"gitRepo"`
 	// Secret represents a secret that should populate this volume.
 	Secret *SecretVolumeSource `json:"secret"`
+	// DownwardAPI represents metadata about the pod that should populate this volume.
+	DownwardAPI *DownwardAPIVolumeSource `json:"downwardAPI"`
+	// PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.
+	PersistentVolumeClaimVolumeSource *PersistentVolumeClaimVolumeSource `json:"persistentVolumeClaim,omitempty"`
+	// NFS represents an NFS mount on the host that shares a pod's lifetime.
+	NFS *NFSVolumeSource `json:"nfs"`
+	// ISCSI represents an ISCSI Disk resource that is attached to a
+	// kubelet's host machine and then exposed to the pod.
+	ISCSI *ISCSIVolumeSource `json:"iscsi"`
 }
 
 // HostPathVolumeSource represents bare host directory volume.
@@ -187,7 +209,200 @@ type HostPathVolumeSource struct {
 	Path string `json:"path"`
 }
 
-type EmptyDirVolumeSource struct{}
+// EmptyDirVolumeSource represents an empty directory for a pod.
+type EmptyDirVolumeSource struct {
+	// Optional: what type of storage medium should back this directory.
+	// The default is "" which means to use the node's default medium.
+	Medium StorageType `json:"medium"`
+	// Optional: the amount of storage a Memory medium may use. It is counted
+	// against the memory of the pod when scheduling; a Memory medium without
+	// a limit is counted as 64Mi.
+	SizeLimit *resource.Quantity `json:"sizeLimit,omitempty"`
+}
+
+// StorageType defines ways that storage can be allocated to a volume.
+type StorageType string
+
+const (
+	StorageTypeDefault StorageType = ""       // use whatever the default is for the node
+	StorageTypeMemory  StorageType = "Memory" // use memory (tmpfs)
+)
+
+// NFSVolumeSource represents an NFS mount that lasts the lifetime of a pod.
+type NFSVolumeSource struct {
+	// Server is the hostname or IP address of the NFS server.
+	Server string `json:"server"`
+	// Path is the exported NFS share.
+	Path string `json:"path"`
+	// Optional: Defaults to false (read/write). ReadOnly here will force
+	// the NFS export to be mounted with read-only permissions.
+	ReadOnly bool `json:"readOnly,omitempty"`
+}
+
+// ISCSIVolumeSource describes an iSCSI disk that is attached to the kubelet's
+// host machine through an iSCSI login and then mounted into the pod.
+type ISCSIVolumeSource struct {
+	// Required: iSCSI target portal, an IP or ip_addr:port
+	TargetPortal string `json:"targetPortal"`
+	// Required: target iSCSI Qualified Name
+	IQN string `json:"iqn"`
+	// Required: iSCSI target lun number
+	Lun int `json:"lun"`
+	// Required: Filesystem type to mount.
+	// Must be a filesystem type supported by the host operating system.
+	// Ex. "ext4", "xfs", "ntfs"
+	// TODO: how do we prevent errors in the filesystem from compromising the machine
+	FSType string `json:"fsType"`
+	// Optional: Defaults to false (read/write). ReadOnly here will force
+	// the ReadOnly setting in VolumeMounts.
+	ReadOnly bool `json:"readOnly,omitempty"`
+}
+
+// PersistentVolumeClaimVolumeSource references the user's PersistentVolumeClaim in the same
+// namespace. The kubelet resolves the claim to the PersistentVolume bound to it and mounts
+// that volume.
+type PersistentVolumeClaimVolumeSource struct {
+	// ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod.
+	ClaimName string `json:"claimName"`
+	// Optional: Defaults to false (read/write). ReadOnly here will force
+	// the ReadOnly setting in VolumeMounts.
+	ReadOnly bool `json:"readOnly,omitempty"`
+}
+
+// PersistentVolumeSource is similar to VolumeSource but meant for the administrator
+// who creates PersistentVolumes. Exactly one of its members must be set.
+type PersistentVolumeSource struct {
+	// GCEPersistentDisk represents a GCE Disk resource that is attached to a
+	// kubelet's host machine and then exposed to the pod.
+	GCEPersistentDisk *GCEPersistentDiskVolumeSource `json:"persistentDisk,omitempty"`
+	// HostPath represents a directory on the host. This is useful for
+	// development and testing only; on-host storage is not supported in any way.
+	HostPath *HostPathVolumeSource `json:"hostPath,omitempty"`
+	// NFS represents an NFS mount on the host.
+	NFS *NFSVolumeSource `json:"nfs,omitempty"`
+	// ISCSI represents an ISCSI Disk resource that is attached to a
+	// kubelet's host machine and then exposed to the pod.
+	ISCSI *ISCSIVolumeSource `json:"iscsi,omitempty"`
+}
+
+// PersistentVolume is a piece of storage provisioned by the cluster administrator.
+// PersistentVolumes are not namespaced and are bound to PersistentVolumeClaims.
+type PersistentVolume struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines a persistent volume owned by the cluster.
+	Spec PersistentVolumeSpec `json:"spec,omitempty"`
+
+	// Status represents the current information about the persistent volume.
+	Status PersistentVolumeStatus `json:"status,omitempty"`
+}
+
+// PersistentVolumeSpec describes the storage offered by a PersistentVolume.
+type PersistentVolumeSpec struct {
+	// Capacity represents the actual resources of the volume.
+	Capacity ResourceList `json:"capacity,omitempty"`
+	// Source represents the location and type of a volume to mount.
+	PersistentVolumeSource `json:",inline"`
+	// AccessModes contains all ways the volume can be mounted.
+	AccessModes []AccessModeType `json:"accessModes,omitempty"`
+	// ClaimRef is part of a bi-directional binding between PersistentVolume and PersistentVolumeClaim.
+	// ClaimRef is expected to be non-nil when bound.
+	ClaimRef *ObjectReference `json:"claimRef,omitempty"`
+}
+
+// PersistentVolumeStatus is the current status of a PersistentVolume.
+type PersistentVolumeStatus struct {
+	// Phase indicates if a volume is available, bound to a claim, or released by a claim.
+	Phase PersistentVolumePhase `json:"phase,omitempty"`
+}
+
+// PersistentVolumeList is a list of PersistentVolume objects.
+type PersistentVolumeList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []PersistentVolume `json:"items"`
+}
+
+// PersistentVolumeClaim is a user's request for and claim to a persistent volume.
+type PersistentVolumeClaim struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the volume requested by a pod author.
+	Spec PersistentVolumeClaimSpec `json:"spec,omitempty"`
+
+	// Status represents the current information about a claim.
+	Status PersistentVolumeClaimStatus `json:"status,omitempty"`
+}
+
+// PersistentVolumeClaimList is a list of PersistentVolumeClaim objects.
+type PersistentVolumeClaimList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []PersistentVolumeClaim `json:"items"`
+}
+
+// PersistentVolumeClaimSpec describes the common attributes of storage devices
+// and allows a Source for provider-specific attributes.
+type PersistentVolumeClaimSpec struct {
+	// AccessModes contains the desired access modes the volume should have.
+	AccessModes []AccessModeType `json:"accessModes,omitempty"`
+	// Resources represents the minimum resources the volume should have.
+	// The amount of storage is requested with the "storage" resource.
+	Resources ResourceRequirements `json:"resources,omitempty"`
+}
+
+// PersistentVolumeClaimStatus is the current status of a PersistentVolumeClaim.
+type PersistentVolumeClaimStatus struct {
+	// Phase represents the current phase of PersistentVolumeClaim.
+	Phase PersistentVolumeClaimPhase `json:"phase,omitempty"`
+	// AccessModes contains the actual access modes the volume has.
+	AccessModes []AccessModeType `json:"accessModes,omitempty"`
+	// Capacity represents the actual resources of the underlying volume.
+	Capacity ResourceList `json:"capacity,omitempty"`
+	// VolumeRef is a reference to the PersistentVolume bound to the PersistentVolumeClaim.
+	VolumeRef *ObjectReference `json:"volumeRef,omitempty"`
+}
+
+// AccessModeType describes the ways a PersistentVolume can be mounted.
+type AccessModeType string
+
+const (
+	// ReadWriteOnce can be mounted read/write by a single host.
+	ReadWriteOnce AccessModeType = "ReadWriteOnce"
+	// ReadOnlyMany can be mounted read-only by many hosts.
+	ReadOnlyMany AccessModeType = "ReadOnlyMany"
+	// ReadWriteMany can be mounted read/write by many hosts.
+	ReadWriteMany AccessModeType = "ReadWriteMany"
+)
+
+// PersistentVolumePhase is the lifecycle phase of a PersistentVolume.
+type PersistentVolumePhase string
+
+const (
+	// VolumePending is used for PersistentVolumes that are not yet available.
+	VolumePending PersistentVolumePhase = "Pending"
+	// VolumeAvailable is used for PersistentVolumes that are not yet bound.
+	VolumeAvailable PersistentVolumePhase = "Available"
+	// VolumeBound is used for PersistentVolumes that are bound to a claim.
+	VolumeBound PersistentVolumePhase = "Bound"
+	// VolumeReleased is used for PersistentVolumes whose claim was deleted.
+	// A released volume is not bound again until an administrator reclaims it.
+	VolumeReleased PersistentVolumePhase = "Released"
+)
+
+// PersistentVolumeClaimPhase is the lifecycle phase of a PersistentVolumeClaim.
+type PersistentVolumeClaimPhase string
+
+const (
+	// ClaimPending is used for PersistentVolumeClaims that are not yet bound.
+	ClaimPending PersistentVolumeClaimPhase = "Pending"
+	// ClaimBound is used for PersistentVolumeClaims that are bound to a volume.
+	ClaimBound PersistentVolumeClaimPhase = "Bound"
+)
 
 // Protocol defines network protocols supported for things like conatiner ports.
 type Protocol string
@@ -239,6 +454,25 @@ type SecretVolumeSource struct {
 	Target ObjectReference `json:"target"`
 }
 
+// DownwardAPIVolumeSource represents a volume containing information about the pod.
+//
+// Each item is written to a file in the volume, and the files are updated
+// when the selected fields of the pod change.
+type DownwardAPIVolumeSource struct {
+	// Items is a list of files to populate the volume with.
+	Items []DownwardAPIVolumeFile `json:"items,omitempty"`
+}
+
+// DownwardAPIVolumeFile represents a single file containing information about the pod.
+type DownwardAPIVolumeFile struct {
+	// Required: Path is the relative path of the file to be created. Must not be
+	// absolute or contain the '..' path element.
+	Path string `json:"path"`
+	// Required: Selects a field of the pod. Only name, namespace, labels and
+	// annotations are supported.
+	FieldRef ObjectFieldSelector `json:"fieldRef"`
+}
+
 // ContainerPort represents a network port in a single container
 type ContainerPort struct {
 	// Optional: If specified, this must be a DNS_LABEL.  Each named port
@@ -270,6 +504,22 @@ type EnvVar struct {
 	Name string `json:"name"`
 	// Optional: defaults to "".
 	Value string `json:"value,omitempty"`
+	// Optional: Specifies a source the value of this var should come from.
+	// May not be set if Value is not empty.
+	ValueFrom *EnvVarSource `json:"valueFrom,omitempty"`
+}
+
+// EnvVarSource represents a source for the value of an EnvVar.
+type EnvVarSource struct {
+	// Required: Selects a field of the pod. Only name, namespace and podIP are
+	// supported.
+	FieldRef *ObjectFieldSelector `json:"fieldRef"`
+}
+
+// ObjectFieldSelector selects a field of an object.
+type ObjectFieldSelector struct {
+	// Required: Path of the field to select, e.g. "metadata.name".
+	FieldPath string `json:"fieldPath"`
 }
 
 // HTTPGetAction describes an action based on HTTP Get requests.
@@ -330,10 +580,41 @@ type Capabilities struct {
 	Drop []CapabilityType `json:"drop,omitempty"`
 }
 
+// SecurityContext holds security configuration that will be applied to a container.
+type SecurityContext struct {
+	// RunAsUser is the UID to run the entrypoint of the container process.
+	// Defaults to the user specified in the image metadata if unspecified.
+	RunAsUser *int64 `json:"runAsUser,omitempty"`
+	// RunAsNonRoot indicates that the container must run as a non-root user.
+	// The kubelet refuses to start a container that would run as UID 0.
+	RunAsNonRoot bool `json:"runAsNonRoot,omitempty"`
+	// SELinuxOptions are the labels to be applied to the container.
+	// Unspecified fields are allocated by the container runtime.
+	SELinuxOptions *SELinuxOptions `json:"seLinuxOptions,omitempty"`
+	// ReadOnlyRootFilesystem mounts the container's root filesystem as read-only.
+	ReadOnlyRootFilesystem bool `json:"readOnlyRootFilesystem,omitempty"`
+}
+
+// SELinuxOptions are the labels to be applied to the container.
+type SELinuxOptions struct {
+	// SELinux user label
+	User string `json:"user,omitempty"`
+	// SELinux role label
+	Role string `json:"role,omitempty"`
+	// SELinux type label
+	Type string `json:"type,omitempty"`
+	// SELinux level label
+	Level string `json:"level,omitempty"`
+}
+
 // ResourceRequirements describes the compute resource requirements.
 type ResourceRequirements struct {
 	// Limits describes the maximum amount of compute resources required.
 	Limits ResourceList `json:"limits,omitempty"`
+	// Requests describes the minimum amount of compute resources required.
+	// Pods are scheduled by the sum of their requests, so a node may be
+	// overcommitted with respect to limits.
+	Requests ResourceList `json:"requests,omitempty"`
 }
 
 // Container represents a single container that is expected to be run on the host.
@@ -363,6 +644,8 @@ type Container struct {
 	ImagePullPolicy PullPolicy `json:"imagePullPolicy"`
 	// Optional: Capabilities for container.
 	Capabilities Capabilities `json:"capabilities,omitempty"`
+	// Optional: SecurityContext defines the security options the container should be run with.
+	SecurityContext *SecurityContext `json:"securityContext,omitempty"`
 }
 
 // Handler defines a specific action that should be taken
@@ -538,6 +821,10 @@ const (
 	DNSDefault DNSPolicy = "Default"
 )
 
+// DefaultTerminationGracePeriodSeconds is the grace period given to a pod that does not
+// specify one.
+const DefaultTerminationGracePeriodSeconds = 30
+
 // PodSpec is a description of a pod
 type PodSpec struct {
 	Volumes       []Volume      `json:"volumes"`
@@ -552,6 +839,19 @@ type PodSpec struct {
 	// the the scheduler simply schedules this pod onto that host, assuming that it fits
 	// resource requirements.
 	Host string `json:"host,omitempty"`
+
+	// Optional duration in seconds the pod needs to terminate gracefully. The grace period
+	// starts when the pod is deleted: the PreStop hooks of its containers are run and the
+	// containers are sent a termination signal, and any container still running when the
+	// grace period expires is killed. May be shortened by the delete request. Zero means
+	// the containers are killed immediately.
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+
+	// ServiceAccount is the name of the ServiceAccount to use to run this pod.
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+	// ImagePullSecrets is a list of references to secrets in the same namespace that hold
+	// credentials for pulling the container images. Only secrets of type SecretTypeDockercfg are used.
+	ImagePullSecrets []LocalObjectReference `json:"imagePullSecrets,omitempty"`
 }
 
 // PodStatus represents information about the status of a pod. Status may trail the actual
@@ -683,6 +983,413 @@ type ServiceList struct {
 	Items []Service `json:"items"`
 }
 
+// Job represents the configuration of a single job, which runs pods until a
+// desired number of them have completed successfully.
+type Job struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is a structure defining the expected behavior of a job.
+	Spec JobSpec `json:"spec,omitempty"`
+
+	// Status is a structure describing the current status of a job.
+	Status JobStatus `json:"status,omitempty"`
+}
+
+// JobList is a collection of jobs.
+type JobList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []Job `json:"items"`
+}
+
+// JobSpec describes how the job execution will look like.
+type JobSpec struct {
+	// Parallelism is the maximum number of pods the job should run at any given time.
+	Parallelism *int `json:"parallelism,omitempty"`
+
+	// Completions is the number of pods that must finish successfully for the job
+	// to be complete.
+	Completions *int `json:"completions,omitempty"`
+
+	// Selector is a label query over the pods that belong to the job.
+	Selector map[string]string `json:"selector"`
+
+	// Template is the object that describes the pods that will be created when
+	// executing the job.
+	Template PodTemplateSpec `json:"template"`
+}
+
+// JobStatus represents the current state of a job.
+type JobStatus struct {
+	// StartTime is the time the job was first acknowledged by the job controller.
+	StartTime *util.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time the job completed.
+	CompletionTime *util.Time `json:"completionTime,omitempty"`
+
+	// Active is the number of pods of the job that are pending or running.
+	Active int `json:"active,omitempty"`
+
+	// Succeeded is the number of pods of the job that finished successfully.
+	Succeeded int `json:"succeeded,omitempty"`
+
+	// Failed is the number of pods of the job that failed.
+	Failed int `json:"failed,omitempty"`
+}
+
+// DaemonSet represents the configuration of a daemon set, which runs one copy
+// of a pod on every node that matches the node selector of its pod template.
+type DaemonSet struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired behavior of the daemon set.
+	Spec DaemonSetSpec `json:"spec,omitempty"`
+
+	// Status is the current status of the daemon set.
+	Status DaemonSetStatus `json:"status,omitempty"`
+}
+
+// DaemonSetList is a collection of daemon sets.
+type DaemonSetList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []DaemonSet `json:"items"`
+}
+
+// DaemonSetSpec is the specification of a daemon set.
+type DaemonSetSpec struct {
+	// Selector is a label query over the pods that are managed by the daemon set.
+	Selector map[string]string `json:"selector"`
+
+	// Template is the object that describes the pod that will be created on
+	// every node. Only nodes matching the node selector of the template run
+	// the pod.
+	Template PodTemplateSpec `json:"template"`
+}
+
+// DaemonSetStatus represents the current status of a daemon set.
+type DaemonSetStatus struct {
+	// CurrentNumberScheduled is the number of nodes that run the daemon pod and
+	// are supposed to.
+	CurrentNumberScheduled int `json:"currentNumberScheduled"`
+
+	// NumberMisscheduled is the number of nodes that run the daemon pod but are
+	// not supposed to.
+	NumberMisscheduled int `json:"numberMisscheduled"`
+
+	// DesiredNumberScheduled is the number of nodes that should run the daemon pod.
+	DesiredNumberScheduled int `json:"desiredNumberScheduled"`
+}
+
+// Deployment represents the configuration of a deployment, which rolls out
+// changes to a pod template by driving the replication controllers of the
+// old and new revisions.
+type Deployment struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired behavior of the deployment.
+	Spec DeploymentSpec `json:"spec,omitempty"`
+
+	// Status is the current status of the deployment.
+	Status DeploymentStatus `json:"status,omitempty"`
+}
+
+// DeploymentList is a collection of deployments.
+type DeploymentList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []Deployment `json:"items"`
+}
+
+// DeploymentSpec is the specification of a deployment.
+type DeploymentSpec struct {
+	// Replicas is the number of desired pods.
+	Replicas int `json:"replicas"`
+
+	// Selector is a label query over the pods that are managed by the deployment.
+	Selector map[string]string `json:"selector"`
+
+	// Template is the object that describes the pods the deployment should
+	// run. Changing it starts a rollout to a new revision.
+	Template PodTemplateSpec `json:"template"`
+
+	// Strategy is how existing pods are replaced by new ones.
+	Strategy DeploymentStrategy `json:"strategy,omitempty"`
+
+	// Paused stops the rollout where it is until it is unset.
+	Paused bool `json:"paused,omitempty"`
+
+	// RollbackTo, when set, rolls the template back to that of an earlier
+	// revision. It is cleared once the rollback has been started.
+	RollbackTo *RollbackConfig `json:"rollbackTo,omitempty"`
+}
+
+// DeploymentStrategyType is the kind of strategy a deployment uses to replace pods.
+type DeploymentStrategyType string
+
+const (
+	// RecreateDeploymentStrategyType kills all existing pods before creating new ones.
+	RecreateDeploymentStrategyType DeploymentStrategyType = "Recreate"
+
+	// RollingUpdateDeploymentStrategyType gradually replaces old pods with new ones.
+	RollingUpdateDeploymentStrategyType DeploymentStrategyType = "RollingUpdate"
+)
+
+// DeploymentStrategy describes how to replace existing pods with new ones.
+type DeploymentStrategy struct {
+	// Type of the strategy, either "Recreate" or "RollingUpdate".
+	Type DeploymentStrategyType `json:"type,omitempty"`
+
+	// RollingUpdate holds the parameters of a rolling update.
+	RollingUpdate *RollingUpdateDeployment `json:"rollingUpdate,omitempty"`
+}
+
+// RollingUpdateDeployment holds the parameters of a rolling update.
+type RollingUpdateDeployment struct {
+	// MaxUnavailable is the number of pods that may be unavailable during the
+	// update, below the desired number of pods.
+	MaxUnavailable int `json:"maxUnavailable"`
+
+	// MaxSurge is the number of pods that may be created above the desired
+	// number of pods during the update.
+	MaxSurge int `json:"maxSurge"`
+}
+
+// RollbackConfig names the revision a deployment rolls back to.
+type RollbackConfig struct {
+	// Revision to roll back to. Zero means the revision before the current one.
+	Revision int64 `json:"revision,omitempty"`
+}
+
+// DeploymentStatus represents the current status of a deployment.
+type DeploymentStatus struct {
+	// Replicas is the number of pods of all revisions.
+	Replicas int `json:"replicas"`
+
+	// UpdatedReplicas is the number of pods of the current revision.
+	UpdatedReplicas int `json:"updatedReplicas"`
+
+	// AvailableReplicas is the number of ready pods of all revisions.
+	AvailableReplicas int `json:"availableReplicas"`
+
+	// Revision is the revision of the current pod template.
+	Revision int64 `json:"revision,omitempty"`
+}
+
+// HorizontalPodAutoscaler represents the configuration of a horizontal pod
+// autoscaler, which resizes a replication controller to keep the CPU usage
+// of its pods near a target.
+type HorizontalPodAutoscaler struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired behavior of the autoscaler.
+	Spec HorizontalPodAutoscalerSpec `json:"spec,omitempty"`
+
+	// Status is the current status of the autoscaler.
+	Status HorizontalPodAutoscalerStatus `json:"status,omitempty"`
+}
+
+// HorizontalPodAutoscalerList is a collection of horizontal pod autoscalers.
+type HorizontalPodAutoscalerList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []HorizontalPodAutoscaler `json:"items"`
+}
+
+// HorizontalPodAutoscalerSpec is the specification of a horizontal pod autoscaler.
+type HorizontalPodAutoscalerSpec struct {
+	// ScaleRef is a reference to the replication controller to scale, in the
+	// namespace of the autoscaler.
+	ScaleRef ObjectReference `json:"scaleRef"`
+
+	// MinReplicas is the lower limit for the number of pods.
+	MinReplicas int `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the upper limit for the number of pods.
+	MaxReplicas int `json:"maxReplicas"`
+
+	// TargetCPUUtilizationPercentage is the average CPU usage of the pods the
+	// autoscaler aims for, as a percentage of their CPU request.
+	TargetCPUUtilizationPercentage int `json:"targetCPUUtilizationPercentage,omitempty"`
+}
+
+// HorizontalPodAutoscalerStatus represents the current status of a horizontal
+// pod autoscaler.
+type HorizontalPodAutoscalerStatus struct {
+	// CurrentReplicas is the number of pods the autoscaler last saw.
+	CurrentReplicas int `json:"currentReplicas"`
+
+	// DesiredReplicas is the number of pods the autoscaler last asked for.
+	DesiredReplicas int `json:"desiredReplicas"`
+
+	// CurrentCPUUtilizationPercentage is the average CPU usage of the pods the
+	// autoscaler last saw, as a percentage of their CPU request.
+	CurrentCPUUtilizationPercentage *int `json:"currentCPUUtilizationPercentage,omitempty"`
+
+	// LastScaleTime is the last time the autoscaler changed the number of pods.
+	LastScaleTime *util.Time `json:"lastScaleTime,omitempty"`
+}
+
+// ThirdPartyResource declares a kind of API object that is not compiled into
+// the apiserver. The name of a ThirdPartyResource is "<kind>.<group>", e.g.
+// "database-cluster.example.com" declares the kind DatabaseCluster in the API
+// group example.com. Once created, objects of the kind are served under
+// /thirdparty/<group>/<version> for each of the declared versions.
+type ThirdPartyResource struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Description is a human readable description of the kind.
+	Description string `json:"description,omitempty"`
+
+	// Versions are the API versions the kind is served under.
+	Versions []APIVersion `json:"versions,omitempty"`
+}
+
+// ThirdPartyResourceList is a collection of third party resources.
+type ThirdPartyResourceList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []ThirdPartyResource `json:"items"`
+}
+
+// APIVersion is an API version of a third party resource.
+type APIVersion struct {
+	// Name is the name of the version, e.g. "v1".
+	Name string `json:"name"`
+}
+
+// ThirdPartyResourceData is an object of a kind declared by a ThirdPartyResource.
+// The apiserver only interprets the object metadata; the object itself is kept
+// as opaque JSON.
+type ThirdPartyResourceData struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Data is the JSON encoding of the object.
+	Data []byte `json:"data,omitempty"`
+}
+
+// ThirdPartyResourceDataList is a collection of third party objects of one kind.
+type ThirdPartyResourceDataList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []ThirdPartyResourceData `json:"items"`
+}
+
+// PolicyRule allows a set of verbs on a set of resources.
+type PolicyRule struct {
+	// Verbs are the verbs the rule allows, e.g. "get", "list", "watch", "create",
+	// "update", "patch" or "delete". "*" allows every verb.
+	Verbs []string `json:"verbs"`
+	// Resources are the resources the rule applies to, e.g. "pods". "*" applies
+	// the rule to every resource.
+	Resources []string `json:"resources"`
+	// ResourceNames optionally restricts the rule to the objects with these names.
+	ResourceNames []string `json:"resourceNames,omitempty"`
+}
+
+// Role is a set of rules that is granted within a namespace by RoleBindings.
+type Role struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Rules are the rules of the role.
+	Rules []PolicyRule `json:"rules"`
+}
+
+// RoleList is a collection of roles.
+type RoleList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []Role `json:"items"`
+}
+
+// ClusterRole is a set of rules that can be granted in every namespace, or
+// within one namespace by a RoleBinding.
+type ClusterRole struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Rules are the rules of the role.
+	Rules []PolicyRule `json:"rules"`
+}
+
+// ClusterRoleList is a collection of cluster roles.
+type ClusterRoleList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []ClusterRole `json:"items"`
+}
+
+// Subject is a user or group a role is bound to.
+type Subject struct {
+	// Kind is "User" or "Group".
+	Kind string `json:"kind"`
+	// Name is the name of the user or group.
+	Name string `json:"name"`
+}
+
+// RoleRef refers to the role a binding grants.
+type RoleRef struct {
+	// Kind is "Role" or "ClusterRole".
+	Kind string `json:"kind"`
+	// Name is the name of the role.
+	Name string `json:"name"`
+}
+
+// RoleBinding grants the rules of a Role in its own namespace, or of a
+// ClusterRole, to a set of subjects within the namespace of the binding.
+type RoleBinding struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Subjects are the users and groups the role is granted to.
+	Subjects []Subject `json:"subjects"`
+	// RoleRef is the role that is granted.
+	RoleRef RoleRef `json:"roleRef"`
+}
+
+// RoleBindingList is a collection of role bindings.
+type RoleBindingList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []RoleBinding `json:"items"`
+}
+
+// ClusterRoleBinding grants the rules of a ClusterRole to a set of subjects in
+// every namespace.
+type ClusterRoleBinding struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Subjects are the users and groups the role is granted to.
+	Subjects []Subject `json:"subjects"`
+	// RoleRef is the cluster role that is granted.
+	RoleRef RoleRef `json:"roleRef"`
+}
+
+// ClusterRoleBindingList is a collection of cluster role bindings.
+type ClusterRoleBindingList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []ClusterRoleBinding `json:"items"`
+}
+
 // Session Affinity Type string
 type AffinityType string
 
@@ -699,12 +1406,8 @@ type ServiceStatus struct{}
 
 // ServiceSpec describes the attributes that a user creates on a service
 type ServiceSpec struct {
-	// Port is the TCP or UDP port that will be made available to each pod for connecting to the pods
-	// proxied by this service.
-	Port int `json:"port"`
-
-	// Required: Supports "TCP" and "UDP".
-	Protocol Protocol `json:"protocol,omitempty"`
+	// Required: The list of ports that are exposed by this service.
+	Ports []ServicePort `json:"ports"`
 
 	// This service will route traffic to pods having labels matching this selector. If empty or not present,
 	// the service is assumed to have endpoints set by an external process and Kubernetes will not modify
@@ -722,15 +1425,30 @@ type ServiceSpec struct {
 	// users to handle external traffic that arrives at a node.
 	PublicIPs []string `json:"publicIPs,omitempty"`
 
+	// Required: Supports "ClientIP" and "None".  Used to maintain session affinity.
+	SessionAffinity AffinityType `json:"sessionAffinity,omitempty"`
+}
+
+// ServicePort describes a single port exposed by a service.
+type ServicePort struct {
+	// Optional if only one ServicePort is defined on this service: The
+	// name of this port within the service.  This must be a DNS_LABEL.
+	// All ports within a ServiceSpec must have unique names.  This maps to
+	// the Name field in EndpointPort objects.
+	Name string `json:"name"`
+
+	// Required: Supports "TCP" and "UDP".
+	Protocol Protocol `json:"protocol"`
+
+	// Required: The port that will be exposed on the service.
+	Port int `json:"port"`
+
 	// ContainerPort is the name or number of the port on the container to direct traffic to.
-	// This is useful if the containers the service points to have multiple open ports.
+	// If this is a string, it will be looked up as a named port in the target pod's container ports.
 	// Optional: If unspecified, the first port on the container will be used.
 	// As of v1beta3 this field will become required in the internal API,
 	// and the versioned APIs must provide a default value.
 	ContainerPort util.IntOrString `json:"containerPort,omitempty"`
-
-	// Required: Supports "ClientIP" and "None".  Used to maintain session affinity.
-	SessionAffinity AffinityType `json:"sessionAffinity,omitempty"`
 }
 
 // Service is a named abstraction of software service (for example, mysql) consisting of local port
@@ -747,15 +1465,34 @@ type Service struct {
 	Status ServiceStatus `json:"status,omitempty"`
 }
 
-// Endpoints is a collection of endpoints that implement the actual service, for example:
-// Name: "mysql", Endpoints: [{"ip": "10.10.1.1", "port": 1909}, {"ip": "10.10.2.2", "port": 8834}]
+// Endpoints is a collection of endpoints that implement the actual service, grouped
+// by the service port they serve, for example:
+//   Name: "mysql",
+//   Ports: [
+//     {Name: "db", Protocol: "TCP", Endpoints: [{"ip": "10.10.1.1", "port": 3306}, {"ip": "10.10.2.2", "port": 3306}]},
+//     {Name: "metrics", Protocol: "TCP", Endpoints: [{"ip": "10.10.1.1", "port": 9102}, {"ip": "10.10.2.2", "port": 9102}]},
+//   ]
 type Endpoints struct {
 	TypeMeta   `json:",inline"`
 	ObjectMeta `json:"metadata,omitempty"`
 
+	// Ports is the set of service ports that have endpoints, each with the
+	// list of addresses that serve it.
+	Ports []EndpointPort `json:"ports,omitempty"`
+}
+
+// EndpointPort is the set of endpoints that serve a single named port of a service.
+type EndpointPort struct {
+	// Optional if only one port is defined on the service: The name of the
+	// service port these endpoints serve.  This must match the Name of a
+	// ServicePort.
+	Name string `json:"name,omitempty"`
+
 	// Optional: The IP protocol for these endpoints. Supports "TCP" and
 	// "UDP".  Defaults to "TCP".
-	Protocol  Protocol   `json:"protocol,omitempty"`
+	Protocol Protocol `json:"protocol,omitempty"`
+
+	// Endpoints is the list of addresses that serve this port.
 	Endpoints []Endpoint `json:"endpoints,omitempty"`
 }
 
@@ -863,6 +1600,8 @@ const (
 	ResourceCPU ResourceName = "cpu"
 	// Memory, in bytes. (500Gi = 500GiB = 500 * 1024 * 1024 * 1024)
 	ResourceMemory ResourceName = "memory"
+	// Volume size, in bytes (e,g. 5Gi = 5GiB = 5 * 1024 * 1024 * 1024)
+	ResourceStorage ResourceName = "storage"
 )
 
 // ResourceList is a set of (resource name, quantity) pairs.
@@ -891,12 +1630,33 @@ type NodeList struct {
 
 // NamespaceSpec describes the attributes on a Namespace
 type NamespaceSpec struct {
+	// Finalizers is an opaque list of values that must be empty to permanently remove object from storage
+	Finalizers []FinalizerName `json:"finalizers,omitempty"`
 }
 
+type FinalizerName string
+
+// These are internal finalizer values to Kubernetes, must be qualified name unless defined here
+const (
+	FinalizerKubernetes FinalizerName = "kubernetes"
+)
+
 // NamespaceStatus is information about the current status of a Namespace.
 type NamespaceStatus struct {
+	// Phase is the current lifecycle phase of the namespace.
+	Phase NamespacePhase `json:"phase,omitempty"`
 }
 
+type NamespacePhase string
+
+// These are the valid phases of a namespace.
+const (
+	// NamespaceActive means the namespace is available for use in the system
+	NamespaceActive NamespacePhase = "Active"
+	// NamespaceTerminating means the namespace is undergoing graceful termination
+	NamespaceTerminating NamespacePhase = "Terminating"
+)
+
 // A namespace provides a scope for Names.
 // Use of multiple namespaces is optional
 type Namespace struct {
@@ -928,6 +1688,28 @@ type Binding struct {
 	Target ObjectReference `json:"target"`
 }
 
+// DeleteOptions may be provided when deleting an API object
+type DeleteOptions struct {
+	TypeMeta `json:",inline"`
+
+	// Optional duration in seconds before the object should be deleted. Value must be non-negative
+	// integer. The value zero indicates delete immediately. If this value is nil, the default grace
+	// period for the specified type will be used.
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds"`
+}
+
+// PatchType identifies the format of the body of a PATCH request.
+type PatchType string
+
+const (
+	// MergePatchType is a JSON merge patch (RFC 7386): objects are merged recursively, null
+	// removes a field and any other value, including a list, replaces the existing value.
+	MergePatchType PatchType = "application/merge-patch+json"
+	// StrategicMergePatchType is a JSON merge patch that merges lists of objects by the key
+	// declared on the field (for example, containers by name) instead of replacing them.
+	StrategicMergePatchType PatchType = "application/strategic-merge-patch+json"
+)
+
 // Status is a return value for calls that don't return other objects.
 // TODO: this could go in apiserver, but I'm including it here so clients needn't
 // import both.
@@ -1066,6 +1848,12 @@ const (
 	// can only be created. API calls that return MethodNotAllowed can never succeed.
 	StatusReasonMethodNotAllowed StatusReason = "MethodNotAllowed"
 
+	// StatusReasonExpired means that the request referred to a version of the resource that is
+	// no longer available, for instance a continue token issued before the list it continues was
+	// changed. The client must restart the operation from the latest version.
+	// Status code 410
+	StatusReasonExpired StatusReason = "Expired"
+
 	// StatusReasonInternalError indicates that an internal error occurred, it is unexpected
 	// and the outcome of the call is unknown.
 	// Details (optional):
@@ -1138,6 +1926,11 @@ type ObjectReference struct {
 	FieldPath string `json:"fieldPath,omitempty"`
 }
 
+// LocalObjectReference contains enough information to let you locate the referenced object inside the same namespace.
+type LocalObjectReference struct {
+	Name string `json:"name,omitempty"`
+}
+
 type EventSource struct {
 	// Component from which the event is generated.
 	Component string `json:"component,omitempty"`
@@ -1204,6 +1997,13 @@ type ContainerManifest struct {
 	RestartPolicy RestartPolicy `json:"restartPolicy,omitempty"`
 	// Required: Set DNS policy.
 	DNSPolicy DNSPolicy `json:"dnsPolicy"`
+	// Optional duration in seconds the pod needs to terminate gracefully.
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+	// ServiceAccount is the name of the ServiceAccount to use to run this pod.
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+	// ImagePullSecrets is a list of references to secrets in the same namespace that hold
+	// credentials for pulling the container images. Only secrets of type SecretTypeDockercfg are used.
+	ImagePullSecrets []LocalObjectReference `json:"imagePullSecrets,omitempty"`
 }
 
 // ContainerManifestList is used to communicate container manifests to kubelet.
@@ -1301,6 +2101,10 @@ const (
 	ResourceReplicationControllers ResourceName = "replicationcontrollers"
 	// ResourceQuotas, number
 	ResourceQuotas ResourceName = "resourcequotas"
+	// CPU limit, in cores. The "cpu" resource of a quota constrains requests.
+	ResourceLimitsCPU ResourceName = "limits.cpu"
+	// Memory limit, in bytes. The "memory" resource of a quota constrains requests.
+	ResourceLimitsMemory ResourceName = "limits.memory"
 )
 
 // ResourceQuotaSpec defines the desired hard limits to enforce for Quota
@@ -1369,6 +2173,30 @@ type SecretType string
 
 const (
 	SecretTypeOpaque SecretType = "Opaque" // Default; arbitrary user-defined data
+
+	// SecretTypeDockercfg contains a dockercfg file that follows the same format rules as ~/.dockercfg
+	//
+	// Required fields:
+	// - Secret.Data[".dockercfg"] - a serialized ~/.dockercfg file
+	SecretTypeDockercfg SecretType = "kubernetes.io/dockercfg"
+
+	// DockerConfigKey is the key of the required data for SecretTypeDockercfg secrets
+	DockerConfigKey = ".dockercfg"
+
+	// SecretTypeServiceAccountToken contains a token that identifies a service account to the API
+	//
+	// Required fields:
+	// - Secret.Annotations["kubernetes.io/service-account.name"] - the name of the ServiceAccount the token identifies
+	// - Secret.Annotations["kubernetes.io/service-account.uid"] - the UID of the ServiceAccount the token identifies
+	// - Secret.Data["token"] - a token that identifies the service account to the API
+	SecretTypeServiceAccountToken SecretType = "kubernetes.io/service-account-token"
+
+	// ServiceAccountNameKey is the key of the required annotation for SecretTypeServiceAccountToken secrets
+	ServiceAccountNameKey = "kubernetes.io/service-account.name"
+	// ServiceAccountUIDKey is the key of the required annotation for SecretTypeServiceAccountToken secrets
+	ServiceAccountUIDKey = "kubernetes.io/service-account.uid"
+	// ServiceAccountTokenKey is the key of the required data for SecretTypeServiceAccountToken secrets
+	ServiceAccountTokenKey = "token"
 )
 
 type SecretList struct {
@@ -1378,6 +2206,26 @@ type SecretList struct {
 	Items []Secret `json:"items"`
 }
 
+// ServiceAccount binds together:
+// * a name, understood by users, and perhaps by peripheral systems, for an identity
+// * a principal that can be authenticated and authorized
+// * a set of secrets
+type ServiceAccount struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Secrets is the list of secrets allowed to be used by pods running using this ServiceAccount
+	Secrets []ObjectReference `json:"secrets,omitempty"`
+}
+
+// ServiceAccountList is a list of ServiceAccount objects
+type ServiceAccountList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []ServiceAccount `json:"items"`
+}
+
 // These constants are for remote command execution and port forwarding and are
 // used by both the client side and server side components.
 //
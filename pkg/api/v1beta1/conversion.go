@@ -57,6 +57,7 @@ func init() {
 		// ListMeta must be converted to TypeMeta
 		func(in *newer.ListMeta, out *TypeMeta, s conversion.Scope) error {
 			out.SelfLink = in.SelfLink
+			out.Continue = in.Continue
 			if len(in.ResourceVersion) > 0 {
 				v, err := strconv.ParseUint(in.ResourceVersion, 10, 64)
 				if err != nil {
@@ -68,6 +69,7 @@ func init() {
 		},
 		func(in *TypeMeta, out *newer.ListMeta, s conversion.Scope) error {
 			out.SelfLink = in.SelfLink
+			out.Continue = in.Continue
 			if in.ResourceVersion != 0 {
 				out.ResourceVersion = strconv.FormatUint(in.ResourceVersion, 10)
 			} else {
@@ -83,6 +85,9 @@ func init() {
 			out.GenerateName = in.GenerateName
 			out.UID = in.UID
 			out.CreationTimestamp = in.CreationTimestamp
+			if err := s.Convert(&in.DeletionTimestamp, &out.DeletionTimestamp, 0); err != nil {
+				return err
+			}
 			out.SelfLink = in.SelfLink
 			if len(in.ResourceVersion) > 0 {
 				v, err := strconv.ParseUint(in.ResourceVersion, 10, 64)
@@ -99,6 +104,9 @@ func init() {
 			out.GenerateName = in.GenerateName
 			out.UID = in.UID
 			out.CreationTimestamp = in.CreationTimestamp
+			if err := s.Convert(&in.DeletionTimestamp, &out.DeletionTimestamp, 0); err != nil {
+				return err
+			}
 			out.SelfLink = in.SelfLink
 			if in.ResourceVersion != 0 {
 				out.ResourceVersion = strconv.FormatUint(in.ResourceVersion, 10)
@@ -113,7 +121,7 @@ func init() {
 			out.Value = in.Value
 			out.Key = in.Name
 			out.Name = in.Name
-			return nil
+			return s.Convert(&in.ValueFrom, &out.ValueFrom, 0)
 		},
 		func(in *EnvVar, out *newer.EnvVar, s conversion.Scope) error {
 			out.Value = in.Value
@@ -122,7 +130,7 @@ func init() {
 			} else {
 				out.Name = in.Key
 			}
-			return nil
+			return s.Convert(&in.ValueFrom, &out.ValueFrom, 0)
 		},
 
 		// Path & MountType are deprecated.
@@ -514,6 +522,9 @@ func init() {
 			if err := s.Convert(&in.Capabilities, &out.Capabilities, 0); err != nil {
 				return err
 			}
+			if err := s.Convert(&in.SecurityContext, &out.SecurityContext, 0); err != nil {
+				return err
+			}
 			return nil
 		},
 		// Internal API does not support CPU to be specified via an explicit field.
@@ -597,6 +608,9 @@ func init() {
 			if err := s.Convert(&in.Capabilities, &out.Capabilities, 0); err != nil {
 				return err
 			}
+			if err := s.Convert(&in.SecurityContext, &out.SecurityContext, 0); err != nil {
+				return err
+			}
 			return nil
 		},
 		func(in *newer.PodSpec, out *ContainerManifest, s conversion.Scope) error {
@@ -610,6 +624,13 @@ func init() {
 				return err
 			}
 			out.DNSPolicy = DNSPolicy(in.DNSPolicy)
+			if err := s.Convert(&in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds, 0); err != nil {
+				return err
+			}
+			out.ServiceAccount = in.ServiceAccount
+			if err := s.Convert(&in.ImagePullSecrets, &out.ImagePullSecrets, 0); err != nil {
+				return err
+			}
 			out.Version = "v1beta2"
 			return nil
 		},
@@ -624,6 +645,13 @@ func init() {
 				return err
 			}
 			out.DNSPolicy = newer.DNSPolicy(in.DNSPolicy)
+			if err := s.Convert(&in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds, 0); err != nil {
+				return err
+			}
+			out.ServiceAccount = in.ServiceAccount
+			if err := s.Convert(&in.ImagePullSecrets, &out.ImagePullSecrets, 0); err != nil {
+				return err
+			}
 			return nil
 		},
 
@@ -638,14 +666,20 @@ func init() {
 				return err
 			}
 
-			out.Port = in.Spec.Port
-			out.Protocol = Protocol(in.Spec.Protocol)
+			// The legacy fields describe the first port.
+			if len(in.Spec.Ports) > 0 {
+				out.Port = in.Spec.Ports[0].Port
+				out.Protocol = Protocol(in.Spec.Ports[0].Protocol)
+				out.ContainerPort = in.Spec.Ports[0].ContainerPort
+			}
+			if err := s.Convert(&in.Spec.Ports, &out.Ports, 0); err != nil {
+				return err
+			}
 			if err := s.Convert(&in.Spec.Selector, &out.Selector, 0); err != nil {
 				return err
 			}
 			out.CreateExternalLoadBalancer = in.Spec.CreateExternalLoadBalancer
 			out.PublicIPs = in.Spec.PublicIPs
-			out.ContainerPort = in.Spec.ContainerPort
 			out.PortalIP = in.Spec.PortalIP
 			if err := s.Convert(&in.Spec.SessionAffinity, &out.SessionAffinity, 0); err != nil {
 				return err
@@ -664,14 +698,23 @@ func init() {
 				return err
 			}
 
-			out.Spec.Port = in.Port
-			out.Spec.Protocol = newer.Protocol(in.Protocol)
+			if len(in.Ports) > 0 {
+				if err := s.Convert(&in.Ports, &out.Spec.Ports, 0); err != nil {
+					return err
+				}
+			} else if in.Port != 0 {
+				// Only the legacy fields are set; they describe a single, unnamed port.
+				out.Spec.Ports = []newer.ServicePort{{
+					Protocol:      newer.Protocol(in.Protocol),
+					Port:          in.Port,
+					ContainerPort: in.ContainerPort,
+				}}
+			}
 			if err := s.Convert(&in.Selector, &out.Spec.Selector, 0); err != nil {
 				return err
 			}
 			out.Spec.CreateExternalLoadBalancer = in.CreateExternalLoadBalancer
 			out.Spec.PublicIPs = in.PublicIPs
-			out.Spec.ContainerPort = in.ContainerPort
 			out.Spec.PortalIP = in.PortalIP
 			if err := s.Convert(&in.SessionAffinity, &out.Spec.SessionAffinity, 0); err != nil {
 				return err
@@ -763,6 +806,323 @@ func init() {
 			return nil
 		},
 
+		func(in *newer.Job, out *Job, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta.Labels, &out.Labels, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+		func(in *Job, out *newer.Job, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.TypeMeta, &out.ObjectMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.ObjectMeta.Labels, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+
+		func(in *newer.DaemonSet, out *DaemonSet, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta.Labels, &out.Labels, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+		func(in *DaemonSet, out *newer.DaemonSet, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.TypeMeta, &out.ObjectMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.ObjectMeta.Labels, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+
+		func(in *newer.Deployment, out *Deployment, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta.Labels, &out.Labels, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+		func(in *Deployment, out *newer.Deployment, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.TypeMeta, &out.ObjectMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.ObjectMeta.Labels, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+
+		func(in *newer.HorizontalPodAutoscaler, out *HorizontalPodAutoscaler, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta.Labels, &out.Labels, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+		func(in *HorizontalPodAutoscaler, out *newer.HorizontalPodAutoscaler, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.TypeMeta, &out.ObjectMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.ObjectMeta.Labels, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+
+		func(in *newer.ThirdPartyResource, out *ThirdPartyResource, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta.Labels, &out.Labels, 0); err != nil {
+				return err
+			}
+			out.Description = in.Description
+			if err := s.Convert(&in.Versions, &out.Versions, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+		func(in *ThirdPartyResource, out *newer.ThirdPartyResource, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.TypeMeta, &out.ObjectMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.ObjectMeta.Labels, 0); err != nil {
+				return err
+			}
+			out.Description = in.Description
+			if err := s.Convert(&in.Versions, &out.Versions, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+
+		func(in *newer.Role, out *Role, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta.Labels, &out.Labels, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Rules, &out.Rules, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+		func(in *Role, out *newer.Role, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.TypeMeta, &out.ObjectMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.ObjectMeta.Labels, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Rules, &out.Rules, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+
+		func(in *newer.ClusterRole, out *ClusterRole, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta.Labels, &out.Labels, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Rules, &out.Rules, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+		func(in *ClusterRole, out *newer.ClusterRole, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.TypeMeta, &out.ObjectMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.ObjectMeta.Labels, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Rules, &out.Rules, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+
+		func(in *newer.RoleBinding, out *RoleBinding, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta.Labels, &out.Labels, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Subjects, &out.Subjects, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.RoleRef, &out.RoleRef, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+		func(in *RoleBinding, out *newer.RoleBinding, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.TypeMeta, &out.ObjectMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.ObjectMeta.Labels, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Subjects, &out.Subjects, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.RoleRef, &out.RoleRef, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+
+		func(in *newer.ClusterRoleBinding, out *ClusterRoleBinding, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta.Labels, &out.Labels, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Subjects, &out.Subjects, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.RoleRef, &out.RoleRef, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+		func(in *ClusterRoleBinding, out *newer.ClusterRoleBinding, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.TypeMeta, &out.ObjectMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.ObjectMeta.Labels, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Subjects, &out.Subjects, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.RoleRef, &out.RoleRef, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+
 		func(in *Namespace, out *newer.Namespace, s conversion.Scope) error {
 			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
 				return err
@@ -773,6 +1133,9 @@ func init() {
 			if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
 				return err
 			}
+			if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+				return err
+			}
 			if err := s.Convert(&in.Labels, &out.ObjectMeta.Labels, 0); err != nil {
 				return err
 			}
@@ -1073,6 +1436,18 @@ func init() {
 			if err := s.Convert(&in.Secret, &out.Secret, 0); err != nil {
 				return err
 			}
+			if err := s.Convert(&in.DownwardAPI, &out.DownwardAPI, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.PersistentVolumeClaimVolumeSource, &out.PersistentVolumeClaimVolumeSource, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.NFS, &out.NFS, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ISCSI, &out.ISCSI, 0); err != nil {
+				return err
+			}
 			return nil
 		},
 		func(in *VolumeSource, out *newer.VolumeSource, s conversion.Scope) error {
@@ -1091,6 +1466,18 @@ func init() {
 			if err := s.Convert(&in.Secret, &out.Secret, 0); err != nil {
 				return err
 			}
+			if err := s.Convert(&in.DownwardAPI, &out.DownwardAPI, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.PersistentVolumeClaimVolumeSource, &out.PersistentVolumeClaimVolumeSource, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.NFS, &out.NFS, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ISCSI, &out.ISCSI, 0); err != nil {
+				return err
+			}
 			return nil
 		},
 
@@ -1163,12 +1550,13 @@ func init() {
 			if err := s.Convert(&in.ObjectMeta, &out.TypeMeta, 0); err != nil {
 				return err
 			}
-			if err := s.Convert(&in.Protocol, &out.Protocol, 0); err != nil {
+			if err := s.Convert(&in.Ports, &out.Ports, 0); err != nil {
 				return err
 			}
-			for i := range in.Endpoints {
-				ep := &in.Endpoints[i]
-				out.Endpoints = append(out.Endpoints, net.JoinHostPort(ep.IP, strconv.Itoa(ep.Port)))
+			// The legacy fields describe the first port.
+			if len(out.Ports) > 0 {
+				out.Protocol = out.Ports[0].Protocol
+				out.Endpoints = out.Ports[0].Endpoints
 			}
 			return nil
 		},
@@ -1179,6 +1567,30 @@ func init() {
 			if err := s.Convert(&in.TypeMeta, &out.ObjectMeta, 0); err != nil {
 				return err
 			}
+			if len(in.Ports) > 0 {
+				return s.Convert(&in.Ports, &out.Ports, 0)
+			}
+			if len(in.Endpoints) == 0 {
+				return nil
+			}
+			// Only the legacy fields are set; they describe a single, unnamed port.
+			out.Ports = []newer.EndpointPort{{}}
+			legacy := EndpointPort{Protocol: in.Protocol, Endpoints: in.Endpoints}
+			return s.Convert(&legacy, &out.Ports[0], 0)
+		},
+		func(in *newer.EndpointPort, out *EndpointPort, s conversion.Scope) error {
+			out.Name = in.Name
+			if err := s.Convert(&in.Protocol, &out.Protocol, 0); err != nil {
+				return err
+			}
+			for i := range in.Endpoints {
+				ep := &in.Endpoints[i]
+				out.Endpoints = append(out.Endpoints, net.JoinHostPort(ep.IP, strconv.Itoa(ep.Port)))
+			}
+			return nil
+		},
+		func(in *EndpointPort, out *newer.EndpointPort, s conversion.Scope) error {
+			out.Name = in.Name
 			if err := s.Convert(&in.Protocol, &out.Protocol, 0); err != nil {
 				return err
 			}
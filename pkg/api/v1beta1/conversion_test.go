@@ -396,10 +396,7 @@ func TestEndpointsConversion(t *testing.T) {
 				Protocol:  current.ProtocolTCP,
 				Endpoints: []string{},
 			},
-			expected: newer.Endpoints{
-				Protocol:  newer.ProtocolTCP,
-				Endpoints: []newer.Endpoint{},
-			},
+			expected: newer.Endpoints{},
 		},
 		{
 			given: current.Endpoints{
@@ -410,8 +407,9 @@ func TestEndpointsConversion(t *testing.T) {
 				Endpoints: []string{"1.2.3.4:88"},
 			},
 			expected: newer.Endpoints{
-				Protocol:  newer.ProtocolTCP,
-				Endpoints: []newer.Endpoint{{IP: "1.2.3.4", Port: 88}},
+				Ports: []newer.EndpointPort{
+					{Protocol: newer.ProtocolTCP, Endpoints: []newer.Endpoint{{IP: "1.2.3.4", Port: 88}}},
+				},
 			},
 		},
 		{
@@ -423,8 +421,28 @@ func TestEndpointsConversion(t *testing.T) {
 				Endpoints: []string{"1.2.3.4:88", "1.2.3.4:89", "1.2.3.4:90"},
 			},
 			expected: newer.Endpoints{
-				Protocol:  newer.ProtocolUDP,
-				Endpoints: []newer.Endpoint{{IP: "1.2.3.4", Port: 88}, {IP: "1.2.3.4", Port: 89}, {IP: "1.2.3.4", Port: 90}},
+				Ports: []newer.EndpointPort{
+					{Protocol: newer.ProtocolUDP, Endpoints: []newer.Endpoint{{IP: "1.2.3.4", Port: 88}, {IP: "1.2.3.4", Port: 89}, {IP: "1.2.3.4", Port: 90}}},
+				},
+			},
+		},
+		{
+			given: current.Endpoints{
+				TypeMeta: current.TypeMeta{
+					ID: "multiple-ports",
+				},
+				Protocol:  current.ProtocolTCP,
+				Endpoints: []string{"1.2.3.4:88"},
+				Ports: []current.EndpointPort{
+					{Name: "p", Protocol: current.ProtocolTCP, Endpoints: []string{"1.2.3.4:88"}},
+					{Name: "q", Protocol: current.ProtocolUDP, Endpoints: []string{"1.2.3.4:89", "[::1]:90"}},
+				},
+			},
+			expected: newer.Endpoints{
+				Ports: []newer.EndpointPort{
+					{Name: "p", Protocol: newer.ProtocolTCP, Endpoints: []newer.Endpoint{{IP: "1.2.3.4", Port: 88}}},
+					{Name: "q", Protocol: newer.ProtocolUDP, Endpoints: []newer.Endpoint{{IP: "1.2.3.4", Port: 89}, {IP: "::1", Port: 90}}},
+				},
 			},
 		},
 	}
@@ -436,7 +454,7 @@ func TestEndpointsConversion(t *testing.T) {
 			t.Errorf("[Case: %d] Unexpected error: %v", i, err)
 			continue
 		}
-		if got.Protocol != tc.expected.Protocol || !newer.Semantic.DeepEqual(got.Endpoints, tc.expected.Endpoints) {
+		if !newer.Semantic.DeepEqual(got.Ports, tc.expected.Ports) {
 			t.Errorf("[Case: %d] Expected %v, got %v", i, tc.expected, got)
 		}
 
@@ -446,7 +464,66 @@ func TestEndpointsConversion(t *testing.T) {
 			t.Errorf("[Case: %d] Unexpected error: %v", i, err)
 			continue
 		}
-		if got2.Protocol != tc.given.Protocol || !newer.Semantic.DeepEqual(got2.Endpoints, tc.given.Endpoints) {
+		if !newer.Semantic.DeepEqual(got2.Endpoints, tc.given.Endpoints) {
+			t.Errorf("[Case: %d] Expected %v, got %v", i, tc.given, got2)
+		}
+		if len(tc.given.Endpoints) > 0 && got2.Protocol != tc.given.Protocol {
+			t.Errorf("[Case: %d] Expected %v, got %v", i, tc.given, got2)
+		}
+	}
+}
+
+func TestServicePortsConversion(t *testing.T) {
+	testCases := []struct {
+		given    current.Service
+		expected []newer.ServicePort
+	}{
+		{
+			given: current.Service{
+				TypeMeta:      current.TypeMeta{ID: "legacy"},
+				Port:          80,
+				Protocol:      current.ProtocolTCP,
+				ContainerPort: util.NewIntOrStringFromInt(8080),
+			},
+			expected: []newer.ServicePort{
+				{Port: 80, Protocol: newer.ProtocolTCP, ContainerPort: util.NewIntOrStringFromInt(8080)},
+			},
+		},
+		{
+			given: current.Service{
+				TypeMeta: current.TypeMeta{ID: "ports"},
+				Port:     80,
+				Protocol: current.ProtocolTCP,
+				Ports: []current.ServicePort{
+					{Name: "http", Port: 80, Protocol: current.ProtocolTCP, ContainerPort: util.NewIntOrStringFromString("http")},
+					{Name: "dns", Port: 53, Protocol: current.ProtocolUDP, ContainerPort: util.NewIntOrStringFromInt(5353)},
+				},
+			},
+			expected: []newer.ServicePort{
+				{Name: "http", Port: 80, Protocol: newer.ProtocolTCP, ContainerPort: util.NewIntOrStringFromString("http")},
+				{Name: "dns", Port: 53, Protocol: newer.ProtocolUDP, ContainerPort: util.NewIntOrStringFromInt(5353)},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		// Convert versioned -> internal.
+		got := newer.Service{}
+		if err := Convert(&tc.given, &got); err != nil {
+			t.Errorf("[Case: %d] Unexpected error: %v", i, err)
+			continue
+		}
+		if !newer.Semantic.DeepEqual(got.Spec.Ports, tc.expected) {
+			t.Errorf("[Case: %d] Expected %v, got %v", i, tc.expected, got.Spec.Ports)
+		}
+
+		// Convert internal -> versioned; the legacy fields describe the first port.
+		got2 := current.Service{}
+		if err := Convert(&got, &got2); err != nil {
+			t.Errorf("[Case: %d] Unexpected error: %v", i, err)
+			continue
+		}
+		if got2.Port != tc.given.Port || got2.Protocol != tc.given.Protocol {
 			t.Errorf("[Case: %d] Expected %v, got %v", i, tc.given, got2)
 		}
 	}
@@ -22,6 +22,7 @@ import (
 
 	current "github.com/GoogleCloudPlatform/kubernetes/pkg/api/v1beta1"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 )
 
 func roundTrip(t *testing.T, obj runtime.Object) runtime.Object {
@@ -66,7 +67,7 @@ func TestSetDefaulPodSpec(t *testing.T) {
 	}
 	vsource := bp2.Spec.Volumes[0].Source
 	if vsource.EmptyDir == nil {
-		t.Errorf("Expected non-empty volume is set, got: %s", vsource.EmptyDir)
+		t.Errorf("Expected non-empty volume is set, got: %#v", vsource.EmptyDir)
 	}
 }
 
@@ -93,6 +94,33 @@ func TestSetDefaultContainer(t *testing.T) {
 	}
 }
 
+func TestSetDefaultContainerRequests(t *testing.T) {
+	bp := &current.BoundPod{}
+	bp.Spec.Containers = []current.Container{{
+		CPU:    500,
+		Memory: 1024 * 1024 * 1024,
+		Resources: current.ResourceRequirements{
+			Limits: current.ResourceList{
+				"example.com/widgets": util.NewIntOrStringFromInt(2),
+			},
+		},
+	}}
+
+	obj2 := roundTrip(t, runtime.Object(bp))
+	bp2 := obj2.(*current.BoundPod)
+
+	requests := bp2.Spec.Containers[0].Resources.Requests
+	if cpu := requests[current.ResourceCPU]; cpu.String() != "0.5" {
+		t.Errorf("Expected the cpu request to default to the legacy cpu field, got: %s", cpu.String())
+	}
+	if memory := requests[current.ResourceMemory]; memory.IntVal != 1024*1024*1024 {
+		t.Errorf("Expected the memory request to default to the legacy memory field, got: %s", memory.String())
+	}
+	if widgets := requests["example.com/widgets"]; widgets.IntVal != 2 {
+		t.Errorf("Expected the widgets request to default to the limit, got: %s", widgets.String())
+	}
+}
+
 func TestSetDefaultSecret(t *testing.T) {
 	s := &current.Secret{}
 	obj2 := roundTrip(t, runtime.Object(s))
@@ -226,10 +226,7 @@ func TestEndpointsConversion(t *testing.T) {
 				Protocol:  current.ProtocolTCP,
 				Endpoints: []string{},
 			},
-			expected: newer.Endpoints{
-				Protocol:  newer.ProtocolTCP,
-				Endpoints: []newer.Endpoint{},
-			},
+			expected: newer.Endpoints{},
 		},
 		{
 			given: current.Endpoints{
@@ -240,8 +237,9 @@ func TestEndpointsConversion(t *testing.T) {
 				Endpoints: []string{"1.2.3.4:88"},
 			},
 			expected: newer.Endpoints{
-				Protocol:  newer.ProtocolTCP,
-				Endpoints: []newer.Endpoint{{IP: "1.2.3.4", Port: 88}},
+				Ports: []newer.EndpointPort{
+					{Protocol: newer.ProtocolTCP, Endpoints: []newer.Endpoint{{IP: "1.2.3.4", Port: 88}}},
+				},
 			},
 		},
 		{
@@ -253,8 +251,28 @@ func TestEndpointsConversion(t *testing.T) {
 				Endpoints: []string{"1.2.3.4:88", "1.2.3.4:89", "1.2.3.4:90"},
 			},
 			expected: newer.Endpoints{
-				Protocol:  newer.ProtocolUDP,
-				Endpoints: []newer.Endpoint{{IP: "1.2.3.4", Port: 88}, {IP: "1.2.3.4", Port: 89}, {IP: "1.2.3.4", Port: 90}},
+				Ports: []newer.EndpointPort{
+					{Protocol: newer.ProtocolUDP, Endpoints: []newer.Endpoint{{IP: "1.2.3.4", Port: 88}, {IP: "1.2.3.4", Port: 89}, {IP: "1.2.3.4", Port: 90}}},
+				},
+			},
+		},
+		{
+			given: current.Endpoints{
+				TypeMeta: current.TypeMeta{
+					ID: "multiple-ports",
+				},
+				Protocol:  current.ProtocolTCP,
+				Endpoints: []string{"1.2.3.4:88"},
+				Ports: []current.EndpointPort{
+					{Name: "p", Protocol: current.ProtocolTCP, Endpoints: []string{"1.2.3.4:88"}},
+					{Name: "q", Protocol: current.ProtocolUDP, Endpoints: []string{"1.2.3.4:89", "[::1]:90"}},
+				},
+			},
+			expected: newer.Endpoints{
+				Ports: []newer.EndpointPort{
+					{Name: "p", Protocol: newer.ProtocolTCP, Endpoints: []newer.Endpoint{{IP: "1.2.3.4", Port: 88}}},
+					{Name: "q", Protocol: newer.ProtocolUDP, Endpoints: []newer.Endpoint{{IP: "1.2.3.4", Port: 89}, {IP: "::1", Port: 90}}},
+				},
 			},
 		},
 	}
@@ -266,7 +284,7 @@ func TestEndpointsConversion(t *testing.T) {
 			t.Errorf("[Case: %d] Unexpected error: %v", i, err)
 			continue
 		}
-		if got.Protocol != tc.expected.Protocol || !newer.Semantic.DeepEqual(got.Endpoints, tc.expected.Endpoints) {
+		if !newer.Semantic.DeepEqual(got.Ports, tc.expected.Ports) {
 			t.Errorf("[Case: %d] Expected %v, got %v", i, tc.expected, got)
 		}
 
@@ -276,7 +294,10 @@ func TestEndpointsConversion(t *testing.T) {
 			t.Errorf("[Case: %d] Unexpected error: %v", i, err)
 			continue
 		}
-		if got2.Protocol != tc.given.Protocol || !newer.Semantic.DeepEqual(got2.Endpoints, tc.given.Endpoints) {
+		if !newer.Semantic.DeepEqual(got2.Endpoints, tc.given.Endpoints) {
+			t.Errorf("[Case: %d] Expected %v, got %v", i, tc.given, got2)
+		}
+		if len(tc.given.Endpoints) > 0 && got2.Protocol != tc.given.Protocol {
 			t.Errorf("[Case: %d] Expected %v, got %v", i, tc.given, got2)
 		}
 	}
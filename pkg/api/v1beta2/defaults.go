@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta2
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
@@ -53,6 +54,26 @@ func init() {
 			if obj.TerminationMessagePath == "" {
 				obj.TerminationMessagePath = TerminationMessagePathDefault
 			}
+			// Requests default to the limits, which may also be set through
+			// the legacy CPU and Memory fields.
+			limits := ResourceList{}
+			for name, limit := range obj.Resources.Limits {
+				limits[name] = limit
+			}
+			if obj.CPU > 0 {
+				limits[ResourceCPU] = util.NewIntOrStringFromString(fmt.Sprintf("%v", float64(obj.CPU)/1000))
+			}
+			if obj.Memory > 0 {
+				limits[ResourceMemory] = util.NewIntOrStringFromInt(int(obj.Memory))
+			}
+			for name, limit := range limits {
+				if _, found := obj.Resources.Requests[name]; !found {
+					if obj.Resources.Requests == nil {
+						obj.Resources.Requests = ResourceList{}
+					}
+					obj.Resources.Requests[name] = limit
+				}
+			}
 		},
 		func(obj *RestartPolicy) {
 			if util.AllPtrFieldsNil(obj) {
@@ -92,10 +113,69 @@ func init() {
 				obj.Protocol = "TCP"
 			}
 		},
+		func(obj *ServicePort) {
+			if obj.Protocol == "" {
+				obj.Protocol = ProtocolTCP
+			}
+		},
+		func(obj *EndpointPort) {
+			if obj.Protocol == "" {
+				obj.Protocol = ProtocolTCP
+			}
+		},
 		func(obj *HTTPGetAction) {
 			if obj.Path == "" {
 				obj.Path = "/"
 			}
 		},
+		func(obj *Job) {
+			if obj.Spec.Completions == nil {
+				completions := 1
+				obj.Spec.Completions = &completions
+			}
+			if obj.Spec.Parallelism == nil {
+				parallelism := *obj.Spec.Completions
+				obj.Spec.Parallelism = &parallelism
+			}
+			if len(obj.Spec.Selector) == 0 && len(obj.Spec.Template.Labels) > 0 {
+				obj.Spec.Selector = make(map[string]string)
+				for k, v := range obj.Spec.Template.Labels {
+					obj.Spec.Selector[k] = v
+				}
+			}
+		},
+		func(obj *DaemonSet) {
+			if len(obj.Spec.Selector) == 0 && len(obj.Spec.Template.Labels) > 0 {
+				obj.Spec.Selector = make(map[string]string)
+				for k, v := range obj.Spec.Template.Labels {
+					obj.Spec.Selector[k] = v
+				}
+			}
+		},
+		func(obj *Deployment) {
+			if len(obj.Spec.Selector) == 0 && len(obj.Spec.Template.Labels) > 0 {
+				obj.Spec.Selector = make(map[string]string)
+				for k, v := range obj.Spec.Template.Labels {
+					obj.Spec.Selector[k] = v
+				}
+			}
+			if obj.Spec.Strategy.Type == "" {
+				obj.Spec.Strategy.Type = RollingUpdateDeploymentStrategyType
+			}
+			if obj.Spec.Strategy.Type == RollingUpdateDeploymentStrategyType && obj.Spec.Strategy.RollingUpdate == nil {
+				obj.Spec.Strategy.RollingUpdate = &RollingUpdateDeployment{
+					MaxUnavailable: 1,
+					MaxSurge:       1,
+				}
+			}
+		},
+		func(obj *HorizontalPodAutoscaler) {
+			if obj.Spec.MinReplicas == 0 {
+				obj.Spec.MinReplicas = 1
+			}
+			if obj.Spec.TargetCPUUtilizationPercentage == 0 {
+				obj.Spec.TargetCPUUtilizationPercentage = 80
+			}
+		},
 	)
 }
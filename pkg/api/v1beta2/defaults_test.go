@@ -66,7 +66,7 @@ func TestSetDefaulPodSpec(t *testing.T) {
 	}
 	vsource := bp2.Spec.Volumes[0].Source
 	if vsource.EmptyDir == nil {
-		t.Errorf("Expected non-empty volume is set, got: %s", vsource.EmptyDir)
+		t.Errorf("Expected non-empty volume is set, got: %#v", vsource.EmptyDir)
 	}
 }
 
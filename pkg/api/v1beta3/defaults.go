@@ -51,6 +51,16 @@ func init() {
 			if obj.TerminationMessagePath == "" {
 				obj.TerminationMessagePath = TerminationMessagePathDefault
 			}
+			// Requests default to the limits, so a container that only sets
+			// limits is scheduled the same way as before requests existed.
+			for name, limit := range obj.Resources.Limits {
+				if _, found := obj.Resources.Requests[name]; !found {
+					if obj.Resources.Requests == nil {
+						obj.Resources.Requests = ResourceList{}
+					}
+					obj.Resources.Requests[name] = *limit.Copy()
+				}
+			}
 		},
 		func(obj *RestartPolicy) {
 			if util.AllPtrFieldsNil(obj) {
@@ -58,9 +68,6 @@ func init() {
 			}
 		},
 		func(obj *Service) {
-			if obj.Spec.Protocol == "" {
-				obj.Spec.Protocol = ProtocolTCP
-			}
 			if obj.Spec.SessionAffinity == "" {
 				obj.Spec.SessionAffinity = AffinityTypeNone
 			}
@@ -80,9 +87,9 @@ func init() {
 				obj.Type = SecretTypeOpaque
 			}
 		},
-		func(obj *Endpoints) {
+		func(obj *EndpointPort) {
 			if obj.Protocol == "" {
-				obj.Protocol = "TCP"
+				obj.Protocol = ProtocolTCP
 			}
 		},
 		func(obj *HTTPGetAction) {
@@ -90,11 +97,63 @@ func init() {
 				obj.Path = "/"
 			}
 		},
-		func(obj *ServiceSpec) {
+		func(obj *ServicePort) {
+			if obj.Protocol == "" {
+				obj.Protocol = ProtocolTCP
+			}
 			if obj.ContainerPort.Kind == util.IntstrInt && obj.ContainerPort.IntVal == 0 ||
 				obj.ContainerPort.Kind == util.IntstrString && obj.ContainerPort.StrVal == "" {
 				obj.ContainerPort = util.NewIntOrStringFromInt(obj.Port)
 			}
 		},
+		func(obj *Job) {
+			if obj.Spec.Completions == nil {
+				completions := 1
+				obj.Spec.Completions = &completions
+			}
+			if obj.Spec.Parallelism == nil {
+				parallelism := *obj.Spec.Completions
+				obj.Spec.Parallelism = &parallelism
+			}
+			if len(obj.Spec.Selector) == 0 && len(obj.Spec.Template.Labels) > 0 {
+				obj.Spec.Selector = make(map[string]string)
+				for k, v := range obj.Spec.Template.Labels {
+					obj.Spec.Selector[k] = v
+				}
+			}
+		},
+		func(obj *DaemonSet) {
+			if len(obj.Spec.Selector) == 0 && len(obj.Spec.Template.Labels) > 0 {
+				obj.Spec.Selector = make(map[string]string)
+				for k, v := range obj.Spec.Template.Labels {
+					obj.Spec.Selector[k] = v
+				}
+			}
+		},
+		func(obj *Deployment) {
+			if len(obj.Spec.Selector) == 0 && len(obj.Spec.Template.Labels) > 0 {
+				obj.Spec.Selector = make(map[string]string)
+				for k, v := range obj.Spec.Template.Labels {
+					obj.Spec.Selector[k] = v
+				}
+			}
+			if obj.Spec.Strategy.Type == "" {
+				obj.Spec.Strategy.Type = RollingUpdateDeploymentStrategyType
+			}
+			if obj.Spec.Strategy.Type == RollingUpdateDeploymentStrategyType && obj.Spec.Strategy.RollingUpdate == nil {
+				obj.Spec.Strategy.RollingUpdate = &RollingUpdateDeployment{
+					MaxUnavailable: 1,
+					MaxSurge:       1,
+				}
+			}
+		},
+		func(obj *HorizontalPodAutoscaler) {
+			if obj.Spec.MinReplicas == 0 {
+				obj.Spec.MinReplicas = 1
+			}
+			if obj.Spec.TargetCPUUtilizationPercentage == 0 {
+				obj.Spec.TargetCPUUtilizationPercentage = 80
+			}
+		},
 	)
 }
@@ -20,6 +20,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
 	current "github.com/GoogleCloudPlatform/kubernetes/pkg/api/v1beta3"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
@@ -44,9 +45,6 @@ func TestSetDefaultService(t *testing.T) {
 	svc := &current.Service{}
 	obj2 := roundTrip(t, runtime.Object(svc))
 	svc2 := obj2.(*current.Service)
-	if svc2.Spec.Protocol != current.ProtocolTCP {
-		t.Errorf("Expected default protocol :%s, got: %s", current.ProtocolTCP, svc2.Spec.Protocol)
-	}
 	if svc2.Spec.SessionAffinity != current.AffinityTypeNone {
 		t.Errorf("Expected default sesseion affinity type:%s, got: %s", current.AffinityTypeNone, svc2.Spec.SessionAffinity)
 	}
@@ -67,7 +65,7 @@ func TestSetDefaulPodSpec(t *testing.T) {
 	}
 	vsource := bp2.Spec.Volumes[0].VolumeSource
 	if vsource.EmptyDir == nil {
-		t.Errorf("Expected non-empty volume is set, got: %s", vsource.EmptyDir)
+		t.Errorf("Expected non-empty volume is set, got: %#v", vsource.EmptyDir)
 	}
 }
 
@@ -94,6 +92,32 @@ func TestSetDefaultContainer(t *testing.T) {
 	}
 }
 
+func TestSetDefaultContainerRequests(t *testing.T) {
+	bp := &current.BoundPod{}
+	bp.Spec.Containers = []current.Container{{
+		Resources: current.ResourceRequirements{
+			Limits: current.ResourceList{
+				current.ResourceCPU:    resource.MustParse("500m"),
+				current.ResourceMemory: resource.MustParse("1Gi"),
+			},
+			Requests: current.ResourceList{
+				current.ResourceCPU: resource.MustParse("100m"),
+			},
+		},
+	}}
+
+	obj2 := roundTrip(t, runtime.Object(bp))
+	bp2 := obj2.(*current.BoundPod)
+
+	requests := bp2.Spec.Containers[0].Resources.Requests
+	if cpu := requests[current.ResourceCPU]; cpu.MilliValue() != 100 {
+		t.Errorf("Expected the explicit cpu request to be kept, got: %s", cpu.String())
+	}
+	if memory := requests[current.ResourceMemory]; memory.Value() != 1024*1024*1024 {
+		t.Errorf("Expected the memory request to default to the limit, got: %s", memory.String())
+	}
+}
+
 func TestSetDefaultSecret(t *testing.T) {
 	s := &current.Secret{}
 	obj2 := roundTrip(t, runtime.Object(s))
@@ -105,27 +129,33 @@ func TestSetDefaultSecret(t *testing.T) {
 }
 
 func TestSetDefaulEndpointsProtocol(t *testing.T) {
-	in := &current.Endpoints{}
+	in := &current.Endpoints{Ports: []current.EndpointPort{{}}}
 	obj := roundTrip(t, runtime.Object(in))
 	out := obj.(*current.Endpoints)
 
-	if out.Protocol != current.ProtocolTCP {
-		t.Errorf("Expected protocol %s, got %s", current.ProtocolTCP, out.Protocol)
+	if out.Ports[0].Protocol != current.ProtocolTCP {
+		t.Errorf("Expected protocol %s, got %s", current.ProtocolTCP, out.Ports[0].Protocol)
 	}
 }
 
 func TestSetDefaulServiceDestinationPort(t *testing.T) {
-	in := &current.Service{Spec: current.ServiceSpec{Port: 1234}}
+	in := &current.Service{Spec: current.ServiceSpec{Ports: []current.ServicePort{{Port: 1234}}}}
 	obj := roundTrip(t, runtime.Object(in))
 	out := obj.(*current.Service)
-	if out.Spec.ContainerPort.Kind != util.IntstrInt || out.Spec.ContainerPort.IntVal != 1234 {
-		t.Errorf("Expected ContainerPort to be defaulted, got %s", out.Spec.ContainerPort)
+	if out.Spec.Ports[0].Protocol != current.ProtocolTCP {
+		t.Errorf("Expected protocol %s, got %s", current.ProtocolTCP, out.Spec.Ports[0].Protocol)
+	}
+	if out.Spec.Ports[0].ContainerPort.Kind != util.IntstrInt || out.Spec.Ports[0].ContainerPort.IntVal != 1234 {
+		t.Errorf("Expected ContainerPort to be defaulted, got %v", out.Spec.Ports[0].ContainerPort)
 	}
 
-	in = &current.Service{Spec: current.ServiceSpec{Port: 1234, ContainerPort: util.NewIntOrStringFromInt(5678)}}
+	in = &current.Service{Spec: current.ServiceSpec{Ports: []current.ServicePort{{Port: 1234, ContainerPort: util.NewIntOrStringFromInt(5678)}, {Name: "p", Port: 80, ContainerPort: util.NewIntOrStringFromString("p")}}}}
 	obj = roundTrip(t, runtime.Object(in))
 	out = obj.(*current.Service)
-	if out.Spec.ContainerPort.Kind != util.IntstrInt || out.Spec.ContainerPort.IntVal != 5678 {
-		t.Errorf("Expected ContainerPort to be unchanged, got %s", out.Spec.ContainerPort)
+	if out.Spec.Ports[0].ContainerPort.Kind != util.IntstrInt || out.Spec.Ports[0].ContainerPort.IntVal != 5678 {
+		t.Errorf("Expected ContainerPort to be unchanged, got %v", out.Spec.Ports[0].ContainerPort)
+	}
+	if out.Spec.Ports[1].ContainerPort.Kind != util.IntstrString || out.Spec.Ports[1].ContainerPort.StrVal != "p" {
+		t.Errorf("Expected ContainerPort to be unchanged, got %v", out.Spec.Ports[1].ContainerPort)
 	}
 }
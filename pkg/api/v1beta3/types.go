@@ -71,6 +71,9 @@ type ListMeta struct {
 	// and values may only be valid for a particular resource or set of resources. Only servers
 	// will generate resource versions.
 	ResourceVersion string `json:"resourceVersion,omitempty" description:"string that identifies the internal version of this object that can be used by clients to determine when objects have changed; populated by the system, read-only; value must be treated as opaque by clients and passed unmodified back to the server: https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#concurrency-control-and-consistency"`
+
+	// Continue is set when a list was requested with a limit and more items remain.
+	Continue string `json:"continue,omitempty" description:"opaque token returned when a list was requested with a limit and more items remain; pass it as the continue parameter to retrieve the next chunk of the list; populated by the system, read-only"`
 }
 
 // ObjectMeta is metadata that all persisted resources must have, which includes all objects
@@ -120,6 +123,12 @@ type ObjectMeta struct {
 	// Clients may not set this value. It is represented in RFC3339 form and is in UTC.
 	CreationTimestamp util.Time `json:"creationTimestamp,omitempty" description:"RFC 3339 date and time at which the object was created; populated by the system, read-only; null for lists"`
 
+	// DeletionTimestamp is the time after which this resource will be deleted. It is set by
+	// the server when a graceful deletion is requested and may not be set directly by clients.
+	// Once set, the value may be shortened by a later delete request but never extended or
+	// unset. The resource remains visible until it is deleted, which may happen before this time.
+	DeletionTimestamp *util.Time `json:"deletionTimestamp,omitempty" description:"RFC 3339 date and time at which the object will be deleted; populated by the system when a graceful deletion is requested, read-only; if not set, graceful deletion of the object has not been requested"`
+
 	// Labels are key value pairs that may be used to scope and select individual resources.
 	// TODO: replace map[string]string with labels.LabelSet type
 	Labels map[string]string `json:"labels,omitempty" description:"map of string keys and values that can be used to organize and categorize objects; may match selectors of replication controllers and services"`
@@ -199,6 +208,15 @@ type VolumeSource struct {
 	GitRepo *GitRepoVolumeSource `json:"gitRepo" description:"git repository at a particular revision"`
 	// Secret represents a secret that should populate this volume.
 	Secret *SecretVolumeSource `json:"secret" description:"secret to populate volume"`
+	// DownwardAPI represents metadata about the pod that should populate this volume.
+	DownwardAPI *DownwardAPIVolumeSource `json:"downwardAPI" description:"metadata about the pod that should populate this volume"`
+	// PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.
+	PersistentVolumeClaimVolumeSource *PersistentVolumeClaimVolumeSource `json:"persistentVolumeClaim,omitempty" description:"claim in the same namespace to be mounted as a volume"`
+	// NFS represents an NFS mount on the host that shares a pod's lifetime.
+	NFS *NFSVolumeSource `json:"nfs" description:"NFS volume that will be mounted in the host machine"`
+	// ISCSI represents an ISCSI Disk resource that is attached to a
+	// kubelet's host machine and then exposed to the pod.
+	ISCSI *ISCSIVolumeSource `json:"iscsi" description:"iSCSI disk attached to host machine on demand"`
 }
 
 // HostPathVolumeSource represents bare host directory volume.
@@ -206,7 +224,199 @@ type HostPathVolumeSource struct {
 	Path string `json:"path" description:"path of the directory on the host"`
 }
 
-type EmptyDirVolumeSource struct{}
+// EmptyDirVolumeSource represents an empty directory for a pod.
+type EmptyDirVolumeSource struct {
+	// Optional: what type of storage medium should back this directory.
+	// The default is "" which means to use the node's default medium.
+	Medium StorageType `json:"medium" description:"type of storage used to back the volume; must be an empty string (default) or Memory"`
+	// Optional: the amount of storage a Memory medium may use. It is counted
+	// against the memory of the pod when scheduling.
+	SizeLimit *resource.Quantity `json:"sizeLimit,omitempty" description:"the maximum amount of memory a Memory medium may use"`
+}
+
+// StorageType defines ways that storage can be allocated to a volume.
+type StorageType string
+
+const (
+	StorageTypeDefault StorageType = ""       // use whatever the default is for the node
+	StorageTypeMemory  StorageType = "Memory" // use memory (tmpfs)
+)
+
+// NFSVolumeSource represents an NFS mount that lasts the lifetime of a pod.
+type NFSVolumeSource struct {
+	// Server is the hostname or IP address of the NFS server.
+	Server string `json:"server" description:"the hostname or IP address of the NFS server"`
+	// Path is the exported NFS share.
+	Path string `json:"path" description:"the path that is exported by the NFS server"`
+	// Optional: Defaults to false (read/write). ReadOnly here will force
+	// the NFS export to be mounted with read-only permissions.
+	ReadOnly bool `json:"readOnly,omitempty" description:"forces the NFS export to be mounted with read-only permissions"`
+}
+
+// ISCSIVolumeSource describes an iSCSI disk that is attached to the kubelet's
+// host machine through an iSCSI login and then mounted into the pod.
+type ISCSIVolumeSource struct {
+	// Required: iSCSI target portal, an IP or ip_addr:port
+	TargetPortal string `json:"targetPortal" description:"iSCSI target portal"`
+	// Required: target iSCSI Qualified Name
+	IQN string `json:"iqn" description:"iSCSI Qualified Name"`
+	// Required: iSCSI target lun number
+	Lun int `json:"lun" description:"iscsi target lun number"`
+	// Required: Filesystem type to mount.
+	// Must be a filesystem type supported by the host operating system.
+	// Ex. "ext4", "xfs", "ntfs"
+	// TODO: how do we prevent errors in the filesystem from compromising the machine
+	FSType string `json:"fsType" description:"file system type to mount, such as ext4, xfs, ntfs"`
+	// Optional: Defaults to false (read/write). ReadOnly here will force
+	// the ReadOnly setting in VolumeMounts.
+	ReadOnly bool `json:"readOnly,omitempty" description:"read-only if true, read-write otherwise (false or unspecified)"`
+}
+
+// PersistentVolumeClaimVolumeSource references the user's PersistentVolumeClaim in the same
+// namespace. The kubelet resolves the claim to the PersistentVolume bound to it and mounts
+// that volume.
+type PersistentVolumeClaimVolumeSource struct {
+	// ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod.
+	ClaimName string `json:"claimName" description:"the name of the claim in the same namespace to be mounted as a volume"`
+	// Optional: Defaults to false (read/write). ReadOnly here will force
+	// the ReadOnly setting in VolumeMounts.
+	ReadOnly bool `json:"readOnly,omitempty" description:"mount volume as read-only when true; default false"`
+}
+
+// PersistentVolumeSource is similar to VolumeSource but meant for the administrator
+// who creates PersistentVolumes. Exactly one of its members must be set.
+type PersistentVolumeSource struct {
+	// GCEPersistentDisk represents a GCE Disk resource that is attached to a
+	// kubelet's host machine and then exposed to the pod.
+	GCEPersistentDisk *GCEPersistentDiskVolumeSource `json:"gcePersistentDisk,omitempty" description:"GCE disk resource provisioned by an admin"`
+	// HostPath represents a directory on the host. This is useful for
+	// development and testing only; on-host storage is not supported in any way.
+	HostPath *HostPathVolumeSource `json:"hostPath,omitempty" description:"a HostPath provisioned by a developer or tester; for development use only"`
+	// NFS represents an NFS mount on the host.
+	NFS *NFSVolumeSource `json:"nfs,omitempty" description:"NFS volume resource provisioned by an admin"`
+	// ISCSI represents an ISCSI Disk resource that is attached to a
+	// kubelet's host machine and then exposed to the pod.
+	ISCSI *ISCSIVolumeSource `json:"iscsi,omitempty" description:"iSCSI disk resource provisioned by an admin"`
+}
+
+// PersistentVolume is a piece of storage provisioned by the cluster administrator.
+// PersistentVolumes are not namespaced and are bound to PersistentVolumeClaims.
+type PersistentVolume struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	// Spec defines a persistent volume owned by the cluster.
+	Spec PersistentVolumeSpec `json:"spec,omitempty" description:"specification of a persistent volume as provisioned by an administrator"`
+
+	// Status represents the current information about the persistent volume.
+	Status PersistentVolumeStatus `json:"status,omitempty" description:"current status of a persistent volume; populated by the system, read-only"`
+}
+
+// PersistentVolumeSpec describes the storage offered by a PersistentVolume.
+type PersistentVolumeSpec struct {
+	// Capacity represents the actual resources of the volume.
+	Capacity ResourceList `json:"capacity,omitempty" description:"a description of the persistent volume's resources and capacity"`
+	// Source represents the location and type of a volume to mount.
+	PersistentVolumeSource `json:",inline" description:"the actual volume backing the persistent volume"`
+	// AccessModes contains all ways the volume can be mounted.
+	AccessModes []AccessModeType `json:"accessModes,omitempty" description:"all ways the volume can be mounted"`
+	// ClaimRef is part of a bi-directional binding between PersistentVolume and PersistentVolumeClaim.
+	// ClaimRef is expected to be non-nil when bound.
+	ClaimRef *ObjectReference `json:"claimRef,omitempty" description:"the binding reference to a persistent volume claim"`
+}
+
+// PersistentVolumeStatus is the current status of a PersistentVolume.
+type PersistentVolumeStatus struct {
+	// Phase indicates if a volume is available, bound to a claim, or released by a claim.
+	Phase PersistentVolumePhase `json:"phase,omitempty" description:"the current phase of a persistent volume"`
+}
+
+// PersistentVolumeList is a list of PersistentVolume objects.
+type PersistentVolumeList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	Items []PersistentVolume `json:"items" description:"list of persistent volumes"`
+}
+
+// PersistentVolumeClaim is a user's request for and claim to a persistent volume.
+type PersistentVolumeClaim struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	// Spec defines the volume requested by a pod author.
+	Spec PersistentVolumeClaimSpec `json:"spec,omitempty" description:"the desired characteristics of a volume"`
+
+	// Status represents the current information about a claim.
+	Status PersistentVolumeClaimStatus `json:"status,omitempty" description:"the current status of a persistent volume claim; read-only"`
+}
+
+// PersistentVolumeClaimList is a list of PersistentVolumeClaim objects.
+type PersistentVolumeClaimList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	Items []PersistentVolumeClaim `json:"items" description:"a list of persistent volume claims"`
+}
+
+// PersistentVolumeClaimSpec describes the common attributes of storage devices
+// and allows a Source for provider-specific attributes.
+type PersistentVolumeClaimSpec struct {
+	// AccessModes contains the desired access modes the volume should have.
+	AccessModes []AccessModeType `json:"accessModes,omitempty" description:"the desired access modes the volume should have"`
+	// Resources represents the minimum resources the volume should have.
+	// The amount of storage is requested with the "storage" resource.
+	Resources ResourceRequirements `json:"resources,omitempty" description:"the desired resources the volume should have"`
+}
+
+// PersistentVolumeClaimStatus is the current status of a PersistentVolumeClaim.
+type PersistentVolumeClaimStatus struct {
+	// Phase represents the current phase of PersistentVolumeClaim.
+	Phase PersistentVolumeClaimPhase `json:"phase,omitempty" description:"the current phase of the claim"`
+	// AccessModes contains the actual access modes the volume has.
+	AccessModes []AccessModeType `json:"accessModes,omitempty" description:"the actual access modes the volume has"`
+	// Capacity represents the actual resources of the underlying volume.
+	Capacity ResourceList `json:"capacity,omitempty" description:"the actual resources the volume has"`
+	// VolumeRef is a reference to the PersistentVolume bound to the PersistentVolumeClaim.
+	VolumeRef *ObjectReference `json:"volumeRef,omitempty" description:"a reference to the backing persistent volume, when bound"`
+}
+
+// AccessModeType describes the ways a PersistentVolume can be mounted.
+type AccessModeType string
+
+const (
+	// ReadWriteOnce can be mounted read/write by a single host.
+	ReadWriteOnce AccessModeType = "ReadWriteOnce"
+	// ReadOnlyMany can be mounted read-only by many hosts.
+	ReadOnlyMany AccessModeType = "ReadOnlyMany"
+	// ReadWriteMany can be mounted read/write by many hosts.
+	ReadWriteMany AccessModeType = "ReadWriteMany"
+)
+
+// PersistentVolumePhase is the lifecycle phase of a PersistentVolume.
+type PersistentVolumePhase string
+
+const (
+	// VolumePending is used for PersistentVolumes that are not yet available.
+	VolumePending PersistentVolumePhase = "Pending"
+	// VolumeAvailable is used for PersistentVolumes that are not yet bound.
+	VolumeAvailable PersistentVolumePhase = "Available"
+	// VolumeBound is used for PersistentVolumes that are bound to a claim.
+	VolumeBound PersistentVolumePhase = "Bound"
+	// VolumeReleased is used for PersistentVolumes whose claim was deleted.
+	// A released volume is not bound again until an administrator reclaims it.
+	VolumeReleased PersistentVolumePhase = "Released"
+)
+
+// PersistentVolumeClaimPhase is the lifecycle phase of a PersistentVolumeClaim.
+type PersistentVolumeClaimPhase string
+
+const (
+	// ClaimPending is used for PersistentVolumeClaims that are not yet bound.
+	ClaimPending PersistentVolumeClaimPhase = "Pending"
+	// ClaimBound is used for PersistentVolumeClaims that are bound to a volume.
+	ClaimBound PersistentVolumeClaimPhase = "Bound"
+)
 
 // Protocol defines network protocols supported for things like conatiner ports.
 type Protocol string
@@ -254,6 +464,25 @@ type SecretVolumeSource struct {
 	Target ObjectReference `json:"target" description:"target is a reference to a secret"`
 }
 
+// DownwardAPIVolumeSource represents a volume containing information about the pod.
+//
+// Each item is written to a file in the volume, and the files are updated
+// when the selected fields of the pod change.
+type DownwardAPIVolumeSource struct {
+	// Items is a list of files to populate the volume with.
+	Items []DownwardAPIVolumeFile `json:"items,omitempty" description:"list of files to populate the volume with"`
+}
+
+// DownwardAPIVolumeFile represents a single file containing information about the pod.
+type DownwardAPIVolumeFile struct {
+	// Required: Path is the relative path of the file to be created. Must not be
+	// absolute or contain the '..' path element.
+	Path string `json:"path" description:"relative path of the file to be created; must not be absolute or contain the '..' path element"`
+	// Required: Selects a field of the pod. Only name, namespace, labels and
+	// annotations are supported.
+	FieldRef ObjectFieldSelector `json:"fieldRef" description:"selects a field of the pod; only name, namespace, labels and annotations are supported"`
+}
+
 // ContainerPort represents a network port in a single container.
 type ContainerPort struct {
 	// Optional: If specified, this must be a DNS_LABEL.  Each named port
@@ -285,6 +514,22 @@ type EnvVar struct {
 	Name string `json:"name" description:"name of the environment variable; must be a C_IDENTIFIER"`
 	// Optional: defaults to "".
 	Value string `json:"value,omitempty" description:"value of the environment variable; defaults to empty string"`
+	// Optional: Specifies a source the value of this var should come from.
+	// May not be set if Value is not empty.
+	ValueFrom *EnvVarSource `json:"valueFrom,omitempty" description:"source for the environment variable's value; cannot be used if value is not empty"`
+}
+
+// EnvVarSource represents a source for the value of an EnvVar.
+type EnvVarSource struct {
+	// Required: Selects a field of the pod. Only name, namespace and podIP are
+	// supported.
+	FieldRef *ObjectFieldSelector `json:"fieldRef" description:"selects a field of the pod; only name, namespace and podIP are supported"`
+}
+
+// ObjectFieldSelector selects a field of an object.
+type ObjectFieldSelector struct {
+	// Required: Path of the field to select, e.g. "metadata.name".
+	FieldPath string `json:"fieldPath" description:"path of the field to select, e.g. metadata.name"`
 }
 
 // HTTPGetAction describes an action based on HTTP Get requests.
@@ -345,10 +590,40 @@ type Capabilities struct {
 	Drop []CapabilityType `json:"drop,omitempty" description:"droped capabilities"`
 }
 
+// SecurityContext holds security configuration that will be applied to a container.
+type SecurityContext struct {
+	// RunAsUser is the UID to run the entrypoint of the container process.
+	// Defaults to the user specified in the image metadata if unspecified.
+	RunAsUser *int64 `json:"runAsUser,omitempty" description:"the user id that runs the first process in the container; defaults to the user specified in the image"`
+	// RunAsNonRoot indicates that the container must run as a non-root user.
+	// The kubelet refuses to start a container that would run as UID 0.
+	RunAsNonRoot bool `json:"runAsNonRoot,omitempty" description:"the container must run as a non-root user if true"`
+	// SELinuxOptions are the labels to be applied to the container.
+	// Unspecified fields are allocated by the container runtime.
+	SELinuxOptions *SELinuxOptions `json:"seLinuxOptions,omitempty" description:"the SELinux labels to be applied to the container"`
+	// ReadOnlyRootFilesystem mounts the container's root filesystem as read-only.
+	ReadOnlyRootFilesystem bool `json:"readOnlyRootFilesystem,omitempty" description:"mount the root filesystem of the container as read-only if true"`
+}
+
+// SELinuxOptions are the labels to be applied to the container.
+type SELinuxOptions struct {
+	// SELinux user label
+	User string `json:"user,omitempty" description:"the SELinux user label that applies to the container"`
+	// SELinux role label
+	Role string `json:"role,omitempty" description:"the SELinux role label that applies to the container"`
+	// SELinux type label
+	Type string `json:"type,omitempty" description:"the SELinux type label that applies to the container"`
+	// SELinux level label
+	Level string `json:"level,omitempty" description:"the SELinux level label that applies to the container"`
+}
+
 // ResourceRequirements describes the compute resource requirements.
 type ResourceRequirements struct {
 	// Limits describes the maximum amount of compute resources required.
 	Limits ResourceList `json:"limits,omitempty" description:"Maximum amount of compute resources allowed"`
+	// Requests describes the minimum amount of compute resources required.
+	// Requests default to Limits for the resources that have a limit.
+	Requests ResourceList `json:"requests,omitempty" description:"Minimum amount of compute resources required; used for scheduling; defaults to Limits"`
 }
 
 const (
@@ -367,10 +642,10 @@ type Container struct {
 	Command []string `json:"command,omitempty" description:"command argv array; not executed within a shell; defaults to entrypoint or command in the image"`
 	// Optional: Defaults to Docker's default.
 	WorkingDir     string               `json:"workingDir,omitempty" description:"container's working directory; defaults to image's default"`
-	Ports          []ContainerPort      `json:"ports,omitempty" description:"list of ports to expose from the container"`
-	Env            []EnvVar             `json:"env,omitempty" description:"list of environment variables to set in the container"`
+	Ports          []ContainerPort      `json:"ports,omitempty" patchStrategy:"merge" patchMergeKey:"containerPort" description:"list of ports to expose from the container"`
+	Env            []EnvVar             `json:"env,omitempty" patchStrategy:"merge" patchMergeKey:"name" description:"list of environment variables to set in the container"`
 	Resources      ResourceRequirements `json:"resources,omitempty" description:"Compute Resources required by this container"`
-	VolumeMounts   []VolumeMount        `json:"volumeMounts,omitempty" description:"pod volumes to mount into the container's filesystem"`
+	VolumeMounts   []VolumeMount        `json:"volumeMounts,omitempty" patchStrategy:"merge" patchMergeKey:"mountPath" description:"pod volumes to mount into the container's filesystem"`
 	LivenessProbe  *Probe               `json:"livenessProbe,omitempty" description:"periodic probe of container liveness; container will be restarted if the probe fails"`
 	ReadinessProbe *Probe               `json:"readinessProbe,omitempty" description:"periodic probe of container service readiness; container will be removed from service endpoints if the probe fails"`
 	Lifecycle      *Lifecycle           `json:"lifecycle,omitempty" description:"actions that the management system should take in response to container lifecycle events"`
@@ -382,6 +657,8 @@ type Container struct {
 	ImagePullPolicy PullPolicy `json:"imagePullPolicy" description:"image pull policy; one of PullAlways, PullNever, PullIfNotPresent; defaults to PullAlways if :latest tag is specified, or PullIfNotPresent otherwise"`
 	// Optional: Capabilities for container.
 	Capabilities Capabilities `json:"capabilities,omitempty" description:"capabilities for container"`
+	// Optional: SecurityContext defines the security options the container should be run with.
+	SecurityContext *SecurityContext `json:"securityContext,omitempty" description:"security options the container should be run with"`
 }
 
 // Handler defines a specific action that should be taken
@@ -543,8 +820,8 @@ const (
 
 // PodSpec is a description of a pod
 type PodSpec struct {
-	Volumes       []Volume      `json:"volumes" description:"list of volumes that can be mounted by containers belonging to the pod"`
-	Containers    []Container   `json:"containers" description:"list of containers belonging to the pod"`
+	Volumes       []Volume      `json:"volumes" patchStrategy:"merge" patchMergeKey:"name" description:"list of volumes that can be mounted by containers belonging to the pod"`
+	Containers    []Container   `json:"containers" patchStrategy:"merge" patchMergeKey:"name" description:"list of containers belonging to the pod"`
 	RestartPolicy RestartPolicy `json:"restartPolicy,omitempty" description:"restart policy for all containers within the pod; one of RestartPolicyAlways, RestartPolicyOnFailure, RestartPolicyNever"`
 	// Optional: Set DNS policy.  Defaults to "ClusterFirst"
 	DNSPolicy DNSPolicy `json:"dnsPolicy,omitempty" description:"DNS policy for containers within the pod; one of 'ClusterFirst' or 'Default'"`
@@ -555,6 +832,18 @@ type PodSpec struct {
 	// the the scheduler simply schedules this pod onto that host, assuming that it fits
 	// resource requirements.
 	Host string `json:"host,omitempty" description:"host requested for this pod"`
+
+	// Optional duration in seconds the pod needs to terminate gracefully. May be decreased in delete request.
+	// Zero means the containers are killed immediately.
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty" description:"optional duration in seconds the pod needs to terminate gracefully; may be decreased in delete request; value must be non-negative integer; the value zero indicates delete immediately; defaults to 30 seconds"`
+
+	// Optional name of the ServiceAccount used to run this pod. If unset, the ServiceAccount
+	// admission plugin assigns the namespace's default account.
+	ServiceAccount string `json:"serviceAccount,omitempty" description:"name of the ServiceAccount to use to run this pod"`
+
+	// Optional list of references to secrets in the same namespace to use for pulling the container images.
+	// Only secrets of type "kubernetes.io/dockercfg" are used.
+	ImagePullSecrets []LocalObjectReference `json:"imagePullSecrets,omitempty" description:"list of references to secrets in the same namespace available for pulling the container images"`
 }
 
 // PodStatus represents information about the status of a pod. Status may trail the actual
@@ -708,6 +997,394 @@ type ReplicationControllerList struct {
 	Items []ReplicationController `json:"items" description:"list of replication controllers"`
 }
 
+// Job represents the configuration of a single job, which runs pods until a
+// desired number of them have completed successfully.
+type Job struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	// Spec is a structure defining the expected behavior of a job.
+	Spec JobSpec `json:"spec,omitempty" description:"specification of the desired behavior of the job"`
+
+	// Status is a structure describing the current status of a job.
+	Status JobStatus `json:"status,omitempty" description:"most recently observed status of the job; populated by the system, read-only"`
+}
+
+// JobList is a collection of jobs.
+type JobList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	Items []Job `json:"items" description:"list of jobs"`
+}
+
+// JobSpec describes how the job execution will look like.
+type JobSpec struct {
+	// Parallelism is the maximum number of pods the job should run at any given time.
+	Parallelism *int `json:"parallelism,omitempty" description:"maximum number of pods the job should run at any given time; defaults to completions"`
+
+	// Completions is the number of pods that must finish successfully for the job
+	// to be complete.
+	Completions *int `json:"completions,omitempty" description:"number of pods that must finish successfully for the job to be complete; defaults to 1"`
+
+	// Selector is a label query over the pods that belong to the job.
+	Selector map[string]string `json:"selector" description:"label query over the pods that belong to the job; defaults to the labels of the pod template"`
+
+	// Template is the object that describes the pods that will be created when
+	// executing the job.
+	Template PodTemplateSpec `json:"template" description:"object that describes the pods that will be created when executing the job"`
+}
+
+// JobStatus represents the current state of a job.
+type JobStatus struct {
+	// StartTime is the time the job was first acknowledged by the job controller.
+	StartTime *util.Time `json:"startTime,omitempty" description:"RFC 3339 date and time at which the job was acknowledged by the job controller"`
+
+	// CompletionTime is the time the job completed.
+	CompletionTime *util.Time `json:"completionTime,omitempty" description:"RFC 3339 date and time at which the job completed"`
+
+	// Active is the number of pods of the job that are pending or running.
+	Active int `json:"active,omitempty" description:"number of pods of the job that are pending or running"`
+
+	// Succeeded is the number of pods of the job that finished successfully.
+	Succeeded int `json:"succeeded,omitempty" description:"number of pods of the job that finished successfully"`
+
+	// Failed is the number of pods of the job that failed.
+	Failed int `json:"failed,omitempty" description:"number of pods of the job that failed"`
+}
+
+// DaemonSet represents the configuration of a daemon set, which runs one copy
+// of a pod on every node that matches the node selector of its pod template.
+type DaemonSet struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	// Spec defines the desired behavior of the daemon set.
+	Spec DaemonSetSpec `json:"spec,omitempty" description:"specification of the desired behavior of the daemon set"`
+
+	// Status is the current status of the daemon set.
+	Status DaemonSetStatus `json:"status,omitempty" description:"most recently observed status of the daemon set; populated by the system, read-only"`
+}
+
+// DaemonSetList is a collection of daemon sets.
+type DaemonSetList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	Items []DaemonSet `json:"items" description:"list of daemon sets"`
+}
+
+// DaemonSetSpec is the specification of a daemon set.
+type DaemonSetSpec struct {
+	// Selector is a label query over the pods that are managed by the daemon set.
+	Selector map[string]string `json:"selector" description:"label query over the pods that are managed by the daemon set; defaults to the labels of the pod template"`
+
+	// Template is the object that describes the pod that will be created on
+	// every node. Only nodes matching the node selector of the template run
+	// the pod.
+	Template PodTemplateSpec `json:"template" description:"object that describes the pod that will be created on every node that matches the node selector of the template"`
+}
+
+// DaemonSetStatus represents the current status of a daemon set.
+type DaemonSetStatus struct {
+	// CurrentNumberScheduled is the number of nodes that run the daemon pod and
+	// are supposed to.
+	CurrentNumberScheduled int `json:"currentNumberScheduled" description:"number of nodes that are running the daemon pod and are supposed to"`
+
+	// NumberMisscheduled is the number of nodes that run the daemon pod but are
+	// not supposed to.
+	NumberMisscheduled int `json:"numberMisscheduled" description:"number of nodes that are running the daemon pod but are not supposed to"`
+
+	// DesiredNumberScheduled is the number of nodes that should run the daemon pod.
+	DesiredNumberScheduled int `json:"desiredNumberScheduled" description:"number of nodes that should be running the daemon pod"`
+}
+
+// Deployment represents the configuration of a deployment, which rolls out
+// changes to a pod template by driving the replication controllers of the
+// old and new revisions.
+type Deployment struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	// Spec defines the desired behavior of the deployment.
+	Spec DeploymentSpec `json:"spec,omitempty" description:"specification of the desired behavior of the deployment"`
+
+	// Status is the current status of the deployment.
+	Status DeploymentStatus `json:"status,omitempty" description:"most recently observed status of the deployment; populated by the system, read-only"`
+}
+
+// DeploymentList is a collection of deployments.
+type DeploymentList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	Items []Deployment `json:"items" description:"list of deployments"`
+}
+
+// DeploymentSpec is the specification of a deployment.
+type DeploymentSpec struct {
+	// Replicas is the number of desired pods.
+	Replicas int `json:"replicas" description:"number of desired pods"`
+
+	// Selector is a label query over the pods that are managed by the deployment.
+	Selector map[string]string `json:"selector" description:"label query over the pods that are managed by the deployment; defaults to the labels of the pod template"`
+
+	// Template is the object that describes the pods the deployment should
+	// run. Changing it starts a rollout to a new revision.
+	Template PodTemplateSpec `json:"template" description:"object that describes the pods the deployment should run"`
+
+	// Strategy is how existing pods are replaced by new ones.
+	Strategy DeploymentStrategy `json:"strategy,omitempty" description:"strategy used to replace existing pods with new ones"`
+
+	// Paused stops the rollout where it is until it is unset.
+	Paused bool `json:"paused,omitempty" description:"the rollout is stopped where it is while set"`
+
+	// RollbackTo, when set, rolls the template back to that of an earlier
+	// revision. It is cleared once the rollback has been started.
+	RollbackTo *RollbackConfig `json:"rollbackTo,omitempty" description:"revision to roll the template back to; cleared once the rollback has started"`
+}
+
+// DeploymentStrategyType is the kind of strategy a deployment uses to replace pods.
+type DeploymentStrategyType string
+
+const (
+	// RecreateDeploymentStrategyType kills all existing pods before creating new ones.
+	RecreateDeploymentStrategyType DeploymentStrategyType = "Recreate"
+
+	// RollingUpdateDeploymentStrategyType gradually replaces old pods with new ones.
+	RollingUpdateDeploymentStrategyType DeploymentStrategyType = "RollingUpdate"
+)
+
+// DeploymentStrategy describes how to replace existing pods with new ones.
+type DeploymentStrategy struct {
+	// Type of the strategy, either "Recreate" or "RollingUpdate".
+	Type DeploymentStrategyType `json:"type,omitempty" description:"type of the strategy; either Recreate or RollingUpdate, which is the default"`
+
+	// RollingUpdate holds the parameters of a rolling update.
+	RollingUpdate *RollingUpdateDeployment `json:"rollingUpdate,omitempty" description:"parameters of a rolling update; only used with the RollingUpdate strategy"`
+}
+
+// RollingUpdateDeployment holds the parameters of a rolling update.
+type RollingUpdateDeployment struct {
+	// MaxUnavailable is the number of pods that may be unavailable during the
+	// update, below the desired number of pods.
+	MaxUnavailable int `json:"maxUnavailable" description:"number of pods that may be unavailable below the desired number of pods during the update"`
+
+	// MaxSurge is the number of pods that may be created above the desired
+	// number of pods during the update.
+	MaxSurge int `json:"maxSurge" description:"number of pods that may be created above the desired number of pods during the update"`
+}
+
+// RollbackConfig names the revision a deployment rolls back to.
+type RollbackConfig struct {
+	// Revision to roll back to. Zero means the revision before the current one.
+	Revision int64 `json:"revision,omitempty" description:"revision to roll back to; zero means the revision before the current one"`
+}
+
+// DeploymentStatus represents the current status of a deployment.
+type DeploymentStatus struct {
+	// Replicas is the number of pods of all revisions.
+	Replicas int `json:"replicas" description:"number of pods of all revisions"`
+
+	// UpdatedReplicas is the number of pods of the current revision.
+	UpdatedReplicas int `json:"updatedReplicas" description:"number of pods of the current revision"`
+
+	// AvailableReplicas is the number of ready pods of all revisions.
+	AvailableReplicas int `json:"availableReplicas" description:"number of ready pods of all revisions"`
+
+	// Revision is the revision of the current pod template.
+	Revision int64 `json:"revision,omitempty" description:"revision of the current pod template"`
+}
+
+// HorizontalPodAutoscaler represents the configuration of a horizontal pod
+// autoscaler, which resizes a replication controller to keep the CPU usage
+// of its pods near a target.
+type HorizontalPodAutoscaler struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	// Spec defines the desired behavior of the autoscaler.
+	Spec HorizontalPodAutoscalerSpec `json:"spec,omitempty" description:"specification of the desired behavior of the autoscaler"`
+
+	// Status is the current status of the autoscaler.
+	Status HorizontalPodAutoscalerStatus `json:"status,omitempty" description:"most recently observed status of the autoscaler; populated by the system, read-only"`
+}
+
+// HorizontalPodAutoscalerList is a collection of horizontal pod autoscalers.
+type HorizontalPodAutoscalerList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	Items []HorizontalPodAutoscaler `json:"items" description:"list of horizontal pod autoscalers"`
+}
+
+// HorizontalPodAutoscalerSpec is the specification of a horizontal pod autoscaler.
+type HorizontalPodAutoscalerSpec struct {
+	// ScaleRef is a reference to the replication controller to scale, in the
+	// namespace of the autoscaler.
+	ScaleRef ObjectReference `json:"scaleRef" description:"reference to the replication controller to scale, in the namespace of the autoscaler"`
+
+	// MinReplicas is the lower limit for the number of pods.
+	MinReplicas int `json:"minReplicas,omitempty" description:"lower limit for the number of pods; defaults to 1"`
+
+	// MaxReplicas is the upper limit for the number of pods.
+	MaxReplicas int `json:"maxReplicas" description:"upper limit for the number of pods"`
+
+	// TargetCPUUtilizationPercentage is the average CPU usage of the pods the
+	// autoscaler aims for, as a percentage of their CPU request.
+	TargetCPUUtilizationPercentage int `json:"targetCPUUtilizationPercentage,omitempty" description:"average CPU usage of the pods to aim for, as a percentage of their CPU request; defaults to 80"`
+}
+
+// HorizontalPodAutoscalerStatus represents the current status of a horizontal
+// pod autoscaler.
+type HorizontalPodAutoscalerStatus struct {
+	// CurrentReplicas is the number of pods the autoscaler last saw.
+	CurrentReplicas int `json:"currentReplicas" description:"number of pods last seen by the autoscaler"`
+
+	// DesiredReplicas is the number of pods the autoscaler last asked for.
+	DesiredReplicas int `json:"desiredReplicas" description:"number of pods last asked for by the autoscaler"`
+
+	// CurrentCPUUtilizationPercentage is the average CPU usage of the pods the
+	// autoscaler last saw, as a percentage of their CPU request.
+	CurrentCPUUtilizationPercentage *int `json:"currentCPUUtilizationPercentage,omitempty" description:"average CPU usage of the pods last seen by the autoscaler, as a percentage of their CPU request"`
+
+	// LastScaleTime is the last time the autoscaler changed the number of pods.
+	LastScaleTime *util.Time `json:"lastScaleTime,omitempty" description:"last time the autoscaler changed the number of pods"`
+}
+
+// ThirdPartyResource declares a kind of API object that is not compiled into
+// the apiserver. The name of a ThirdPartyResource is "<kind>.<group>", e.g.
+// "database-cluster.example.com" declares the kind DatabaseCluster in the API
+// group example.com. Once created, objects of the kind are served under
+// /thirdparty/<group>/<version> for each of the declared versions.
+type ThirdPartyResource struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	// Description is a human readable description of the kind.
+	Description string `json:"description,omitempty" description:"human readable description of the kind"`
+
+	// Versions are the API versions the kind is served under.
+	Versions []APIVersion `json:"versions,omitempty" description:"API versions the kind is served under"`
+}
+
+// ThirdPartyResourceList is a collection of third party resources.
+type ThirdPartyResourceList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	Items []ThirdPartyResource `json:"items" description:"list of third party resources"`
+}
+
+// APIVersion is an API version of a third party resource.
+type APIVersion struct {
+	// Name is the name of the version, e.g. "v1".
+	Name string `json:"name" description:"name of the version, e.g. v1"`
+}
+
+// PolicyRule allows a set of verbs on a set of resources.
+type PolicyRule struct {
+	// Verbs are the verbs the rule allows, e.g. "get", "list", "watch", "create",
+	// "update", "patch" or "delete". "*" allows every verb.
+	Verbs []string `json:"verbs" description:"verbs the rule allows, or * for every verb"`
+	// Resources are the resources the rule applies to, e.g. "pods". "*" applies
+	// the rule to every resource.
+	Resources []string `json:"resources" description:"resources the rule applies to, or * for every resource"`
+	// ResourceNames optionally restricts the rule to the objects with these names.
+	ResourceNames []string `json:"resourceNames,omitempty" description:"optional names of the objects the rule is restricted to"`
+}
+
+// Role is a set of rules that is granted within a namespace by RoleBindings.
+type Role struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	// Rules are the rules of the role.
+	Rules []PolicyRule `json:"rules" description:"rules of the role"`
+}
+
+// RoleList is a collection of roles.
+type RoleList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	Items []Role `json:"items" description:"list of roles"`
+}
+
+// ClusterRole is a set of rules that can be granted in every namespace, or
+// within one namespace by a RoleBinding.
+type ClusterRole struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	// Rules are the rules of the role.
+	Rules []PolicyRule `json:"rules" description:"rules of the role"`
+}
+
+// ClusterRoleList is a collection of cluster roles.
+type ClusterRoleList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	Items []ClusterRole `json:"items" description:"list of cluster roles"`
+}
+
+// Subject is a user or group a role is bound to.
+type Subject struct {
+	// Kind is "User" or "Group".
+	Kind string `json:"kind" description:"kind of the subject; User or Group"`
+	// Name is the name of the user or group.
+	Name string `json:"name" description:"name of the user or group"`
+}
+
+// RoleRef refers to the role a binding grants.
+type RoleRef struct {
+	// Kind is "Role" or "ClusterRole".
+	Kind string `json:"kind" description:"kind of the role; Role or ClusterRole"`
+	// Name is the name of the role.
+	Name string `json:"name" description:"name of the role"`
+}
+
+// RoleBinding grants the rules of a Role in its own namespace, or of a
+// ClusterRole, to a set of subjects within the namespace of the binding.
+type RoleBinding struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	// Subjects are the users and groups the role is granted to.
+	Subjects []Subject `json:"subjects" description:"users and groups the role is granted to"`
+	// RoleRef is the role that is granted.
+	RoleRef RoleRef `json:"roleRef" description:"role that is granted"`
+}
+
+// RoleBindingList is a collection of role bindings.
+type RoleBindingList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	Items []RoleBinding `json:"items" description:"list of role bindings"`
+}
+
+// ClusterRoleBinding grants the rules of a ClusterRole to a set of subjects in
+// every namespace.
+type ClusterRoleBinding struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	// Subjects are the users and groups the role is granted to.
+	Subjects []Subject `json:"subjects" description:"users and groups the role is granted to"`
+	// RoleRef is the cluster role that is granted.
+	RoleRef RoleRef `json:"roleRef" description:"cluster role that is granted"`
+}
+
+// ClusterRoleBindingList is a collection of cluster role bindings.
+type ClusterRoleBindingList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	Items []ClusterRoleBinding `json:"items" description:"list of cluster role bindings"`
+}
+
 // Session Affinity Type string
 type AffinityType string
 
@@ -724,12 +1401,8 @@ type ServiceStatus struct{}
 
 // ServiceSpec describes the attributes that a user creates on a service
 type ServiceSpec struct {
-	// Port is the TCP or UDP port that will be made available to each pod for connecting to the pods
-	// proxied by this service.
-	Port int `json:"port" description:"port exposed by the service"`
-
-	// Optional: Supports "TCP" and "UDP".  Defaults to "TCP".
-	Protocol Protocol `json:"protocol,omitempty" description:"protocol for port; must be UDP or TCP; TCP if unspecified"`
+	// Required: The list of ports that are exposed by this service.
+	Ports []ServicePort `json:"ports" description:"ports exposed by the service"`
 
 	// This service will route traffic to pods having labels matching this selector. If null, no endpoints will be automatically created. If empty, all pods will be selected.
 	Selector map[string]string `json:"selector" description:"label keys and values that must match in order to receive traffic for this service; if empty, all pods are selected, if not specified, endpoints must be manually specified"`
@@ -746,15 +1419,32 @@ type ServiceSpec struct {
 	// users to handle external traffic that arrives at a node.
 	PublicIPs []string `json:"publicIPs,omitempty" description:"externally visible IPs (e.g. load balancers) that should be proxied to this service"`
 
-	// ContainerPort is the name or number of the port on the container to direct traffic to.
-	// This is useful if the containers the service points to have multiple open ports.
-	// Optional: If unspecified, the service port is used (an identity map).
-	ContainerPort util.IntOrString `json:"containerPort,omitempty" description:"number or name of the port to access on the containers belonging to pods targeted by the service; defaults to the container's first open port"`
-
 	// Optional: Supports "ClientIP" and "None".  Used to maintain session affinity.
 	SessionAffinity AffinityType `json:"sessionAffinity,omitempty" description:"enable client IP based session affinity; must be ClientIP or None; defaults to None"`
 }
 
+// ServicePort describes a single port exposed by a service.
+type ServicePort struct {
+	// Optional if only one ServicePort is defined on this service: The
+	// name of this port within the service.  This must be a DNS_LABEL.
+	// All ports within a ServiceSpec must have unique names.  This maps to
+	// the Name field in EndpointPort objects.
+	Name string `json:"name,omitempty" description:"the name of this port; optional if only one port is defined"`
+
+	// Optional: The IP protocol for this port.  Supports "TCP" and "UDP",
+	// defaults to "TCP".
+	Protocol Protocol `json:"protocol,omitempty" description:"the protocol used by this port; must be UDP or TCP; TCP if unspecified"`
+
+	// Required: The port that will be exposed on the service.
+	Port int `json:"port" description:"the port number that is exposed"`
+
+	// Optional: The name or number of the port on the container to direct
+	// traffic to.  If this is a string, it will be looked up as a named port
+	// in the target pod's container ports.  If unspecified, the service port
+	// is used (an identity map).
+	ContainerPort util.IntOrString `json:"containerPort,omitempty" description:"number or name of the port to access on the containers belonging to pods targeted by the service; defaults to the service port"`
+}
+
 // Service is a named abstraction of software service (for example, mysql) consisting of local port
 // (for example 3306) that the proxy listens on, and the selector that determines which pods
 // will answer requests sent through the proxy.
@@ -777,17 +1467,33 @@ type ServiceList struct {
 	Items []Service `json:"items" description:"list of services"`
 }
 
-// Endpoints is a collection of endpoints that implement the actual service, for example:
-// Name: "mysql", Endpoints: [{"ip": "10.10.1.1", "port": 1909}, {"ip": "10.10.2.2", "port": 8834}]
+// Endpoints is a collection of endpoints that implement the actual service, grouped
+// by the service port they serve, for example:
+//   Name: "mysql",
+//   Ports: [
+//     {Name: "db", Protocol: "TCP", Endpoints: [{"ip": "10.10.1.1", "port": 3306}, {"ip": "10.10.2.2", "port": 3306}]},
+//     {Name: "metrics", Protocol: "TCP", Endpoints: [{"ip": "10.10.1.1", "port": 9102}, {"ip": "10.10.2.2", "port": 9102}]},
+//   ]
 type Endpoints struct {
 	TypeMeta   `json:",inline"`
 	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
+	// Ports is the set of service ports that have endpoints, each with the
+	// list of addresses that serve it.
+	Ports []EndpointPort `json:"ports,omitempty" description:"endpoints corresponding to a service, grouped by service port"`
+}
+
+// EndpointPort is the set of endpoints that serve a single named port of a service.
+type EndpointPort struct {
+	// Optional if only one port is defined on the service: The name of the
+	// service port these endpoints serve.
+	Name string `json:"name,omitempty" description:"name of the service port these endpoints serve; must match the name of a service port"`
+
 	// Optional: The IP protocol for these endpoints. Supports "TCP" and
 	// "UDP".  Defaults to "TCP".
 	Protocol Protocol `json:"protocol,omitempty" description:"IP protocol for endpoint ports; must be UDP or TCP; TCP if unspecified"`
 
-	Endpoints []Endpoint `json:"endpoints,omitempty" description:"list of endpoints corresponding to a service"`
+	Endpoints []Endpoint `json:"endpoints,omitempty" description:"list of endpoints serving this port"`
 }
 
 // Endpoint is a single IP endpoint of a service.
@@ -884,6 +1590,8 @@ const (
 	ResourceCPU ResourceName = "cpu"
 	// Memory, in bytes. (500Gi = 500GiB = 500 * 1024 * 1024 * 1024)
 	ResourceMemory ResourceName = "memory"
+	// Volume size, in bytes (e,g. 5Gi = 5GiB = 5 * 1024 * 1024 * 1024)
+	ResourceStorage ResourceName = "storage"
 )
 
 // ResourceList is a set of (resource name, quantity) pairs.
@@ -912,12 +1620,33 @@ type NodeList struct {
 
 // NamespaceSpec describes the attributes on a Namespace
 type NamespaceSpec struct {
+	// Finalizers is an opaque list of values that must be empty to permanently remove object from storage
+	Finalizers []FinalizerName `json:"finalizers,omitempty" description:"an opaque list of values that must be empty to permanently remove object from storage"`
 }
 
+type FinalizerName string
+
+// These are internal finalizer values to Kubernetes, must be qualified name unless defined here
+const (
+	FinalizerKubernetes FinalizerName = "kubernetes"
+)
+
 // NamespaceStatus is information about the current status of a Namespace.
 type NamespaceStatus struct {
+	// Phase is the current lifecycle phase of the namespace.
+	Phase NamespacePhase `json:"phase,omitempty" description:"phase is the current lifecycle phase of the namespace"`
 }
 
+type NamespacePhase string
+
+// These are the valid phases of a namespace.
+const (
+	// NamespaceActive means the namespace is available for use in the system
+	NamespaceActive NamespacePhase = "Active"
+	// NamespaceTerminating means the namespace is undergoing graceful termination
+	NamespaceTerminating NamespacePhase = "Terminating"
+)
+
 // A namespace provides a scope for Names.
 // Use of multiple namespaces is optional
 type Namespace struct {
@@ -950,6 +1679,16 @@ type Binding struct {
 	Target ObjectReference `json:"target" description:"an object to bind to"`
 }
 
+// DeleteOptions may be provided when deleting an API object
+type DeleteOptions struct {
+	TypeMeta `json:",inline"`
+
+	// Optional duration in seconds before the object should be deleted. Value must be non-negative
+	// integer. The value zero indicates delete immediately. If this value is nil, the default grace
+	// period for the specified type will be used.
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds" description:"the duration in seconds to wait before deleting this object; defaults to a per object value if not specified; zero means delete immediately"`
+}
+
 // Status is a return value for calls that don't return other objects.
 type Status struct {
 	TypeMeta `json:",inline"`
@@ -1122,6 +1861,11 @@ type ObjectReference struct {
 	FieldPath string `json:"fieldPath,omitempty" description:"if referring to a piece of an object instead of an entire object, this string should contain a valid JSON/Go field access statement, such as desiredState.manifest.containers[2]"`
 }
 
+// LocalObjectReference contains enough information to let you locate the referenced object inside the same namespace.
+type LocalObjectReference struct {
+	Name string `json:"name,omitempty" description:"name of the referent"`
+}
+
 type EventSource struct {
 	// Component from which the event is generated.
 	Component string `json:"component,omitempty" description:"component that generated the event"`
@@ -1230,6 +1974,10 @@ const (
 	ResourceReplicationControllers ResourceName = "replicationcontrollers"
 	// ResourceQuotas, number
 	ResourceQuotas ResourceName = "resourcequotas"
+	// CPU limit, in cores. The "cpu" resource of a quota constrains requests.
+	ResourceLimitsCPU ResourceName = "limits.cpu"
+	// Memory limit, in bytes. The "memory" resource of a quota constrains requests.
+	ResourceLimitsMemory ResourceName = "limits.memory"
 )
 
 // ResourceQuotaSpec defines the desired hard limits to enforce for Quota
@@ -1298,6 +2046,30 @@ type SecretType string
 
 const (
 	SecretTypeOpaque SecretType = "Opaque" // Default; arbitrary user-defined data
+
+	// SecretTypeDockercfg contains a dockercfg file that follows the same format rules as ~/.dockercfg
+	//
+	// Required fields:
+	// - Secret.Data[".dockercfg"] - a serialized ~/.dockercfg file
+	SecretTypeDockercfg SecretType = "kubernetes.io/dockercfg"
+
+	// DockerConfigKey is the key of the required data for SecretTypeDockercfg secrets
+	DockerConfigKey = ".dockercfg"
+
+	// SecretTypeServiceAccountToken contains a token that identifies a service account to the API
+	//
+	// Required fields:
+	// - Secret.Annotations["kubernetes.io/service-account.name"] - the name of the ServiceAccount the token identifies
+	// - Secret.Annotations["kubernetes.io/service-account.uid"] - the UID of the ServiceAccount the token identifies
+	// - Secret.Data["token"] - a token that identifies the service account to the API
+	SecretTypeServiceAccountToken SecretType = "kubernetes.io/service-account-token"
+
+	// ServiceAccountNameKey is the key of the required annotation for SecretTypeServiceAccountToken secrets
+	ServiceAccountNameKey = "kubernetes.io/service-account.name"
+	// ServiceAccountUIDKey is the key of the required annotation for SecretTypeServiceAccountToken secrets
+	ServiceAccountUIDKey = "kubernetes.io/service-account.uid"
+	// ServiceAccountTokenKey is the key of the required data for SecretTypeServiceAccountToken secrets
+	ServiceAccountTokenKey = "token"
 )
 
 type SecretList struct {
@@ -1306,3 +2078,23 @@ type SecretList struct {
 
 	Items []Secret `json:"items" description:"items is a list of secret objects"`
 }
+
+// ServiceAccount binds together:
+// * a name, understood by users, and perhaps by peripheral systems, for an identity
+// * a principal that can be authenticated and authorized
+// * a set of secrets
+type ServiceAccount struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	// Secrets is the list of secrets allowed to be used by pods running using this ServiceAccount
+	Secrets []ObjectReference `json:"secrets,omitempty" description:"list of secrets that can be used by pods running as this service account"`
+}
+
+// ServiceAccountList is a list of ServiceAccount objects
+type ServiceAccountList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	Items []ServiceAccount `json:"items" description:"list of ServiceAccounts"`
+}
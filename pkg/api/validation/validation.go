@@ -17,7 +17,9 @@ limitations under the License.
 package validation
 
 import (
+	"encoding/json"
 	"fmt"
+	"path"
 	"strings"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
@@ -152,6 +154,96 @@ func ValidateSecretName(name string, prefix bool) (bool, string) {
 	return nameIsDNSSubdomain(name, prefix)
 }
 
+// ValidateServiceAccountName can be used to check whether the given service account name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateServiceAccountName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidatePersistentVolumeName can be used to check whether the given persistent volume name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidatePersistentVolumeName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidatePersistentVolumeClaimName can be used to check whether the given persistent volume claim name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidatePersistentVolumeClaimName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidateJobName can be used to check whether the given job name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateJobName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidateDaemonSetName can be used to check whether the given daemon set name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateDaemonSetName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidateDeploymentName can be used to check whether the given deployment name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateDeploymentName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidateHorizontalPodAutoscalerName can be used to check whether the given autoscaler name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateHorizontalPodAutoscalerName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidateThirdPartyResourceName can be used to check whether the given third party resource name is valid.
+// The name must be a DNS subdomain of the form <kind>.<group>, where the kind segment is a DNS 952 label.
+func ValidateThirdPartyResourceName(name string, prefix bool) (bool, string) {
+	if ok, msg := nameIsDNSSubdomain(name, prefix); !ok {
+		return false, msg
+	}
+	if prefix {
+		return true, ""
+	}
+	parts := strings.SplitN(name, ".", 2)
+	if len(parts) != 2 {
+		return false, "must be of the form <kind>.<group>"
+	}
+	if !util.IsDNS952Label(parts[0]) {
+		return false, "kind segment " + dns952LabelErrorMsg
+	}
+	return true, ""
+}
+
+// ValidateThirdPartyResourceDataName can be used to check whether the given third party object name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateThirdPartyResourceDataName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidateRoleName can be used to check whether the given role or cluster role name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateRoleName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidateRoleBindingName can be used to check whether the given role binding or cluster
+// role binding name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateRoleBindingName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
 // nameIsDNSSubdomain is a ValidateNameFunc for names that must be a DNS subdomain.
 func nameIsDNSSubdomain(name string, prefix bool) (bool, string) {
 	if prefix {
@@ -222,6 +314,9 @@ func ValidateObjectMetaUpdate(old, meta *api.ObjectMeta) errs.ValidationErrorLis
 	if meta.CreationTimestamp.IsZero() {
 		meta.CreationTimestamp = old.CreationTimestamp
 	}
+	if meta.DeletionTimestamp == nil {
+		meta.DeletionTimestamp = old.DeletionTimestamp
+	}
 
 	if old.Name != meta.Name {
 		allErrs = append(allErrs, errs.NewFieldInvalid("name", meta.Name, "field is immutable"))
@@ -235,6 +330,9 @@ func ValidateObjectMetaUpdate(old, meta *api.ObjectMeta) errs.ValidationErrorLis
 	if old.CreationTimestamp != meta.CreationTimestamp {
 		allErrs = append(allErrs, errs.NewFieldInvalid("creationTimestamp", meta.CreationTimestamp, "field is immutable"))
 	}
+	if !api.Semantic.DeepEqual(old.DeletionTimestamp, meta.DeletionTimestamp) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("deletionTimestamp", meta.DeletionTimestamp, "field is immutable; may only be changed by deletion"))
+	}
 
 	allErrs = append(allErrs, ValidateLabels(meta.Labels, "labels")...)
 	allErrs = append(allErrs, ValidateAnnotations(meta.Annotations, "annotations")...)
@@ -273,7 +371,7 @@ func validateSource(source *api.VolumeSource) errs.ValidationErrorList {
 	}
 	if source.EmptyDir != nil {
 		numVolumes++
-		// EmptyDirs have nothing to validate
+		allErrs = append(allErrs, validateEmptyDirVolumeSource(source.EmptyDir).Prefix("emptyDir")...)
 	}
 	if source.GitRepo != nil {
 		numVolumes++
@@ -287,12 +385,45 @@ func validateSource(source *api.VolumeSource) errs.ValidationErrorList {
 		numVolumes++
 		allErrs = append(allErrs, validateSecretVolumeSource(source.Secret).Prefix("secret")...)
 	}
+	if source.DownwardAPI != nil {
+		numVolumes++
+		allErrs = append(allErrs, validateDownwardAPIVolumeSource(source.DownwardAPI).Prefix("downwardAPI")...)
+	}
+	if source.PersistentVolumeClaimVolumeSource != nil {
+		numVolumes++
+		allErrs = append(allErrs, validatePersistentClaimVolumeSource(source.PersistentVolumeClaimVolumeSource).Prefix("persistentVolumeClaim")...)
+	}
+	if source.NFS != nil {
+		numVolumes++
+		allErrs = append(allErrs, validateNFS(source.NFS).Prefix("nfs")...)
+	}
+	if source.ISCSI != nil {
+		numVolumes++
+		allErrs = append(allErrs, validateISCSIVolumeSource(source.ISCSI).Prefix("iscsi")...)
+	}
 	if numVolumes != 1 {
 		allErrs = append(allErrs, errs.NewFieldInvalid("", source, "exactly 1 volume type is required"))
 	}
 	return allErrs
 }
 
+var supportedStorageTypes = util.NewStringSet(string(api.StorageTypeDefault), string(api.StorageTypeMemory))
+
+func validateEmptyDirVolumeSource(emptyDir *api.EmptyDirVolumeSource) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if !supportedStorageTypes.Has(string(emptyDir.Medium)) {
+		allErrs = append(allErrs, errs.NewFieldNotSupported("medium", emptyDir.Medium))
+	}
+	if emptyDir.SizeLimit != nil {
+		if emptyDir.Medium != api.StorageTypeMemory {
+			allErrs = append(allErrs, errs.NewFieldInvalid("sizeLimit", emptyDir.SizeLimit.String(), "only supported for the Memory medium"))
+		} else if emptyDir.SizeLimit.Value() < 0 {
+			allErrs = append(allErrs, errs.NewFieldInvalid("sizeLimit", emptyDir.SizeLimit.String(), isNegativeErrorMsg))
+		}
+	}
+	return allErrs
+}
+
 func validateHostPathVolumeSource(hostDir *api.HostPathVolumeSource) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
 	if hostDir.Path == "" {
@@ -323,6 +454,31 @@ func validateGCEPersistentDiskVolumeSource(PD *api.GCEPersistentDiskVolumeSource
 	return allErrs
 }
 
+// downwardAPIVolumeFieldPaths are the fields of a pod that may be exposed in a downward API volume.
+var downwardAPIVolumeFieldPaths = util.NewStringSet("metadata.name", "metadata.namespace", "metadata.labels", "metadata.annotations")
+
+func validateDownwardAPIVolumeSource(downwardAPI *api.DownwardAPIVolumeSource) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	paths := util.StringSet{}
+	for i, item := range downwardAPI.Items {
+		iErrs := errs.ValidationErrorList{}
+		if len(item.Path) == 0 {
+			iErrs = append(iErrs, errs.NewFieldRequired("path", item.Path))
+		} else if path.IsAbs(item.Path) {
+			iErrs = append(iErrs, errs.NewFieldInvalid("path", item.Path, "must not be an absolute path"))
+		} else if util.NewStringSet(strings.Split(item.Path, "/")...).Has("..") {
+			iErrs = append(iErrs, errs.NewFieldInvalid("path", item.Path, "must not contain '..'"))
+		} else if paths.Has(item.Path) {
+			iErrs = append(iErrs, errs.NewFieldDuplicate("path", item.Path))
+		} else {
+			paths.Insert(item.Path)
+		}
+		iErrs = append(iErrs, validateObjectFieldSelector(&item.FieldRef, downwardAPIVolumeFieldPaths).Prefix("fieldRef")...)
+		allErrs = append(allErrs, iErrs.PrefixIndex(i).Prefix("items")...)
+	}
+	return allErrs
+}
+
 func validateSecretVolumeSource(secretSource *api.SecretVolumeSource) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
 	if secretSource.Target.Name == "" {
@@ -337,6 +493,44 @@ func validateSecretVolumeSource(secretSource *api.SecretVolumeSource) errs.Valid
 	return allErrs
 }
 
+func validatePersistentClaimVolumeSource(claim *api.PersistentVolumeClaimVolumeSource) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if claim.ClaimName == "" {
+		allErrs = append(allErrs, errs.NewFieldRequired("claimName", claim.ClaimName))
+	}
+	return allErrs
+}
+
+func validateISCSIVolumeSource(iscsi *api.ISCSIVolumeSource) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if iscsi.TargetPortal == "" {
+		allErrs = append(allErrs, errs.NewFieldRequired("targetPortal", iscsi.TargetPortal))
+	}
+	if iscsi.IQN == "" {
+		allErrs = append(allErrs, errs.NewFieldRequired("iqn", iscsi.IQN))
+	}
+	if iscsi.FSType == "" {
+		allErrs = append(allErrs, errs.NewFieldRequired("fsType", iscsi.FSType))
+	}
+	if iscsi.Lun < 0 || iscsi.Lun > 255 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("lun", iscsi.Lun, ""))
+	}
+	return allErrs
+}
+
+func validateNFS(nfs *api.NFSVolumeSource) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if nfs.Server == "" {
+		allErrs = append(allErrs, errs.NewFieldRequired("server", nfs.Server))
+	}
+	if nfs.Path == "" {
+		allErrs = append(allErrs, errs.NewFieldRequired("path", nfs.Path))
+	} else if !path.IsAbs(nfs.Path) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("path", nfs.Path, "must be an absolute path"))
+	}
+	return allErrs
+}
+
 var supportedPortProtocols = util.NewStringSet(string(api.ProtocolTCP), string(api.ProtocolUDP))
 
 func validatePorts(ports []api.ContainerPort) errs.ValidationErrorList {
@@ -383,11 +577,40 @@ func validateEnv(vars []api.EnvVar) errs.ValidationErrorList {
 		if !util.IsCIdentifier(ev.Name) {
 			vErrs = append(vErrs, errs.NewFieldInvalid("name", ev.Name, cIdentifierErrorMsg))
 		}
+		if ev.ValueFrom != nil {
+			vErrs = append(vErrs, validateEnvVarValueFrom(ev).Prefix("valueFrom")...)
+		}
 		allErrs = append(allErrs, vErrs.PrefixIndex(i)...)
 	}
 	return allErrs
 }
 
+// envVarFieldPaths are the fields of a pod that may be exposed as environment variables.
+var envVarFieldPaths = util.NewStringSet("metadata.name", "metadata.namespace", "status.podIP")
+
+func validateEnvVarValueFrom(ev api.EnvVar) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if len(ev.Value) != 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("", ev.ValueFrom, "may not be specified when value is not empty"))
+	}
+	if ev.ValueFrom.FieldRef == nil {
+		allErrs = append(allErrs, errs.NewFieldRequired("fieldRef", ev.ValueFrom.FieldRef))
+		return allErrs
+	}
+	allErrs = append(allErrs, validateObjectFieldSelector(ev.ValueFrom.FieldRef, envVarFieldPaths).Prefix("fieldRef")...)
+	return allErrs
+}
+
+func validateObjectFieldSelector(fs *api.ObjectFieldSelector, supported util.StringSet) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if len(fs.FieldPath) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("fieldPath", fs.FieldPath))
+	} else if !supported.Has(fs.FieldPath) {
+		allErrs = append(allErrs, errs.NewFieldNotSupported("fieldPath", fs.FieldPath))
+	}
+	return allErrs
+}
+
 func validateVolumeMounts(mounts []api.VolumeMount, volumes util.StringSet) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
 
@@ -561,6 +784,7 @@ func validateContainers(containers []api.Container, volumes util.StringSet) errs
 		cErrs = append(cErrs, validateVolumeMounts(ctr.VolumeMounts, volumes).Prefix("volumeMounts")...)
 		cErrs = append(cErrs, validatePullPolicy(&ctr).Prefix("pullPolicy")...)
 		cErrs = append(cErrs, validateResourceRequirements(&ctr).Prefix("resources")...)
+		cErrs = append(cErrs, ValidateSecurityContext(ctr.SecurityContext).Prefix("securityContext")...)
 		allErrs = append(allErrs, cErrs.PrefixIndex(i)...)
 	}
 	// Check for colliding ports across all containers.
@@ -573,6 +797,22 @@ func validateContainers(containers []api.Container, volumes util.StringSet) errs
 	return allErrs
 }
 
+// ValidateSecurityContext ensures the security context contains valid settings.
+func ValidateSecurityContext(sc *api.SecurityContext) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if sc == nil {
+		return allErrs
+	}
+	if sc.RunAsUser != nil {
+		if *sc.RunAsUser < 0 {
+			allErrs = append(allErrs, errs.NewFieldInvalid("runAsUser", *sc.RunAsUser, isNegativeErrorMsg))
+		} else if *sc.RunAsUser == 0 && sc.RunAsNonRoot {
+			allErrs = append(allErrs, errs.NewFieldInvalid("runAsUser", *sc.RunAsUser, "must not be 0 when runAsNonRoot is set"))
+		}
+	}
+	return allErrs
+}
+
 var supportedManifestVersions = util.NewStringSet("v1beta1", "v1beta2")
 
 // ValidateManifest tests that the specified ContainerManifest has valid data.
@@ -649,6 +889,19 @@ func ValidatePodSpec(spec *api.PodSpec) errs.ValidationErrorList {
 	allErrs = append(allErrs, validateRestartPolicy(&spec.RestartPolicy).Prefix("restartPolicy")...)
 	allErrs = append(allErrs, validateDNSPolicy(&spec.DNSPolicy).Prefix("dnsPolicy")...)
 	allErrs = append(allErrs, ValidateLabels(spec.NodeSelector, "nodeSelector")...)
+	if spec.TerminationGracePeriodSeconds != nil && *spec.TerminationGracePeriodSeconds < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("terminationGracePeriodSeconds", *spec.TerminationGracePeriodSeconds, "must be non-negative"))
+	}
+	if len(spec.ServiceAccount) > 0 {
+		if ok, msg := ValidateServiceAccountName(spec.ServiceAccount, false); !ok {
+			allErrs = append(allErrs, errs.NewFieldInvalid("serviceAccount", spec.ServiceAccount, msg))
+		}
+	}
+	for i, secret := range spec.ImagePullSecrets {
+		if ok, msg := ValidateSecretName(secret.Name, false); !ok {
+			allErrs = append(allErrs, errs.NewFieldInvalid(fmt.Sprintf("imagePullSecrets[%d].name", i), secret.Name, msg))
+		}
+	}
 	return allErrs
 }
 
@@ -704,18 +957,12 @@ func ValidateService(service *api.Service) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
 	allErrs = append(allErrs, ValidateObjectMeta(&service.ObjectMeta, true, ValidateServiceName).Prefix("metadata")...)
 
-	if !util.IsValidPortNum(service.Spec.Port) {
-		allErrs = append(allErrs, errs.NewFieldInvalid("spec.port", service.Spec.Port, portRangeErrorMsg))
-	}
-	if len(service.Spec.Protocol) == 0 {
-		allErrs = append(allErrs, errs.NewFieldRequired("spec.protocol", service.Spec.Protocol))
-	} else if !supportedPortProtocols.Has(strings.ToUpper(string(service.Spec.Protocol))) {
-		allErrs = append(allErrs, errs.NewFieldNotSupported("spec.protocol", service.Spec.Protocol))
+	if len(service.Spec.Ports) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("spec.ports", service.Spec.Ports))
 	}
-	if service.Spec.ContainerPort.Kind == util.IntstrInt && service.Spec.ContainerPort.IntVal != 0 && !util.IsValidPortNum(service.Spec.ContainerPort.IntVal) {
-		allErrs = append(allErrs, errs.NewFieldInvalid("spec.containerPort", service.Spec.Port, portRangeErrorMsg))
-	} else if service.Spec.ContainerPort.Kind == util.IntstrString && len(service.Spec.ContainerPort.StrVal) == 0 {
-		allErrs = append(allErrs, errs.NewFieldRequired("spec.containerPort", service.Spec.ContainerPort.StrVal))
+	allPortNames := util.StringSet{}
+	for i := range service.Spec.Ports {
+		allErrs = append(allErrs, validateServicePort(&service.Spec.Ports[i], i, &allPortNames).PrefixIndex(i).Prefix("spec.ports")...)
 	}
 
 	if service.Spec.Selector != nil {
@@ -731,6 +978,41 @@ func ValidateService(service *api.Service) errs.ValidationErrorList {
 	return allErrs
 }
 
+func validateServicePort(sp *api.ServicePort, index int, allNames *util.StringSet) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+
+	if len(sp.Name) == 0 {
+		// Allow empty names if they are the first port (mostly for compat).
+		if index != 0 {
+			allErrs = append(allErrs, errs.NewFieldRequired("name", sp.Name))
+		}
+	} else if len(sp.Name) > util.DNS1123LabelMaxLength || !util.IsDNSLabel(sp.Name) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("name", sp.Name, dnsLabelErrorMsg))
+	} else if allNames.Has(sp.Name) {
+		allErrs = append(allErrs, errs.NewFieldDuplicate("name", sp.Name))
+	} else {
+		allNames.Insert(sp.Name)
+	}
+
+	if !util.IsValidPortNum(sp.Port) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("port", sp.Port, portRangeErrorMsg))
+	}
+
+	if len(sp.Protocol) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("protocol", sp.Protocol))
+	} else if !supportedPortProtocols.Has(strings.ToUpper(string(sp.Protocol))) {
+		allErrs = append(allErrs, errs.NewFieldNotSupported("protocol", sp.Protocol))
+	}
+
+	if sp.ContainerPort.Kind == util.IntstrInt && sp.ContainerPort.IntVal != 0 && !util.IsValidPortNum(sp.ContainerPort.IntVal) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("containerPort", sp.ContainerPort, portRangeErrorMsg))
+	} else if sp.ContainerPort.Kind == util.IntstrString && len(sp.ContainerPort.StrVal) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("containerPort", sp.ContainerPort.StrVal))
+	}
+
+	return allErrs
+}
+
 // ValidateServiceUpdate tests if required fields in the service are set during an update
 func ValidateServiceUpdate(oldService, service *api.Service) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
@@ -914,8 +1196,8 @@ func ValidateSecret(secret *api.Secret) errs.ValidationErrorList {
 
 	totalSize := 0
 	for key, value := range secret.Data {
-		if !util.IsDNSSubdomain(key) {
-			allErrs = append(allErrs, errs.NewFieldInvalid(fmt.Sprintf("data[%s]", key), key, cIdentifierErrorMsg))
+		if !isSecretKey(key) {
+			allErrs = append(allErrs, errs.NewFieldInvalid(fmt.Sprintf("data[%s]", key), key, secretKeyErrorMsg))
 		}
 
 		totalSize += len(value)
@@ -925,6 +1207,349 @@ func ValidateSecret(secret *api.Secret) errs.ValidationErrorList {
 		allErrs = append(allErrs, errs.NewFieldForbidden("data", "Maximum secret size exceeded"))
 	}
 
+	switch secret.Type {
+	case api.SecretTypeDockercfg:
+		dockercfgBytes, exists := secret.Data[api.DockerConfigKey]
+		if !exists {
+			allErrs = append(allErrs, errs.NewFieldRequired(fmt.Sprintf("data[%s]", api.DockerConfigKey), api.DockerConfigKey))
+			break
+		}
+		// make sure that the content is well-formed json.
+		if err := json.Unmarshal(dockercfgBytes, &map[string]interface{}{}); err != nil {
+			allErrs = append(allErrs, errs.NewFieldInvalid(fmt.Sprintf("data[%s]", api.DockerConfigKey), "<secret contents redacted>", err.Error()))
+		}
+	case api.SecretTypeServiceAccountToken:
+		// Only require the name annotation, the UID is optional so clients can create a token
+		// for an account before they know its UID.
+		if value := secret.Annotations[api.ServiceAccountNameKey]; len(value) == 0 {
+			allErrs = append(allErrs, errs.NewFieldRequired(fmt.Sprintf("metadata.annotations[%s]", api.ServiceAccountNameKey), value))
+		}
+	}
+
+	return allErrs
+}
+
+var secretKeyErrorMsg string = "must be a DNS subdomain, optionally with a leading dot"
+
+// isSecretKey returns true if key is allowed as the key of a secret's data: a DNS
+// subdomain with an optional leading dot, so that secrets can hold dot files.
+func isSecretKey(key string) bool {
+	return util.IsDNSSubdomain(strings.TrimPrefix(key, "."))
+}
+
+// ValidateServiceAccount tests if required fields in the ServiceAccount are set.
+func ValidateServiceAccount(serviceAccount *api.ServiceAccount) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&serviceAccount.ObjectMeta, true, ValidateServiceAccountName).Prefix("metadata")...)
+	for i := range serviceAccount.Secrets {
+		if len(serviceAccount.Secrets[i].Name) == 0 {
+			allErrs = append(allErrs, errs.NewFieldRequired(fmt.Sprintf("secrets[%d].name", i), serviceAccount.Secrets[i].Name))
+		}
+	}
+	return allErrs
+}
+
+// ValidateServiceAccountUpdate tests if required fields in the ServiceAccount are set and the
+// update is legal.
+func ValidateServiceAccountUpdate(oldServiceAccount, newServiceAccount *api.ServiceAccount) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldServiceAccount.ObjectMeta, &newServiceAccount.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateServiceAccount(newServiceAccount)...)
+	return allErrs
+}
+
+var supportedAccessModes = util.NewStringSet(string(api.ReadWriteOnce), string(api.ReadOnlyMany), string(api.ReadWriteMany))
+
+func validateAccessModes(accessModes []api.AccessModeType) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if len(accessModes) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("accessModes", accessModes))
+	}
+	for i, mode := range accessModes {
+		if !supportedAccessModes.Has(string(mode)) {
+			allErrs = append(allErrs, errs.NewFieldNotSupported(fmt.Sprintf("accessModes[%d]", i), mode))
+		}
+	}
+	return allErrs
+}
+
+// ValidatePersistentVolume tests if required fields in the PersistentVolume are set.
+func ValidatePersistentVolume(pv *api.PersistentVolume) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&pv.ObjectMeta, false, ValidatePersistentVolumeName).Prefix("metadata")...)
+
+	specErrs := validateAccessModes(pv.Spec.AccessModes)
+	if _, ok := pv.Spec.Capacity[api.ResourceStorage]; !ok || len(pv.Spec.Capacity) > 1 {
+		specErrs = append(specErrs, errs.NewFieldInvalid("capacity", pv.Spec.Capacity, fmt.Sprintf("only %s is supported", api.ResourceStorage)))
+	}
+	for resourceName, quantity := range pv.Spec.Capacity {
+		if quantity.Value() < 0 {
+			specErrs = append(specErrs, errs.NewFieldInvalid(fmt.Sprintf("capacity[%s]", resourceName), quantity.String(), "must not be negative"))
+		}
+	}
+
+	numVolumes := 0
+	if pv.Spec.HostPath != nil {
+		numVolumes++
+		specErrs = append(specErrs, validateHostPathVolumeSource(pv.Spec.HostPath).Prefix("hostPath")...)
+	}
+	if pv.Spec.GCEPersistentDisk != nil {
+		numVolumes++
+		specErrs = append(specErrs, validateGCEPersistentDiskVolumeSource(pv.Spec.GCEPersistentDisk).Prefix("persistentDisk")...)
+	}
+	if pv.Spec.NFS != nil {
+		numVolumes++
+		specErrs = append(specErrs, validateNFS(pv.Spec.NFS).Prefix("nfs")...)
+	}
+	if pv.Spec.ISCSI != nil {
+		numVolumes++
+		specErrs = append(specErrs, validateISCSIVolumeSource(pv.Spec.ISCSI).Prefix("iscsi")...)
+	}
+	if numVolumes != 1 {
+		specErrs = append(specErrs, errs.NewFieldInvalid("", pv.Spec.PersistentVolumeSource, "exactly 1 volume type is required"))
+	}
+	allErrs = append(allErrs, specErrs.Prefix("spec")...)
+	return allErrs
+}
+
+// ValidatePersistentVolumeUpdate tests to see if the update is legal for an end user to make.
+// newPv is updated with fields that cannot be changed.
+func ValidatePersistentVolumeUpdate(newPv, oldPv *api.PersistentVolume) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldPv.ObjectMeta, &newPv.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidatePersistentVolume(newPv)...)
+	return allErrs
+}
+
+// ValidatePersistentVolumeClaim tests if required fields in the PersistentVolumeClaim are set.
+func ValidatePersistentVolumeClaim(pvc *api.PersistentVolumeClaim) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&pvc.ObjectMeta, true, ValidatePersistentVolumeClaimName).Prefix("metadata")...)
+
+	specErrs := validateAccessModes(pvc.Spec.AccessModes)
+	if _, ok := pvc.Spec.Resources.Limits[api.ResourceStorage]; !ok {
+		specErrs = append(specErrs, errs.NewFieldRequired(fmt.Sprintf("resources.limits[%s]", api.ResourceStorage), pvc.Spec.Resources.Limits))
+	}
+	for resourceName, quantity := range pvc.Spec.Resources.Limits {
+		if quantity.Value() < 0 {
+			specErrs = append(specErrs, errs.NewFieldInvalid(fmt.Sprintf("resources.limits[%s]", resourceName), quantity.String(), "must not be negative"))
+		}
+	}
+	allErrs = append(allErrs, specErrs.Prefix("spec")...)
+	return allErrs
+}
+
+// ValidatePersistentVolumeClaimUpdate tests to see if the update is legal for an end user to make.
+func ValidatePersistentVolumeClaimUpdate(newPvc, oldPvc *api.PersistentVolumeClaim) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldPvc.ObjectMeta, &newPvc.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidatePersistentVolumeClaim(newPvc)...)
+	return allErrs
+}
+
+// ValidateJob tests if required fields in the job are set.
+func ValidateJob(job *api.Job) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&job.ObjectMeta, true, ValidateJobName).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateJobSpec(&job.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateJobUpdate tests if an update to a job is valid. Only the parallelism
+// of a job may change once it has been created.
+func ValidateJobUpdate(oldJob, job *api.Job) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldJob.ObjectMeta, &job.ObjectMeta).Prefix("metadata")...)
+
+	specErrs := ValidateJobSpec(&job.Spec)
+	if !api.Semantic.DeepEqual(oldJob.Spec.Completions, job.Spec.Completions) {
+		specErrs = append(specErrs, errs.NewFieldInvalid("completions", job.Spec.Completions, "field is immutable"))
+	}
+	if !api.Semantic.DeepEqual(oldJob.Spec.Selector, job.Spec.Selector) {
+		specErrs = append(specErrs, errs.NewFieldInvalid("selector", job.Spec.Selector, "field is immutable"))
+	}
+	if !api.Semantic.DeepEqual(oldJob.Spec.Template, job.Spec.Template) {
+		specErrs = append(specErrs, errs.NewFieldInvalid("template", "", "field is immutable"))
+	}
+	allErrs = append(allErrs, specErrs.Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateJobSpec tests if required fields in the job spec are set.
+func ValidateJobSpec(spec *api.JobSpec) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+
+	if spec.Parallelism == nil {
+		allErrs = append(allErrs, errs.NewFieldRequired("parallelism", spec.Parallelism))
+	} else if *spec.Parallelism < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("parallelism", *spec.Parallelism, isNegativeErrorMsg))
+	}
+	if spec.Completions == nil {
+		allErrs = append(allErrs, errs.NewFieldRequired("completions", spec.Completions))
+	} else if *spec.Completions < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("completions", *spec.Completions, isNegativeErrorMsg))
+	}
+
+	selector := labels.Set(spec.Selector).AsSelector()
+	if selector.Empty() {
+		allErrs = append(allErrs, errs.NewFieldRequired("selector", spec.Selector))
+	}
+	if !selector.Matches(labels.Set(spec.Template.Labels)) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("template.labels", spec.Template.Labels, "selector does not match template"))
+	}
+	parallelism := 0
+	if spec.Parallelism != nil {
+		parallelism = *spec.Parallelism
+	}
+	allErrs = append(allErrs, ValidatePodTemplateSpec(&spec.Template, parallelism).Prefix("template")...)
+	if spec.Template.Spec.RestartPolicy.Always != nil {
+		allErrs = append(allErrs, errs.NewFieldInvalid("template.restartPolicy", spec.Template.Spec.RestartPolicy, "must be OnFailure or Never"))
+	}
+	return allErrs
+}
+
+// ValidateDaemonSet tests if required fields in the daemon set are set.
+func ValidateDaemonSet(ds *api.DaemonSet) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&ds.ObjectMeta, true, ValidateDaemonSetName).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateDaemonSetSpec(&ds.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateDaemonSetUpdate tests if an update to a daemon set is valid.
+func ValidateDaemonSetUpdate(oldDS, ds *api.DaemonSet) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldDS.ObjectMeta, &ds.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateDaemonSetSpec(&ds.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateDaemonSetSpec tests if required fields in the daemon set spec are set.
+func ValidateDaemonSetSpec(spec *api.DaemonSetSpec) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+
+	selector := labels.Set(spec.Selector).AsSelector()
+	if selector.Empty() {
+		allErrs = append(allErrs, errs.NewFieldRequired("selector", spec.Selector))
+	}
+	if !selector.Matches(labels.Set(spec.Template.Labels)) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("template.labels", spec.Template.Labels, "selector does not match template"))
+	}
+	allErrs = append(allErrs, ValidatePodTemplateSpec(&spec.Template, 0).Prefix("template")...)
+	// The daemon pod runs on many nodes at once.
+	allErrs = append(allErrs, ValidateReadOnlyPersistentDisks(spec.Template.Spec.Volumes).Prefix("template.spec.volumes")...)
+	if spec.Template.Spec.RestartPolicy.Always == nil {
+		allErrs = append(allErrs, errs.NewFieldInvalid("template.restartPolicy", spec.Template.Spec.RestartPolicy, "must be Always"))
+	}
+	if len(spec.Template.Spec.Host) > 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("template.spec.host", spec.Template.Spec.Host, "must be empty; the daemon set assigns its pods to nodes"))
+	}
+	return allErrs
+}
+
+// ValidateDeployment tests if required fields in the deployment are set.
+func ValidateDeployment(deployment *api.Deployment) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&deployment.ObjectMeta, true, ValidateDeploymentName).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateDeploymentSpec(&deployment.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateDeploymentUpdate tests if an update to a deployment is valid.
+func ValidateDeploymentUpdate(oldDeployment, deployment *api.Deployment) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldDeployment.ObjectMeta, &deployment.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateDeploymentSpec(&deployment.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateDeploymentSpec tests if required fields in the deployment spec are set.
+func ValidateDeploymentSpec(spec *api.DeploymentSpec) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+
+	selector := labels.Set(spec.Selector).AsSelector()
+	if selector.Empty() {
+		allErrs = append(allErrs, errs.NewFieldRequired("selector", spec.Selector))
+	}
+	if spec.Replicas < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("replicas", spec.Replicas, isNegativeErrorMsg))
+	}
+	if !selector.Matches(labels.Set(spec.Template.Labels)) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("template.labels", spec.Template.Labels, "selector does not match template"))
+	}
+	allErrs = append(allErrs, ValidatePodTemplateSpec(&spec.Template, spec.Replicas).Prefix("template")...)
+	if spec.Template.Spec.RestartPolicy.Always == nil {
+		allErrs = append(allErrs, errs.NewFieldInvalid("template.restartPolicy", spec.Template.Spec.RestartPolicy, "must be Always"))
+	}
+	allErrs = append(allErrs, validateDeploymentStrategy(&spec.Strategy).Prefix("strategy")...)
+	if spec.RollbackTo != nil && spec.RollbackTo.Revision < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("rollbackTo.revision", spec.RollbackTo.Revision, isNegativeErrorMsg))
+	}
+	return allErrs
+}
+
+func validateDeploymentStrategy(strategy *api.DeploymentStrategy) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	switch strategy.Type {
+	case api.RecreateDeploymentStrategyType:
+		if strategy.RollingUpdate != nil {
+			allErrs = append(allErrs, errs.NewFieldInvalid("rollingUpdate", strategy.RollingUpdate, "may only be set for the RollingUpdate strategy"))
+		}
+	case api.RollingUpdateDeploymentStrategyType:
+		if strategy.RollingUpdate == nil {
+			allErrs = append(allErrs, errs.NewFieldRequired("rollingUpdate", strategy.RollingUpdate))
+			break
+		}
+		rollingUpdate := strategy.RollingUpdate
+		if rollingUpdate.MaxUnavailable < 0 {
+			allErrs = append(allErrs, errs.NewFieldInvalid("rollingUpdate.maxUnavailable", rollingUpdate.MaxUnavailable, isNegativeErrorMsg))
+		}
+		if rollingUpdate.MaxSurge < 0 {
+			allErrs = append(allErrs, errs.NewFieldInvalid("rollingUpdate.maxSurge", rollingUpdate.MaxSurge, isNegativeErrorMsg))
+		}
+		if rollingUpdate.MaxUnavailable == 0 && rollingUpdate.MaxSurge == 0 {
+			allErrs = append(allErrs, errs.NewFieldInvalid("rollingUpdate.maxSurge", rollingUpdate.MaxSurge, "may not be 0 when maxUnavailable is 0"))
+		}
+	default:
+		allErrs = append(allErrs, errs.NewFieldNotSupported("type", strategy.Type))
+	}
+	return allErrs
+}
+
+// ValidateHorizontalPodAutoscaler tests if required fields in the autoscaler are set.
+func ValidateHorizontalPodAutoscaler(autoscaler *api.HorizontalPodAutoscaler) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&autoscaler.ObjectMeta, true, ValidateHorizontalPodAutoscalerName).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateHorizontalPodAutoscalerSpec(&autoscaler.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateHorizontalPodAutoscalerUpdate tests if an update to an autoscaler is valid.
+func ValidateHorizontalPodAutoscalerUpdate(oldAutoscaler, autoscaler *api.HorizontalPodAutoscaler) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldAutoscaler.ObjectMeta, &autoscaler.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateHorizontalPodAutoscalerSpec(&autoscaler.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateHorizontalPodAutoscalerSpec tests if required fields in the autoscaler spec are set.
+func ValidateHorizontalPodAutoscalerSpec(spec *api.HorizontalPodAutoscalerSpec) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if spec.ScaleRef.Kind != "ReplicationController" {
+		allErrs = append(allErrs, errs.NewFieldNotSupported("scaleRef.kind", spec.ScaleRef.Kind))
+	}
+	if len(spec.ScaleRef.Name) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("scaleRef.name", spec.ScaleRef.Name))
+	}
+	if spec.MinReplicas < 1 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("minReplicas", spec.MinReplicas, "must be at least 1"))
+	}
+	if spec.MaxReplicas < spec.MinReplicas {
+		allErrs = append(allErrs, errs.NewFieldInvalid("maxReplicas", spec.MaxReplicas, "must not be less than minReplicas"))
+	}
+	if spec.TargetCPUUtilizationPercentage < 1 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("targetCPUUtilizationPercentage", spec.TargetCPUUtilizationPercentage, "must be at least 1"))
+	}
 	return allErrs
 }
 
@@ -946,7 +1571,169 @@ func validateResourceRequirements(container *api.Container) errs.ValidationError
 		}
 		allErrs = append(allErrs, errs...)
 	}
+	for resourceName, quantity := range container.Resources.Requests {
+		// Validate resource name.
+		requestErrs := validateResourceName(resourceName.String(), fmt.Sprintf("resources.requests[%s]", resourceName))
+		if api.IsStandardResourceName(resourceName.String()) {
+			requestErrs = append(requestErrs, validateBasicResource(quantity).Prefix(fmt.Sprintf("Resource %s: ", resourceName))...)
+		}
+		// A container may not request more than its limit.
+		if limit, ok := container.Resources.Limits[resourceName]; ok && quantity.MilliValue() > limit.MilliValue() {
+			requestErrs = append(requestErrs, errs.NewFieldInvalid(fmt.Sprintf("resources.requests[%s]", resourceName), quantity.String(), "must be less than or equal to the limit"))
+		}
+		allErrs = append(allErrs, requestErrs...)
+	}
+
+	return allErrs
+}
+
+// ValidateThirdPartyResource tests if required fields in the third party resource are set.
+func ValidateThirdPartyResource(resource *api.ThirdPartyResource) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&resource.ObjectMeta, false, ValidateThirdPartyResourceName).Prefix("metadata")...)
+	allErrs = append(allErrs, validateThirdPartyResourceVersions(resource.Versions).Prefix("versions")...)
+	return allErrs
+}
+
+// ValidateThirdPartyResourceUpdate tests if an update to a third party resource is valid.
+func ValidateThirdPartyResourceUpdate(oldResource, resource *api.ThirdPartyResource) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldResource.ObjectMeta, &resource.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, validateThirdPartyResourceVersions(resource.Versions).Prefix("versions")...)
+	return allErrs
+}
+
+func validateThirdPartyResourceVersions(versions []api.APIVersion) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if len(versions) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("", versions))
+	}
+	names := util.StringSet{}
+	for i, version := range versions {
+		if !util.IsDNSLabel(version.Name) {
+			allErrs = append(allErrs, errs.NewFieldInvalid(fmt.Sprintf("[%d].name", i), version.Name, dnsLabelErrorMsg))
+		} else if names.Has(version.Name) {
+			allErrs = append(allErrs, errs.NewFieldDuplicate(fmt.Sprintf("[%d].name", i), version.Name))
+		}
+		names.Insert(version.Name)
+	}
+	return allErrs
+}
+
+// ValidateThirdPartyResourceData tests if required fields in a third party object are set.
+func ValidateThirdPartyResourceData(obj *api.ThirdPartyResourceData) errs.ValidationErrorList {
+	return ValidateObjectMeta(&obj.ObjectMeta, true, ValidateThirdPartyResourceDataName).Prefix("metadata")
+}
+
+// ValidateThirdPartyResourceDataUpdate tests if an update to a third party object is valid.
+func ValidateThirdPartyResourceDataUpdate(oldObj, obj *api.ThirdPartyResourceData) errs.ValidationErrorList {
+	return ValidateObjectMetaUpdate(&oldObj.ObjectMeta, &obj.ObjectMeta).Prefix("metadata")
+}
+
+// ValidateRole tests if required fields in the role are set.
+func ValidateRole(role *api.Role) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&role.ObjectMeta, true, ValidateRoleName).Prefix("metadata")...)
+	allErrs = append(allErrs, validatePolicyRules(role.Rules).Prefix("rules")...)
+	return allErrs
+}
+
+// ValidateRoleUpdate tests if an update to a role is valid.
+func ValidateRoleUpdate(oldRole, role *api.Role) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldRole.ObjectMeta, &role.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, validatePolicyRules(role.Rules).Prefix("rules")...)
+	return allErrs
+}
+
+// ValidateClusterRole tests if required fields in the cluster role are set.
+func ValidateClusterRole(role *api.ClusterRole) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&role.ObjectMeta, false, ValidateRoleName).Prefix("metadata")...)
+	allErrs = append(allErrs, validatePolicyRules(role.Rules).Prefix("rules")...)
+	return allErrs
+}
+
+// ValidateClusterRoleUpdate tests if an update to a cluster role is valid.
+func ValidateClusterRoleUpdate(oldRole, role *api.ClusterRole) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldRole.ObjectMeta, &role.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, validatePolicyRules(role.Rules).Prefix("rules")...)
+	return allErrs
+}
+
+func validatePolicyRules(rules []api.PolicyRule) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	for i, rule := range rules {
+		ruleErrs := errs.ValidationErrorList{}
+		if len(rule.Verbs) == 0 {
+			ruleErrs = append(ruleErrs, errs.NewFieldRequired("verbs", rule.Verbs))
+		}
+		if len(rule.Resources) == 0 {
+			ruleErrs = append(ruleErrs, errs.NewFieldRequired("resources", rule.Resources))
+		}
+		allErrs = append(allErrs, ruleErrs.PrefixIndex(i)...)
+	}
+	return allErrs
+}
 
+// ValidateRoleBinding tests if required fields in the role binding are set.
+func ValidateRoleBinding(binding *api.RoleBinding) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&binding.ObjectMeta, true, ValidateRoleBindingName).Prefix("metadata")...)
+	allErrs = append(allErrs, validateRoleBinding(binding.Subjects, binding.RoleRef, util.NewStringSet("Role", "ClusterRole"))...)
+	return allErrs
+}
+
+// ValidateRoleBindingUpdate tests if an update to a role binding is valid.
+func ValidateRoleBindingUpdate(oldBinding, binding *api.RoleBinding) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldBinding.ObjectMeta, &binding.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, validateRoleBinding(binding.Subjects, binding.RoleRef, util.NewStringSet("Role", "ClusterRole"))...)
+	return allErrs
+}
+
+// ValidateClusterRoleBinding tests if required fields in the cluster role binding are set.
+func ValidateClusterRoleBinding(binding *api.ClusterRoleBinding) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&binding.ObjectMeta, false, ValidateRoleBindingName).Prefix("metadata")...)
+	allErrs = append(allErrs, validateRoleBinding(binding.Subjects, binding.RoleRef, util.NewStringSet("ClusterRole"))...)
+	return allErrs
+}
+
+// ValidateClusterRoleBindingUpdate tests if an update to a cluster role binding is valid.
+func ValidateClusterRoleBindingUpdate(oldBinding, binding *api.ClusterRoleBinding) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldBinding.ObjectMeta, &binding.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, validateRoleBinding(binding.Subjects, binding.RoleRef, util.NewStringSet("ClusterRole"))...)
+	return allErrs
+}
+
+// validateRoleBinding checks the subjects of a binding, and that it refers to a role
+// of one of the given kinds.
+func validateRoleBinding(subjects []api.Subject, roleRef api.RoleRef, roleKinds util.StringSet) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if len(subjects) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("subjects", subjects))
+	}
+	for i, subject := range subjects {
+		subjectErrs := errs.ValidationErrorList{}
+		if subject.Kind != "User" && subject.Kind != "Group" {
+			subjectErrs = append(subjectErrs, errs.NewFieldNotSupported("kind", subject.Kind))
+		}
+		if len(subject.Name) == 0 {
+			subjectErrs = append(subjectErrs, errs.NewFieldRequired("name", subject.Name))
+		}
+		allErrs = append(allErrs, subjectErrs.PrefixIndex(i).Prefix("subjects")...)
+	}
+	if !roleKinds.Has(roleRef.Kind) {
+		allErrs = append(allErrs, errs.NewFieldNotSupported("roleRef.kind", roleRef.Kind))
+	}
+	if len(roleRef.Name) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("roleRef.name", roleRef.Name))
+	} else if ok, qualifier := ValidateRoleName(roleRef.Name, false); !ok {
+		allErrs = append(allErrs, errs.NewFieldInvalid("roleRef.name", roleRef.Name, qualifier))
+	}
 	return allErrs
 }
 
@@ -971,9 +1758,28 @@ func ValidateResourceQuota(resourceQuota *api.ResourceQuota) errs.ValidationErro
 func ValidateNamespace(namespace *api.Namespace) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
 	allErrs = append(allErrs, ValidateObjectMeta(&namespace.ObjectMeta, false, ValidateNamespaceName).Prefix("metadata")...)
+	for i := range namespace.Spec.Finalizers {
+		allErrs = append(allErrs, validateFinalizerName(string(namespace.Spec.Finalizers[i]))...)
+	}
 	return allErrs
 }
 
+// Validate finalizer names
+func validateFinalizerName(stringValue string) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if !util.IsQualifiedName(stringValue) {
+		return append(allErrs, errs.NewFieldInvalid("spec.finalizers", stringValue, qualifiedNameErrorMsg))
+	}
+
+	if len(strings.Split(stringValue, "/")) == 1 {
+		if !api.IsStandardFinalizerName(stringValue) {
+			return append(allErrs, errs.NewFieldInvalid("spec.finalizers", stringValue, "finalizer name is neither a standard finalizer name nor is it fully qualified"))
+		}
+	}
+
+	return errs.ValidationErrorList{}
+}
+
 // ValidateNamespaceUpdate tests to make sure a mamespace update can be applied.  Modifies oldNamespace.
 func ValidateNamespaceUpdate(oldNamespace *api.Namespace, namespace *api.Namespace) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
@@ -990,3 +1796,14 @@ func ValidateNamespaceUpdate(oldNamespace *api.Namespace, namespace *api.Namespa
 	}
 	return allErrs
 }
+
+// ValidateNamespaceFinalizeUpdate tests to see if the update is legal for an end user to make.
+// Only the finalizers of a namespace may be changed through the finalize subresource.
+func ValidateNamespaceFinalizeUpdate(oldNamespace *api.Namespace, namespace *api.Namespace) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldNamespace.ObjectMeta, &namespace.ObjectMeta).Prefix("metadata")...)
+	for i := range namespace.Spec.Finalizers {
+		allErrs = append(allErrs, validateFinalizerName(string(namespace.Spec.Finalizers[i]))...)
+	}
+	return allErrs
+}
@@ -19,6 +19,7 @@ package validation
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
@@ -182,6 +183,7 @@ func TestValidateAnnotations(t *testing.T) {
 }
 
 func TestValidateVolumes(t *testing.T) {
+	sizeLimit := resource.MustParse("64Mi")
 	successCase := []api.Volume{
 		{Name: "abc", VolumeSource: api.VolumeSource{HostPath: &api.HostPathVolumeSource{"/mnt/path1"}}},
 		{Name: "123", VolumeSource: api.VolumeSource{HostPath: &api.HostPathVolumeSource{"/mnt/path2"}}},
@@ -190,12 +192,20 @@ func TestValidateVolumes(t *testing.T) {
 		{Name: "gcepd", VolumeSource: api.VolumeSource{GCEPersistentDisk: &api.GCEPersistentDiskVolumeSource{"my-PD", "ext4", 1, false}}},
 		{Name: "gitrepo", VolumeSource: api.VolumeSource{GitRepo: &api.GitRepoVolumeSource{"my-repo", "hashstring"}}},
 		{Name: "secret", VolumeSource: api.VolumeSource{Secret: &api.SecretVolumeSource{api.ObjectReference{Namespace: api.NamespaceDefault, Name: "my-secret", Kind: "Secret"}}}},
+		{Name: "downwardapi", VolumeSource: api.VolumeSource{DownwardAPI: &api.DownwardAPIVolumeSource{Items: []api.DownwardAPIVolumeFile{
+			{Path: "labels", FieldRef: api.ObjectFieldSelector{FieldPath: "metadata.labels"}},
+			{Path: "meta/annotations", FieldRef: api.ObjectFieldSelector{FieldPath: "metadata.annotations"}},
+		}}}},
+		{Name: "claim", VolumeSource: api.VolumeSource{PersistentVolumeClaimVolumeSource: &api.PersistentVolumeClaimVolumeSource{ClaimName: "my-claim"}}},
+		{Name: "nfs", VolumeSource: api.VolumeSource{NFS: &api.NFSVolumeSource{Server: "nfs.example.com", Path: "/exports/data", ReadOnly: true}}},
+		{Name: "iscsidisk", VolumeSource: api.VolumeSource{ISCSI: &api.ISCSIVolumeSource{TargetPortal: "127.0.0.1", IQN: "iqn.2015-02.example.com:test", Lun: 1, FSType: "ext4", ReadOnly: false}}},
+		{Name: "tmpfs", VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{Medium: api.StorageTypeMemory, SizeLimit: &sizeLimit}}},
 	}
 	names, errs := validateVolumes(successCase)
 	if len(errs) != 0 {
 		t.Errorf("expected success: %v", errs)
 	}
-	if len(names) != len(successCase) || !names.HasAll("abc", "123", "abc-123", "empty", "gcepd", "gitrepo", "secret") {
+	if len(names) != len(successCase) || !names.HasAll("abc", "123", "abc-123", "empty", "gcepd", "gitrepo", "secret", "downwardapi", "claim", "nfs", "iscsidisk", "tmpfs") {
 		t.Errorf("wrong names result: %v", names)
 	}
 	emptyVS := api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}}
@@ -208,6 +218,13 @@ func TestValidateVolumes(t *testing.T) {
 		"name > 63 characters": {[]api.Volume{{Name: strings.Repeat("a", 64), VolumeSource: emptyVS}}, errors.ValidationErrorTypeInvalid, "[0].name"},
 		"name not a DNS label": {[]api.Volume{{Name: "a.b.c", VolumeSource: emptyVS}}, errors.ValidationErrorTypeInvalid, "[0].name"},
 		"name not unique":      {[]api.Volume{{Name: "abc", VolumeSource: emptyVS}, {Name: "abc", VolumeSource: emptyVS}}, errors.ValidationErrorTypeDuplicate, "[1].name"},
+		"empty claim name":     {[]api.Volume{{Name: "claim", VolumeSource: api.VolumeSource{PersistentVolumeClaimVolumeSource: &api.PersistentVolumeClaimVolumeSource{}}}}, errors.ValidationErrorTypeRequired, "[0].source.persistentVolumeClaim.claimName"},
+		"empty nfs server":     {[]api.Volume{{Name: "nfs", VolumeSource: api.VolumeSource{NFS: &api.NFSVolumeSource{Path: "/exports"}}}}, errors.ValidationErrorTypeRequired, "[0].source.nfs.server"},
+		"empty nfs path":       {[]api.Volume{{Name: "nfs", VolumeSource: api.VolumeSource{NFS: &api.NFSVolumeSource{Server: "nfs.example.com"}}}}, errors.ValidationErrorTypeRequired, "[0].source.nfs.path"},
+		"empty portal":         {[]api.Volume{{Name: "iscsidisk", VolumeSource: api.VolumeSource{ISCSI: &api.ISCSIVolumeSource{IQN: "iqn.2015-02.example.com:test", FSType: "ext4"}}}}, errors.ValidationErrorTypeRequired, "[0].source.iscsi.targetPortal"},
+		"empty iqn":            {[]api.Volume{{Name: "iscsidisk", VolumeSource: api.VolumeSource{ISCSI: &api.ISCSIVolumeSource{TargetPortal: "127.0.0.1", FSType: "ext4"}}}}, errors.ValidationErrorTypeRequired, "[0].source.iscsi.iqn"},
+		"bad lun":              {[]api.Volume{{Name: "iscsidisk", VolumeSource: api.VolumeSource{ISCSI: &api.ISCSIVolumeSource{TargetPortal: "127.0.0.1", IQN: "iqn.2015-02.example.com:test", Lun: 256, FSType: "ext4"}}}}, errors.ValidationErrorTypeInvalid, "[0].source.iscsi.lun"},
+		"unsupported medium":   {[]api.Volume{{Name: "tmpfs", VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{Medium: "Flash"}}}}, errors.ValidationErrorTypeNotSupported, "[0].source.emptyDir.medium"},
 	}
 	for k, v := range errorCases {
 		_, errs := validateVolumes(v.V)
@@ -230,6 +247,96 @@ func TestValidateVolumes(t *testing.T) {
 	}
 }
 
+func TestValidateDownwardAPIVolumeSource(t *testing.T) {
+	labels := api.ObjectFieldSelector{FieldPath: "metadata.labels"}
+	errorCases := map[string]struct {
+		Items []api.DownwardAPIVolumeFile
+		T     errors.ValidationErrorType
+		F     string
+	}{
+		"empty path":        {[]api.DownwardAPIVolumeFile{{FieldRef: labels}}, errors.ValidationErrorTypeRequired, "items[0].path"},
+		"absolute path":     {[]api.DownwardAPIVolumeFile{{Path: "/labels", FieldRef: labels}}, errors.ValidationErrorTypeInvalid, "items[0].path"},
+		"path contains ..":  {[]api.DownwardAPIVolumeFile{{Path: "../labels", FieldRef: labels}}, errors.ValidationErrorTypeInvalid, "items[0].path"},
+		"duplicate path":    {[]api.DownwardAPIVolumeFile{{Path: "labels", FieldRef: labels}, {Path: "labels", FieldRef: labels}}, errors.ValidationErrorTypeDuplicate, "items[1].path"},
+		"empty field path":  {[]api.DownwardAPIVolumeFile{{Path: "labels"}}, errors.ValidationErrorTypeRequired, "items[0].fieldRef.fieldPath"},
+		"unsupported field": {[]api.DownwardAPIVolumeFile{{Path: "ip", FieldRef: api.ObjectFieldSelector{FieldPath: "status.podIP"}}}, errors.ValidationErrorTypeNotSupported, "items[0].fieldRef.fieldPath"},
+	}
+	for k, v := range errorCases {
+		errs := validateDownwardAPIVolumeSource(&api.DownwardAPIVolumeSource{Items: v.Items})
+		if len(errs) != 1 {
+			t.Errorf("%s: expected one failure, got %v", k, errs)
+			continue
+		}
+		if errs[0].(*errors.ValidationError).Type != v.T {
+			t.Errorf("%s: expected error to have type %s: %v", k, v.T, errs[0])
+		}
+		if errs[0].(*errors.ValidationError).Field != v.F {
+			t.Errorf("%s: expected error to have field %s: %v", k, v.F, errs[0])
+		}
+	}
+}
+
+func TestValidateSecurityContext(t *testing.T) {
+	validUser := int64(1001)
+	rootUser := int64(0)
+	negativeUser := int64(-1)
+	successCases := map[string]*api.SecurityContext{
+		"nil":              nil,
+		"empty":            {},
+		"root user":        {RunAsUser: &rootUser},
+		"non-root user":    {RunAsUser: &validUser, RunAsNonRoot: true},
+		"non-root check":   {RunAsNonRoot: true},
+		"selinux options":  {SELinuxOptions: &api.SELinuxOptions{Level: "s0:c1,c2"}},
+		"read only rootfs": {ReadOnlyRootFilesystem: true},
+	}
+	for k, sc := range successCases {
+		if errs := ValidateSecurityContext(sc); len(errs) != 0 {
+			t.Errorf("%s: expected success: %v", k, errs)
+		}
+	}
+
+	errorCases := map[string]*api.SecurityContext{
+		"negative user":           {RunAsUser: &negativeUser},
+		"root user, non-root set": {RunAsUser: &rootUser, RunAsNonRoot: true},
+	}
+	for k, sc := range errorCases {
+		errs := ValidateSecurityContext(sc)
+		if len(errs) == 0 {
+			t.Errorf("%s: expected failure", k)
+			continue
+		}
+		if field := errs[0].(*errors.ValidationError).Field; field != "runAsUser" {
+			t.Errorf("%s: expected error on runAsUser, got %s", k, field)
+		}
+	}
+}
+
+func TestValidateEmptyDirVolumeSource(t *testing.T) {
+	sizeLimit := resource.MustParse("64Mi")
+	negative := resource.MustParse("-1")
+	successCases := []api.EmptyDirVolumeSource{
+		{},
+		{Medium: api.StorageTypeMemory},
+		{Medium: api.StorageTypeMemory, SizeLimit: &sizeLimit},
+	}
+	for _, emptyDir := range successCases {
+		if errs := validateEmptyDirVolumeSource(&emptyDir); len(errs) != 0 {
+			t.Errorf("expected success for %#v: %v", emptyDir, errs)
+		}
+	}
+
+	errorCases := map[string]api.EmptyDirVolumeSource{
+		"unsupported medium":         {Medium: "Flash"},
+		"size limit on default disk": {SizeLimit: &sizeLimit},
+		"negative size limit":        {Medium: api.StorageTypeMemory, SizeLimit: &negative},
+	}
+	for k, emptyDir := range errorCases {
+		if errs := validateEmptyDirVolumeSource(&emptyDir); len(errs) == 0 {
+			t.Errorf("%s: expected failure", k)
+		}
+	}
+}
+
 func TestValidatePorts(t *testing.T) {
 	successCase := []api.ContainerPort{
 		{Name: "abc", ContainerPort: 80, HostPort: 80, Protocol: "TCP"},
@@ -294,6 +401,8 @@ func TestValidateEnv(t *testing.T) {
 		{Name: "ABC", Value: "value"},
 		{Name: "AbC_123", Value: "value"},
 		{Name: "abc", Value: ""},
+		{Name: "abc", ValueFrom: &api.EnvVarSource{FieldRef: &api.ObjectFieldSelector{FieldPath: "metadata.name"}}},
+		{Name: "abc", ValueFrom: &api.EnvVarSource{FieldRef: &api.ObjectFieldSelector{FieldPath: "status.podIP"}}},
 	}
 	if errs := validateEnv(successCase); len(errs) != 0 {
 		t.Errorf("expected success: %v", errs)
@@ -317,6 +426,40 @@ func TestValidateEnv(t *testing.T) {
 	}
 }
 
+func TestValidateEnvValueFrom(t *testing.T) {
+	errorCases := map[string]struct {
+		Env api.EnvVar
+		T   errors.ValidationErrorType
+		F   string
+	}{
+		"value and valueFrom": {
+			api.EnvVar{Name: "abc", Value: "foo", ValueFrom: &api.EnvVarSource{FieldRef: &api.ObjectFieldSelector{FieldPath: "metadata.name"}}},
+			errors.ValidationErrorTypeInvalid, "[0].valueFrom",
+		},
+		"missing fieldRef": {
+			api.EnvVar{Name: "abc", ValueFrom: &api.EnvVarSource{}},
+			errors.ValidationErrorTypeRequired, "[0].valueFrom.fieldRef",
+		},
+		"unsupported fieldPath": {
+			api.EnvVar{Name: "abc", ValueFrom: &api.EnvVarSource{FieldRef: &api.ObjectFieldSelector{FieldPath: "metadata.labels"}}},
+			errors.ValidationErrorTypeNotSupported, "[0].valueFrom.fieldRef.fieldPath",
+		},
+	}
+	for k, v := range errorCases {
+		errs := validateEnv([]api.EnvVar{v.Env})
+		if len(errs) != 1 {
+			t.Errorf("%s: expected one failure, got %v", k, errs)
+			continue
+		}
+		if errs[0].(*errors.ValidationError).Type != v.T {
+			t.Errorf("%s: expected error to have type %s: %v", k, v.T, errs[0])
+		}
+		if errs[0].(*errors.ValidationError).Field != v.F {
+			t.Errorf("%s: expected error to have field %s: %v", k, v.F, errs[0])
+		}
+	}
+}
+
 func TestValidateVolumeMounts(t *testing.T) {
 	volumes := util.NewStringSet("abc", "123", "abc-123")
 
@@ -447,6 +590,7 @@ func getResourceLimits(cpu, memory string) api.ResourceList {
 
 func TestValidateContainers(t *testing.T) {
 	volumes := util.StringSet{}
+	runAsUser := int64(1001)
 	capabilities.SetForTests(capabilities.Capabilities{
 		AllowPrivileged: true,
 	})
@@ -477,7 +621,30 @@ func TestValidateContainers(t *testing.T) {
 			},
 			ImagePullPolicy: "IfNotPresent",
 		},
+		{
+			Name:  "resources-request-test",
+			Image: "image",
+			Resources: api.ResourceRequirements{
+				Limits: getResourceLimits("10", "10G"),
+				Requests: api.ResourceList{
+					api.ResourceName(api.ResourceCPU):   resource.MustParse("500m"),
+					api.ResourceName("my.org/resource"): resource.MustParse("10m"),
+				},
+			},
+			ImagePullPolicy: "IfNotPresent",
+		},
 		{Name: "abc-1234", Image: "image", Privileged: true, ImagePullPolicy: "IfNotPresent"},
+		{
+			Name:            "security-context",
+			Image:           "image",
+			ImagePullPolicy: "IfNotPresent",
+			SecurityContext: &api.SecurityContext{
+				RunAsUser:              &runAsUser,
+				RunAsNonRoot:           true,
+				SELinuxOptions:         &api.SELinuxOptions{User: "user", Role: "role", Type: "type", Level: "level"},
+				ReadOnlyRootFilesystem: true,
+			},
+		},
 	}
 	if errs := validateContainers(successCase, volumes); len(errs) != 0 {
 		t.Errorf("expected success: %v", errs)
@@ -625,6 +792,27 @@ func TestValidateContainers(t *testing.T) {
 				ImagePullPolicy: "IfNotPresent",
 			},
 		},
+		"Request invalid": {
+			{
+				Name:  "abc-123",
+				Image: "image",
+				Resources: api.ResourceRequirements{
+					Requests: getResourceLimits("-10", "0"),
+				},
+				ImagePullPolicy: "IfNotPresent",
+			},
+		},
+		"Request exceeds limit": {
+			{
+				Name:  "abc-123",
+				Image: "image",
+				Resources: api.ResourceRequirements{
+					Limits:   getResourceLimits("100m", "1G"),
+					Requests: getResourceLimits("200m", "1G"),
+				},
+				ImagePullPolicy: "IfNotPresent",
+			},
+		},
 	}
 	for k, v := range errorCases {
 		if errs := validateContainers(v, volumes); len(errs) == 0 {
@@ -756,6 +944,8 @@ func TestValidateManifest(t *testing.T) {
 }
 
 func TestValidatePodSpec(t *testing.T) {
+	grace := int64(30)
+	negativeGrace := int64(-1)
 	successCases := []api.PodSpec{
 		{ // Populate basic fields, leave defaults for most.
 			Volumes:       []api.Volume{{Name: "vol", VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}}}},
@@ -772,8 +962,13 @@ func TestValidatePodSpec(t *testing.T) {
 			NodeSelector: map[string]string{
 				"key": "value",
 			},
-			Host:      "foobar",
-			DNSPolicy: api.DNSClusterFirst,
+			Host:           "foobar",
+			DNSPolicy:      api.DNSClusterFirst,
+			ServiceAccount: "acct",
+
+			ImagePullSecrets: []api.LocalObjectReference{{Name: "registry-key"}},
+
+			TerminationGracePeriodSeconds: &grace,
 		},
 	}
 	for i := range successCases {
@@ -801,6 +996,22 @@ func TestValidatePodSpec(t *testing.T) {
 			RestartPolicy: api.RestartPolicy{},
 			DNSPolicy:     api.DNSClusterFirst,
 		},
+		"negative termination grace period": {
+			RestartPolicy: api.RestartPolicy{Always: &api.RestartPolicyAlways{}},
+			DNSPolicy:     api.DNSClusterFirst,
+
+			TerminationGracePeriodSeconds: &negativeGrace,
+		},
+		"bad image pull secret name": {
+			RestartPolicy:    api.RestartPolicy{Always: &api.RestartPolicyAlways{}},
+			DNSPolicy:        api.DNSClusterFirst,
+			ImagePullSecrets: []api.LocalObjectReference{{Name: ""}},
+		},
+		"bad service account name": {
+			RestartPolicy:  api.RestartPolicy{Always: &api.RestartPolicyAlways{}},
+			DNSPolicy:      api.DNSClusterFirst,
+			ServiceAccount: "invalid/name",
+		},
 	}
 	for k, v := range failureCases {
 		if errs := ValidatePodSpec(&v); len(errs) == 0 {
@@ -885,6 +1096,8 @@ func TestValidatePod(t *testing.T) {
 }
 
 func TestValidatePodUpdate(t *testing.T) {
+	now := util.Now()
+	later := util.NewTime(now.Add(time.Minute))
 	tests := []struct {
 		a       api.Pod
 		b       api.Pod
@@ -892,6 +1105,26 @@ func TestValidatePodUpdate(t *testing.T) {
 		test    string
 	}{
 		{api.Pod{}, api.Pod{}, true, "nothing"},
+		{
+			api.Pod{
+				ObjectMeta: api.ObjectMeta{Name: "foo"},
+			},
+			api.Pod{
+				ObjectMeta: api.ObjectMeta{Name: "foo", DeletionTimestamp: &now},
+			},
+			true,
+			"deletion timestamp left unset",
+		},
+		{
+			api.Pod{
+				ObjectMeta: api.ObjectMeta{Name: "foo", DeletionTimestamp: &later},
+			},
+			api.Pod{
+				ObjectMeta: api.ObjectMeta{Name: "foo", DeletionTimestamp: &now},
+			},
+			false,
+			"deletion timestamp changed",
+		},
 		{
 			api.Pod{
 				ObjectMeta: api.ObjectMeta{Name: "foo"},
@@ -1195,9 +1428,8 @@ func TestValidateService(t *testing.T) {
 			svc: api.Service{
 				ObjectMeta: api.ObjectMeta{Namespace: api.NamespaceDefault},
 				Spec: api.ServiceSpec{
-					Port:            8675,
+					Ports:           []api.ServicePort{{Port: 8675, Protocol: "TCP"}},
 					Selector:        map[string]string{"foo": "bar"},
-					Protocol:        "TCP",
 					SessionAffinity: "None",
 				},
 			},
@@ -1209,7 +1441,7 @@ func TestValidateService(t *testing.T) {
 			svc: api.Service{
 				ObjectMeta: api.ObjectMeta{Name: "abc123", Namespace: api.NamespaceDefault},
 				Spec: api.ServiceSpec{
-					Port:            8675,
+					Ports:           []api.ServicePort{{Port: 8675}},
 					Selector:        map[string]string{"foo": "bar"},
 					SessionAffinity: "None",
 				},
@@ -1222,9 +1454,8 @@ func TestValidateService(t *testing.T) {
 			svc: api.Service{
 				ObjectMeta: api.ObjectMeta{Name: "abc123", Namespace: api.NamespaceDefault},
 				Spec: api.ServiceSpec{
-					Port:     8675,
+					Ports:    []api.ServicePort{{Port: 8675, Protocol: "TCP"}},
 					Selector: map[string]string{"foo": "bar"},
-					Protocol: "TCP",
 				},
 			},
 			// Should fail because the session affinity is missing.
@@ -1235,9 +1466,8 @@ func TestValidateService(t *testing.T) {
 			svc: api.Service{
 				ObjectMeta: api.ObjectMeta{Name: "foo"},
 				Spec: api.ServiceSpec{
-					Port:            8675,
+					Ports:           []api.ServicePort{{Port: 8675, Protocol: "TCP"}},
 					Selector:        map[string]string{"foo": "bar"},
-					Protocol:        "TCP",
 					SessionAffinity: "None",
 				},
 			},
@@ -1249,9 +1479,8 @@ func TestValidateService(t *testing.T) {
 			svc: api.Service{
 				ObjectMeta: api.ObjectMeta{Name: "-123abc", Namespace: api.NamespaceDefault},
 				Spec: api.ServiceSpec{
-					Port:            8675,
+					Ports:           []api.ServicePort{{Port: 8675, Protocol: "TCP"}},
 					Selector:        map[string]string{"foo": "bar"},
-					Protocol:        "TCP",
 					SessionAffinity: "None",
 				},
 			},
@@ -1267,9 +1496,8 @@ func TestValidateService(t *testing.T) {
 					Namespace:    api.NamespaceDefault,
 				},
 				Spec: api.ServiceSpec{
-					Port:            8675,
+					Ports:           []api.ServicePort{{Port: 8675, Protocol: "TCP"}},
 					Selector:        map[string]string{"foo": "bar"},
-					Protocol:        "TCP",
 					SessionAffinity: "None",
 				},
 			},
@@ -1285,9 +1513,8 @@ func TestValidateService(t *testing.T) {
 					Namespace:    api.NamespaceDefault,
 				},
 				Spec: api.ServiceSpec{
-					Port:            8675,
+					Ports:           []api.ServicePort{{Port: 8675, Protocol: "TCP"}},
 					Selector:        map[string]string{"foo": "bar"},
-					Protocol:        "TCP",
 					SessionAffinity: "None",
 				},
 			},
@@ -1300,7 +1527,7 @@ func TestValidateService(t *testing.T) {
 				ObjectMeta: api.ObjectMeta{Name: "abc123", Namespace: api.NamespaceDefault},
 				Spec: api.ServiceSpec{
 					Selector:        map[string]string{"foo": "bar"},
-					Protocol:        "TCP",
+					Ports:           []api.ServicePort{{Protocol: "TCP"}},
 					SessionAffinity: "None",
 				},
 			},
@@ -1312,9 +1539,8 @@ func TestValidateService(t *testing.T) {
 			svc: api.Service{
 				ObjectMeta: api.ObjectMeta{Name: "abc123", Namespace: api.NamespaceDefault},
 				Spec: api.ServiceSpec{
-					Port:            66536,
+					Ports:           []api.ServicePort{{Port: 66536, Protocol: "TCP"}},
 					Selector:        map[string]string{"foo": "bar"},
-					Protocol:        "TCP",
 					SessionAffinity: "None",
 				},
 			},
@@ -1326,9 +1552,8 @@ func TestValidateService(t *testing.T) {
 			svc: api.Service{
 				ObjectMeta: api.ObjectMeta{Name: "abc123", Namespace: api.NamespaceDefault},
 				Spec: api.ServiceSpec{
-					Port:            8675,
+					Ports:           []api.ServicePort{{Port: 8675, Protocol: "INVALID"}},
 					Selector:        map[string]string{"foo": "bar"},
-					Protocol:        "INVALID",
 					SessionAffinity: "None",
 				},
 			},
@@ -1340,8 +1565,7 @@ func TestValidateService(t *testing.T) {
 			svc: api.Service{
 				ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault},
 				Spec: api.ServiceSpec{
-					Port:            8675,
-					Protocol:        "TCP",
+					Ports:           []api.ServicePort{{Port: 8675, Protocol: "TCP"}},
 					SessionAffinity: "None",
 				},
 			},
@@ -1353,9 +1577,8 @@ func TestValidateService(t *testing.T) {
 			svc: api.Service{
 				ObjectMeta: api.ObjectMeta{Name: "abc123", Namespace: api.NamespaceDefault},
 				Spec: api.ServiceSpec{
-					Port:            8675,
+					Ports:           []api.ServicePort{{Port: 8675, Protocol: "TCP"}},
 					Selector:        map[string]string{"foo": "bar"},
-					Protocol:        "TCP",
 					SessionAffinity: "None",
 				},
 			},
@@ -1366,9 +1589,8 @@ func TestValidateService(t *testing.T) {
 			svc: api.Service{
 				ObjectMeta: api.ObjectMeta{Name: "abc123", Namespace: api.NamespaceDefault},
 				Spec: api.ServiceSpec{
-					Port:            8675,
+					Ports:           []api.ServicePort{{Port: 8675, Protocol: "UDP"}},
 					Selector:        map[string]string{"foo": "bar"},
-					Protocol:        "UDP",
 					SessionAffinity: "None",
 				},
 			},
@@ -1379,9 +1601,8 @@ func TestValidateService(t *testing.T) {
 			svc: api.Service{
 				ObjectMeta: api.ObjectMeta{Name: "abc123", Namespace: api.NamespaceDefault},
 				Spec: api.ServiceSpec{
-					Port:            8675,
+					Ports:           []api.ServicePort{{Port: 8675, Protocol: "UDP"}},
 					Selector:        map[string]string{"foo": "bar"},
-					Protocol:        "UDP",
 					SessionAffinity: "None",
 				},
 			},
@@ -1392,10 +1613,9 @@ func TestValidateService(t *testing.T) {
 			svc: api.Service{
 				ObjectMeta: api.ObjectMeta{Name: "abc123", Namespace: api.NamespaceDefault},
 				Spec: api.ServiceSpec{
-					Port: 80,
+					Ports:                      []api.ServicePort{{Port: 80, Protocol: "TCP"}},
 					CreateExternalLoadBalancer: true,
 					Selector:                   map[string]string{"foo": "bar"},
-					Protocol:                   "TCP",
 					SessionAffinity:            "None",
 				},
 			},
@@ -1403,7 +1623,7 @@ func TestValidateService(t *testing.T) {
 				Items: []api.Service{
 					{
 						ObjectMeta: api.ObjectMeta{Name: "def123", Namespace: api.NamespaceDefault},
-						Spec:       api.ServiceSpec{Port: 80, CreateExternalLoadBalancer: true, Protocol: "TCP"},
+						Spec:       api.ServiceSpec{Ports: []api.ServicePort{{Port: 80, Protocol: "TCP"}}, CreateExternalLoadBalancer: true},
 					},
 				},
 			},
@@ -1414,10 +1634,9 @@ func TestValidateService(t *testing.T) {
 			svc: api.Service{
 				ObjectMeta: api.ObjectMeta{Name: "abc123", Namespace: api.NamespaceDefault},
 				Spec: api.ServiceSpec{
-					Port: 80,
+					Ports:                      []api.ServicePort{{Port: 80, Protocol: "TCP"}},
 					CreateExternalLoadBalancer: true,
 					Selector:                   map[string]string{"foo": "bar"},
-					Protocol:                   "TCP",
 					SessionAffinity:            "None",
 				},
 			},
@@ -1425,7 +1644,7 @@ func TestValidateService(t *testing.T) {
 				Items: []api.Service{
 					{
 						ObjectMeta: api.ObjectMeta{Name: "def123", Namespace: api.NamespaceDefault},
-						Spec:       api.ServiceSpec{Port: 80, Protocol: "TCP"},
+						Spec:       api.ServiceSpec{Ports: []api.ServicePort{{Port: 80, Protocol: "TCP"}}},
 					},
 				},
 			},
@@ -1436,9 +1655,8 @@ func TestValidateService(t *testing.T) {
 			svc: api.Service{
 				ObjectMeta: api.ObjectMeta{Name: "abc123", Namespace: api.NamespaceDefault},
 				Spec: api.ServiceSpec{
-					Port:            80,
+					Ports:           []api.ServicePort{{Port: 80, Protocol: "TCP"}},
 					Selector:        map[string]string{"foo": "bar"},
-					Protocol:        "TCP",
 					SessionAffinity: "None",
 				},
 			},
@@ -1446,7 +1664,7 @@ func TestValidateService(t *testing.T) {
 				Items: []api.Service{
 					{
 						ObjectMeta: api.ObjectMeta{Name: "def123", Namespace: api.NamespaceDefault},
-						Spec:       api.ServiceSpec{Port: 80, CreateExternalLoadBalancer: true, Protocol: "TCP"},
+						Spec:       api.ServiceSpec{Ports: []api.ServicePort{{Port: 80, Protocol: "TCP"}}, CreateExternalLoadBalancer: true},
 					},
 				},
 			},
@@ -1457,9 +1675,8 @@ func TestValidateService(t *testing.T) {
 			svc: api.Service{
 				ObjectMeta: api.ObjectMeta{Name: "abc123", Namespace: api.NamespaceDefault},
 				Spec: api.ServiceSpec{
-					Port:            80,
+					Ports:           []api.ServicePort{{Port: 80, Protocol: "TCP"}},
 					Selector:        map[string]string{"foo": "bar"},
-					Protocol:        "TCP",
 					SessionAffinity: "None",
 				},
 			},
@@ -1467,12 +1684,91 @@ func TestValidateService(t *testing.T) {
 				Items: []api.Service{
 					{
 						ObjectMeta: api.ObjectMeta{Name: "def123", Namespace: api.NamespaceDefault},
-						Spec:       api.ServiceSpec{Port: 80, Protocol: "TCP"},
+						Spec:       api.ServiceSpec{Ports: []api.ServicePort{{Port: 80, Protocol: "TCP"}}},
+					},
+				},
+			},
+			numErrs: 0,
+		},
+		{
+			name: "missing ports",
+			svc: api.Service{
+				ObjectMeta: api.ObjectMeta{Name: "abc123", Namespace: api.NamespaceDefault},
+				Spec: api.ServiceSpec{
+					Selector:        map[string]string{"foo": "bar"},
+					SessionAffinity: "None",
+				},
+			},
+			// Should fail because there are no ports.
+			numErrs: 1,
+		},
+		{
+			name: "valid multiple ports",
+			svc: api.Service{
+				ObjectMeta: api.ObjectMeta{Name: "abc123", Namespace: api.NamespaceDefault},
+				Spec: api.ServiceSpec{
+					Ports: []api.ServicePort{
+						{Name: "http", Port: 80, Protocol: "TCP", ContainerPort: util.NewIntOrStringFromString("http")},
+						{Name: "dns", Port: 53, Protocol: "UDP", ContainerPort: util.NewIntOrStringFromInt(5353)},
 					},
+					Selector:        map[string]string{"foo": "bar"},
+					SessionAffinity: "None",
 				},
 			},
 			numErrs: 0,
 		},
+		{
+			name: "missing name on second port",
+			svc: api.Service{
+				ObjectMeta: api.ObjectMeta{Name: "abc123", Namespace: api.NamespaceDefault},
+				Spec: api.ServiceSpec{
+					Ports:           []api.ServicePort{{Name: "http", Port: 80, Protocol: "TCP"}, {Port: 81, Protocol: "TCP"}},
+					Selector:        map[string]string{"foo": "bar"},
+					SessionAffinity: "None",
+				},
+			},
+			// Should fail because every port but the first must be named.
+			numErrs: 1,
+		},
+		{
+			name: "duplicate port name",
+			svc: api.Service{
+				ObjectMeta: api.ObjectMeta{Name: "abc123", Namespace: api.NamespaceDefault},
+				Spec: api.ServiceSpec{
+					Ports:           []api.ServicePort{{Name: "http", Port: 80, Protocol: "TCP"}, {Name: "http", Port: 81, Protocol: "TCP"}},
+					Selector:        map[string]string{"foo": "bar"},
+					SessionAffinity: "None",
+				},
+			},
+			// Should fail because port names must be unique.
+			numErrs: 1,
+		},
+		{
+			name: "invalid port name",
+			svc: api.Service{
+				ObjectMeta: api.ObjectMeta{Name: "abc123", Namespace: api.NamespaceDefault},
+				Spec: api.ServiceSpec{
+					Ports:           []api.ServicePort{{Name: "Not_A_Label", Port: 80, Protocol: "TCP"}},
+					Selector:        map[string]string{"foo": "bar"},
+					SessionAffinity: "None",
+				},
+			},
+			// Should fail because the port name is not a DNS label.
+			numErrs: 1,
+		},
+		{
+			name: "invalid container port",
+			svc: api.Service{
+				ObjectMeta: api.ObjectMeta{Name: "abc123", Namespace: api.NamespaceDefault},
+				Spec: api.ServiceSpec{
+					Ports:           []api.ServicePort{{Port: 80, Protocol: "TCP", ContainerPort: util.NewIntOrStringFromInt(65536)}},
+					Selector:        map[string]string{"foo": "bar"},
+					SessionAffinity: "None",
+				},
+			},
+			// Should fail because the container port is out of range.
+			numErrs: 1,
+		},
 		{
 			name: "invalid label",
 			svc: api.Service{
@@ -1484,8 +1780,7 @@ func TestValidateService(t *testing.T) {
 					},
 				},
 				Spec: api.ServiceSpec{
-					Port:            8675,
-					Protocol:        "TCP",
+					Ports:           []api.ServicePort{{Port: 8675, Protocol: "TCP"}},
 					SessionAffinity: "None",
 				},
 			},
@@ -1499,9 +1794,8 @@ func TestValidateService(t *testing.T) {
 					Namespace: api.NamespaceDefault,
 				},
 				Spec: api.ServiceSpec{
-					Port:            8675,
+					Ports:           []api.ServicePort{{Port: 8675, Protocol: "TCP"}},
 					Selector:        map[string]string{"foo": "bar", "NoUppercaseOrSpecialCharsLike=Equals": "bar"},
-					Protocol:        "TCP",
 					SessionAffinity: "None",
 				},
 			},
@@ -1521,9 +1815,8 @@ func TestValidateService(t *testing.T) {
 	svc := api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault},
 		Spec: api.ServiceSpec{
-			Port:            8675,
+			Ports:           []api.ServicePort{{Port: 8675, Protocol: "TCP"}},
 			Selector:        map[string]string{"foo": "bar"},
-			Protocol:        "TCP",
 			SessionAffinity: "None",
 		},
 	}
@@ -2456,6 +2749,10 @@ func TestValidateNamespace(t *testing.T) {
 		{
 			ObjectMeta: api.ObjectMeta{Name: "abc-123"},
 		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "abc"},
+			Spec:       api.NamespaceSpec{Finalizers: []api.FinalizerName{api.FinalizerKubernetes, "example.com/foo"}},
+		},
 	}
 	for _, successCase := range successCases {
 		if errs := ValidateNamespace(&successCase); len(errs) != 0 {
@@ -2478,6 +2775,10 @@ func TestValidateNamespace(t *testing.T) {
 			api.Namespace{ObjectMeta: api.ObjectMeta{Name: "abc", Labels: invalidLabels}},
 			"",
 		},
+		"unqualified-finalizer": {
+			api.Namespace{ObjectMeta: api.ObjectMeta{Name: "abc"}, Spec: api.NamespaceSpec{Finalizers: []api.FinalizerName{"foo"}}},
+			"",
+		},
 	}
 	for k, v := range errorCases {
 		errs := ValidateNamespace(&v.R)
@@ -2557,6 +2858,72 @@ func TestValidateNamespaceUpdate(t *testing.T) {
 	}
 }
 
+func TestValidateNamespaceFinalizeUpdate(t *testing.T) {
+	tests := []struct {
+		oldNamespace api.Namespace
+		namespace    api.Namespace
+		valid        bool
+	}{
+		{api.Namespace{}, api.Namespace{}, true},
+		{api.Namespace{
+			ObjectMeta: api.ObjectMeta{
+				Name: "foo"}},
+			api.Namespace{
+				ObjectMeta: api.ObjectMeta{
+					Name: "foo"},
+				Spec: api.NamespaceSpec{
+					Finalizers: []api.FinalizerName{"Foo"},
+				},
+			}, false},
+		{api.Namespace{
+			ObjectMeta: api.ObjectMeta{
+				Name: "foo"},
+			Spec: api.NamespaceSpec{
+				Finalizers: []api.FinalizerName{"foo.com/bar"},
+			},
+		},
+			api.Namespace{
+				ObjectMeta: api.ObjectMeta{
+					Name: "foo"},
+				Spec: api.NamespaceSpec{
+					Finalizers: []api.FinalizerName{"foo.com/bar", "what.com/bar"},
+				},
+			}, true},
+		{api.Namespace{
+			ObjectMeta: api.ObjectMeta{
+				Name: "foo"},
+			Spec: api.NamespaceSpec{
+				Finalizers: []api.FinalizerName{api.FinalizerKubernetes},
+			},
+		},
+			api.Namespace{
+				ObjectMeta: api.ObjectMeta{
+					Name: "foo"},
+			}, true},
+		{api.Namespace{
+			ObjectMeta: api.ObjectMeta{
+				Name: "foo"}},
+			api.Namespace{
+				ObjectMeta: api.ObjectMeta{
+					Name: "bar"},
+			}, false},
+	}
+	for i, test := range tests {
+		oldFinalizers := test.oldNamespace.Spec.Finalizers
+		errs := ValidateNamespaceFinalizeUpdate(&test.oldNamespace, &test.namespace)
+		if !api.Semantic.DeepEqual(oldFinalizers, test.oldNamespace.Spec.Finalizers) {
+			t.Errorf("%d: expected the old namespace to be left alone, got %v", i, test.oldNamespace.Spec.Finalizers)
+		}
+		if test.valid && len(errs) > 0 {
+			t.Errorf("%d: Unexpected error: %v", i, errs)
+			t.Logf("%#v vs %#v", test.oldNamespace, test.namespace)
+		}
+		if !test.valid && len(errs) == 0 {
+			t.Errorf("%d: Unexpected non-error", i)
+		}
+	}
+}
+
 func TestValidateSecret(t *testing.T) {
 	validSecret := func() api.Secret {
 		return api.Secret{
@@ -2574,6 +2941,14 @@ func TestValidateSecret(t *testing.T) {
 		invalidNs   = validSecret()
 		overMaxSize = validSecret()
 		invalidKey  = validSecret()
+
+		validToken   = validSecret()
+		missingToken = validSecret()
+
+		dotKey             = validSecret()
+		validDockercfg     = validSecret()
+		missingDockercfg   = validSecret()
+		malformedDockercfg = validSecret()
 	)
 
 	emptyName.Name = ""
@@ -2584,6 +2959,15 @@ func TestValidateSecret(t *testing.T) {
 		"over": make([]byte, api.MaxSecretSize+1),
 	}
 	invalidKey.Data["a..b"] = []byte("whoops")
+	validToken.Type = api.SecretTypeServiceAccountToken
+	validToken.Annotations = map[string]string{api.ServiceAccountNameKey: "default"}
+	missingToken.Type = api.SecretTypeServiceAccountToken
+	dotKey.Data[".dotfile"] = []byte("bar")
+	validDockercfg.Type = api.SecretTypeDockercfg
+	validDockercfg.Data = map[string][]byte{api.DockerConfigKey: []byte(`{"https://index.docker.io/v1/": {"auth": "Zm9vOmJhcg==", "email": "foo@example.com"}}`)}
+	missingDockercfg.Type = api.SecretTypeDockercfg
+	malformedDockercfg.Type = api.SecretTypeDockercfg
+	malformedDockercfg.Data = map[string][]byte{api.DockerConfigKey: []byte(`{"https://index.docker.io/v1/":`)}
 
 	tests := map[string]struct {
 		secret api.Secret
@@ -2596,6 +2980,14 @@ func TestValidateSecret(t *testing.T) {
 		"invalid namespace": {invalidNs, false},
 		"over max size":     {overMaxSize, false},
 		"invalid key":       {invalidKey, false},
+
+		"valid service account token":          {validToken, true},
+		"service account token without a name": {missingToken, false},
+
+		"key with a leading dot": {dotKey, true},
+		"valid dockercfg":        {validDockercfg, true},
+		"missing dockercfg":      {missingDockercfg, false},
+		"malformed dockercfg":    {malformedDockercfg, false},
 	}
 
 	for name, tc := range tests {
@@ -2608,3 +3000,657 @@ func TestValidateSecret(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateServiceAccount(t *testing.T) {
+	validServiceAccount := func() api.ServiceAccount {
+		return api.ServiceAccount{
+			ObjectMeta: api.ObjectMeta{Name: "default", Namespace: "bar"},
+			Secrets:    []api.ObjectReference{{Name: "default-token-abcde"}},
+		}
+	}
+
+	var (
+		emptyName     = validServiceAccount()
+		invalidName   = validServiceAccount()
+		emptyNs       = validServiceAccount()
+		unnamedSecret = validServiceAccount()
+	)
+
+	emptyName.Name = ""
+	invalidName.Name = "NoUppercaseOrSpecialCharsLike=Equals"
+	emptyNs.Namespace = ""
+	unnamedSecret.Secrets = []api.ObjectReference{{}}
+
+	tests := map[string]struct {
+		serviceAccount api.ServiceAccount
+		valid          bool
+	}{
+		"valid":           {validServiceAccount(), true},
+		"empty name":      {emptyName, false},
+		"invalid name":    {invalidName, false},
+		"empty namespace": {emptyNs, false},
+		"unnamed secret":  {unnamedSecret, false},
+	}
+
+	for name, tc := range tests {
+		errs := ValidateServiceAccount(&tc.serviceAccount)
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("%v: Unexpected error: %v", name, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("%v: Unexpected non-error", name)
+		}
+	}
+}
+
+func TestValidatePersistentVolume(t *testing.T) {
+	validVolume := func() api.PersistentVolume {
+		return api.PersistentVolume{
+			ObjectMeta: api.ObjectMeta{Name: "foo"},
+			Spec: api.PersistentVolumeSpec{
+				Capacity: api.ResourceList{
+					api.ResourceStorage: resource.MustParse("10G"),
+				},
+				AccessModes: []api.AccessModeType{api.ReadWriteOnce},
+				PersistentVolumeSource: api.PersistentVolumeSource{
+					HostPath: &api.HostPathVolumeSource{Path: "/foo"},
+				},
+			},
+		}
+	}
+
+	var (
+		namespaced     = validVolume()
+		noAccessModes  = validVolume()
+		badAccessMode  = validVolume()
+		noCapacity     = validVolume()
+		extraCapacity  = validVolume()
+		noSource       = validVolume()
+		multipleSource = validVolume()
+		nfsSource      = validVolume()
+		badNFSSource   = validVolume()
+		relativeNFS    = validVolume()
+	)
+
+	namespaced.Namespace = "unexpected-namespace"
+	noAccessModes.Spec.AccessModes = nil
+	badAccessMode.Spec.AccessModes = []api.AccessModeType{"WriteSometimes"}
+	noCapacity.Spec.Capacity = nil
+	extraCapacity.Spec.Capacity[api.ResourceCPU] = resource.MustParse("1")
+	noSource.Spec.HostPath = nil
+	multipleSource.Spec.GCEPersistentDisk = &api.GCEPersistentDiskVolumeSource{PDName: "foo", FSType: "ext4"}
+	nfsSource.Spec.HostPath = nil
+	nfsSource.Spec.NFS = &api.NFSVolumeSource{Server: "nfs.example.com", Path: "/exports/foo"}
+	badNFSSource.Spec.HostPath = nil
+	badNFSSource.Spec.NFS = &api.NFSVolumeSource{Path: "/exports/foo"}
+	relativeNFS.Spec.HostPath = nil
+	relativeNFS.Spec.NFS = &api.NFSVolumeSource{Server: "nfs.example.com", Path: "exports/foo"}
+
+	tests := map[string]struct {
+		volume api.PersistentVolume
+		valid  bool
+	}{
+		"valid":              {validVolume(), true},
+		"namespaced":         {namespaced, false},
+		"missing accessmode": {noAccessModes, false},
+		"bad accessmode":     {badAccessMode, false},
+		"missing capacity":   {noCapacity, false},
+		"extra capacity":     {extraCapacity, false},
+		"missing source":     {noSource, false},
+		"multiple sources":   {multipleSource, false},
+		"nfs source":         {nfsSource, true},
+		"nfs without server": {badNFSSource, false},
+		"relative nfs path":  {relativeNFS, false},
+	}
+
+	for name, tc := range tests {
+		errs := ValidatePersistentVolume(&tc.volume)
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("%v: Unexpected error: %v", name, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("%v: Unexpected non-error", name)
+		}
+	}
+}
+
+func TestValidatePersistentVolumeClaim(t *testing.T) {
+	validClaim := func() api.PersistentVolumeClaim {
+		return api.PersistentVolumeClaim{
+			ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "ns"},
+			Spec: api.PersistentVolumeClaimSpec{
+				AccessModes: []api.AccessModeType{api.ReadWriteOnce, api.ReadOnlyMany},
+				Resources: api.ResourceRequirements{
+					Limits: api.ResourceList{
+						api.ResourceStorage: resource.MustParse("10G"),
+					},
+				},
+			},
+		}
+	}
+
+	var (
+		emptyNs       = validClaim()
+		noAccessModes = validClaim()
+		noStorage     = validClaim()
+		negative      = validClaim()
+	)
+
+	emptyNs.Namespace = ""
+	noAccessModes.Spec.AccessModes = []api.AccessModeType{}
+	noStorage.Spec.Resources.Limits = api.ResourceList{}
+	negative.Spec.Resources.Limits[api.ResourceStorage] = resource.MustParse("-10G")
+
+	tests := map[string]struct {
+		claim api.PersistentVolumeClaim
+		valid bool
+	}{
+		"valid":              {validClaim(), true},
+		"empty namespace":    {emptyNs, false},
+		"missing accessmode": {noAccessModes, false},
+		"missing storage":    {noStorage, false},
+		"negative storage":   {negative, false},
+	}
+
+	for name, tc := range tests {
+		errs := ValidatePersistentVolumeClaim(&tc.claim)
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("%v: Unexpected error: %v", name, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("%v: Unexpected non-error", name)
+		}
+	}
+}
+
+func validJob() api.Job {
+	completions := 3
+	parallelism := 2
+	selector := map[string]string{"job": "migrate"}
+	return api.Job{
+		ObjectMeta: api.ObjectMeta{Name: "migrate", Namespace: api.NamespaceDefault},
+		Spec: api.JobSpec{
+			Completions: &completions,
+			Parallelism: &parallelism,
+			Selector:    selector,
+			Template: api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{Labels: selector},
+				Spec: api.PodSpec{
+					RestartPolicy: api.RestartPolicy{OnFailure: &api.RestartPolicyOnFailure{}},
+					DNSPolicy:     api.DNSClusterFirst,
+				},
+			},
+		},
+	}
+}
+
+func TestValidateJob(t *testing.T) {
+	negative := -1
+	var (
+		emptyNs          = validJob()
+		noCompletions    = validJob()
+		negativeParallel = validJob()
+		noSelector       = validJob()
+		mismatchLabels   = validJob()
+		restartAlways    = validJob()
+		restartNever     = validJob()
+	)
+	emptyNs.Namespace = ""
+	noCompletions.Spec.Completions = nil
+	negativeParallel.Spec.Parallelism = &negative
+	noSelector.Spec.Selector = nil
+	mismatchLabels.Spec.Template.Labels = map[string]string{"job": "other"}
+	restartAlways.Spec.Template.Spec.RestartPolicy = api.RestartPolicy{Always: &api.RestartPolicyAlways{}}
+	restartNever.Spec.Template.Spec.RestartPolicy = api.RestartPolicy{Never: &api.RestartPolicyNever{}}
+
+	tests := map[string]struct {
+		job   api.Job
+		valid bool
+	}{
+		"valid":                {validJob(), true},
+		"restart never":        {restartNever, true},
+		"empty namespace":      {emptyNs, false},
+		"missing completions":  {noCompletions, false},
+		"negative parallelism": {negativeParallel, false},
+		"missing selector":     {noSelector, false},
+		"selector mismatch":    {mismatchLabels, false},
+		"restart always":       {restartAlways, false},
+	}
+
+	for name, tc := range tests {
+		errs := ValidateJob(&tc.job)
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("%v: Unexpected error: %v", name, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("%v: Unexpected non-error", name)
+		}
+	}
+}
+
+func TestValidateJobUpdate(t *testing.T) {
+	old := validJob()
+	old.ResourceVersion = "1"
+	other := 5
+	var (
+		parallelism = validJob()
+		completions = validJob()
+		template    = validJob()
+	)
+	parallelism.Spec.Parallelism = &other
+	completions.Spec.Completions = &other
+	template.Spec.Template.Spec.DNSPolicy = api.DNSDefault
+
+	tests := map[string]struct {
+		job   api.Job
+		valid bool
+	}{
+		"unchanged":            {validJob(), true},
+		"parallelism changed":  {parallelism, true},
+		"completions changed":  {completions, false},
+		"pod template changed": {template, false},
+	}
+
+	for name, tc := range tests {
+		tc.job.ResourceVersion = "1"
+		errs := ValidateJobUpdate(&old, &tc.job)
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("%v: Unexpected error: %v", name, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("%v: Unexpected non-error", name)
+		}
+	}
+}
+
+func validDaemonSet() api.DaemonSet {
+	selector := map[string]string{"daemon": "logger"}
+	return api.DaemonSet{
+		ObjectMeta: api.ObjectMeta{Name: "logger", Namespace: api.NamespaceDefault},
+		Spec: api.DaemonSetSpec{
+			Selector: selector,
+			Template: api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{Labels: selector},
+				Spec: api.PodSpec{
+					RestartPolicy: api.RestartPolicy{Always: &api.RestartPolicyAlways{}},
+					DNSPolicy:     api.DNSClusterFirst,
+					NodeSelector:  map[string]string{"role": "worker"},
+				},
+			},
+		},
+	}
+}
+
+func TestValidateDaemonSet(t *testing.T) {
+	var (
+		emptyNs        = validDaemonSet()
+		noSelector     = validDaemonSet()
+		mismatchLabels = validDaemonSet()
+		restartNever   = validDaemonSet()
+		withHost       = validDaemonSet()
+		readWritePD    = validDaemonSet()
+	)
+	emptyNs.Namespace = ""
+	noSelector.Spec.Selector = nil
+	mismatchLabels.Spec.Template.Labels = map[string]string{"daemon": "other"}
+	restartNever.Spec.Template.Spec.RestartPolicy = api.RestartPolicy{Never: &api.RestartPolicyNever{}}
+	withHost.Spec.Template.Spec.Host = "node1"
+	readWritePD.Spec.Template.Spec.Volumes = []api.Volume{{Name: "gcepd", VolumeSource: api.VolumeSource{GCEPersistentDisk: &api.GCEPersistentDiskVolumeSource{PDName: "my-PD", FSType: "ext4"}}}}
+
+	tests := map[string]struct {
+		ds    api.DaemonSet
+		valid bool
+	}{
+		"valid":               {validDaemonSet(), true},
+		"empty namespace":     {emptyNs, false},
+		"missing selector":    {noSelector, false},
+		"selector mismatch":   {mismatchLabels, false},
+		"restart never":       {restartNever, false},
+		"host set":            {withHost, false},
+		"read-write gce disk": {readWritePD, false},
+	}
+
+	for name, tc := range tests {
+		errs := ValidateDaemonSet(&tc.ds)
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("%v: Unexpected error: %v", name, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("%v: Unexpected non-error", name)
+		}
+	}
+}
+
+func validDeployment() api.Deployment {
+	selector := map[string]string{"app": "frontend"}
+	return api.Deployment{
+		ObjectMeta: api.ObjectMeta{Name: "frontend", Namespace: api.NamespaceDefault},
+		Spec: api.DeploymentSpec{
+			Replicas: 3,
+			Selector: selector,
+			Template: api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{Labels: selector},
+				Spec: api.PodSpec{
+					RestartPolicy: api.RestartPolicy{Always: &api.RestartPolicyAlways{}},
+					DNSPolicy:     api.DNSClusterFirst,
+				},
+			},
+			Strategy: api.DeploymentStrategy{
+				Type:          api.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &api.RollingUpdateDeployment{MaxUnavailable: 1, MaxSurge: 1},
+			},
+		},
+	}
+}
+
+func TestValidateDeployment(t *testing.T) {
+	var (
+		emptyNs           = validDeployment()
+		noSelector        = validDeployment()
+		mismatchLabels    = validDeployment()
+		negativeReplicas  = validDeployment()
+		restartNever      = validDeployment()
+		unknownStrategy   = validDeployment()
+		recreate          = validDeployment()
+		recreateWithParam = validDeployment()
+		noRollingUpdate   = validDeployment()
+		negativeSurge     = validDeployment()
+		zeroProgress      = validDeployment()
+		rollback          = validDeployment()
+		negativeRollback  = validDeployment()
+	)
+	emptyNs.Namespace = ""
+	noSelector.Spec.Selector = nil
+	mismatchLabels.Spec.Template.Labels = map[string]string{"app": "backend"}
+	negativeReplicas.Spec.Replicas = -1
+	restartNever.Spec.Template.Spec.RestartPolicy = api.RestartPolicy{Never: &api.RestartPolicyNever{}}
+	unknownStrategy.Spec.Strategy.Type = "Blue"
+	recreate.Spec.Strategy = api.DeploymentStrategy{Type: api.RecreateDeploymentStrategyType}
+	recreateWithParam.Spec.Strategy.Type = api.RecreateDeploymentStrategyType
+	noRollingUpdate.Spec.Strategy.RollingUpdate = nil
+	negativeSurge.Spec.Strategy.RollingUpdate = &api.RollingUpdateDeployment{MaxSurge: -1}
+	zeroProgress.Spec.Strategy.RollingUpdate = &api.RollingUpdateDeployment{}
+	rollback.Spec.RollbackTo = &api.RollbackConfig{}
+	negativeRollback.Spec.RollbackTo = &api.RollbackConfig{Revision: -2}
+
+	tests := map[string]struct {
+		deployment api.Deployment
+		valid      bool
+	}{
+		"valid":                          {validDeployment(), true},
+		"empty namespace":                {emptyNs, false},
+		"missing selector":               {noSelector, false},
+		"selector mismatch":              {mismatchLabels, false},
+		"negative replicas":              {negativeReplicas, false},
+		"restart never":                  {restartNever, false},
+		"unknown strategy":               {unknownStrategy, false},
+		"recreate":                       {recreate, true},
+		"recreate with rolling params":   {recreateWithParam, false},
+		"rolling update without params":  {noRollingUpdate, false},
+		"negative surge":                 {negativeSurge, false},
+		"no surge and no unavailability": {zeroProgress, false},
+		"rollback to previous":           {rollback, true},
+		"negative rollback revision":     {negativeRollback, false},
+	}
+
+	for name, tc := range tests {
+		errs := ValidateDeployment(&tc.deployment)
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("%v: Unexpected error: %v", name, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("%v: Unexpected non-error", name)
+		}
+	}
+}
+
+func validHorizontalPodAutoscaler() api.HorizontalPodAutoscaler {
+	return api.HorizontalPodAutoscaler{
+		ObjectMeta: api.ObjectMeta{Name: "frontend", Namespace: api.NamespaceDefault},
+		Spec: api.HorizontalPodAutoscalerSpec{
+			ScaleRef:                       api.ObjectReference{Kind: "ReplicationController", Name: "frontend"},
+			MinReplicas:                    1,
+			MaxReplicas:                    5,
+			TargetCPUUtilizationPercentage: 70,
+		},
+	}
+}
+
+func TestValidateHorizontalPodAutoscaler(t *testing.T) {
+	var (
+		emptyNs       = validHorizontalPodAutoscaler()
+		wrongKind     = validHorizontalPodAutoscaler()
+		noName        = validHorizontalPodAutoscaler()
+		zeroMin       = validHorizontalPodAutoscaler()
+		maxBelowMin   = validHorizontalPodAutoscaler()
+		minEqualsMax  = validHorizontalPodAutoscaler()
+		noUtilization = validHorizontalPodAutoscaler()
+	)
+	emptyNs.Namespace = ""
+	wrongKind.Spec.ScaleRef.Kind = "Pod"
+	noName.Spec.ScaleRef.Name = ""
+	zeroMin.Spec.MinReplicas = 0
+	maxBelowMin.Spec.MaxReplicas = 0
+	minEqualsMax.Spec.MinReplicas = 5
+	noUtilization.Spec.TargetCPUUtilizationPercentage = 0
+
+	tests := map[string]struct {
+		autoscaler api.HorizontalPodAutoscaler
+		valid      bool
+	}{
+		"valid":                {validHorizontalPodAutoscaler(), true},
+		"empty namespace":      {emptyNs, false},
+		"unsupported kind":     {wrongKind, false},
+		"missing name":         {noName, false},
+		"zero min replicas":    {zeroMin, false},
+		"max below min":        {maxBelowMin, false},
+		"min equals max":       {minEqualsMax, true},
+		"zero cpu utilization": {noUtilization, false},
+	}
+
+	for name, tc := range tests {
+		errs := ValidateHorizontalPodAutoscaler(&tc.autoscaler)
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("%v: Unexpected error: %v", name, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("%v: Unexpected non-error", name)
+		}
+	}
+}
+
+func validThirdPartyResource() api.ThirdPartyResource {
+	return api.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "database-cluster.example.com"},
+		Versions:   []api.APIVersion{{Name: "v1"}, {Name: "v2"}},
+	}
+}
+
+func TestValidateThirdPartyResource(t *testing.T) {
+	var (
+		noGroup        = validThirdPartyResource()
+		badKind        = validThirdPartyResource()
+		noVersions     = validThirdPartyResource()
+		badVersion     = validThirdPartyResource()
+		dupVersion     = validThirdPartyResource()
+		withNamespace  = validThirdPartyResource()
+		longGroup      = validThirdPartyResource()
+		upperCaseGroup = validThirdPartyResource()
+	)
+	noGroup.Name = "database-cluster"
+	badKind.Name = "1database.example.com"
+	noVersions.Versions = nil
+	badVersion.Versions = []api.APIVersion{{Name: "V1"}}
+	dupVersion.Versions = []api.APIVersion{{Name: "v1"}, {Name: "v1"}}
+	withNamespace.Namespace = api.NamespaceDefault
+	longGroup.Name = "database-cluster.stable.example.com"
+	upperCaseGroup.Name = "database-cluster.Example.com"
+
+	tests := map[string]struct {
+		resource api.ThirdPartyResource
+		valid    bool
+	}{
+		"valid":              {validThirdPartyResource(), true},
+		"missing group":      {noGroup, false},
+		"invalid kind":       {badKind, false},
+		"missing versions":   {noVersions, false},
+		"invalid version":    {badVersion, false},
+		"duplicate version":  {dupVersion, false},
+		"namespaced":         {withNamespace, false},
+		"multi-label group":  {longGroup, true},
+		"upper case in name": {upperCaseGroup, false},
+	}
+
+	for name, tc := range tests {
+		errs := ValidateThirdPartyResource(&tc.resource)
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("%v: Unexpected error: %v", name, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("%v: Unexpected non-error", name)
+		}
+	}
+}
+
+func validRole() api.Role {
+	return api.Role{
+		ObjectMeta: api.ObjectMeta{Name: "reader", Namespace: api.NamespaceDefault},
+		Rules: []api.PolicyRule{
+			{Verbs: []string{"get", "list"}, Resources: []string{"pods"}},
+		},
+	}
+}
+
+func TestValidateRole(t *testing.T) {
+	var (
+		noNamespace  = validRole()
+		noVerbs      = validRole()
+		noResources  = validRole()
+		namedRule    = validRole()
+		noRules      = validRole()
+		badName      = validRole()
+		clusterValid = validRole()
+	)
+	noNamespace.Namespace = ""
+	noVerbs.Rules = []api.PolicyRule{{Resources: []string{"pods"}}}
+	noResources.Rules = []api.PolicyRule{{Verbs: []string{"get"}}}
+	namedRule.Rules = []api.PolicyRule{{Verbs: []string{"*"}, Resources: []string{"secrets"}, ResourceNames: []string{"token"}}}
+	noRules.Rules = nil
+	badName.Name = "Reader"
+	clusterValid.Namespace = ""
+
+	tests := map[string]struct {
+		role  api.Role
+		valid bool
+	}{
+		"valid":            {validRole(), true},
+		"missing ns":       {noNamespace, false},
+		"missing verbs":    {noVerbs, false},
+		"missing resource": {noResources, false},
+		"resource names":   {namedRule, true},
+		"no rules":         {noRules, true},
+		"invalid name":     {badName, false},
+	}
+	for name, tc := range tests {
+		errs := ValidateRole(&tc.role)
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("%v: Unexpected error: %v", name, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("%v: Unexpected non-error", name)
+		}
+	}
+
+	clusterRole := api.ClusterRole{ObjectMeta: clusterValid.ObjectMeta, Rules: clusterValid.Rules}
+	if errs := ValidateClusterRole(&clusterRole); len(errs) > 0 {
+		t.Errorf("Unexpected error: %v", errs)
+	}
+	clusterRole.Namespace = api.NamespaceDefault
+	if errs := ValidateClusterRole(&clusterRole); len(errs) == 0 {
+		t.Errorf("Expected an error for a namespaced cluster role")
+	}
+}
+
+func validRoleBinding() api.RoleBinding {
+	return api.RoleBinding{
+		ObjectMeta: api.ObjectMeta{Name: "read-pods", Namespace: api.NamespaceDefault},
+		Subjects:   []api.Subject{{Kind: "User", Name: "alice"}, {Kind: "Group", Name: "dev"}},
+		RoleRef:    api.RoleRef{Kind: "Role", Name: "reader"},
+	}
+}
+
+func TestValidateRoleBinding(t *testing.T) {
+	var (
+		noSubjects      = validRoleBinding()
+		badSubjectKind  = validRoleBinding()
+		noSubjectName   = validRoleBinding()
+		clusterRoleRef  = validRoleBinding()
+		badRoleKind     = validRoleBinding()
+		noRoleName      = validRoleBinding()
+		invalidRoleName = validRoleBinding()
+		noNamespace     = validRoleBinding()
+	)
+	noSubjects.Subjects = nil
+	badSubjectKind.Subjects = []api.Subject{{Kind: "ServiceAccount", Name: "default"}}
+	noSubjectName.Subjects = []api.Subject{{Kind: "User"}}
+	clusterRoleRef.RoleRef.Kind = "ClusterRole"
+	badRoleKind.RoleRef.Kind = "Policy"
+	noRoleName.RoleRef.Name = ""
+	invalidRoleName.RoleRef.Name = "Reader"
+	noNamespace.Namespace = ""
+
+	tests := map[string]struct {
+		binding api.RoleBinding
+		valid   bool
+	}{
+		"valid":             {validRoleBinding(), true},
+		"no subjects":       {noSubjects, false},
+		"bad subject kind":  {badSubjectKind, false},
+		"no subject name":   {noSubjectName, false},
+		"cluster role ref":  {clusterRoleRef, true},
+		"bad role kind":     {badRoleKind, false},
+		"no role name":      {noRoleName, false},
+		"invalid role name": {invalidRoleName, false},
+		"missing ns":        {noNamespace, false},
+	}
+	for name, tc := range tests {
+		errs := ValidateRoleBinding(&tc.binding)
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("%v: Unexpected error: %v", name, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("%v: Unexpected non-error", name)
+		}
+	}
+}
+
+func TestValidateClusterRoleBinding(t *testing.T) {
+	valid := api.ClusterRoleBinding{
+		ObjectMeta: api.ObjectMeta{Name: "admins"},
+		Subjects:   []api.Subject{{Kind: "Group", Name: "admins"}},
+		RoleRef:    api.RoleRef{Kind: "ClusterRole", Name: "admin"},
+	}
+	if errs := ValidateClusterRoleBinding(&valid); len(errs) > 0 {
+		t.Errorf("Unexpected error: %v", errs)
+	}
+
+	roleRef := valid
+	roleRef.RoleRef.Kind = "Role"
+	if errs := ValidateClusterRoleBinding(&roleRef); len(errs) == 0 {
+		t.Errorf("Expected an error for a cluster role binding to a namespaced role")
+	}
+
+	namespaced := valid
+	namespaced.Namespace = api.NamespaceDefault
+	if errs := ValidateClusterRoleBinding(&namespaced); len(errs) == 0 {
+		t.Errorf("Expected an error for a namespaced cluster role binding")
+	}
+
+	update := valid
+	update.ResourceVersion = "1"
+	update.Subjects = []api.Subject{{Kind: "User", Name: "bob"}}
+	valid.ResourceVersion = "1"
+	if errs := ValidateClusterRoleBindingUpdate(&valid, &update); len(errs) > 0 {
+		t.Errorf("Unexpected error: %v", errs)
+	}
+}
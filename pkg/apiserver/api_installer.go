@@ -130,11 +130,23 @@ func (a *APIInstaller) registerResourceHandlers(path string, storage RESTStorage
 	creater, isCreater := storage.(RESTCreater)
 	lister, isLister := storage.(RESTLister)
 	getter, isGetter := storage.(RESTGetter)
+	gracefulDeleter, isGracefulDeleter := storage.(RESTGracefulDeleter)
 	deleter, isDeleter := storage.(RESTDeleter)
 	updater, isUpdater := storage.(RESTUpdater)
+	patcher, isPatcher := storage.(RESTPatcher)
 	_, isWatcher := storage.(ResourceWatcher)
 	_, isRedirector := storage.(Redirector)
 
+	if !isGracefulDeleter && isDeleter {
+		gracefulDeleter = GracefulDeleteAdapter{deleter}
+		isGracefulDeleter = true
+	}
+
+	if !isPatcher && isGetter && isUpdater {
+		patcher = GetUpdatePatchAdapter{getter, updater}
+		isPatcher = true
+	}
+
 	var ctxFn ContextFunc
 	ctxFn = func(req *restful.Request) api.Context {
 		if ctx, ok := context.Get(req.Request); ok {
@@ -160,14 +172,15 @@ func (a *APIInstaller) registerResourceHandlers(path string, storage RESTStorage
 		nameParams := append(params, nameParam)
 		namer := rootScopeNaming{scope, a.group.Linker, gpath.Join(a.prefix, itemPath)}
 
-		// Handler for standard REST verbs (GET, PUT, POST and DELETE).
+		// Handler for standard REST verbs (GET, PUT, PATCH, POST and DELETE).
 		actions = appendIf(actions, action{"LIST", resourcePath, params, namer}, isLister)
 		actions = appendIf(actions, action{"POST", resourcePath, params, namer}, isCreater)
 		actions = appendIf(actions, action{"WATCHLIST", "watch/" + resourcePath, params, namer}, allowWatchList)
 
 		actions = appendIf(actions, action{"GET", itemPath, nameParams, namer}, isGetter)
 		actions = appendIf(actions, action{"PUT", itemPath, nameParams, namer}, isUpdater)
-		actions = appendIf(actions, action{"DELETE", itemPath, nameParams, namer}, isDeleter)
+		actions = appendIf(actions, action{"PATCH", itemPath, nameParams, namer}, isPatcher)
+		actions = appendIf(actions, action{"DELETE", itemPath, nameParams, namer}, isGracefulDeleter)
 		actions = appendIf(actions, action{"WATCH", "watch/" + itemPath, nameParams, namer}, isWatcher)
 		actions = appendIf(actions, action{"REDIRECT", "redirect/" + itemPath, nameParams, namer}, isRedirector)
 		actions = appendIf(actions, action{"PROXY", "proxy/" + itemPath + "/{path:*}", nameParams, namer}, isRedirector)
@@ -176,7 +189,7 @@ func (a *APIInstaller) registerResourceHandlers(path string, storage RESTStorage
 	} else {
 		// v1beta3 format with namespace in path
 		if scope.ParamPath() {
-			// Handler for standard REST verbs (GET, PUT, POST and DELETE).
+			// Handler for standard REST verbs (GET, PUT, PATCH, POST and DELETE).
 			namespaceParam := ws.PathParameter(scope.ParamName(), scope.ParamDescription()).DataType("string")
 			namespacedPath := scope.ParamName() + "/{" + scope.ParamName() + "}/" + resource
 			namespaceParams := []*restful.Parameter{namespaceParam}
@@ -196,7 +209,8 @@ func (a *APIInstaller) registerResourceHandlers(path string, storage RESTStorage
 
 			actions = appendIf(actions, action{"GET", itemPath, nameParams, namer}, isGetter)
 			actions = appendIf(actions, action{"PUT", itemPath, nameParams, namer}, isUpdater)
-			actions = appendIf(actions, action{"DELETE", itemPath, nameParams, namer}, isDeleter)
+			actions = appendIf(actions, action{"PATCH", itemPath, nameParams, namer}, isPatcher)
+			actions = appendIf(actions, action{"DELETE", itemPath, nameParams, namer}, isGracefulDeleter)
 			actions = appendIf(actions, action{"WATCH", "watch/" + itemPath, nameParams, namer}, isWatcher)
 			actions = appendIf(actions, action{"REDIRECT", "redirect/" + itemPath, nameParams, namer}, isRedirector)
 			actions = appendIf(actions, action{"PROXY", "proxy/" + itemPath + "/{path:*}", nameParams, namer}, isRedirector)
@@ -208,7 +222,7 @@ func (a *APIInstaller) registerResourceHandlers(path string, storage RESTStorage
 			actions = appendIf(actions, action{"WATCHLIST", "watch/" + resource, params, namer}, allowWatchList)
 
 		} else {
-			// Handler for standard REST verbs (GET, PUT, POST and DELETE).
+			// Handler for standard REST verbs (GET, PUT, PATCH, POST and DELETE).
 			// v1beta1/v1beta2 format where namespace was a query parameter
 			namespaceParam := ws.QueryParameter(scope.ParamName(), scope.ParamDescription()).DataType("string")
 			namespaceParams := []*restful.Parameter{namespaceParam}
@@ -229,7 +243,8 @@ func (a *APIInstaller) registerResourceHandlers(path string, storage RESTStorage
 
 			actions = appendIf(actions, action{"GET", itemPath, nameParams, namer}, isGetter)
 			actions = appendIf(actions, action{"PUT", itemPath, nameParams, namer}, isUpdater)
-			actions = appendIf(actions, action{"DELETE", itemPath, nameParams, namer}, isDeleter)
+			actions = appendIf(actions, action{"PATCH", itemPath, nameParams, namer}, isPatcher)
+			actions = appendIf(actions, action{"DELETE", itemPath, nameParams, namer}, isGracefulDeleter)
 			actions = appendIf(actions, action{"WATCH", "watch/" + itemPath, nameParams, namer}, isWatcher)
 			actions = appendIf(actions, action{"REDIRECT", "redirect/" + itemPath, nameParams, namer}, isRedirector)
 			actions = appendIf(actions, action{"PROXY", "proxy/" + itemPath + "/{path:*}", nameParams, namer}, isRedirector)
@@ -272,6 +287,7 @@ func (a *APIInstaller) registerResourceHandlers(path string, storage RESTStorage
 				Operation("list" + kind).
 				Writes(versionedList)
 			addParams(route, action.Params)
+			addParams(route, listPageParams(ws))
 			ws.Route(route)
 		case "PUT": // Update a resource.
 			route := ws.PUT(action.Path).To(UpdateResource(updater, ctxFn, action.Namer, mapping.Codec, a.group.Typer, resource, admit)).
@@ -281,6 +297,15 @@ func (a *APIInstaller) registerResourceHandlers(path string, storage RESTStorage
 				Reads(versionedObject)
 			addParams(route, action.Params)
 			ws.Route(route)
+		case "PATCH": // Partially update a resource.
+			route := ws.PATCH(action.Path).To(PatchResource(patcher, ctxFn, action.Namer, mapping.Codec, a.group.Typer, resource, versionedObject, admit)).
+				Filter(m).
+				Doc("partially update the specified " + kind).
+				Operation("patch" + kind).
+				Reads(versionedObject)
+			route.Consumes(string(api.MergePatchType), string(api.StrategicMergePatchType))
+			addParams(route, action.Params)
+			ws.Route(route)
 		case "POST": // Create a resource.
 			route := ws.POST(action.Path).To(CreateResource(creater, ctxFn, action.Namer, mapping.Codec, a.group.Typer, resource, admit)).
 				Filter(m).
@@ -290,7 +315,7 @@ func (a *APIInstaller) registerResourceHandlers(path string, storage RESTStorage
 			addParams(route, action.Params)
 			ws.Route(route)
 		case "DELETE": // Delete a resource.
-			route := ws.DELETE(action.Path).To(DeleteResource(deleter, ctxFn, action.Namer, mapping.Codec, resource, kind, admit)).
+			route := ws.DELETE(action.Path).To(DeleteResource(gracefulDeleter, ctxFn, action.Namer, mapping.Codec, resource, kind, admit)).
 				Filter(m).
 				Doc("delete a " + kind).
 				Operation("delete" + kind)
@@ -583,6 +608,14 @@ func addProxyRoute(ws *restful.WebService, method string, prefix string, path st
 	ws.Route(proxyRoute)
 }
 
+// listPageParams describes the query parameters used to retrieve a list in chunks.
+func listPageParams(ws *restful.WebService) []*restful.Parameter {
+	return []*restful.Parameter{
+		ws.QueryParameter("limit", "maximum number of items to return; if more items exist, the list carries a continue token").DataType("integer"),
+		ws.QueryParameter("continue", "token returned by a previous limited list; retrieves the next chunk of the same list").DataType("string"),
+	}
+}
+
 func addParams(route *restful.RouteBuilder, params []*restful.Parameter) {
 	for _, param := range params {
 		route.Param(param)
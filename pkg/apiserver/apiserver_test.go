@@ -97,7 +97,7 @@ func init() {
 	// "version" version
 	// TODO: Use versioned api objects?
 	api.Scheme.AddKnownTypes(testVersion, &Simple{}, &SimpleList{},
-		&api.Status{})
+		&api.Status{}, &api.DeleteOptions{})
 
 	nsMapper := newMapper()
 	legacyNsMapper := newMapper()
@@ -219,6 +219,10 @@ type SimpleRESTStorage struct {
 	requestedResourceVersion   string
 	requestedResourceNamespace string
 
+	// These are set when List is called
+	requestedListPage   api.ListPage
+	requestedListPaging bool
+
 	// The id requested, and location to return for ResourceLocation
 	requestedResourceLocationID string
 	resourceLocation            string
@@ -231,6 +235,7 @@ type SimpleRESTStorage struct {
 
 func (storage *SimpleRESTStorage) List(ctx api.Context, label, field labels.Selector) (runtime.Object, error) {
 	storage.checkContext(ctx)
+	storage.requestedListPage, storage.requestedListPaging = api.ListPageFrom(ctx)
 	result := &SimpleList{
 		Items: storage.list,
 	}
@@ -531,6 +536,43 @@ func TestErrorList(t *testing.T) {
 	}
 }
 
+func TestListPage(t *testing.T) {
+	testCases := []struct {
+		query  string
+		page   api.ListPage
+		paging bool
+		status int
+	}{
+		{"", api.ListPage{}, false, http.StatusOK},
+		{"?limit=0", api.ListPage{}, false, http.StatusOK},
+		{"?limit=10", api.ListPage{Limit: 10}, true, http.StatusOK},
+		{"?limit=10&continue=abc", api.ListPage{Limit: 10, Continue: "abc"}, true, http.StatusOK},
+		{"?continue=abc", api.ListPage{Continue: "abc"}, true, http.StatusOK},
+		{"?limit=-1", api.ListPage{}, false, http.StatusBadRequest},
+		{"?limit=foo", api.ListPage{}, false, http.StatusBadRequest},
+	}
+	for i, testCase := range testCases {
+		storage := map[string]RESTStorage{}
+		simpleStorage := SimpleRESTStorage{}
+		storage["simple"] = &simpleStorage
+		server := httptest.NewServer(handle(storage))
+		defer server.Close()
+
+		resp, err := http.Get(server.URL + "/api/version/simple" + testCase.query)
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+		if resp.StatusCode != testCase.status {
+			t.Errorf("%d: unexpected status: %d, Expected: %d", i, resp.StatusCode, testCase.status)
+			continue
+		}
+		if simpleStorage.requestedListPaging != testCase.paging || simpleStorage.requestedListPage != testCase.page {
+			t.Errorf("%d: unexpected page: %t %#v", i, simpleStorage.requestedListPaging, simpleStorage.requestedListPage)
+		}
+	}
+}
+
 func TestNonEmptyList(t *testing.T) {
 	storage := map[string]RESTStorage{}
 	simpleStorage := SimpleRESTStorage{
@@ -783,6 +825,51 @@ func TestDelete(t *testing.T) {
 	}
 }
 
+type GracefulSimpleRESTStorage struct {
+	SimpleRESTStorage
+	deleteOptions *api.DeleteOptions
+}
+
+func (storage *GracefulSimpleRESTStorage) Delete(ctx api.Context, id string, options *api.DeleteOptions) (runtime.Object, error) {
+	storage.deleteOptions = options
+	return storage.SimpleRESTStorage.Delete(ctx, id)
+}
+
+func TestDeleteWithOptions(t *testing.T) {
+	storage := map[string]RESTStorage{}
+	simpleStorage := GracefulSimpleRESTStorage{}
+	ID := "id"
+	storage["simple"] = &simpleStorage
+	handler := handle(storage)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	grace := int64(300)
+	item := &api.DeleteOptions{
+		GracePeriodSeconds: &grace,
+	}
+	body, err := codec.Encode(item)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	client := http.Client{}
+	request, err := http.NewRequest("DELETE", server.URL+"/api/version/simple/"+ID, bytes.NewReader(body))
+	res, err := client.Do(request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("unexpected response: %#v", res)
+	}
+	if simpleStorage.deleted != ID {
+		t.Errorf("Unexpected delete: %s, expected %s", simpleStorage.deleted, ID)
+	}
+	if !api.Semantic.DeepEqual(simpleStorage.deleteOptions, item) {
+		t.Errorf("unexpected delete options: %#v", simpleStorage.deleteOptions)
+	}
+}
+
 func TestDeleteInvokesAdmissionControl(t *testing.T) {
 	storage := map[string]RESTStorage{}
 	simpleStorage := SimpleRESTStorage{}
@@ -1072,6 +1159,152 @@ func TestUpdateMissing(t *testing.T) {
 	}
 }
 
+func TestPatch(t *testing.T) {
+	for _, patchType := range []api.PatchType{api.MergePatchType, api.StrategicMergePatchType} {
+		storage := map[string]RESTStorage{}
+		ID := "id"
+		simpleStorage := SimpleRESTStorage{
+			item: Simple{
+				ObjectMeta: api.ObjectMeta{
+					Name:   ID,
+					Labels: map[string]string{"keep": "yes", "drop": "yes"},
+				},
+				Other: "foo",
+			},
+		}
+		storage["simple"] = &simpleStorage
+		selfLinker := &setTestSelfLinker{
+			t:           t,
+			expectedSet: "/api/version/simple/" + ID + "?namespace=default",
+			name:        ID,
+			namespace:   api.NamespaceDefault,
+		}
+		handler := handleLinker(storage, selfLinker)
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		body := []byte(`{"other":"bar","metadata":{"labels":{"drop":null,"add":"yes"}}}`)
+		client := http.Client{}
+		request, err := http.NewRequest("PATCH", server.URL+"/api/version/simple/"+ID, bytes.NewReader(body))
+		request.Header.Set("Content-Type", string(patchType))
+		response, err := client.Do(request)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", patchType, err)
+			continue
+		}
+		if response.StatusCode != http.StatusOK {
+			t.Errorf("%s: unexpected response %#v", patchType, response)
+		}
+
+		expected := map[string]string{"keep": "yes", "add": "yes"}
+		if simpleStorage.updated == nil || simpleStorage.updated.Other != "bar" || !reflect.DeepEqual(simpleStorage.updated.Labels, expected) {
+			t.Errorf("%s: unexpected update value %#v", patchType, simpleStorage.updated)
+		}
+		if !selfLinker.called {
+			t.Errorf("%s: never set self link", patchType)
+		}
+	}
+}
+
+func TestPatchRequiresPatchContentType(t *testing.T) {
+	storage := map[string]RESTStorage{}
+	simpleStorage := SimpleRESTStorage{item: Simple{ObjectMeta: api.ObjectMeta{Name: "id"}}}
+	storage["simple"] = &simpleStorage
+	handler := handle(storage)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := http.Client{}
+	request, err := http.NewRequest("PATCH", server.URL+"/api/version/simple/id", bytes.NewReader([]byte(`{"other":"bar"}`)))
+	request.Header.Set("Content-Type", "application/json")
+	response, err := client.Do(request)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if response.StatusCode != http.StatusUnsupportedMediaType {
+		t.Errorf("Unexpected response %#v", response)
+	}
+	if simpleStorage.updated != nil {
+		t.Errorf("Unexpected update %#v", simpleStorage.updated)
+	}
+}
+
+func TestPatchPreventsRename(t *testing.T) {
+	storage := map[string]RESTStorage{}
+	simpleStorage := SimpleRESTStorage{item: Simple{ObjectMeta: api.ObjectMeta{Name: "id"}}}
+	storage["simple"] = &simpleStorage
+	handler := handle(storage)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := http.Client{}
+	request, err := http.NewRequest("PATCH", server.URL+"/api/version/simple/id", bytes.NewReader([]byte(`{"metadata":{"name":"other"}}`)))
+	request.Header.Set("Content-Type", string(api.MergePatchType))
+	response, err := client.Do(request)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if response.StatusCode != http.StatusBadRequest {
+		t.Errorf("Unexpected response %#v", response)
+	}
+	if simpleStorage.updated != nil {
+		t.Errorf("Unexpected update %#v", simpleStorage.updated)
+	}
+}
+
+func TestPatchInvokesAdmissionControl(t *testing.T) {
+	storage := map[string]RESTStorage{}
+	simpleStorage := SimpleRESTStorage{item: Simple{ObjectMeta: api.ObjectMeta{Name: "id"}}}
+	storage["simple"] = &simpleStorage
+	handler := handleDeny(storage)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := http.Client{}
+	request, err := http.NewRequest("PATCH", server.URL+"/api/version/simple/id", bytes.NewReader([]byte(`{"other":"bar"}`)))
+	request.Header.Set("Content-Type", string(api.MergePatchType))
+	response, err := client.Do(request)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if response.StatusCode != http.StatusForbidden {
+		t.Errorf("Unexpected response %#v", response)
+	}
+}
+
+func TestPatchRetriesConflicts(t *testing.T) {
+	storage := map[string]RESTStorage{}
+	conflicts := 2
+	simpleStorage := SimpleRESTStorage{
+		item: Simple{ObjectMeta: api.ObjectMeta{Name: "id"}, Other: "foo"},
+		injectedFunction: func(obj runtime.Object) (runtime.Object, error) {
+			if conflicts > 0 {
+				conflicts--
+				return nil, apierrs.NewConflict("simple", "id", fmt.Errorf("the resource was updated"))
+			}
+			return obj, nil
+		},
+	}
+	storage["simple"] = &simpleStorage
+	handler := handle(storage)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := http.Client{}
+	request, err := http.NewRequest("PATCH", server.URL+"/api/version/simple/id", bytes.NewReader([]byte(`{"other":"bar"}`)))
+	request.Header.Set("Content-Type", string(api.MergePatchType))
+	response, err := client.Do(request)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		t.Errorf("Unexpected response %#v", response)
+	}
+	if conflicts != 0 || simpleStorage.updated == nil || simpleStorage.updated.Other != "bar" {
+		t.Errorf("Unexpected update %#v after %d remaining conflicts", simpleStorage.updated, conflicts)
+	}
+}
+
 func TestCreateNotFound(t *testing.T) {
 	handler := handle(map[string]RESTStorage{
 		"simple": &SimpleRESTStorage{
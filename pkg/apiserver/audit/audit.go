@@ -0,0 +1,203 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/apiserver"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/golang/glog"
+)
+
+// RequestIDHeader is the response header that carries the ID of the audit record
+// of a request, so that clients can correlate their requests with the audit log.
+const RequestIDHeader = "X-Request-Id"
+
+// maxRecordedBodyLength is the number of bytes of a request body recorded at most.
+const maxRecordedBodyLength = 64 * 1024
+
+// Record is the audit entry written for a single request.
+type Record struct {
+	Timestamp time.Time `json:"timestamp"`
+	RequestID string    `json:"requestID"`
+	SourceIP  string    `json:"sourceIP"`
+	User      string    `json:"user"`
+	Groups    []string  `json:"groups,omitempty"`
+	Verb      string    `json:"verb"`
+	Namespace string    `json:"namespace,omitempty"`
+	Resource  string    `json:"resource,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	URI       string    `json:"uri"`
+	Code      int       `json:"code"`
+	Latency   string    `json:"latency"`
+	// RequestBody is only recorded for the requests the Policy selects, and is
+	// cut off after maxRecordedBodyLength bytes.
+	RequestBody string `json:"requestBody,omitempty"`
+}
+
+// Policy decides which requests have their bodies recorded.
+type Policy interface {
+	RecordRequestBody(info apiserver.APIRequestInfo) bool
+}
+
+// resourcePolicy records the bodies of requests that change the listed resources.
+type resourcePolicy struct {
+	resources util.StringSet
+}
+
+// NewResourcePolicy returns a Policy that records the bodies of create, update and
+// patch requests to the given resources. Resources are matched case insensitively,
+// and "*" matches every resource.
+func NewResourcePolicy(resources ...string) Policy {
+	set := util.NewStringSet()
+	for _, resource := range resources {
+		set.Insert(strings.ToLower(resource))
+	}
+	return &resourcePolicy{set}
+}
+
+func (p *resourcePolicy) RecordRequestBody(info apiserver.APIRequestInfo) bool {
+	switch info.Verb {
+	case "create", "update", "patch":
+	default:
+		return false
+	}
+	return p.resources.Has("*") || p.resources.Has(strings.ToLower(info.Resource))
+}
+
+// WithAudit passes all requests on to handler, and writes a Record describing each
+// of them to out once handler has returned. The user is read from the request
+// context at that point, so the filter belongs outside the authenticating filter,
+// where it also records the requests that fail to authenticate.
+func WithAudit(handler http.Handler, requestContextMapper api.RequestContextMapper, resolver *apiserver.APIRequestInfoResolver, policy Policy, out io.Writer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		start := time.Now()
+		info, _ := resolver.GetAPIRequestInfo(req)
+		record := &Record{
+			Timestamp: start,
+			RequestID: string(util.NewUUID()),
+			SourceIP:  sourceIP(req),
+			Verb:      info.Verb,
+			Namespace: info.Namespace,
+			Resource:  info.Resource,
+			Name:      info.Name,
+			URI:       req.URL.RequestURI(),
+		}
+		if policy != nil && policy.RecordRequestBody(info) && req.Body != nil {
+			body, err := ioutil.ReadAll(io.LimitReader(req.Body, maxRecordedBodyLength))
+			if err != nil {
+				glog.Errorf("Unable to read the body of request %s for auditing: %v", record.RequestID, err)
+			}
+			record.RequestBody = string(body)
+			// The handler reads the recorded part again, followed by the rest.
+			req.Body = &readCloser{io.MultiReader(bytes.NewReader(body), req.Body), req.Body}
+		}
+
+		w.Header().Set(RequestIDHeader, record.RequestID)
+		recorder := &statusRecorder{w: w, status: http.StatusOK}
+		defer func() {
+			if ctx, ok := requestContextMapper.Get(req); ok {
+				if user, ok := api.UserFrom(ctx); ok {
+					record.User = user.GetName()
+					record.Groups = user.GetGroups()
+				}
+			}
+			record.Code = recorder.status
+			record.Latency = time.Since(start).String()
+			write(out, record)
+		}()
+		handler.ServeHTTP(recorder, req)
+	})
+}
+
+// write serializes record as a single line of JSON.
+func write(out io.Writer, record *Record) {
+	data, err := json.Marshal(record)
+	if err != nil {
+		glog.Errorf("Unable to encode audit record %s: %v", record.RequestID, err)
+		return
+	}
+	if _, err := out.Write(append(data, '\n')); err != nil {
+		glog.Errorf("Unable to write audit record %s: %v", record.RequestID, err)
+	}
+}
+
+// sourceIP returns the address of the client that sent req.
+func sourceIP(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
+
+// readCloser reads from one reader and closes another.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// statusRecorder remembers the status code written to an http.ResponseWriter.
+type statusRecorder struct {
+	w      http.ResponseWriter
+	status int
+}
+
+// Header implements http.ResponseWriter.
+func (r *statusRecorder) Header() http.Header {
+	return r.w.Header()
+}
+
+// Write implements http.ResponseWriter.
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	return r.w.Write(b)
+}
+
+// WriteHeader implements http.ResponseWriter.
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.w.WriteHeader(status)
+}
+
+// Flush implements http.Flusher.
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack implements http.Hijacker. A hijacked connection is recorded as having
+// switched protocols.
+func (r *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := r.w.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("the response writer does not support hijacking")
+	}
+	r.status = http.StatusSwitchingProtocols
+	return hijacker.Hijack()
+}
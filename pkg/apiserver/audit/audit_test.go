@@ -0,0 +1,217 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/apiserver"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/user"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+// newAuditedServer serves handler behind an authenticator that rejects requests
+// without an Authorization header, and audits every request outside of it.
+func newAuditedServer(t *testing.T, policy Policy, out *bytes.Buffer, handler http.HandlerFunc) *httptest.Server {
+	mapper := api.NewRequestContextMapper()
+	resolver := &apiserver.APIRequestInfoResolver{APIPrefixes: util.NewStringSet("api"), RestMapper: latest.RESTMapper}
+	authenticated := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if len(req.Header.Get("Authorization")) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		ctx, _ := mapper.Get(req)
+		mapper.Update(req, api.WithUser(ctx, &user.DefaultInfo{Name: "alice", Groups: []string{"admins"}}))
+		handler.ServeHTTP(w, req)
+	})
+	audited := WithAudit(authenticated, mapper, resolver, policy, out)
+	filter, err := api.NewRequestContextFilter(mapper, audited)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return httptest.NewServer(filter)
+}
+
+func readRecords(t *testing.T, out *bytes.Buffer) []Record {
+	records := []Record{}
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		record := Record{}
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("unexpected error decoding %q: %v", line, err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestWithAudit(t *testing.T) {
+	out := &bytes.Buffer{}
+	server := newAuditedServer(t, NewResourcePolicy(), out, func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	defer server.Close()
+
+	req, _ := http.NewRequest("DELETE", server.URL+"/api/v1beta3/namespaces/prod/replicationcontrollers/frontend", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	records := readRecords(t, out)
+	if len(records) != 1 {
+		t.Fatalf("expected one record, got %#v", records)
+	}
+	record := records[0]
+	if record.User != "alice" || len(record.Groups) != 1 || record.Groups[0] != "admins" {
+		t.Errorf("unexpected user: %#v", record)
+	}
+	if record.Verb != "delete" || record.Namespace != "prod" || record.Resource != "replicationcontrollers" || record.Name != "frontend" {
+		t.Errorf("unexpected request attributes: %#v", record)
+	}
+	if record.Code != http.StatusForbidden {
+		t.Errorf("expected code %d, got %d", http.StatusForbidden, record.Code)
+	}
+	if len(record.RequestID) == 0 || resp.Header.Get(RequestIDHeader) != record.RequestID {
+		t.Errorf("expected the request ID %q to be returned, got %q", record.RequestID, resp.Header.Get(RequestIDHeader))
+	}
+	if len(record.Latency) == 0 || record.Timestamp.IsZero() {
+		t.Errorf("expected timing information: %#v", record)
+	}
+	if len(record.RequestBody) != 0 {
+		t.Errorf("unexpected request body: %s", record.RequestBody)
+	}
+}
+
+func TestWithAuditRecordsBodies(t *testing.T) {
+	body := `{"kind":"ReplicationController","metadata":{"name":"frontend"}}`
+	out := &bytes.Buffer{}
+	server := newAuditedServer(t, NewResourcePolicy("replicationControllers"), out, func(w http.ResponseWriter, req *http.Request) {
+		data, err := ioutil.ReadAll(req.Body)
+		if err != nil || string(data) != body {
+			t.Errorf("expected the handler to receive the body, got %q %v", string(data), err)
+		}
+	})
+	defer server.Close()
+
+	for _, path := range []string{"/api/v1beta3/namespaces/prod/replicationcontrollers", "/api/v1beta3/namespaces/prod/secrets"} {
+		req, _ := http.NewRequest("POST", server.URL+path, strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer token")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	records := readRecords(t, out)
+	if len(records) != 2 {
+		t.Fatalf("expected two records, got %#v", records)
+	}
+	if records[0].Verb != "create" || records[0].Code != http.StatusOK || records[0].RequestBody != body {
+		t.Errorf("expected the controller body to be recorded: %#v", records[0])
+	}
+	if len(records[1].RequestBody) != 0 {
+		t.Errorf("unexpected secret body: %#v", records[1])
+	}
+}
+
+func TestWithAuditRecordsUnauthenticated(t *testing.T) {
+	out := &bytes.Buffer{}
+	server := newAuditedServer(t, NewResourcePolicy(), out, func(w http.ResponseWriter, req *http.Request) {
+		t.Errorf("unexpected request to the handler")
+	})
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/api/v1beta3/namespaces/prod/secrets")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	records := readRecords(t, out)
+	if len(records) != 1 {
+		t.Fatalf("expected one record, got %#v", records)
+	}
+	if records[0].Code != http.StatusUnauthorized || len(records[0].User) != 0 || records[0].Resource != "secrets" {
+		t.Errorf("expected the rejected request to be recorded: %#v", records[0])
+	}
+}
+
+func TestWithAuditLimitsRecordedBodies(t *testing.T) {
+	body := strings.Repeat("x", maxRecordedBodyLength+10)
+	out := &bytes.Buffer{}
+	server := newAuditedServer(t, NewResourcePolicy("*"), out, func(w http.ResponseWriter, req *http.Request) {
+		data, err := ioutil.ReadAll(req.Body)
+		if err != nil || string(data) != body {
+			t.Errorf("expected the handler to receive the whole body, got %d bytes: %v", len(data), err)
+		}
+	})
+	defer server.Close()
+
+	req, _ := http.NewRequest("POST", server.URL+"/api/v1beta3/namespaces/prod/pods", strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer token")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	records := readRecords(t, out)
+	if len(records) != 1 || records[0].RequestBody != body[:maxRecordedBodyLength] {
+		t.Errorf("expected the first %d bytes of the body to be recorded, got %d records", maxRecordedBodyLength, len(records))
+	}
+}
+
+func TestStatusRecorderHijackUnsupported(t *testing.T) {
+	recorder := &statusRecorder{w: httptest.NewRecorder(), status: http.StatusOK}
+	if _, _, err := recorder.Hijack(); err == nil {
+		t.Errorf("expected an error from a response writer that cannot be hijacked")
+	}
+	if recorder.status != http.StatusOK {
+		t.Errorf("expected the status to be unchanged, got %d", recorder.status)
+	}
+}
+
+func TestResourcePolicy(t *testing.T) {
+	testCases := []struct {
+		resources []string
+		info      apiserver.APIRequestInfo
+		expected  bool
+	}{
+		{[]string{"pods"}, apiserver.APIRequestInfo{Verb: "create", Resource: "pods"}, true},
+		{[]string{"pods"}, apiserver.APIRequestInfo{Verb: "update", Resource: "Pods"}, true},
+		{[]string{"pods"}, apiserver.APIRequestInfo{Verb: "get", Resource: "pods"}, false},
+		{[]string{"pods"}, apiserver.APIRequestInfo{Verb: "patch", Resource: "services"}, false},
+		{[]string{"*"}, apiserver.APIRequestInfo{Verb: "patch", Resource: "services"}, true},
+		{[]string{}, apiserver.APIRequestInfo{Verb: "create", Resource: "pods"}, false},
+	}
+	for i, testCase := range testCases {
+		if actual := NewResourcePolicy(testCase.resources...).RecordRequestBody(testCase.info); actual != testCase.expected {
+			t.Errorf("%d: expected %t, got %t", i, testCase.expected, actual)
+		}
+	}
+}
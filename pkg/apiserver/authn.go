@@ -17,20 +17,84 @@ limitations under the License.
 package apiserver
 
 import (
+	"fmt"
+	"net/url"
+	"time"
+
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authenticator"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authenticator/bearertoken"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/auth/authenticator/password/passwordfile"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/auth/authenticator/request/basicauth"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/auth/authenticator/request/union"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/auth/authenticator/token/serviceaccount"
 	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/auth/authenticator/token/tokenfile"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/auth/authenticator/token/webhook"
 )
 
-// NewAuthenticatorFromTokenFile returns an authenticator.Request or an error
-func NewAuthenticatorFromTokenFile(tokenAuthFile string) (authenticator.Request, error) {
-	var authenticator authenticator.Request
-	if len(tokenAuthFile) != 0 {
-		tokenAuthenticator, err := tokenfile.NewCSV(tokenAuthFile)
+// basicAuthFileReloadPeriod is how often the basic auth file is checked for changes.
+const basicAuthFileReloadPeriod = 10 * time.Second
+
+// AuthenticatorConfig selects the ways in which the secure port authenticates requests.
+type AuthenticatorConfig struct {
+	// BasicAuthFile lists the users, and their passwords, accepted through basic auth.
+	// It is read again whenever it changes.
+	BasicAuthFile string
+	// TokenAuthFile lists the bearer tokens that are accepted.
+	TokenAuthFile string
+	// ServiceAccountTokens accepts the API tokens of service accounts, which are
+	// looked up through KubeClient.
+	ServiceAccountTokens bool
+	KubeClient           client.Interface
+	// TokenWebhookURL is the HTTPS endpoint bearer tokens are POSTed to, and
+	// TokenWebhookCAFile the certificate authority used to verify it.
+	TokenWebhookURL    string
+	TokenWebhookCAFile string
+	// The durations for which accepted and rejected tokens are remembered.
+	TokenWebhookCacheTTL         time.Duration
+	TokenWebhookNegativeCacheTTL time.Duration
+}
+
+// NewAuthenticator returns an authenticator.Request or an error, accepting the
+// credentials selected by config.
+func NewAuthenticator(config AuthenticatorConfig) (authenticator.Request, error) {
+	authenticators := []authenticator.Request{}
+	if len(config.BasicAuthFile) != 0 {
+		passwordAuthenticator, err := passwordfile.NewCSV(config.BasicAuthFile)
+		if err != nil {
+			return nil, err
+		}
+		passwordAuthenticator.ReloadEvery(basicAuthFileReloadPeriod)
+		authenticators = append(authenticators, basicauth.New(passwordAuthenticator))
+	}
+	if len(config.TokenAuthFile) != 0 {
+		tokenAuthenticator, err := tokenfile.NewCSV(config.TokenAuthFile)
 		if err != nil {
 			return nil, err
 		}
-		authenticator = bearertoken.New(tokenAuthenticator)
+		authenticators = append(authenticators, bearertoken.New(tokenAuthenticator))
+	}
+	if config.ServiceAccountTokens {
+		authenticators = append(authenticators, bearertoken.New(serviceaccount.NewTokenAuthenticator(config.KubeClient)))
+	}
+	if len(config.TokenWebhookURL) != 0 {
+		if u, err := url.Parse(config.TokenWebhookURL); err != nil || u.Scheme != "https" {
+			return nil, fmt.Errorf("token webhook %q must be an https URL", config.TokenWebhookURL)
+		}
+		transport, err := client.TransportFor(&client.Config{CAFile: config.TokenWebhookCAFile})
+		if err != nil {
+			return nil, err
+		}
+		webhookAuthenticator := webhook.New(config.TokenWebhookURL, transport, config.TokenWebhookCacheTTL, config.TokenWebhookNegativeCacheTTL)
+		authenticators = append(authenticators, bearertoken.New(webhookAuthenticator))
+	}
+
+	switch len(authenticators) {
+	case 0:
+		return nil, nil
+	case 1:
+		return authenticators[0], nil
+	default:
+		return union.New(authenticators...), nil
 	}
-	return authenticator, nil
 }
@@ -18,9 +18,15 @@ package apiserver
 
 import (
 	"errors"
+	"fmt"
+	"net/url"
+	"time"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authorizer"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authorizer/abac"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authorizer/rbac"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authorizer/webhook"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
 )
 
 // Attributes implements authorizer.Attributes interface.
@@ -58,25 +64,61 @@ const (
 	ModeAlwaysAllow string = "AlwaysAllow"
 	ModeAlwaysDeny  string = "AlwaysDeny"
 	ModeABAC        string = "ABAC"
+	ModeWebhook     string = "Webhook"
+	ModeRBAC        string = "RBAC"
 )
 
 // Keep this list in sync with constant list above.
-var AuthorizationModeChoices = []string{ModeAlwaysAllow, ModeAlwaysDeny, ModeABAC}
+var AuthorizationModeChoices = []string{ModeAlwaysAllow, ModeAlwaysDeny, ModeABAC, ModeWebhook, ModeRBAC}
+
+// AuthorizationConfig selects and configures the authorizer of the secure port.
+type AuthorizationConfig struct {
+	// Mode should be one of AuthorizationModeChoices.
+	Mode string
+	// PolicyFile holds the policy of mode ABAC.
+	PolicyFile string
+	// WebhookURL is the https endpoint mode Webhook sends the attributes of requests
+	// to, and WebhookCAFile the certificate authority used to verify it.
+	WebhookURL    string
+	WebhookCAFile string
+	// The durations for which allowed and denied requests are remembered by mode Webhook.
+	WebhookAllowTTL time.Duration
+	WebhookDenyTTL  time.Duration
+	// KubeClient is used by mode RBAC to watch roles and role bindings.
+	KubeClient client.Interface
+}
 
 // NewAuthorizerFromAuthorizationConfig returns the right sort of authorizer.Authorizer
-// based on the authorizationMode xor an error.  authorizationMode should be one of AuthorizationModeChoices.
-func NewAuthorizerFromAuthorizationConfig(authorizationMode string, authorizationPolicyFile string) (authorizer.Authorizer, error) {
-	if authorizationPolicyFile != "" && authorizationMode != "ABAC" {
+// based on config.Mode xor an error.
+func NewAuthorizerFromAuthorizationConfig(config AuthorizationConfig) (authorizer.Authorizer, error) {
+	if config.PolicyFile != "" && config.Mode != ModeABAC {
 		return nil, errors.New("Cannot specify --authorization_policy_file without mode ABAC")
 	}
+	if config.WebhookURL != "" && config.Mode != ModeWebhook {
+		return nil, errors.New("Cannot specify --authorization_webhook_url without mode Webhook")
+	}
 	// Keep cases in sync with constant list above.
-	switch authorizationMode {
+	switch config.Mode {
 	case ModeAlwaysAllow:
 		return NewAlwaysAllowAuthorizer(), nil
 	case ModeAlwaysDeny:
 		return NewAlwaysDenyAuthorizer(), nil
 	case ModeABAC:
-		return abac.NewFromFile(authorizationPolicyFile)
+		return abac.NewFromFile(config.PolicyFile)
+	case ModeWebhook:
+		if u, err := url.Parse(config.WebhookURL); err != nil || u.Scheme != "https" {
+			return nil, fmt.Errorf("authorization webhook %q must be an https URL", config.WebhookURL)
+		}
+		transport, err := client.TransportFor(&client.Config{CAFile: config.WebhookCAFile})
+		if err != nil {
+			return nil, err
+		}
+		return webhook.New(config.WebhookURL, transport, config.WebhookAllowTTL, config.WebhookDenyTTL), nil
+	case ModeRBAC:
+		if config.KubeClient == nil {
+			return nil, errors.New("Mode RBAC requires a client to watch roles and role bindings")
+		}
+		return rbac.New(config.KubeClient), nil
 	default:
 		return nil, errors.New("Unknown authorization mode")
 	}
@@ -128,7 +128,7 @@ func CORS(handler http.Handler, allowedOriginPatterns []*regexp.Regexp, allowedM
 				w.Header().Set("Access-Control-Allow-Origin", origin)
 				// Set defaults for methods and headers if nothing was passed
 				if allowedMethods == nil {
-					allowedMethods = []string{"POST", "GET", "OPTIONS", "PUT", "DELETE"}
+					allowedMethods = []string{"POST", "GET", "OPTIONS", "PUT", "PATCH", "DELETE"}
 				}
 				if allowedHeaders == nil {
 					allowedHeaders = []string{"Content-Type", "Content-Length", "Accept-Encoding", "X-CSRF-Token", "Authorization", "X-Requested-With", "If-Modified-Since"}
@@ -160,8 +160,8 @@ type requestAttributeGetter struct {
 }
 
 // NewAttributeGetter returns an object which implements the RequestAttributeGetter interface.
-func NewRequestAttributeGetter(requestContextMapper api.RequestContextMapper, restMapper meta.RESTMapper, apiRoots ...string) RequestAttributeGetter {
-	return &requestAttributeGetter{requestContextMapper, &APIRequestInfoResolver{util.NewStringSet(apiRoots...), restMapper}}
+func NewRequestAttributeGetter(requestContextMapper api.RequestContextMapper, apiRequestInfoResolver *APIRequestInfoResolver) RequestAttributeGetter {
+	return &requestAttributeGetter{requestContextMapper, apiRequestInfoResolver}
 }
 
 func (r *requestAttributeGetter) GetAttribs(req *http.Request) authorizer.Attributes {
@@ -188,6 +188,10 @@ func (r *requestAttributeGetter) GetAttribs(req *http.Request) authorizer.Attrib
 	// in empty (does not understand defaulting rules.)
 	attribs.Namespace = apiRequestInfo.Namespace
 
+	// The verb and name are only known for requests to the REST object store.
+	attribs.Verb = apiRequestInfo.Verb
+	attribs.Name = apiRequestInfo.Name
+
 	return &attribs
 }
 
@@ -234,8 +238,11 @@ func (info APIRequestInfo) URLPath() string {
 }
 
 type APIRequestInfoResolver struct {
+	// APIPrefixes are the paths, of one or more segments, that are followed by a version.
 	APIPrefixes util.StringSet
-	RestMapper  meta.RESTMapper
+	// APIGroupPrefixes are the paths that are followed by an API group and then a version.
+	APIGroupPrefixes util.StringSet
+	RestMapper       meta.RESTMapper
 }
 
 // GetAPIRequestInfo returns the information from the http request.  If error is not nil, APIRequestInfo holds the information as best it is known before the failure
@@ -260,7 +267,7 @@ type APIRequestInfoResolver struct {
 //
 // Fully qualified paths for above:
 // /api/{version}/*
-// /api/{version}/*
+// /{groupPrefix}/{group}/{version}/*
 func (r *APIRequestInfoResolver) GetAPIRequestInfo(req *http.Request) (APIRequestInfo, error) {
 	requestInfo := APIRequestInfo{
 		Raw: splitPath(req.URL.Path),
@@ -271,18 +278,27 @@ func (r *APIRequestInfoResolver) GetAPIRequestInfo(req *http.Request) (APIReques
 		return requestInfo, fmt.Errorf("Unable to determine kind and namespace from an empty URL path")
 	}
 
+	// the number of parts before the version, if the path starts with a known prefix
+	prefixLen := 0
 	for _, currPrefix := range r.APIPrefixes.List() {
-		// handle input of form /api/{version}/* by adjusting special paths
-		if currentParts[0] == currPrefix {
-			if len(currentParts) > 1 {
-				requestInfo.APIVersion = currentParts[1]
-			}
+		if prefixParts := splitPath(currPrefix); hasPathPrefix(currentParts, prefixParts) {
+			prefixLen = len(prefixParts)
+		}
+	}
+	if r.APIGroupPrefixes.Has(currentParts[0]) {
+		// skip the group segment as well
+		prefixLen = 2
+	}
+	// handle input of form /api/{version}/* by adjusting special paths
+	if prefixLen > 0 {
+		if len(currentParts) > prefixLen {
+			requestInfo.APIVersion = currentParts[prefixLen]
+		}
 
-			if len(currentParts) > 2 {
-				currentParts = currentParts[2:]
-			} else {
-				return requestInfo, fmt.Errorf("Unable to determine kind and namespace from url, %v", req.URL)
-			}
+		if len(currentParts) > prefixLen+1 {
+			currentParts = currentParts[prefixLen+1:]
+		} else {
+			return requestInfo, fmt.Errorf("Unable to determine kind and namespace from url, %v", req.URL)
 		}
 	}
 
@@ -303,6 +319,8 @@ func (r *APIRequestInfoResolver) GetAPIRequestInfo(req *http.Request) (APIReques
 			requestInfo.Verb = "get"
 		case "PUT":
 			requestInfo.Verb = "update"
+		case "PATCH":
+			requestInfo.Verb = "patch"
 		case "DELETE":
 			requestInfo.Verb = "delete"
 		}
@@ -359,3 +377,16 @@ func (r *APIRequestInfoResolver) GetAPIRequestInfo(req *http.Request) (APIReques
 
 	return requestInfo, nil
 }
+
+// hasPathPrefix returns true if parts begins with all of prefix.
+func hasPathPrefix(parts, prefix []string) bool {
+	if len(prefix) == 0 || len(parts) < len(prefix) {
+		return false
+	}
+	for i := range prefix {
+		if parts[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -89,6 +89,7 @@ func TestGetAPIRequestInfo(t *testing.T) {
 		{"POST", "/pods", "create", "", api.NamespaceDefault, "pods", "Pod", "", []string{"pods"}},
 		{"GET", "/pods/foo", "get", "", api.NamespaceDefault, "pods", "Pod", "foo", []string{"pods", "foo"}},
 		{"GET", "/pods/foo?namespace=other", "get", "", "other", "pods", "Pod", "foo", []string{"pods", "foo"}},
+		{"PATCH", "/pods/foo", "patch", "", api.NamespaceDefault, "pods", "Pod", "foo", []string{"pods", "foo"}},
 		{"GET", "/pods?namespace=other", "list", "", "other", "pods", "Pod", "", []string{"pods"}},
 
 		// special verbs
@@ -111,9 +112,19 @@ func TestGetAPIRequestInfo(t *testing.T) {
 		{"GET", "/api/v1beta1/redirect/pods/foo", "redirect", "v1beta1", api.NamespaceDefault, "pods", "Pod", "foo", []string{"pods", "foo"}},
 		{"GET", "/api/v1beta1/watch/pods", "watch", "v1beta1", api.NamespaceAll, "pods", "Pod", "", []string{"pods"}},
 		{"GET", "/api/v1beta1/watch/namespaces/other/pods", "watch", "v1beta1", "other", "pods", "Pod", "", []string{"pods"}},
+		{"GET", "/other/api/v1beta1/pods", "list", "v1beta1", api.NamespaceAll, "pods", "Pod", "", []string{"pods"}},
+
+		// grouped paths
+		{"GET", "/thirdparty/example.com/v1/namespaces/other/foos", "list", "v1", "other", "foos", "", "", []string{"foos"}},
+		{"DELETE", "/thirdparty/example.com/v1/namespaces/other/foos/bar", "delete", "v1", "other", "foos", "", "bar", []string{"foos", "bar"}},
+		{"GET", "/thirdparty/example.com/v1/watch/namespaces/other/foos", "watch", "v1", "other", "foos", "", "", []string{"foos"}},
 	}
 
-	apiRequestInfoResolver := &APIRequestInfoResolver{util.NewStringSet("api"), latest.RESTMapper}
+	apiRequestInfoResolver := &APIRequestInfoResolver{
+		APIPrefixes:      util.NewStringSet("api", "other/api"),
+		APIGroupPrefixes: util.NewStringSet("thirdparty"),
+		RestMapper:       latest.RESTMapper,
+	}
 
 	for _, successCase := range successCases {
 		req, _ := http.NewRequest(successCase.method, successCase.url, nil)
@@ -152,6 +163,8 @@ func TestGetAPIRequestInfo(t *testing.T) {
 		"no resource path":            "/",
 		"just apiversion":             "/api/v1beta1/",
 		"apiversion with no resource": "/api/v1beta1/",
+		"group with no version":       "/thirdparty/example.com",
+		"group with no resource":      "/thirdparty/example.com/v1",
 	}
 	for k, v := range errorCases {
 		req, err := http.NewRequest("GET", v, nil)
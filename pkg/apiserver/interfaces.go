@@ -18,6 +18,8 @@ package apiserver
 
 import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
@@ -57,6 +59,27 @@ type RESTDeleter interface {
 	Delete(ctx api.Context, id string) (runtime.Object, error)
 }
 
+type RESTGracefulDeleter interface {
+	// Delete finds a resource in the storage and deletes it.
+	// If options are provided, the resource will attempt to honor them or return an invalid
+	// request error.
+	// Although it can return an arbitrary error value, IsNotFound(err) is true for the
+	// returned error value err when the specified resource is not found.
+	// Delete *may* return the object that was deleted, or a status object indicating additional
+	// information about deletion.
+	Delete(ctx api.Context, id string, options *api.DeleteOptions) (runtime.Object, error)
+}
+
+// GracefulDeleteAdapter adapts the RESTDeleter interface to RESTGracefulDeleter
+type GracefulDeleteAdapter struct {
+	RESTDeleter
+}
+
+// Delete implements RESTGracefulDeleter in terms of RESTDeleter
+func (w GracefulDeleteAdapter) Delete(ctx api.Context, id string, options *api.DeleteOptions) (runtime.Object, error) {
+	return w.RESTDeleter.Delete(ctx, id)
+}
+
 type RESTCreater interface {
 	// New returns an empty object that can be used with Create after request data has been put into it.
 	// This object must be a pointer type for use with Codec.DecodeInto([]byte, runtime.Object)
@@ -77,6 +100,49 @@ type RESTUpdater interface {
 	Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool, error)
 }
 
+// RESTPatcher is implemented by storage that can apply a change to the current state of an
+// object without racing against other writers.
+type RESTPatcher interface {
+	// New returns an empty object that a patched object can be decoded into.
+	// This object must be a pointer type for use with Codec.DecodeInto([]byte, runtime.Object)
+	New() runtime.Object
+
+	// Patch passes the current state of the named resource to patchFn and stores the object it
+	// returns. patchFn may be called again with a newer state if the resource changes before the
+	// result is stored.
+	Patch(ctx api.Context, name string, patchFn rest.PatchFunc) (runtime.Object, error)
+}
+
+// maxPatchUpdateRetries bounds how often GetUpdatePatchAdapter retries an update that conflicted
+// with another writer.
+const maxPatchUpdateRetries = 5
+
+// GetUpdatePatchAdapter adapts storage that can only get and update resources to RESTPatcher.
+type GetUpdatePatchAdapter struct {
+	RESTGetter
+	RESTUpdater
+}
+
+// Patch implements RESTPatcher with a read-modify-write cycle, patching the latest state
+// again when the update conflicts with another writer.
+func (w GetUpdatePatchAdapter) Patch(ctx api.Context, name string, patchFn rest.PatchFunc) (runtime.Object, error) {
+	for i := 0; ; i++ {
+		existing, err := w.Get(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		obj, err := patchFn(existing)
+		if err != nil {
+			return nil, err
+		}
+		result, _, err := w.Update(ctx, obj)
+		if errors.IsConflict(err) && i < maxPatchUpdateRetries {
+			continue
+		}
+		return result, err
+	}
+}
+
 // RESTResult indicates the result of a REST transformation.
 type RESTResult struct {
 	// The result of this operation. May be nil if the operation has no meaningful
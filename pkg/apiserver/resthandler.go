@@ -21,6 +21,8 @@ import (
 	"net/http"
 	"net/url"
 	gpath "path"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/admission"
@@ -28,8 +30,10 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/strategicpatch"
 
 	"github.com/emicklei/go-restful"
+	"github.com/evanphx/json-patch"
 	"github.com/golang/glog"
 )
 
@@ -97,6 +101,20 @@ func parseSelectorQueryParams(query url.Values, version, apiResource string) (la
 	return label, field, nil
 }
 
+// parseListPageQueryParams reads the limit and continue parameters of a list request. ok is
+// false if the client did not ask for a paged list.
+func parseListPageQueryParams(query url.Values) (page api.ListPage, ok bool, err error) {
+	if s := query.Get("limit"); len(s) > 0 {
+		limit, err := strconv.Atoi(s)
+		if err != nil || limit < 0 {
+			return api.ListPage{}, false, errors.NewBadRequest(fmt.Sprintf("limit must be a non-negative integer: %q", s))
+		}
+		page.Limit = limit
+	}
+	page.Continue = query.Get("continue")
+	return page, page.Limit > 0 || len(page.Continue) > 0, nil
+}
+
 // ListResource returns a function that handles retrieving a list of resources from a RESTStorage object.
 func ListResource(r RESTLister, ctxFn ContextFunc, namer ScopeNamer, codec runtime.Codec, version, apiResource string) restful.RouteFunction {
 	return func(req *restful.Request, res *restful.Response) {
@@ -110,11 +128,20 @@ func ListResource(r RESTLister, ctxFn ContextFunc, namer ScopeNamer, codec runti
 		ctx := ctxFn(req)
 		ctx = api.WithNamespace(ctx, namespace)
 
-		label, field, err := parseSelectorQueryParams(req.Request.URL.Query(), version, apiResource)
+		query := req.Request.URL.Query()
+		label, field, err := parseSelectorQueryParams(query, version, apiResource)
 		if err != nil {
 			errorJSON(err, codec, w)
 			return
 		}
+		page, paged, err := parseListPageQueryParams(query)
+		if err != nil {
+			errorJSON(err, codec, w)
+			return
+		}
+		if paged {
+			ctx = api.WithListPage(ctx, page)
+		}
 
 		result, err := r.List(ctx, label, field)
 		if err != nil {
@@ -258,8 +285,93 @@ func UpdateResource(r RESTUpdater, ctxFn ContextFunc, namer ScopeNamer, codec ru
 	}
 }
 
+// PatchResource returns a function that will handle a resource patch. The patch is applied to the
+// current state of the resource inside the storage update, so concurrent writers are not lost.
+func PatchResource(r RESTPatcher, ctxFn ContextFunc, namer ScopeNamer, codec runtime.Codec, typer runtime.ObjectTyper, resource string, versionedObj interface{}, admit admission.Interface) restful.RouteFunction {
+	return func(req *restful.Request, res *restful.Response) {
+		w := res.ResponseWriter
+
+		// TODO: we either want to remove timeout or document it (if we document, move timeout out of this function and declare it in api_installer)
+		timeout := parseTimeout(req.Request.URL.Query().Get("timeout"))
+
+		namespace, name, err := namer.Name(req)
+		if err != nil {
+			notFound(w, req.Request)
+			return
+		}
+		ctx := ctxFn(req)
+		ctx = api.WithNamespace(ctx, namespace)
+
+		// strip parameters such as the charset from the content type
+		patchType := api.PatchType(strings.TrimSpace(strings.SplitN(req.HeaderParameter("Content-Type"), ";", 2)[0]))
+
+		body, err := readBody(req.Request)
+		if err != nil {
+			errorJSON(err, codec, w)
+			return
+		}
+
+		result, err := finishRequest(timeout, func() (runtime.Object, error) {
+			return r.Patch(ctx, name, func(existing runtime.Object) (runtime.Object, error) {
+				original, err := codec.Encode(existing)
+				if err != nil {
+					return nil, err
+				}
+				patched, err := applyPatch(patchType, original, body, versionedObj)
+				if err != nil {
+					return nil, errors.NewBadRequest(err.Error())
+				}
+
+				obj := r.New()
+				if err := codec.DecodeInto(patched, obj); err != nil {
+					return nil, transformDecodeError(typer, err, obj, patched)
+				}
+
+				// a patch may not move the object
+				if objNamespace, objName, err := namer.ObjectName(obj); err == nil {
+					if objName != name {
+						return nil, errors.NewBadRequest("the name of the object may not be changed by a patch")
+					}
+					if len(namespace) > 0 && len(objNamespace) > 0 && objNamespace != namespace {
+						return nil, errors.NewBadRequest("the namespace of the object may not be changed by a patch")
+					}
+				}
+
+				if err := admit.Admit(admission.NewAttributesRecord(obj, namespace, resource, "UPDATE")); err != nil {
+					return nil, err
+				}
+				return obj, nil
+			})
+		})
+		if err != nil {
+			errorJSON(err, codec, w)
+			return
+		}
+
+		if err := setSelfLink(result, req, namer); err != nil {
+			errorJSON(err, codec, w)
+			return
+		}
+
+		writeJSON(http.StatusOK, codec, result, w)
+	}
+}
+
+// applyPatch applies a patch of the given type to the JSON encoding of an object. versionedObj is
+// an instance of the versioned type the JSON describes.
+func applyPatch(patchType api.PatchType, original, patch []byte, versionedObj interface{}) ([]byte, error) {
+	switch patchType {
+	case api.MergePatchType:
+		return jsonpatch.MergePatch(original, patch)
+	case api.StrategicMergePatchType:
+		return strategicpatch.StrategicMergePatch(original, patch, versionedObj)
+	default:
+		return nil, fmt.Errorf("unsupported patch type %q", patchType)
+	}
+}
+
 // DeleteResource returns a function that will handle a resource deletion
-func DeleteResource(r RESTDeleter, ctxFn ContextFunc, namer ScopeNamer, codec runtime.Codec, resource, kind string, admit admission.Interface) restful.RouteFunction {
+func DeleteResource(r RESTGracefulDeleter, ctxFn ContextFunc, namer ScopeNamer, codec runtime.Codec, resource, kind string, admit admission.Interface) restful.RouteFunction {
 	return func(req *restful.Request, res *restful.Response) {
 		w := res.ResponseWriter
 
@@ -276,6 +388,20 @@ func DeleteResource(r RESTDeleter, ctxFn ContextFunc, namer ScopeNamer, codec ru
 			ctx = api.WithNamespace(ctx, namespace)
 		}
 
+		var options *api.DeleteOptions
+		body, err := readBody(req.Request)
+		if err != nil {
+			errorJSON(err, codec, w)
+			return
+		}
+		if len(body) > 0 {
+			options = &api.DeleteOptions{}
+			if err := codec.DecodeInto(body, options); err != nil {
+				errorJSON(err, codec, w)
+				return
+			}
+		}
+
 		err = admit.Admit(admission.NewAttributesRecord(nil, namespace, resource, "DELETE"))
 		if err != nil {
 			errorJSON(err, codec, w)
@@ -283,7 +409,7 @@ func DeleteResource(r RESTDeleter, ctxFn ContextFunc, namer ScopeNamer, codec ru
 		}
 
 		result, err := finishRequest(timeout, func() (runtime.Object, error) {
-			return r.Delete(ctx, name)
+			return r.Delete(ctx, name, options)
 		})
 		if err != nil {
 			errorJSON(err, codec, w)
@@ -41,6 +41,13 @@ type Attributes interface {
 
 	// The kind of object, if a request is for a REST object.
 	GetResource() string
+
+	// The verb of the request, if a request is for a REST object, for example
+	// get, list, watch, create, update or delete.
+	GetVerb() string
+
+	// The name of the object, if a request is for a single named REST object.
+	GetName() string
 }
 
 // Authorizer makes an authorization decision based on information gained by making
@@ -56,6 +63,8 @@ type AttributesRecord struct {
 	ReadOnly  bool
 	Namespace string
 	Resource  string
+	Verb      string
+	Name      string
 }
 
 func (a AttributesRecord) GetUserName() string {
@@ -77,3 +86,11 @@ func (a AttributesRecord) GetNamespace() string {
 func (a AttributesRecord) GetResource() string {
 	return a.Resource
 }
+
+func (a AttributesRecord) GetVerb() string {
+	return a.Verb
+}
+
+func (a AttributesRecord) GetName() string {
+	return a.Name
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authorizer"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+)
+
+// readOnlyVerbs are the verbs of requests that have no side effects.
+var readOnlyVerbs = map[string]bool{"get": true, "list": true, "watch": true}
+
+// Getter retrieves objects by name. The role and cluster role storages implement it.
+type Getter interface {
+	Get(ctx api.Context, name string) (runtime.Object, error)
+}
+
+// ConfirmNoEscalation returns a forbidden error for the named object of the given kind,
+// unless the user of ctx is already allowed by a to do everything the rules allow in
+// namespace. An empty namespace stands for every namespace. Requests without a user, which
+// only arrive through the insecure port, are trusted.
+func ConfirmNoEscalation(ctx api.Context, a authorizer.Authorizer, kind, name, namespace string, rules []api.PolicyRule) error {
+	user, ok := api.UserFrom(ctx)
+	if !ok || a == nil {
+		return nil
+	}
+	for _, rule := range rules {
+		names := rule.ResourceNames
+		if len(names) == 0 {
+			// a rule without names covers the whole resource, so the user must too
+			names = []string{""}
+		}
+		for _, verb := range rule.Verbs {
+			for _, resource := range rule.Resources {
+				for _, resourceName := range names {
+					attributes := authorizer.AttributesRecord{
+						User:      user,
+						ReadOnly:  readOnlyVerbs[verb],
+						Namespace: namespace,
+						Resource:  resource,
+						Verb:      verb,
+						Name:      resourceName,
+					}
+					if err := a.Authorize(attributes); err != nil {
+						return errors.NewForbidden(kind, name, fmt.Errorf("user %q cannot grant %s on %s it is not allowed itself: %v", user.GetName(), verb, resource, err))
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// RoleRefRules returns the rules of the role that ref refers to from a binding in namespace.
+// An empty namespace only allows cluster roles.
+func RoleRefRules(ctx api.Context, roles, clusterRoles Getter, namespace string, ref api.RoleRef) ([]api.PolicyRule, error) {
+	switch {
+	case ref.Kind == "ClusterRole":
+		obj, err := clusterRoles.Get(api.WithNamespace(ctx, api.NamespaceNone), ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		return obj.(*api.ClusterRole).Rules, nil
+	case ref.Kind == "Role" && len(namespace) != 0:
+		obj, err := roles.Get(api.WithNamespace(ctx, namespace), ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		return obj.(*api.Role).Rules, nil
+	}
+	return nil, fmt.Errorf("cannot refer to a %s %q", ref.Kind, ref.Name)
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/user"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+)
+
+type fakeGetter map[string]runtime.Object
+
+func (g fakeGetter) Get(ctx api.Context, name string) (runtime.Object, error) {
+	obj, ok := g[api.NamespaceValue(ctx)+"/"+name]
+	if !ok {
+		return nil, errors.NewNotFound("role", name)
+	}
+	return obj, nil
+}
+
+func TestConfirmNoEscalation(t *testing.T) {
+	a := newTestAuthorizer(t,
+		&api.Role{
+			ObjectMeta: api.ObjectMeta{Namespace: "prod", Name: "web-config"},
+			Rules: []api.PolicyRule{
+				{Verbs: []string{"get", "list"}, Resources: []string{"pods"}},
+				{Verbs: []string{"get", "update"}, Resources: []string{"secrets"}, ResourceNames: []string{"web"}},
+			},
+		},
+		&api.RoleBinding{
+			ObjectMeta: api.ObjectMeta{Namespace: "prod", Name: "web-config"},
+			Subjects:   []api.Subject{{Kind: "User", Name: "alice"}},
+			RoleRef:    api.RoleRef{Kind: "Role", Name: "web-config"},
+		},
+	)
+	ctx := api.WithUser(api.NewContext(), &user.DefaultInfo{Name: "alice"})
+
+	testCases := map[string]struct {
+		ctx     api.Context
+		ns      string
+		rules   []api.PolicyRule
+		allowed bool
+	}{
+		"subset":          {ctx, "prod", []api.PolicyRule{{Verbs: []string{"get"}, Resources: []string{"pods"}}}, true},
+		"named subset":    {ctx, "prod", []api.PolicyRule{{Verbs: []string{"update"}, Resources: []string{"secrets"}, ResourceNames: []string{"web"}}}, true},
+		"no rules":        {ctx, "prod", nil, true},
+		"other verb":      {ctx, "prod", []api.PolicyRule{{Verbs: []string{"get", "delete"}, Resources: []string{"pods"}}}, false},
+		"wildcard verb":   {ctx, "prod", []api.PolicyRule{{Verbs: []string{"*"}, Resources: []string{"pods"}}}, false},
+		"other name":      {ctx, "prod", []api.PolicyRule{{Verbs: []string{"get"}, Resources: []string{"secrets"}, ResourceNames: []string{"web", "db"}}}, false},
+		"all names":       {ctx, "prod", []api.PolicyRule{{Verbs: []string{"get"}, Resources: []string{"secrets"}}}, false},
+		"other namespace": {ctx, "dev", []api.PolicyRule{{Verbs: []string{"get"}, Resources: []string{"pods"}}}, false},
+		"every namespace": {ctx, "", []api.PolicyRule{{Verbs: []string{"get"}, Resources: []string{"pods"}}}, false},
+		"unauthenticated": {api.NewContext(), "prod", []api.PolicyRule{{Verbs: []string{"*"}, Resources: []string{"*"}}}, true},
+		"unknown user":    {api.WithUser(api.NewContext(), &user.DefaultInfo{Name: "mallory"}), "prod", []api.PolicyRule{{Verbs: []string{"get"}, Resources: []string{"pods"}}}, false},
+	}
+	for name, tc := range testCases {
+		err := ConfirmNoEscalation(tc.ctx, a, "role", "test", tc.ns, tc.rules)
+		if tc.allowed && err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+		if !tc.allowed && !errors.IsForbidden(err) {
+			t.Errorf("%s: expected forbidden error, got %v", name, err)
+		}
+	}
+}
+
+func TestRoleRefRules(t *testing.T) {
+	roles := fakeGetter{"prod/web-config": &api.Role{Rules: []api.PolicyRule{{Verbs: []string{"get"}, Resources: []string{"secrets"}}}}}
+	clusterRoles := fakeGetter{"/admin": &api.ClusterRole{Rules: []api.PolicyRule{{Verbs: []string{"*"}, Resources: []string{"*"}}}}}
+	ctx := api.NewContext()
+
+	testCases := map[string]struct {
+		ns       string
+		ref      api.RoleRef
+		resource string
+	}{
+		"role":                    {"prod", api.RoleRef{Kind: "Role", Name: "web-config"}, "secrets"},
+		"cluster role":            {"prod", api.RoleRef{Kind: "ClusterRole", Name: "admin"}, "*"},
+		"cluster role globally":   {"", api.RoleRef{Kind: "ClusterRole", Name: "admin"}, "*"},
+		"role globally":           {"", api.RoleRef{Kind: "Role", Name: "web-config"}, ""},
+		"role in other namespace": {"dev", api.RoleRef{Kind: "Role", Name: "web-config"}, ""},
+		"missing cluster role":    {"prod", api.RoleRef{Kind: "ClusterRole", Name: "missing"}, ""},
+		"unknown kind":            {"prod", api.RoleRef{Kind: "Group", Name: "admin"}, ""},
+	}
+	for name, tc := range testCases {
+		rules, err := RoleRefRules(ctx, roles, clusterRoles, tc.ns, tc.ref)
+		if len(tc.resource) == 0 {
+			if err == nil {
+				t.Errorf("%s: expected error, got %#v", name, rules)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if len(rules) != 1 || rules[0].Resources[0] != tc.resource {
+			t.Errorf("%s: unexpected rules: %#v", name, rules)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rbac authorizes requests against the Role, ClusterRole, RoleBinding
+// and ClusterRoleBinding objects stored in the apiserver.
+package rbac
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authorizer"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// namespaceIndex is the name of the index of role bindings by their namespace.
+const namespaceIndex = "namespace"
+
+// Authorizer allows a request when a ClusterRoleBinding, or a RoleBinding in the
+// namespace of the request, binds the user or one of its groups to a role with a
+// rule matching the verb, resource and name of the request.
+type Authorizer struct {
+	roles               cache.Store
+	roleBindings        cache.Indexer
+	clusterRoles        cache.Store
+	clusterRoleBindings cache.Store
+}
+
+// New returns an Authorizer that watches roles and role bindings in all namespaces
+// through the given client.
+func New(c client.Interface) *Authorizer {
+	roles := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	roleBindings := NewRoleBindingIndexer()
+	clusterRoles := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	clusterRoleBindings := cache.NewStore(cache.MetaNamespaceKeyFunc)
+
+	cache.NewReflector(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return c.Roles(api.NamespaceAll).List(labels.Everything(), labels.Everything())
+			},
+			WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+				return c.Roles(api.NamespaceAll).Watch(labels.Everything(), labels.Everything(), resourceVersion)
+			},
+		},
+		&api.Role{},
+		roles,
+		0,
+	).Run()
+	cache.NewReflector(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return c.RoleBindings(api.NamespaceAll).List(labels.Everything(), labels.Everything())
+			},
+			WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+				return c.RoleBindings(api.NamespaceAll).Watch(labels.Everything(), labels.Everything(), resourceVersion)
+			},
+		},
+		&api.RoleBinding{},
+		roleBindings,
+		0,
+	).Run()
+	cache.NewReflector(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return c.ClusterRoles().List(labels.Everything(), labels.Everything())
+			},
+			WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+				return c.ClusterRoles().Watch(labels.Everything(), labels.Everything(), resourceVersion)
+			},
+		},
+		&api.ClusterRole{},
+		clusterRoles,
+		0,
+	).Run()
+	cache.NewReflector(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return c.ClusterRoleBindings().List(labels.Everything(), labels.Everything())
+			},
+			WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+				return c.ClusterRoleBindings().Watch(labels.Everything(), labels.Everything(), resourceVersion)
+			},
+		},
+		&api.ClusterRoleBinding{},
+		clusterRoleBindings,
+		0,
+	).Run()
+
+	return NewFromStores(roles, roleBindings, clusterRoles, clusterRoleBindings)
+}
+
+// NewFromStores returns an Authorizer that reads roles and bindings from the given
+// stores, which must be keyed by cache.MetaNamespaceKeyFunc. The role bindings must
+// be held in an indexer created by NewRoleBindingIndexer.
+func NewFromStores(roles cache.Store, roleBindings cache.Indexer, clusterRoles, clusterRoleBindings cache.Store) *Authorizer {
+	return &Authorizer{
+		roles:               roles,
+		roleBindings:        roleBindings,
+		clusterRoles:        clusterRoles,
+		clusterRoleBindings: clusterRoleBindings,
+	}
+}
+
+// NewRoleBindingIndexer returns an indexer of role bindings that can be searched by namespace.
+func NewRoleBindingIndexer() cache.Indexer {
+	return cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{namespaceIndex: cache.MetaNamespaceIndexFunc})
+}
+
+// Authorize implements authorizer.Authorizer
+func (r *Authorizer) Authorize(a authorizer.Attributes) error {
+	for _, obj := range r.clusterRoleBindings.List() {
+		binding := obj.(*api.ClusterRoleBinding)
+		if !appliesTo(binding.Subjects, a) {
+			continue
+		}
+		if r.allowedBy("", binding.RoleRef, a) {
+			return nil
+		}
+	}
+
+	if namespace := a.GetNamespace(); len(namespace) != 0 {
+		bindings, err := r.roleBindings.Index(namespaceIndex, &api.RoleBinding{ObjectMeta: api.ObjectMeta{Namespace: namespace}})
+		if err != nil {
+			return err
+		}
+		for _, obj := range bindings {
+			binding := obj.(*api.RoleBinding)
+			if !appliesTo(binding.Subjects, a) {
+				continue
+			}
+			if r.allowedBy(namespace, binding.RoleRef, a) {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("no role binding allows user %q to %s %s in namespace %q", a.GetUserName(), a.GetVerb(), a.GetResource(), a.GetNamespace())
+}
+
+// allowedBy returns true if the role referred to by ref, resolved in the given
+// namespace, has a rule that matches the request. Roles that do not exist allow nothing.
+func (r *Authorizer) allowedBy(namespace string, ref api.RoleRef, a authorizer.Attributes) bool {
+	var rules []api.PolicyRule
+	switch ref.Kind {
+	case "ClusterRole":
+		obj, exists, err := r.clusterRoles.GetByKey(ref.Name)
+		if err != nil || !exists {
+			return false
+		}
+		rules = obj.(*api.ClusterRole).Rules
+	case "Role":
+		if len(namespace) == 0 {
+			return false
+		}
+		obj, exists, err := r.roles.GetByKey(namespace + "/" + ref.Name)
+		if err != nil || !exists {
+			return false
+		}
+		rules = obj.(*api.Role).Rules
+	default:
+		return false
+	}
+	for _, rule := range rules {
+		if ruleMatches(rule, a) {
+			return true
+		}
+	}
+	return false
+}
+
+// appliesTo returns true if one of the subjects names the user or one of its groups.
+func appliesTo(subjects []api.Subject, a authorizer.Attributes) bool {
+	for _, subject := range subjects {
+		switch subject.Kind {
+		case "User":
+			if subject.Name == a.GetUserName() {
+				return true
+			}
+		case "Group":
+			for _, group := range a.GetGroups() {
+				if subject.Name == group {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// ruleMatches returns true if the rule covers the verb, resource and name of the
+// request. A rule without resource names covers every object of its resources.
+func ruleMatches(rule api.PolicyRule, a authorizer.Attributes) bool {
+	if !has(rule.Verbs, a.GetVerb()) || !has(rule.Resources, a.GetResource()) {
+		return false
+	}
+	return len(rule.ResourceNames) == 0 || has(rule.ResourceNames, a.GetName())
+}
+
+// has returns true if values contains value, ignoring case, or the wildcard "*".
+func has(values []string, value string) bool {
+	for _, v := range values {
+		if v == "*" || strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
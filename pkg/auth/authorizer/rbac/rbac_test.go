@@ -0,0 +1,133 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authorizer"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/user"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+)
+
+func newTestAuthorizer(t *testing.T, objects ...interface{}) *Authorizer {
+	roles := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	roleBindings := NewRoleBindingIndexer()
+	clusterRoles := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	clusterRoleBindings := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	for _, obj := range objects {
+		var err error
+		switch obj.(type) {
+		case *api.Role:
+			err = roles.Add(obj)
+		case *api.RoleBinding:
+			err = roleBindings.Add(obj)
+		case *api.ClusterRole:
+			err = clusterRoles.Add(obj)
+		case *api.ClusterRoleBinding:
+			err = clusterRoleBindings.Add(obj)
+		default:
+			t.Fatalf("unexpected object %#v", obj)
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	return NewFromStores(roles, roleBindings, clusterRoles, clusterRoleBindings)
+}
+
+func TestAuthorize(t *testing.T) {
+	a := newTestAuthorizer(t,
+		&api.ClusterRole{
+			ObjectMeta: api.ObjectMeta{Name: "admin"},
+			Rules:      []api.PolicyRule{{Verbs: []string{"*"}, Resources: []string{"*"}}},
+		},
+		&api.ClusterRole{
+			ObjectMeta: api.ObjectMeta{Name: "pod-reader"},
+			Rules:      []api.PolicyRule{{Verbs: []string{"get", "list", "watch"}, Resources: []string{"pods"}}},
+		},
+		&api.ClusterRoleBinding{
+			ObjectMeta: api.ObjectMeta{Name: "admins"},
+			Subjects:   []api.Subject{{Kind: "Group", Name: "admins"}},
+			RoleRef:    api.RoleRef{Kind: "ClusterRole", Name: "admin"},
+		},
+		&api.Role{
+			ObjectMeta: api.ObjectMeta{Namespace: "prod", Name: "web-config"},
+			Rules: []api.PolicyRule{
+				{Verbs: []string{"get", "update"}, Resources: []string{"secrets"}, ResourceNames: []string{"web"}},
+				{Verbs: []string{"create"}, Resources: []string{"replicationControllers"}},
+			},
+		},
+		&api.RoleBinding{
+			ObjectMeta: api.ObjectMeta{Namespace: "prod", Name: "web-config"},
+			Subjects:   []api.Subject{{Kind: "User", Name: "alice"}},
+			RoleRef:    api.RoleRef{Kind: "Role", Name: "web-config"},
+		},
+		&api.RoleBinding{
+			ObjectMeta: api.ObjectMeta{Namespace: "dev", Name: "readers"},
+			Subjects:   []api.Subject{{Kind: "User", Name: "alice"}, {Kind: "Group", Name: "devs"}},
+			RoleRef:    api.RoleRef{Kind: "ClusterRole", Name: "pod-reader"},
+		},
+		&api.RoleBinding{
+			ObjectMeta: api.ObjectMeta{Namespace: "dev", Name: "dangling"},
+			Subjects:   []api.Subject{{Kind: "User", Name: "bob"}},
+			RoleRef:    api.RoleRef{Kind: "Role", Name: "missing"},
+		},
+	)
+
+	testCases := map[string]struct {
+		user    string
+		groups  []string
+		verb    string
+		ns      string
+		res     string
+		name    string
+		allowed bool
+	}{
+		"cluster admin":               {"root", []string{"admins"}, "delete", "", "nodes", "node-1", true},
+		"cluster admin in namespace":  {"root", []string{"admins"}, "create", "prod", "pods", "", true},
+		"named secret":                {"alice", nil, "get", "prod", "secrets", "web", true},
+		"named secret, case":          {"alice", nil, "GET", "prod", "Secrets", "web", true},
+		"other secret":                {"alice", nil, "get", "prod", "secrets", "db", false},
+		"list secrets":                {"alice", nil, "list", "prod", "secrets", "", false},
+		"delete named secret":         {"alice", nil, "delete", "prod", "secrets", "web", false},
+		"create controller":           {"alice", nil, "create", "prod", "replicationControllers", "", true},
+		"role in other namespace":     {"alice", nil, "get", "dev", "secrets", "web", false},
+		"cluster role in namespace":   {"alice", nil, "watch", "dev", "pods", "", true},
+		"cluster role by group":       {"carol", []string{"devs"}, "list", "dev", "pods", "", true},
+		"cluster role wrong verb":     {"carol", []string{"devs"}, "delete", "dev", "pods", "web", false},
+		"namespaced binding globally": {"carol", []string{"devs"}, "list", "", "pods", "", false},
+		"missing role":                {"bob", nil, "get", "dev", "pods", "", false},
+		"unknown user":                {"mallory", nil, "get", "prod", "pods", "", false},
+	}
+	for name, tc := range testCases {
+		err := a.Authorize(authorizer.AttributesRecord{
+			User:      &user.DefaultInfo{Name: tc.user, Groups: tc.groups},
+			Verb:      tc.verb,
+			Namespace: tc.ns,
+			Resource:  tc.res,
+			Name:      tc.name,
+		})
+		if tc.allowed && err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+		if !tc.allowed && err == nil {
+			t.Errorf("%s: expected request to be denied", name)
+		}
+	}
+}
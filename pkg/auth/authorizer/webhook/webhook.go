@@ -0,0 +1,153 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook implements an authorizer that delegates decisions to a remote
+// service.
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authorizer"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/cache"
+)
+
+// maxCacheEntries bounds the number of decisions that are remembered.
+const maxCacheEntries = 4096
+
+// requestTimeout bounds how long a request waits for the remote service.
+const requestTimeout = 10 * time.Second
+
+// AccessReview is the body POSTed to the remote service, and the body it responds
+// with. The service is sent the attributes of a request in Spec, and answers by
+// filling in Status.
+type AccessReview struct {
+	Spec   AccessReviewSpec   `json:"spec"`
+	Status AccessReviewStatus `json:"status"`
+}
+
+// AccessReviewSpec holds the authorizer.Attributes of a request.
+type AccessReviewSpec struct {
+	User      string   `json:"user"`
+	Groups    []string `json:"groups,omitempty"`
+	Readonly  bool     `json:"readonly"`
+	Namespace string   `json:"namespace,omitempty"`
+	Resource  string   `json:"resource,omitempty"`
+	Verb      string   `json:"verb,omitempty"`
+	Name      string   `json:"name,omitempty"`
+}
+
+// AccessReviewStatus is the decision of the remote service.
+type AccessReviewStatus struct {
+	Allowed bool `json:"allowed"`
+	// Reason optionally explains a denial.
+	Reason string `json:"reason,omitempty"`
+}
+
+// webhookAuthorizer asks a remote service whether requests are allowed. Decisions
+// are cached, for allowTTL when the request was allowed and for denyTTL when it
+// was denied. Failures to reach the service deny the request and are not cached.
+type webhookAuthorizer struct {
+	url      string
+	client   *http.Client
+	allowTTL time.Duration
+	denyTTL  time.Duration
+	cache    *cache.ExpiringCache
+}
+
+// New returns an authorizer.Authorizer that POSTs the attributes of each request
+// to url through transport.
+func New(url string, transport http.RoundTripper, allowTTL, denyTTL time.Duration) authorizer.Authorizer {
+	return newWithClock(url, transport, allowTTL, denyTTL, util.RealClock{})
+}
+
+func newWithClock(url string, transport http.RoundTripper, allowTTL, denyTTL time.Duration, clock util.Clock) *webhookAuthorizer {
+	return &webhookAuthorizer{
+		url:      url,
+		client:   &http.Client{Transport: transport, Timeout: requestTimeout},
+		allowTTL: allowTTL,
+		denyTTL:  denyTTL,
+		cache:    cache.NewExpiringCache(maxCacheEntries, clock),
+	}
+}
+
+// Authorize implements authorizer.Authorizer
+func (w *webhookAuthorizer) Authorize(a authorizer.Attributes) error {
+	spec := AccessReviewSpec{
+		User:      a.GetUserName(),
+		Groups:    a.GetGroups(),
+		Readonly:  a.IsReadOnly(),
+		Namespace: a.GetNamespace(),
+		Resource:  a.GetResource(),
+		Verb:      a.GetVerb(),
+		Name:      a.GetName(),
+	}
+	key, err := json.Marshal(&spec)
+	if err != nil {
+		return err
+	}
+	if cached, ok := w.cache.Get(string(key)); ok {
+		return decision(cached.(AccessReviewStatus))
+	}
+
+	status, err := w.review(spec)
+	if err != nil {
+		return err
+	}
+	ttl := w.denyTTL
+	if status.Allowed {
+		ttl = w.allowTTL
+	}
+	w.cache.Add(string(key), *status, ttl)
+	return decision(*status)
+}
+
+// review asks the remote service whether the request described by spec is allowed.
+func (w *webhookAuthorizer) review(spec AccessReviewSpec) (*AccessReviewStatus, error) {
+	body, err := json.Marshal(&AccessReview{Spec: spec})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := w.client.Post(w.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("authorization webhook returned status %d", resp.StatusCode)
+	}
+	review := AccessReview{}
+	if err := json.NewDecoder(resp.Body).Decode(&review); err != nil {
+		return nil, fmt.Errorf("unable to decode authorization webhook response: %v", err)
+	}
+	return &review.Status, nil
+}
+
+func decision(status AccessReviewStatus) error {
+	if status.Allowed {
+		return nil
+	}
+	if len(status.Reason) != 0 {
+		return errors.New(status.Reason)
+	}
+	return errors.New("access denied by the authorization webhook")
+}
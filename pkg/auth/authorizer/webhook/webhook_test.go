@@ -0,0 +1,142 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authorizer"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/user"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+// fakeService allows read only requests and the requests of members of "admins",
+// and records the reviews it receives.
+type fakeService struct {
+	status  int
+	reviews []AccessReviewSpec
+}
+
+func (s *fakeService) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if s.status != 0 {
+		w.WriteHeader(s.status)
+		return
+	}
+	review := AccessReview{}
+	if err := json.NewDecoder(req.Body).Decode(&review); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	s.reviews = append(s.reviews, review.Spec)
+	review.Status.Allowed = review.Spec.Readonly
+	for _, group := range review.Spec.Groups {
+		if group == "admins" {
+			review.Status.Allowed = true
+		}
+	}
+	if !review.Status.Allowed {
+		review.Status.Reason = review.Spec.User + " may not change " + review.Spec.Resource
+	}
+	json.NewEncoder(w).Encode(&review)
+}
+
+func newTestAuthorizer(service *fakeService) (*webhookAuthorizer, *util.FakeClock, func()) {
+	server := httptest.NewTLSServer(service)
+	transport := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+	clock := &util.FakeClock{Time: time.Now()}
+	return newWithClock(server.URL, transport, 5*time.Minute, 30*time.Second, clock), clock, server.Close
+}
+
+func attributes(name string, groups []string, readOnly bool) authorizer.Attributes {
+	return authorizer.AttributesRecord{
+		User:      &user.DefaultInfo{Name: name, Groups: groups},
+		ReadOnly:  readOnly,
+		Namespace: "prod",
+		Resource:  "replicationControllers",
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	service := &fakeService{}
+	a, _, stop := newTestAuthorizer(service)
+	defer stop()
+
+	if err := a.Authorize(attributes("alice", []string{"admins"}, false)); err != nil {
+		t.Errorf("expected alice to be allowed: %v", err)
+	}
+	if err := a.Authorize(attributes("bob", nil, true)); err != nil {
+		t.Errorf("expected bob to be allowed to read: %v", err)
+	}
+	err := a.Authorize(attributes("bob", nil, false))
+	if err == nil || err.Error() != "bob may not change replicationControllers" {
+		t.Errorf("expected bob to be denied with the service's reason, got %v", err)
+	}
+
+	expected := AccessReviewSpec{User: "alice", Groups: []string{"admins"}, Namespace: "prod", Resource: "replicationControllers"}
+	if len(service.reviews) != 3 || !reflect.DeepEqual(service.reviews[0], expected) {
+		t.Errorf("expected the attributes to be sent, got %#v", service.reviews)
+	}
+}
+
+func TestAuthorizeCaches(t *testing.T) {
+	service := &fakeService{}
+	a, clock, stop := newTestAuthorizer(service)
+	defer stop()
+
+	authorize := func(name string, expectAllowed bool, expectedReviews int) {
+		err := a.Authorize(attributes(name, nil, name == "reader"))
+		if (err == nil) != expectAllowed {
+			t.Errorf("%s: expected allowed=%v, got %v", name, expectAllowed, err)
+		}
+		if len(service.reviews) != expectedReviews {
+			t.Errorf("%s: expected %d reviews, got %d", name, expectedReviews, len(service.reviews))
+		}
+	}
+
+	authorize("reader", true, 1)
+	authorize("writer", false, 2)
+	authorize("reader", true, 2)
+	authorize("writer", false, 2)
+
+	// Denials expire before permissions.
+	clock.Time = clock.Time.Add(time.Minute)
+	authorize("reader", true, 2)
+	authorize("writer", false, 3)
+
+	clock.Time = clock.Time.Add(5 * time.Minute)
+	authorize("reader", true, 4)
+}
+
+func TestAuthorizeErrors(t *testing.T) {
+	service := &fakeService{status: http.StatusServiceUnavailable}
+	a, _, stop := newTestAuthorizer(service)
+	defer stop()
+
+	if err := a.Authorize(attributes("alice", []string{"admins"}, false)); err == nil {
+		t.Errorf("expected requests to be denied when the service fails")
+	}
+	service.status = 0
+	if err := a.Authorize(attributes("alice", []string{"admins"}, false)); err != nil {
+		t.Errorf("expected failures not to be cached: %v", err)
+	}
+}
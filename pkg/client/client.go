@@ -41,7 +41,20 @@ type Interface interface {
 	ResourceQuotasNamespacer
 	ResourceQuotaUsagesNamespacer
 	SecretsNamespacer
+	ServiceAccountsNamespacer
 	NamespacesInterface
+	PersistentVolumesInterface
+	PersistentVolumeClaimsNamespacer
+	JobsNamespacer
+	DaemonSetsNamespacer
+	DeploymentsNamespacer
+	HorizontalPodAutoscalersNamespacer
+	ThirdPartyResourcesInterface
+	ThirdPartyResourceDataNamespacer
+	RolesNamespacer
+	RoleBindingsNamespacer
+	ClusterRolesInterface
+	ClusterRoleBindingsInterface
 }
 
 func (c *Client) ReplicationControllers(namespace string) ReplicationControllerInterface {
@@ -84,10 +97,62 @@ func (c *Client) Secrets(namespace string) SecretsInterface {
 	return newSecrets(c, namespace)
 }
 
+func (c *Client) ServiceAccounts(namespace string) ServiceAccountsInterface {
+	return newServiceAccounts(c, namespace)
+}
+
 func (c *Client) Namespaces() NamespaceInterface {
 	return newNamespaces(c)
 }
 
+func (c *Client) PersistentVolumes() PersistentVolumeInterface {
+	return newPersistentVolumes(c)
+}
+
+func (c *Client) PersistentVolumeClaims(namespace string) PersistentVolumeClaimInterface {
+	return newPersistentVolumeClaims(c, namespace)
+}
+
+func (c *Client) Jobs(namespace string) JobInterface {
+	return newJobs(c, namespace)
+}
+
+func (c *Client) DaemonSets(namespace string) DaemonSetInterface {
+	return newDaemonSets(c, namespace)
+}
+
+func (c *Client) Deployments(namespace string) DeploymentInterface {
+	return newDeployments(c, namespace)
+}
+
+func (c *Client) HorizontalPodAutoscalers(namespace string) HorizontalPodAutoscalerInterface {
+	return newHorizontalPodAutoscalers(c, namespace)
+}
+
+func (c *Client) ThirdPartyResources() ThirdPartyResourceInterface {
+	return newThirdPartyResources(c)
+}
+
+func (c *Client) ThirdPartyResourceData(group, version, kind, namespace string) ThirdPartyResourceDataInterface {
+	return newThirdPartyResourceData(c, group, version, kind, namespace)
+}
+
+func (c *Client) Roles(namespace string) RoleInterface {
+	return newRoles(c, namespace)
+}
+
+func (c *Client) RoleBindings(namespace string) RoleBindingInterface {
+	return newRoleBindings(c, namespace)
+}
+
+func (c *Client) ClusterRoles() ClusterRoleInterface {
+	return newClusterRoles(c)
+}
+
+func (c *Client) ClusterRoleBindings() ClusterRoleBindingInterface {
+	return newClusterRoleBindings(c)
+}
+
 // VersionInterface has a method to retrieve the server version.
 type VersionInterface interface {
 	ServerVersion() (*version.Info, error)
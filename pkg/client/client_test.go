@@ -316,7 +316,7 @@ func TestDeletePod(t *testing.T) {
 		Request:  testRequest{Method: "DELETE", Path: buildResourcePath(ns, "/pods/foo"), Query: buildQueryValues(ns, nil)},
 		Response: Response{StatusCode: 200},
 	}
-	err := c.Setup().Pods(ns).Delete("foo")
+	err := c.Setup().Pods(ns).Delete("foo", nil)
 	c.Validate(t, nil, err)
 }
 
@@ -367,6 +367,23 @@ func TestUpdatePod(t *testing.T) {
 	c.Validate(t, receivedPod, err)
 }
 
+func TestPatchPod(t *testing.T) {
+	ns := api.NamespaceDefault
+	patch := `{"metadata":{"labels":{"name":"baz"}}}`
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			Name:   "foo",
+			Labels: map[string]string{"name": "baz"},
+		},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "PATCH", Path: buildResourcePath(ns, "/pods/foo"), Query: buildQueryValues(ns, nil), Header: "Content-Type", RawBody: &patch},
+		Response: Response{StatusCode: 200, Body: pod},
+	}
+	receivedPod, err := c.Setup().Pods(ns).Patch("foo", api.MergePatchType, []byte(patch))
+	c.Validate(t, receivedPod, err)
+}
+
 func TestListControllers(t *testing.T) {
 	c := &testClient{
 		Request: testRequest{Method: "GET", Path: "/replicationControllers"},
@@ -629,8 +646,10 @@ func TestListEndpooints(t *testing.T) {
 				Items: []api.Endpoints{
 					{
 						ObjectMeta: api.ObjectMeta{Name: "endpoint-1"},
-						Endpoints: []api.Endpoint{
-							{IP: "10.245.1.2", Port: 8080}, {IP: "10.245.1.3", Port: 8080}},
+						Ports: []api.EndpointPort{{
+							Endpoints: []api.Endpoint{
+								{IP: "10.245.1.2", Port: 8080}, {IP: "10.245.1.3", Port: 8080}},
+						}},
 					},
 				},
 			},
@@ -792,6 +811,15 @@ func TestUpdateMinion(t *testing.T) {
 	c.Validate(t, response, err)
 }
 
+func TestWatchMinions(t *testing.T) {
+	c := &testClient{
+		Request:  testRequest{Method: "GET", Path: "/watch/minions", Query: url.Values{"resourceVersion": []string{}}},
+		Response: Response{StatusCode: 200},
+	}
+	_, err := c.Setup().Nodes().Watch(labels.Everything(), labels.Everything(), "")
+	c.Validate(t, nil, err)
+}
+
 func TestNewMinionPath(t *testing.T) {
 	c := &testClient{
 		Request:  testRequest{Method: "DELETE", Path: "/nodes/foo"},
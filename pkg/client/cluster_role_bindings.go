@@ -0,0 +1,106 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+type ClusterRoleBindingsInterface interface {
+	ClusterRoleBindings() ClusterRoleBindingInterface
+}
+
+type ClusterRoleBindingInterface interface {
+	Create(binding *api.ClusterRoleBinding) (*api.ClusterRoleBinding, error)
+	Update(binding *api.ClusterRoleBinding) (*api.ClusterRoleBinding, error)
+	Delete(name string) error
+	List(label, field labels.Selector) (*api.ClusterRoleBindingList, error)
+	Get(name string) (*api.ClusterRoleBinding, error)
+	Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// clusterRoleBindings implements ClusterRoleBindingsInterface
+type clusterRoleBindings struct {
+	client *Client
+}
+
+// newClusterRoleBindings returns a clusterRoleBindings object.
+func newClusterRoleBindings(c *Client) *clusterRoleBindings {
+	return &clusterRoleBindings{client: c}
+}
+
+// Create creates a new cluster role binding.
+func (c *clusterRoleBindings) Create(binding *api.ClusterRoleBinding) (*api.ClusterRoleBinding, error) {
+	result := &api.ClusterRoleBinding{}
+	err := c.client.Post().Resource("clusterRoleBindings").Body(binding).Do().Into(result)
+	return result, err
+}
+
+// List lists all the cluster role bindings in the cluster matching the selectors.
+func (c *clusterRoleBindings) List(label, field labels.Selector) (*api.ClusterRoleBindingList, error) {
+	result := &api.ClusterRoleBindingList{}
+	err := c.client.Get().
+		Resource("clusterRoleBindings").
+		SelectorParam("labels", label).
+		SelectorParam("fields", field).
+		Do().
+		Into(result)
+	return result, err
+}
+
+// Update takes the representation of a cluster role binding to update.  Returns the server's representation of the cluster role binding, and an error, if it occurs.
+func (c *clusterRoleBindings) Update(binding *api.ClusterRoleBinding) (result *api.ClusterRoleBinding, err error) {
+	result = &api.ClusterRoleBinding{}
+	if len(binding.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", binding)
+		return
+	}
+	err = c.client.Put().Resource("clusterRoleBindings").Name(binding.Name).Body(binding).Do().Into(result)
+	return
+}
+
+// Get gets an existing cluster role binding.
+func (c *clusterRoleBindings) Get(name string) (*api.ClusterRoleBinding, error) {
+	if len(name) == 0 {
+		return nil, errors.New("name is required parameter to Get")
+	}
+
+	result := &api.ClusterRoleBinding{}
+	err := c.client.Get().Resource("clusterRoleBindings").Name(name).Do().Into(result)
+	return result, err
+}
+
+// Delete deletes an existing cluster role binding.
+func (c *clusterRoleBindings) Delete(name string) error {
+	return c.client.Delete().Resource("clusterRoleBindings").Name(name).Do().Error()
+}
+
+// Watch returns a watch.Interface that watches the requested cluster role bindings.
+func (c *clusterRoleBindings) Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.client.Get().
+		Prefix("watch").
+		Resource("clusterRoleBindings").
+		Param("resourceVersion", resourceVersion).
+		SelectorParam("labels", label).
+		SelectorParam("fields", field).
+		Watch()
+}
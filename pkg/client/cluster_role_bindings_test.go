@@ -0,0 +1,121 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func TestClusterRoleBindingCreate(t *testing.T) {
+	binding := &api.ClusterRoleBinding{
+		ObjectMeta: api.ObjectMeta{Name: "admins"},
+		Subjects:   []api.Subject{{Kind: "Group", Name: "admins"}},
+		RoleRef:    api.RoleRef{Kind: "ClusterRole", Name: "admin"},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "POST",
+			Path:   "/clusterRoleBindings",
+			Body:   binding,
+		},
+		Response: Response{StatusCode: 200, Body: binding},
+	}
+
+	response, err := c.Setup().ClusterRoleBindings().Create(binding)
+	c.Validate(t, response, err)
+}
+
+func TestClusterRoleBindingGet(t *testing.T) {
+	binding := &api.ClusterRoleBinding{
+		ObjectMeta: api.ObjectMeta{Name: "admins"},
+		Subjects:   []api.Subject{{Kind: "Group", Name: "admins"}},
+		RoleRef:    api.RoleRef{Kind: "ClusterRole", Name: "admin"},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   "/clusterRoleBindings/admins",
+		},
+		Response: Response{StatusCode: 200, Body: binding},
+	}
+
+	response, err := c.Setup().ClusterRoleBindings().Get("admins")
+	c.Validate(t, response, err)
+}
+
+func TestClusterRoleBindingList(t *testing.T) {
+	list := &api.ClusterRoleBindingList{
+		Items: []api.ClusterRoleBinding{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "admins"},
+				Subjects:   []api.Subject{{Kind: "Group", Name: "admins"}},
+				RoleRef:    api.RoleRef{Kind: "ClusterRole", Name: "admin"},
+			},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   "/clusterRoleBindings",
+		},
+		Response: Response{StatusCode: 200, Body: list},
+	}
+	response, err := c.Setup().ClusterRoleBindings().List(labels.Everything(), labels.Everything())
+	c.Validate(t, response, err)
+}
+
+func TestClusterRoleBindingUpdate(t *testing.T) {
+	binding := &api.ClusterRoleBinding{
+		ObjectMeta: api.ObjectMeta{Name: "admins", ResourceVersion: "1"},
+		Subjects:   []api.Subject{{Kind: "Group", Name: "admins"}},
+		RoleRef:    api.RoleRef{Kind: "ClusterRole", Name: "admin"},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "PUT",
+			Path:   "/clusterRoleBindings/admins",
+		},
+		Response: Response{StatusCode: 200, Body: binding},
+	}
+	response, err := c.Setup().ClusterRoleBindings().Update(binding)
+	c.Validate(t, response, err)
+}
+
+func TestClusterRoleBindingDelete(t *testing.T) {
+	c := &testClient{
+		Request: testRequest{
+			Method: "DELETE",
+			Path:   "/clusterRoleBindings/admins",
+		},
+		Response: Response{StatusCode: 200},
+	}
+	err := c.Setup().ClusterRoleBindings().Delete("admins")
+	c.Validate(t, nil, err)
+}
+
+func TestClusterRoleBindingWatch(t *testing.T) {
+	c := &testClient{
+		Request:  testRequest{Method: "GET", Path: "/watch/clusterRoleBindings", Query: url.Values{"resourceVersion": []string{}}},
+		Response: Response{StatusCode: 200},
+	}
+	_, err := c.Setup().ClusterRoleBindings().Watch(labels.Everything(), labels.Everything(), "")
+	c.Validate(t, nil, err)
+}
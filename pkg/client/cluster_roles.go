@@ -0,0 +1,106 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+type ClusterRolesInterface interface {
+	ClusterRoles() ClusterRoleInterface
+}
+
+type ClusterRoleInterface interface {
+	Create(role *api.ClusterRole) (*api.ClusterRole, error)
+	Update(role *api.ClusterRole) (*api.ClusterRole, error)
+	Delete(name string) error
+	List(label, field labels.Selector) (*api.ClusterRoleList, error)
+	Get(name string) (*api.ClusterRole, error)
+	Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// clusterRoles implements ClusterRolesInterface
+type clusterRoles struct {
+	client *Client
+}
+
+// newClusterRoles returns a clusterRoles object.
+func newClusterRoles(c *Client) *clusterRoles {
+	return &clusterRoles{client: c}
+}
+
+// Create creates a new cluster role.
+func (c *clusterRoles) Create(role *api.ClusterRole) (*api.ClusterRole, error) {
+	result := &api.ClusterRole{}
+	err := c.client.Post().Resource("clusterRoles").Body(role).Do().Into(result)
+	return result, err
+}
+
+// List lists all the cluster roles in the cluster matching the selectors.
+func (c *clusterRoles) List(label, field labels.Selector) (*api.ClusterRoleList, error) {
+	result := &api.ClusterRoleList{}
+	err := c.client.Get().
+		Resource("clusterRoles").
+		SelectorParam("labels", label).
+		SelectorParam("fields", field).
+		Do().
+		Into(result)
+	return result, err
+}
+
+// Update takes the representation of a cluster role to update.  Returns the server's representation of the cluster role, and an error, if it occurs.
+func (c *clusterRoles) Update(role *api.ClusterRole) (result *api.ClusterRole, err error) {
+	result = &api.ClusterRole{}
+	if len(role.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", role)
+		return
+	}
+	err = c.client.Put().Resource("clusterRoles").Name(role.Name).Body(role).Do().Into(result)
+	return
+}
+
+// Get gets an existing cluster role.
+func (c *clusterRoles) Get(name string) (*api.ClusterRole, error) {
+	if len(name) == 0 {
+		return nil, errors.New("name is required parameter to Get")
+	}
+
+	result := &api.ClusterRole{}
+	err := c.client.Get().Resource("clusterRoles").Name(name).Do().Into(result)
+	return result, err
+}
+
+// Delete deletes an existing cluster role.
+func (c *clusterRoles) Delete(name string) error {
+	return c.client.Delete().Resource("clusterRoles").Name(name).Do().Error()
+}
+
+// Watch returns a watch.Interface that watches the requested cluster roles.
+func (c *clusterRoles) Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.client.Get().
+		Prefix("watch").
+		Resource("clusterRoles").
+		Param("resourceVersion", resourceVersion).
+		SelectorParam("labels", label).
+		SelectorParam("fields", field).
+		Watch()
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func TestClusterRoleCreate(t *testing.T) {
+	role := &api.ClusterRole{
+		ObjectMeta: api.ObjectMeta{Name: "admin"},
+		Rules:      []api.PolicyRule{{Verbs: []string{"*"}, Resources: []string{"*"}}},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "POST",
+			Path:   "/clusterRoles",
+			Body:   role,
+		},
+		Response: Response{StatusCode: 200, Body: role},
+	}
+
+	response, err := c.Setup().ClusterRoles().Create(role)
+	c.Validate(t, response, err)
+}
+
+func TestClusterRoleGet(t *testing.T) {
+	role := &api.ClusterRole{
+		ObjectMeta: api.ObjectMeta{Name: "admin"},
+		Rules:      []api.PolicyRule{{Verbs: []string{"*"}, Resources: []string{"*"}}},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   "/clusterRoles/admin",
+		},
+		Response: Response{StatusCode: 200, Body: role},
+	}
+
+	response, err := c.Setup().ClusterRoles().Get("admin")
+	c.Validate(t, response, err)
+}
+
+func TestClusterRoleList(t *testing.T) {
+	list := &api.ClusterRoleList{
+		Items: []api.ClusterRole{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "admin"},
+				Rules:      []api.PolicyRule{{Verbs: []string{"*"}, Resources: []string{"*"}}},
+			},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   "/clusterRoles",
+		},
+		Response: Response{StatusCode: 200, Body: list},
+	}
+	response, err := c.Setup().ClusterRoles().List(labels.Everything(), labels.Everything())
+	c.Validate(t, response, err)
+}
+
+func TestClusterRoleUpdate(t *testing.T) {
+	role := &api.ClusterRole{
+		ObjectMeta: api.ObjectMeta{Name: "admin", ResourceVersion: "1"},
+		Rules:      []api.PolicyRule{{Verbs: []string{"*"}, Resources: []string{"*"}}},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "PUT",
+			Path:   "/clusterRoles/admin",
+		},
+		Response: Response{StatusCode: 200, Body: role},
+	}
+	response, err := c.Setup().ClusterRoles().Update(role)
+	c.Validate(t, response, err)
+}
+
+func TestClusterRoleDelete(t *testing.T) {
+	c := &testClient{
+		Request: testRequest{
+			Method: "DELETE",
+			Path:   "/clusterRoles/admin",
+		},
+		Response: Response{StatusCode: 200},
+	}
+	err := c.Setup().ClusterRoles().Delete("admin")
+	c.Validate(t, nil, err)
+}
+
+func TestClusterRoleWatch(t *testing.T) {
+	c := &testClient{
+		Request:  testRequest{Method: "GET", Path: "/watch/clusterRoles", Query: url.Values{"resourceVersion": []string{}}},
+		Response: Response{StatusCode: 200},
+	}
+	_, err := c.Setup().ClusterRoles().Watch(labels.Everything(), labels.Everything(), "")
+	c.Validate(t, nil, err)
+}
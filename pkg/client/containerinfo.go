@@ -38,16 +38,37 @@ type ContainerInfoGetter interface {
 }
 
 type HTTPContainerInfoGetter struct {
-	Client *http.Client
-	Port   int
+	Client      *http.Client
+	Port        int
+	EnableHttps bool
+}
+
+// NewHTTPContainerInfoGetter returns an HTTPContainerInfoGetter that reaches kubelets
+// the way config describes.
+func NewHTTPContainerInfoGetter(config *KubeletConfig) (*HTTPContainerInfoGetter, error) {
+	c, err := kubeletHTTPClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return &HTTPContainerInfoGetter{
+		Client:      c,
+		Port:        int(config.Port),
+		EnableHttps: config.EnableHttps,
+	}, nil
+}
+
+func (self *HTTPContainerInfoGetter) url(host, path string) string {
+	scheme := "http"
+	if self.EnableHttps {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s/%s", scheme, net.JoinHostPort(host, strconv.Itoa(self.Port)), path)
 }
 
 func (self *HTTPContainerInfoGetter) GetMachineInfo(host string) (*cadvisorApi.MachineInfo, error) {
 	request, err := http.NewRequest(
 		"GET",
-		fmt.Sprintf("http://%v/spec",
-			net.JoinHostPort(host, strconv.Itoa(self.Port)),
-		),
+		self.url(host, "spec"),
 		nil,
 	)
 	if err != nil {
@@ -83,10 +104,7 @@ func (self *HTTPContainerInfoGetter) getContainerInfo(host, path string, req *ca
 
 	request, err := http.NewRequest(
 		"GET",
-		fmt.Sprintf("http://%v/stats/%v",
-			net.JoinHostPort(host, strconv.Itoa(self.Port)),
-			path,
-		),
+		self.url(host, "stats/"+path),
 		body,
 	)
 	if err != nil {
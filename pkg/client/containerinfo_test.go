@@ -196,3 +196,22 @@ func TestHTTPGetMachineInfo(t *testing.T) {
 		t.Errorf("received wrong machine spec")
 	}
 }
+
+func TestNewHTTPContainerInfoGetter(t *testing.T) {
+	getter, err := NewHTTPContainerInfoGetter(&KubeletConfig{
+		Port:        10250,
+		EnableHttps: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if getter.Port != 10250 {
+		t.Errorf("expected port 10250, got %d", getter.Port)
+	}
+	if e, a := "https://127.0.0.1:10250/spec", getter.url("127.0.0.1", "spec"); e != a {
+		t.Errorf("expected %s, got %s", e, a)
+	}
+	if getter.Client == http.DefaultClient {
+		t.Errorf("expected a client built from the kubelet config")
+	}
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+type DaemonSetsNamespacer interface {
+	DaemonSets(namespace string) DaemonSetInterface
+}
+
+type DaemonSetInterface interface {
+	Create(ds *api.DaemonSet) (*api.DaemonSet, error)
+	Update(ds *api.DaemonSet) (*api.DaemonSet, error)
+	Delete(name string) error
+	List(label, field labels.Selector) (*api.DaemonSetList, error)
+	Get(name string) (*api.DaemonSet, error)
+	Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// daemonSets implements DaemonSets interface
+type daemonSets struct {
+	client    *Client
+	namespace string
+}
+
+// newDaemonSets returns a new daemonSets object.
+func newDaemonSets(c *Client, ns string) *daemonSets {
+	return &daemonSets{
+		client:    c,
+		namespace: ns,
+	}
+}
+
+func (s *daemonSets) Create(ds *api.DaemonSet) (*api.DaemonSet, error) {
+	if s.namespace != "" && ds.Namespace != s.namespace {
+		return nil, fmt.Errorf("can't create a daemon set with namespace '%v' in namespace '%v'", ds.Namespace, s.namespace)
+	}
+
+	result := &api.DaemonSet{}
+	err := s.client.Post().
+		Namespace(ds.Namespace).
+		Resource("daemonSets").
+		Body(ds).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+// List returns a list of daemon sets matching the selectors.
+func (s *daemonSets) List(label, field labels.Selector) (*api.DaemonSetList, error) {
+	result := &api.DaemonSetList{}
+
+	err := s.client.Get().
+		Namespace(s.namespace).
+		Resource("daemonSets").
+		SelectorParam("labels", label).
+		SelectorParam("fields", field).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+// Get returns the given daemon set, or an error.
+func (s *daemonSets) Get(name string) (*api.DaemonSet, error) {
+	if len(name) == 0 {
+		return nil, errors.New("name is required parameter to Get")
+	}
+
+	result := &api.DaemonSet{}
+	err := s.client.Get().
+		Namespace(s.namespace).
+		Resource("daemonSets").
+		Name(name).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+// Watch starts watching for daemon sets matching the given selectors.
+func (s *daemonSets) Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error) {
+	return s.client.Get().
+		Prefix("watch").
+		Namespace(s.namespace).
+		Resource("daemonSets").
+		Param("resourceVersion", resourceVersion).
+		SelectorParam("labels", label).
+		SelectorParam("fields", field).
+		Watch()
+}
+
+func (s *daemonSets) Delete(name string) error {
+	return s.client.Delete().
+		Namespace(s.namespace).
+		Resource("daemonSets").
+		Name(name).
+		Do().
+		Error()
+}
+
+func (s *daemonSets) Update(ds *api.DaemonSet) (result *api.DaemonSet, err error) {
+	result = &api.DaemonSet{}
+	if len(ds.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", ds)
+		return
+	}
+
+	err = s.client.Put().
+		Namespace(s.namespace).
+		Resource("daemonSets").
+		Name(ds.Name).
+		Body(ds).
+		Do().
+		Into(result)
+
+	return
+}
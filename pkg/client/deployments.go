@@ -0,0 +1,140 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+type DeploymentsNamespacer interface {
+	Deployments(namespace string) DeploymentInterface
+}
+
+type DeploymentInterface interface {
+	Create(deployment *api.Deployment) (*api.Deployment, error)
+	Update(deployment *api.Deployment) (*api.Deployment, error)
+	Delete(name string) error
+	List(label, field labels.Selector) (*api.DeploymentList, error)
+	Get(name string) (*api.Deployment, error)
+	Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// deployments implements Deployments interface
+type deployments struct {
+	client    *Client
+	namespace string
+}
+
+// newDeployments returns a new deployments object.
+func newDeployments(c *Client, ns string) *deployments {
+	return &deployments{
+		client:    c,
+		namespace: ns,
+	}
+}
+
+func (s *deployments) Create(deployment *api.Deployment) (*api.Deployment, error) {
+	if s.namespace != "" && deployment.Namespace != s.namespace {
+		return nil, fmt.Errorf("can't create a deployment with namespace '%v' in namespace '%v'", deployment.Namespace, s.namespace)
+	}
+
+	result := &api.Deployment{}
+	err := s.client.Post().
+		Namespace(deployment.Namespace).
+		Resource("deployments").
+		Body(deployment).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+// List returns a list of deployments matching the selectors.
+func (s *deployments) List(label, field labels.Selector) (*api.DeploymentList, error) {
+	result := &api.DeploymentList{}
+
+	err := s.client.Get().
+		Namespace(s.namespace).
+		Resource("deployments").
+		SelectorParam("labels", label).
+		SelectorParam("fields", field).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+// Get returns the given deployment, or an error.
+func (s *deployments) Get(name string) (*api.Deployment, error) {
+	if len(name) == 0 {
+		return nil, errors.New("name is required parameter to Get")
+	}
+
+	result := &api.Deployment{}
+	err := s.client.Get().
+		Namespace(s.namespace).
+		Resource("deployments").
+		Name(name).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+// Watch starts watching for deployments matching the given selectors.
+func (s *deployments) Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error) {
+	return s.client.Get().
+		Prefix("watch").
+		Namespace(s.namespace).
+		Resource("deployments").
+		Param("resourceVersion", resourceVersion).
+		SelectorParam("labels", label).
+		SelectorParam("fields", field).
+		Watch()
+}
+
+func (s *deployments) Delete(name string) error {
+	return s.client.Delete().
+		Namespace(s.namespace).
+		Resource("deployments").
+		Name(name).
+		Do().
+		Error()
+}
+
+func (s *deployments) Update(deployment *api.Deployment) (result *api.Deployment, err error) {
+	result = &api.Deployment{}
+	if len(deployment.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", deployment)
+		return
+	}
+
+	err = s.client.Put().
+		Namespace(s.namespace).
+		Resource("deployments").
+		Name(deployment.Name).
+		Body(deployment).
+		Do().
+		Into(result)
+
+	return
+}
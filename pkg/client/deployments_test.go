@@ -0,0 +1,133 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func TestDeploymentCreate(t *testing.T) {
+	ns := api.NamespaceDefault
+	deployment := &api.Deployment{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "abc",
+			Namespace: ns,
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "POST",
+			Path:   buildResourcePath(ns, "/deployments"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   deployment,
+		},
+		Response: Response{StatusCode: 200, Body: deployment},
+	}
+
+	response, err := c.Setup().Deployments(ns).Create(deployment)
+	c.Validate(t, response, err)
+}
+
+func TestDeploymentGet(t *testing.T) {
+	ns := api.NamespaceDefault
+	deployment := &api.Deployment{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "abc",
+			Namespace: ns,
+		},
+		Spec: api.DeploymentSpec{
+			Selector: map[string]string{"app": "abc"},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   buildResourcePath(ns, "/deployments/abc"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   nil,
+		},
+		Response: Response{StatusCode: 200, Body: deployment},
+	}
+
+	response, err := c.Setup().Deployments(ns).Get("abc")
+	c.Validate(t, response, err)
+}
+
+func TestDeploymentList(t *testing.T) {
+	ns := api.NamespaceDefault
+	deploymentList := &api.DeploymentList{
+		Items: []api.Deployment{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "foo"},
+			},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   buildResourcePath(ns, "/deployments"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   nil,
+		},
+		Response: Response{StatusCode: 200, Body: deploymentList},
+	}
+	response, err := c.Setup().Deployments(ns).List(labels.Everything(), labels.Everything())
+	c.Validate(t, response, err)
+}
+
+func TestDeploymentUpdate(t *testing.T) {
+	ns := api.NamespaceDefault
+	deployment := &api.Deployment{
+		ObjectMeta: api.ObjectMeta{
+			Name:            "abc",
+			Namespace:       ns,
+			ResourceVersion: "1",
+		},
+		Spec: api.DeploymentSpec{
+			Selector: map[string]string{"app": "abc"},
+		},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "PUT", Path: buildResourcePath(ns, "/deployments/abc"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200, Body: deployment},
+	}
+	response, err := c.Setup().Deployments(ns).Update(deployment)
+	c.Validate(t, response, err)
+}
+
+func TestDeploymentDelete(t *testing.T) {
+	ns := api.NamespaceDefault
+	c := &testClient{
+		Request:  testRequest{Method: "DELETE", Path: buildResourcePath(ns, "/deployments/foo"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200},
+	}
+	err := c.Setup().Deployments(ns).Delete("foo")
+	c.Validate(t, nil, err)
+}
+
+func TestDeploymentWatch(t *testing.T) {
+	c := &testClient{
+		Request:  testRequest{Method: "GET", Path: "/watch/deployments", Query: url.Values{"resourceVersion": []string{}}},
+		Response: Response{StatusCode: 200},
+	}
+	_, err := c.Setup().Deployments(api.NamespaceAll).Watch(labels.Everything(), labels.Everything(), "")
+	c.Validate(t, nil, err)
+}
@@ -38,6 +38,7 @@ type EventInterface interface {
 	List(label, field labels.Selector) (*api.EventList, error)
 	Get(name string) (*api.Event, error)
 	Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error)
+	Delete(name string) error
 	// Search finds events about the specified object
 	Search(objOrRef runtime.Object) (*api.EventList, error)
 }
@@ -123,6 +124,16 @@ func (e *events) Get(name string) (*api.Event, error) {
 	return result, err
 }
 
+// Delete deletes an existing event.
+func (e *events) Delete(name string) error {
+	return e.client.Delete().
+		NamespaceIfScoped(e.namespace, len(e.namespace) > 0).
+		Resource("events").
+		Name(name).
+		Do().
+		Error()
+}
+
 // Watch starts watching for events matching the given selectors.
 func (e *events) Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error) {
 	return e.client.Get().
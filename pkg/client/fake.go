@@ -34,22 +34,47 @@ type FakeAction struct {
 // Fake implements Interface. Meant to be embedded into a struct to get a default
 // implementation. This makes faking out just the method you want to test easier.
 type Fake struct {
-	Actions            []FakeAction
-	PodsList           api.PodList
-	CtrlList           api.ReplicationControllerList
-	Ctrl               api.ReplicationController
-	ServiceList        api.ServiceList
-	EndpointsList      api.EndpointsList
-	MinionsList        api.NodeList
-	EventsList         api.EventList
-	LimitRangesList    api.LimitRangeList
-	ResourceQuotasList api.ResourceQuotaList
-	ResourceQuotaUsage api.ResourceQuotaUsage
-	NamespacesList     api.NamespaceList
-	SecretList         api.SecretList
-	Secret             api.Secret
-	Err                error
-	Watch              watch.Interface
+	Actions                     []FakeAction
+	PodsList                    api.PodList
+	CtrlList                    api.ReplicationControllerList
+	Ctrl                        api.ReplicationController
+	ServiceList                 api.ServiceList
+	EndpointsList               api.EndpointsList
+	MinionsList                 api.NodeList
+	EventsList                  api.EventList
+	LimitRangesList             api.LimitRangeList
+	ResourceQuotasList          api.ResourceQuotaList
+	ResourceQuotaUsage          api.ResourceQuotaUsage
+	NamespacesList              api.NamespaceList
+	SecretList                  api.SecretList
+	Secret                      api.Secret
+	ServiceAccountList          api.ServiceAccountList
+	ServiceAccount              api.ServiceAccount
+	PersistentVolumeList        api.PersistentVolumeList
+	PersistentVolume            api.PersistentVolume
+	PersistentVolumeClaimList   api.PersistentVolumeClaimList
+	PersistentVolumeClaim       api.PersistentVolumeClaim
+	JobList                     api.JobList
+	Job                         api.Job
+	DaemonSetList               api.DaemonSetList
+	DaemonSet                   api.DaemonSet
+	DeploymentList              api.DeploymentList
+	Deployment                  api.Deployment
+	HorizontalPodAutoscalerList api.HorizontalPodAutoscalerList
+	HorizontalPodAutoscaler     api.HorizontalPodAutoscaler
+	ThirdPartyResourceList      api.ThirdPartyResourceList
+	ThirdPartyResource          api.ThirdPartyResource
+	ThirdPartyResourceDataList  api.ThirdPartyResourceDataList
+	RoleList                    api.RoleList
+	Role                        api.Role
+	RoleBindingList             api.RoleBindingList
+	RoleBinding                 api.RoleBinding
+	ClusterRoleList             api.ClusterRoleList
+	ClusterRole                 api.ClusterRole
+	ClusterRoleBindingList      api.ClusterRoleBindingList
+	ClusterRoleBinding          api.ClusterRoleBinding
+	Err                         error
+	Watch                       watch.Interface
 }
 
 func (c *Fake) LimitRanges(namespace string) LimitRangeInterface {
@@ -92,10 +117,62 @@ func (c *Fake) Secrets(namespace string) SecretsInterface {
 	return &FakeSecrets{Fake: c, Namespace: namespace}
 }
 
+func (c *Fake) ServiceAccounts(namespace string) ServiceAccountsInterface {
+	return &FakeServiceAccounts{Fake: c, Namespace: namespace}
+}
+
 func (c *Fake) Namespaces() NamespaceInterface {
 	return &FakeNamespaces{Fake: c}
 }
 
+func (c *Fake) PersistentVolumes() PersistentVolumeInterface {
+	return &FakePersistentVolumes{Fake: c}
+}
+
+func (c *Fake) PersistentVolumeClaims(namespace string) PersistentVolumeClaimInterface {
+	return &FakePersistentVolumeClaims{Fake: c, Namespace: namespace}
+}
+
+func (c *Fake) Jobs(namespace string) JobInterface {
+	return &FakeJobs{Fake: c, Namespace: namespace}
+}
+
+func (c *Fake) DaemonSets(namespace string) DaemonSetInterface {
+	return &FakeDaemonSets{Fake: c, Namespace: namespace}
+}
+
+func (c *Fake) Deployments(namespace string) DeploymentInterface {
+	return &FakeDeployments{Fake: c, Namespace: namespace}
+}
+
+func (c *Fake) HorizontalPodAutoscalers(namespace string) HorizontalPodAutoscalerInterface {
+	return &FakeHorizontalPodAutoscalers{Fake: c, Namespace: namespace}
+}
+
+func (c *Fake) ThirdPartyResources() ThirdPartyResourceInterface {
+	return &FakeThirdPartyResources{Fake: c}
+}
+
+func (c *Fake) ThirdPartyResourceData(group, version, kind, namespace string) ThirdPartyResourceDataInterface {
+	return &FakeThirdPartyResourceData{Fake: c, APIVersion: api.ThirdPartyAPIVersion(group, version), Kind: kind, Namespace: namespace}
+}
+
+func (c *Fake) Roles(namespace string) RoleInterface {
+	return &FakeRoles{Fake: c, Namespace: namespace}
+}
+
+func (c *Fake) RoleBindings(namespace string) RoleBindingInterface {
+	return &FakeRoleBindings{Fake: c, Namespace: namespace}
+}
+
+func (c *Fake) ClusterRoles() ClusterRoleInterface {
+	return &FakeClusterRoles{Fake: c}
+}
+
+func (c *Fake) ClusterRoleBindings() ClusterRoleBindingInterface {
+	return &FakeClusterRoleBindings{Fake: c}
+}
+
 func (c *Fake) ServerVersion() (*version.Info, error) {
 	c.Actions = append(c.Actions, FakeAction{Action: "get-version", Value: nil})
 	versionInfo := version.Get()
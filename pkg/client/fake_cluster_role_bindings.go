@@ -0,0 +1,59 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// FakeClusterRoleBindings implements ClusterRoleBindingsInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakeClusterRoleBindings struct {
+	Fake *Fake
+}
+
+func (c *FakeClusterRoleBindings) List(label, field labels.Selector) (*api.ClusterRoleBindingList, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "list-clusterrolebindings"})
+	return api.Scheme.CopyOrDie(&c.Fake.ClusterRoleBindingList).(*api.ClusterRoleBindingList), c.Fake.Err
+}
+
+func (c *FakeClusterRoleBindings) Get(name string) (*api.ClusterRoleBinding, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "get-clusterrolebinding", Value: name})
+	return api.Scheme.CopyOrDie(&c.Fake.ClusterRoleBinding).(*api.ClusterRoleBinding), c.Fake.Err
+}
+
+func (c *FakeClusterRoleBindings) Create(binding *api.ClusterRoleBinding) (*api.ClusterRoleBinding, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "create-clusterrolebinding", Value: binding})
+	return &api.ClusterRoleBinding{}, nil
+}
+
+func (c *FakeClusterRoleBindings) Update(binding *api.ClusterRoleBinding) (*api.ClusterRoleBinding, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "update-clusterrolebinding", Value: binding})
+	return &api.ClusterRoleBinding{}, nil
+}
+
+func (c *FakeClusterRoleBindings) Delete(name string) error {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "delete-clusterrolebinding", Value: name})
+	return nil
+}
+
+func (c *FakeClusterRoleBindings) Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "watch-clusterrolebindings", Value: resourceVersion})
+	return c.Fake.Watch, c.Fake.Err
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// FakeClusterRoles implements ClusterRolesInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakeClusterRoles struct {
+	Fake *Fake
+}
+
+func (c *FakeClusterRoles) List(label, field labels.Selector) (*api.ClusterRoleList, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "list-clusterroles"})
+	return api.Scheme.CopyOrDie(&c.Fake.ClusterRoleList).(*api.ClusterRoleList), c.Fake.Err
+}
+
+func (c *FakeClusterRoles) Get(name string) (*api.ClusterRole, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "get-clusterrole", Value: name})
+	return api.Scheme.CopyOrDie(&c.Fake.ClusterRole).(*api.ClusterRole), c.Fake.Err
+}
+
+func (c *FakeClusterRoles) Create(role *api.ClusterRole) (*api.ClusterRole, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "create-clusterrole", Value: role})
+	return &api.ClusterRole{}, nil
+}
+
+func (c *FakeClusterRoles) Update(role *api.ClusterRole) (*api.ClusterRole, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "update-clusterrole", Value: role})
+	return &api.ClusterRole{}, nil
+}
+
+func (c *FakeClusterRoles) Delete(name string) error {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "delete-clusterrole", Value: name})
+	return nil
+}
+
+func (c *FakeClusterRoles) Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "watch-clusterroles", Value: resourceVersion})
+	return c.Fake.Watch, c.Fake.Err
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2014 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// Fake implements DaemonSetInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the method you want to test easier.
+type FakeDaemonSets struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakeDaemonSets) List(labels, fields labels.Selector) (*api.DaemonSetList, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "list-daemonsets"})
+	return api.Scheme.CopyOrDie(&c.Fake.DaemonSetList).(*api.DaemonSetList), c.Fake.Err
+}
+
+func (c *FakeDaemonSets) Get(name string) (*api.DaemonSet, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "get-daemonset", Value: name})
+	return api.Scheme.CopyOrDie(&c.Fake.DaemonSet).(*api.DaemonSet), c.Fake.Err
+}
+
+func (c *FakeDaemonSets) Create(ds *api.DaemonSet) (*api.DaemonSet, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "create-daemonset", Value: ds})
+	return &api.DaemonSet{}, nil
+}
+
+func (c *FakeDaemonSets) Update(ds *api.DaemonSet) (*api.DaemonSet, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "update-daemonset", Value: ds})
+	return &api.DaemonSet{}, nil
+}
+
+func (c *FakeDaemonSets) Delete(name string) error {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "delete-daemonset", Value: name})
+	return nil
+}
+
+func (c *FakeDaemonSets) Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "watch-daemonsets", Value: resourceVersion})
+	return c.Fake.Watch, c.Fake.Err
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2014 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// Fake implements DeploymentInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the method you want to test easier.
+type FakeDeployments struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakeDeployments) List(labels, fields labels.Selector) (*api.DeploymentList, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "list-deployments"})
+	return api.Scheme.CopyOrDie(&c.Fake.DeploymentList).(*api.DeploymentList), c.Fake.Err
+}
+
+func (c *FakeDeployments) Get(name string) (*api.Deployment, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "get-deployment", Value: name})
+	return api.Scheme.CopyOrDie(&c.Fake.Deployment).(*api.Deployment), c.Fake.Err
+}
+
+func (c *FakeDeployments) Create(deployment *api.Deployment) (*api.Deployment, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "create-deployment", Value: deployment})
+	return &api.Deployment{}, nil
+}
+
+func (c *FakeDeployments) Update(deployment *api.Deployment) (*api.Deployment, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "update-deployment", Value: deployment})
+	return &api.Deployment{}, nil
+}
+
+func (c *FakeDeployments) Delete(name string) error {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "delete-deployment", Value: name})
+	return nil
+}
+
+func (c *FakeDeployments) Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "watch-deployments", Value: resourceVersion})
+	return c.Fake.Watch, c.Fake.Err
+}
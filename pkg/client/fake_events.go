@@ -59,6 +59,12 @@ func (c *FakeEvents) Watch(label, field labels.Selector, resourceVersion string)
 	return c.Fake.Watch, c.Fake.Err
 }
 
+// Delete deletes the given event.
+func (c *FakeEvents) Delete(name string) error {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "delete-event", Value: name})
+	return nil
+}
+
 // Search returns a list of events matching the specified object.
 func (c *FakeEvents) Search(objOrRef runtime.Object) (*api.EventList, error) {
 	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "search-events"})
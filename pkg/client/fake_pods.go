@@ -38,13 +38,13 @@ func (c *FakePods) Get(name string) (*api.Pod, error) {
 	return &api.Pod{ObjectMeta: api.ObjectMeta{Name: name, Namespace: c.Namespace}}, nil
 }
 
-func (c *FakePods) Delete(name string) error {
+func (c *FakePods) Delete(name string, options *api.DeleteOptions) error {
 	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "delete-pod", Value: name})
 	return nil
 }
 
 func (c *FakePods) Create(pod *api.Pod) (*api.Pod, error) {
-	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "create-pod"})
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "create-pod", Value: pod})
 	return &api.Pod{}, nil
 }
 
@@ -53,6 +53,11 @@ func (c *FakePods) Update(pod *api.Pod) (*api.Pod, error) {
 	return &api.Pod{}, nil
 }
 
+func (c *FakePods) Patch(name string, pt api.PatchType, data []byte) (*api.Pod, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "patch-pod", Value: name})
+	return &api.Pod{}, nil
+}
+
 func (c *FakePods) Bind(bind *api.Binding) error {
 	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "bind-pod", Value: bind.Name})
 	return nil
@@ -49,6 +49,11 @@ func (c *FakeReplicationControllers) Update(controller *api.ReplicationControlle
 	return &api.ReplicationController{}, nil
 }
 
+func (c *FakeReplicationControllers) Patch(name string, pt api.PatchType, data []byte) (*api.ReplicationController, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "patch-controller", Value: name})
+	return &api.ReplicationController{}, nil
+}
+
 func (c *FakeReplicationControllers) Delete(controller string) error {
 	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "delete-controller", Value: controller})
 	return nil
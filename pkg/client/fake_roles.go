@@ -0,0 +1,60 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// FakeRoles implements RoleInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the method you want to test easier.
+type FakeRoles struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakeRoles) List(label, field labels.Selector) (*api.RoleList, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "list-roles"})
+	return api.Scheme.CopyOrDie(&c.Fake.RoleList).(*api.RoleList), c.Fake.Err
+}
+
+func (c *FakeRoles) Get(name string) (*api.Role, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "get-role", Value: name})
+	return api.Scheme.CopyOrDie(&c.Fake.Role).(*api.Role), c.Fake.Err
+}
+
+func (c *FakeRoles) Create(role *api.Role) (*api.Role, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "create-role", Value: role})
+	return &api.Role{}, nil
+}
+
+func (c *FakeRoles) Update(role *api.Role) (*api.Role, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "update-role", Value: role})
+	return &api.Role{}, nil
+}
+
+func (c *FakeRoles) Delete(name string) error {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "delete-role", Value: name})
+	return nil
+}
+
+func (c *FakeRoles) Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "watch-roles", Value: resourceVersion})
+	return c.Fake.Watch, c.Fake.Err
+}
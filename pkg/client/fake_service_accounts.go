@@ -0,0 +1,60 @@
+/*
+Copyright 2014 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// Fake implements ServiceAccountsInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the method you want to test easier.
+type FakeServiceAccounts struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakeServiceAccounts) List(labels, fields labels.Selector) (*api.ServiceAccountList, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "list-serviceaccounts"})
+	return &c.Fake.ServiceAccountList, c.Fake.Err
+}
+
+func (c *FakeServiceAccounts) Get(name string) (*api.ServiceAccount, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "get-serviceaccount", Value: name})
+	return api.Scheme.CopyOrDie(&c.Fake.ServiceAccount).(*api.ServiceAccount), c.Fake.Err
+}
+
+func (c *FakeServiceAccounts) Create(serviceAccount *api.ServiceAccount) (*api.ServiceAccount, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "create-serviceaccount", Value: serviceAccount})
+	return &api.ServiceAccount{}, nil
+}
+
+func (c *FakeServiceAccounts) Update(serviceAccount *api.ServiceAccount) (*api.ServiceAccount, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "update-serviceaccount", Value: serviceAccount})
+	return &api.ServiceAccount{}, nil
+}
+
+func (c *FakeServiceAccounts) Delete(name string) error {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "delete-serviceaccount", Value: name})
+	return nil
+}
+
+func (c *FakeServiceAccounts) Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "watch-serviceaccounts", Value: resourceVersion})
+	return c.Fake.Watch, c.Fake.Err
+}
@@ -49,6 +49,11 @@ func (c *FakeServices) Update(service *api.Service) (*api.Service, error) {
 	return &api.Service{}, nil
 }
 
+func (c *FakeServices) Patch(name string, pt api.PatchType, data []byte) (*api.Service, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "patch-service", Value: name})
+	return &api.Service{}, nil
+}
+
 func (c *FakeServices) Delete(service string) error {
 	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "delete-service", Value: service})
 	return nil
@@ -0,0 +1,41 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+// FakeThirdPartyResourceData implements ThirdPartyResourceDataInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakeThirdPartyResourceData struct {
+	Fake       *Fake
+	APIVersion string
+	Kind       string
+	Namespace  string
+}
+
+func (c *FakeThirdPartyResourceData) List(label, field labels.Selector) (*api.ThirdPartyResourceDataList, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "list-thirdpartyresourcedata", Value: c.APIVersion + "/" + c.Kind})
+	return api.Scheme.CopyOrDie(&c.Fake.ThirdPartyResourceDataList).(*api.ThirdPartyResourceDataList), c.Fake.Err
+}
+
+func (c *FakeThirdPartyResourceData) Delete(name string) error {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "delete-thirdpartyresourcedata", Value: name})
+	return nil
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// FakeThirdPartyResources implements ThirdPartyResourcesInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakeThirdPartyResources struct {
+	Fake *Fake
+}
+
+func (c *FakeThirdPartyResources) List(label, field labels.Selector) (*api.ThirdPartyResourceList, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "list-thirdpartyresources"})
+	return api.Scheme.CopyOrDie(&c.Fake.ThirdPartyResourceList).(*api.ThirdPartyResourceList), c.Fake.Err
+}
+
+func (c *FakeThirdPartyResources) Get(name string) (*api.ThirdPartyResource, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "get-thirdpartyresource", Value: name})
+	return api.Scheme.CopyOrDie(&c.Fake.ThirdPartyResource).(*api.ThirdPartyResource), c.Fake.Err
+}
+
+func (c *FakeThirdPartyResources) Create(resource *api.ThirdPartyResource) (*api.ThirdPartyResource, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "create-thirdpartyresource", Value: resource})
+	return &api.ThirdPartyResource{}, nil
+}
+
+func (c *FakeThirdPartyResources) Update(resource *api.ThirdPartyResource) (*api.ThirdPartyResource, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "update-thirdpartyresource", Value: resource})
+	return &api.ThirdPartyResource{}, nil
+}
+
+func (c *FakeThirdPartyResources) Delete(name string) error {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "delete-thirdpartyresource", Value: name})
+	return nil
+}
+
+func (c *FakeThirdPartyResources) Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "watch-thirdpartyresources", Value: resourceVersion})
+	return c.Fake.Watch, c.Fake.Err
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+type HorizontalPodAutoscalersNamespacer interface {
+	HorizontalPodAutoscalers(namespace string) HorizontalPodAutoscalerInterface
+}
+
+type HorizontalPodAutoscalerInterface interface {
+	Create(autoscaler *api.HorizontalPodAutoscaler) (*api.HorizontalPodAutoscaler, error)
+	Update(autoscaler *api.HorizontalPodAutoscaler) (*api.HorizontalPodAutoscaler, error)
+	Delete(name string) error
+	List(label, field labels.Selector) (*api.HorizontalPodAutoscalerList, error)
+	Get(name string) (*api.HorizontalPodAutoscaler, error)
+	Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// horizontalPodAutoscalers implements HorizontalPodAutoscalers interface
+type horizontalPodAutoscalers struct {
+	client    *Client
+	namespace string
+}
+
+// newHorizontalPodAutoscalers returns a new horizontalPodAutoscalers object.
+func newHorizontalPodAutoscalers(c *Client, ns string) *horizontalPodAutoscalers {
+	return &horizontalPodAutoscalers{
+		client:    c,
+		namespace: ns,
+	}
+}
+
+func (s *horizontalPodAutoscalers) Create(autoscaler *api.HorizontalPodAutoscaler) (*api.HorizontalPodAutoscaler, error) {
+	if s.namespace != "" && autoscaler.Namespace != s.namespace {
+		return nil, fmt.Errorf("can't create a horizontal pod autoscaler with namespace '%v' in namespace '%v'", autoscaler.Namespace, s.namespace)
+	}
+
+	result := &api.HorizontalPodAutoscaler{}
+	err := s.client.Post().
+		Namespace(autoscaler.Namespace).
+		Resource("horizontalPodAutoscalers").
+		Body(autoscaler).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+// List returns a list of horizontal pod autoscalers matching the selectors.
+func (s *horizontalPodAutoscalers) List(label, field labels.Selector) (*api.HorizontalPodAutoscalerList, error) {
+	result := &api.HorizontalPodAutoscalerList{}
+
+	err := s.client.Get().
+		Namespace(s.namespace).
+		Resource("horizontalPodAutoscalers").
+		SelectorParam("labels", label).
+		SelectorParam("fields", field).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+// Get returns the given autoscaler, or an error.
+func (s *horizontalPodAutoscalers) Get(name string) (*api.HorizontalPodAutoscaler, error) {
+	if len(name) == 0 {
+		return nil, errors.New("name is required parameter to Get")
+	}
+
+	result := &api.HorizontalPodAutoscaler{}
+	err := s.client.Get().
+		Namespace(s.namespace).
+		Resource("horizontalPodAutoscalers").
+		Name(name).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+// Watch starts watching for horizontal pod autoscalers matching the given selectors.
+func (s *horizontalPodAutoscalers) Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error) {
+	return s.client.Get().
+		Prefix("watch").
+		Namespace(s.namespace).
+		Resource("horizontalPodAutoscalers").
+		Param("resourceVersion", resourceVersion).
+		SelectorParam("labels", label).
+		SelectorParam("fields", field).
+		Watch()
+}
+
+func (s *horizontalPodAutoscalers) Delete(name string) error {
+	return s.client.Delete().
+		Namespace(s.namespace).
+		Resource("horizontalPodAutoscalers").
+		Name(name).
+		Do().
+		Error()
+}
+
+func (s *horizontalPodAutoscalers) Update(autoscaler *api.HorizontalPodAutoscaler) (result *api.HorizontalPodAutoscaler, err error) {
+	result = &api.HorizontalPodAutoscaler{}
+	if len(autoscaler.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", autoscaler)
+		return
+	}
+
+	err = s.client.Put().
+		Namespace(s.namespace).
+		Resource("horizontalPodAutoscalers").
+		Name(autoscaler.Name).
+		Body(autoscaler).
+		Do().
+		Into(result)
+
+	return
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func TestHorizontalPodAutoscalerCreate(t *testing.T) {
+	ns := api.NamespaceDefault
+	autoscaler := &api.HorizontalPodAutoscaler{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "abc",
+			Namespace: ns,
+		},
+		Spec: api.HorizontalPodAutoscalerSpec{
+			ScaleRef:                       api.ObjectReference{Kind: "ReplicationController", Name: "abc"},
+			MinReplicas:                    1,
+			MaxReplicas:                    3,
+			TargetCPUUtilizationPercentage: 80,
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "POST",
+			Path:   buildResourcePath(ns, "/horizontalPodAutoscalers"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   autoscaler,
+		},
+		Response: Response{StatusCode: 200, Body: autoscaler},
+	}
+
+	response, err := c.Setup().HorizontalPodAutoscalers(ns).Create(autoscaler)
+	c.Validate(t, response, err)
+}
+
+func TestHorizontalPodAutoscalerGet(t *testing.T) {
+	ns := api.NamespaceDefault
+	autoscaler := &api.HorizontalPodAutoscaler{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "abc",
+			Namespace: ns,
+		},
+		Spec: api.HorizontalPodAutoscalerSpec{
+			ScaleRef:                       api.ObjectReference{Kind: "ReplicationController", Name: "abc"},
+			MinReplicas:                    1,
+			MaxReplicas:                    3,
+			TargetCPUUtilizationPercentage: 80,
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   buildResourcePath(ns, "/horizontalPodAutoscalers/abc"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   nil,
+		},
+		Response: Response{StatusCode: 200, Body: autoscaler},
+	}
+
+	response, err := c.Setup().HorizontalPodAutoscalers(ns).Get("abc")
+	c.Validate(t, response, err)
+}
+
+func TestHorizontalPodAutoscalerList(t *testing.T) {
+	ns := api.NamespaceDefault
+	autoscalerList := &api.HorizontalPodAutoscalerList{
+		Items: []api.HorizontalPodAutoscaler{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "foo"},
+				Spec: api.HorizontalPodAutoscalerSpec{
+					ScaleRef:                       api.ObjectReference{Kind: "ReplicationController", Name: "foo"},
+					MinReplicas:                    1,
+					MaxReplicas:                    3,
+					TargetCPUUtilizationPercentage: 80,
+				},
+			},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   buildResourcePath(ns, "/horizontalPodAutoscalers"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   nil,
+		},
+		Response: Response{StatusCode: 200, Body: autoscalerList},
+	}
+	response, err := c.Setup().HorizontalPodAutoscalers(ns).List(labels.Everything(), labels.Everything())
+	c.Validate(t, response, err)
+}
+
+func TestHorizontalPodAutoscalerUpdate(t *testing.T) {
+	ns := api.NamespaceDefault
+	autoscaler := &api.HorizontalPodAutoscaler{
+		ObjectMeta: api.ObjectMeta{
+			Name:            "abc",
+			Namespace:       ns,
+			ResourceVersion: "1",
+		},
+		Spec: api.HorizontalPodAutoscalerSpec{
+			ScaleRef:                       api.ObjectReference{Kind: "ReplicationController", Name: "abc"},
+			MinReplicas:                    1,
+			MaxReplicas:                    3,
+			TargetCPUUtilizationPercentage: 80,
+		},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "PUT", Path: buildResourcePath(ns, "/horizontalPodAutoscalers/abc"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200, Body: autoscaler},
+	}
+	response, err := c.Setup().HorizontalPodAutoscalers(ns).Update(autoscaler)
+	c.Validate(t, response, err)
+}
+
+func TestHorizontalPodAutoscalerDelete(t *testing.T) {
+	ns := api.NamespaceDefault
+	c := &testClient{
+		Request:  testRequest{Method: "DELETE", Path: buildResourcePath(ns, "/horizontalPodAutoscalers/foo"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200},
+	}
+	err := c.Setup().HorizontalPodAutoscalers(ns).Delete("foo")
+	c.Validate(t, nil, err)
+}
+
+func TestHorizontalPodAutoscalerWatch(t *testing.T) {
+	c := &testClient{
+		Request:  testRequest{Method: "GET", Path: "/watch/horizontalPodAutoscalers", Query: url.Values{"resourceVersion": []string{}}},
+		Response: Response{StatusCode: 200},
+	}
+	_, err := c.Setup().HorizontalPodAutoscalers(api.NamespaceAll).Watch(labels.Everything(), labels.Everything(), "")
+	c.Validate(t, nil, err)
+}
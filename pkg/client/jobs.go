@@ -0,0 +1,140 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+type JobsNamespacer interface {
+	Jobs(namespace string) JobInterface
+}
+
+type JobInterface interface {
+	Create(job *api.Job) (*api.Job, error)
+	Update(job *api.Job) (*api.Job, error)
+	Delete(name string) error
+	List(label, field labels.Selector) (*api.JobList, error)
+	Get(name string) (*api.Job, error)
+	Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// jobs implements Jobs interface
+type jobs struct {
+	client    *Client
+	namespace string
+}
+
+// newJobs returns a new jobs object.
+func newJobs(c *Client, ns string) *jobs {
+	return &jobs{
+		client:    c,
+		namespace: ns,
+	}
+}
+
+func (s *jobs) Create(job *api.Job) (*api.Job, error) {
+	if s.namespace != "" && job.Namespace != s.namespace {
+		return nil, fmt.Errorf("can't create a job with namespace '%v' in namespace '%v'", job.Namespace, s.namespace)
+	}
+
+	result := &api.Job{}
+	err := s.client.Post().
+		Namespace(job.Namespace).
+		Resource("jobs").
+		Body(job).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+// List returns a list of jobs matching the selectors.
+func (s *jobs) List(label, field labels.Selector) (*api.JobList, error) {
+	result := &api.JobList{}
+
+	err := s.client.Get().
+		Namespace(s.namespace).
+		Resource("jobs").
+		SelectorParam("labels", label).
+		SelectorParam("fields", field).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+// Get returns the given job, or an error.
+func (s *jobs) Get(name string) (*api.Job, error) {
+	if len(name) == 0 {
+		return nil, errors.New("name is required parameter to Get")
+	}
+
+	result := &api.Job{}
+	err := s.client.Get().
+		Namespace(s.namespace).
+		Resource("jobs").
+		Name(name).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+// Watch starts watching for jobs matching the given selectors.
+func (s *jobs) Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error) {
+	return s.client.Get().
+		Prefix("watch").
+		Namespace(s.namespace).
+		Resource("jobs").
+		Param("resourceVersion", resourceVersion).
+		SelectorParam("labels", label).
+		SelectorParam("fields", field).
+		Watch()
+}
+
+func (s *jobs) Delete(name string) error {
+	return s.client.Delete().
+		Namespace(s.namespace).
+		Resource("jobs").
+		Name(name).
+		Do().
+		Error()
+}
+
+func (s *jobs) Update(job *api.Job) (result *api.Job, err error) {
+	result = &api.Job{}
+	if len(job.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", job)
+		return
+	}
+
+	err = s.client.Put().
+		Namespace(s.namespace).
+		Resource("jobs").
+		Name(job.Name).
+		Body(job).
+		Do().
+		Into(result)
+
+	return
+}
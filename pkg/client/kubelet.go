@@ -61,6 +61,19 @@ type HTTPKubeletClient struct {
 
 // TODO: this structure is questionable, it should be using client.Config and overriding defaults.
 func NewKubeletClient(config *KubeletConfig) (KubeletClient, error) {
+	c, err := kubeletHTTPClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return &HTTPKubeletClient{
+		Client:      c,
+		Port:        config.Port,
+		EnableHttps: config.EnableHttps,
+	}, nil
+}
+
+// kubeletHTTPClient returns an http.Client that uses the TLS settings of config.
+func kubeletHTTPClient(config *KubeletConfig) (*http.Client, error) {
 	transport := http.DefaultTransport
 
 	tlsConfig, err := TLSConfigFor(&Config{
@@ -75,13 +88,8 @@ func NewKubeletClient(config *KubeletConfig) (KubeletClient, error) {
 		}
 	}
 
-	c := &http.Client{
+	return &http.Client{
 		Transport: transport,
-	}
-	return &HTTPKubeletClient{
-		Client:      c,
-		Port:        config.Port,
-		EnableHttps: config.EnableHttps,
 	}, nil
 }
 
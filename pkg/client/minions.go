@@ -21,6 +21,8 @@ import (
 	"fmt"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
 )
 
 type NodesInterface interface {
@@ -33,6 +35,8 @@ type NodeInterface interface {
 	List() (*api.NodeList, error)
 	Delete(name string) error
 	Update(*api.Node) (*api.Node, error)
+	Patch(name string, pt api.PatchType, data []byte) (*api.Node, error)
+	Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error)
 }
 
 // nodes implements NodesInterface
@@ -64,7 +68,7 @@ func (c *nodes) Create(minion *api.Node) (*api.Node, error) {
 // List lists all the nodes in the cluster.
 func (c *nodes) List() (*api.NodeList, error) {
 	result := &api.NodeList{}
-	err := c.r.Get().Resource(c.resourceName()).Do().Into(result)
+	err := c.r.Get().Resource(c.resourceName()).PageSize(DefaultPageSize).Do().Into(result)
 	return result, err
 }
 
@@ -94,3 +98,21 @@ func (c *nodes) Update(minion *api.Node) (*api.Node, error) {
 	err := c.r.Put().Resource(c.resourceName()).Name(minion.Name).Body(minion).Do().Into(result)
 	return result, err
 }
+
+// Patch applies a patch of the given type to the named node.
+func (c *nodes) Patch(name string, pt api.PatchType, data []byte) (*api.Node, error) {
+	result := &api.Node{}
+	err := c.r.Patch(pt).Resource(c.resourceName()).Name(name).Body(data).Do().Into(result)
+	return result, err
+}
+
+// Watch starts watching for nodes matching the given selectors.
+func (c *nodes) Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.r.Get().
+		Prefix("watch").
+		Resource(c.resourceName()).
+		Param("resourceVersion", resourceVersion).
+		SelectorParam("labels", label).
+		SelectorParam("fields", field).
+		Watch()
+}
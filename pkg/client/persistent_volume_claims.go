@@ -0,0 +1,140 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+type PersistentVolumeClaimsNamespacer interface {
+	PersistentVolumeClaims(namespace string) PersistentVolumeClaimInterface
+}
+
+type PersistentVolumeClaimInterface interface {
+	Create(claim *api.PersistentVolumeClaim) (*api.PersistentVolumeClaim, error)
+	Update(claim *api.PersistentVolumeClaim) (*api.PersistentVolumeClaim, error)
+	Delete(name string) error
+	List(label, field labels.Selector) (*api.PersistentVolumeClaimList, error)
+	Get(name string) (*api.PersistentVolumeClaim, error)
+	Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// persistentVolumeClaims implements PersistentVolumeClaims interface
+type persistentVolumeClaims struct {
+	client    *Client
+	namespace string
+}
+
+// newPersistentVolumeClaims returns a new persistentVolumeClaims object.
+func newPersistentVolumeClaims(c *Client, ns string) *persistentVolumeClaims {
+	return &persistentVolumeClaims{
+		client:    c,
+		namespace: ns,
+	}
+}
+
+func (s *persistentVolumeClaims) Create(claim *api.PersistentVolumeClaim) (*api.PersistentVolumeClaim, error) {
+	if s.namespace != "" && claim.Namespace != s.namespace {
+		return nil, fmt.Errorf("can't create a persistent volume claim with namespace '%v' in namespace '%v'", claim.Namespace, s.namespace)
+	}
+
+	result := &api.PersistentVolumeClaim{}
+	err := s.client.Post().
+		Namespace(claim.Namespace).
+		Resource("persistentVolumeClaims").
+		Body(claim).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+// List returns a list of persistent volume claims matching the selectors.
+func (s *persistentVolumeClaims) List(label, field labels.Selector) (*api.PersistentVolumeClaimList, error) {
+	result := &api.PersistentVolumeClaimList{}
+
+	err := s.client.Get().
+		Namespace(s.namespace).
+		Resource("persistentVolumeClaims").
+		SelectorParam("labels", label).
+		SelectorParam("fields", field).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+// Get returns the given persistent volume claim, or an error.
+func (s *persistentVolumeClaims) Get(name string) (*api.PersistentVolumeClaim, error) {
+	if len(name) == 0 {
+		return nil, errors.New("name is required parameter to Get")
+	}
+
+	result := &api.PersistentVolumeClaim{}
+	err := s.client.Get().
+		Namespace(s.namespace).
+		Resource("persistentVolumeClaims").
+		Name(name).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+// Watch starts watching for persistent volume claims matching the given selectors.
+func (s *persistentVolumeClaims) Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error) {
+	return s.client.Get().
+		Prefix("watch").
+		Namespace(s.namespace).
+		Resource("persistentVolumeClaims").
+		Param("resourceVersion", resourceVersion).
+		SelectorParam("labels", label).
+		SelectorParam("fields", field).
+		Watch()
+}
+
+func (s *persistentVolumeClaims) Delete(name string) error {
+	return s.client.Delete().
+		Namespace(s.namespace).
+		Resource("persistentVolumeClaims").
+		Name(name).
+		Do().
+		Error()
+}
+
+func (s *persistentVolumeClaims) Update(claim *api.PersistentVolumeClaim) (result *api.PersistentVolumeClaim, err error) {
+	result = &api.PersistentVolumeClaim{}
+	if len(claim.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", claim)
+		return
+	}
+
+	err = s.client.Put().
+		Namespace(s.namespace).
+		Resource("persistentVolumeClaims").
+		Name(claim.Name).
+		Body(claim).
+		Do().
+		Into(result)
+
+	return
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func TestPersistentVolumeClaimCreate(t *testing.T) {
+	ns := api.NamespaceDefault
+	claim := &api.PersistentVolumeClaim{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "abc",
+			Namespace: ns,
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "POST",
+			Path:   buildResourcePath(ns, "/persistentVolumeClaims"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   claim,
+		},
+		Response: Response{StatusCode: 200, Body: claim},
+	}
+
+	response, err := c.Setup().PersistentVolumeClaims(ns).Create(claim)
+	c.Validate(t, response, err)
+}
+
+func TestPersistentVolumeClaimGet(t *testing.T) {
+	ns := api.NamespaceDefault
+	claim := &api.PersistentVolumeClaim{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "abc",
+			Namespace: ns,
+		},
+		Spec: api.PersistentVolumeClaimSpec{
+			AccessModes: []api.AccessModeType{api.ReadWriteOnce},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   buildResourcePath(ns, "/persistentVolumeClaims/abc"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   nil,
+		},
+		Response: Response{StatusCode: 200, Body: claim},
+	}
+
+	response, err := c.Setup().PersistentVolumeClaims(ns).Get("abc")
+	c.Validate(t, response, err)
+}
+
+func TestPersistentVolumeClaimList(t *testing.T) {
+	ns := api.NamespaceDefault
+	claimList := &api.PersistentVolumeClaimList{
+		Items: []api.PersistentVolumeClaim{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "foo"},
+			},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   buildResourcePath(ns, "/persistentVolumeClaims"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   nil,
+		},
+		Response: Response{StatusCode: 200, Body: claimList},
+	}
+	response, err := c.Setup().PersistentVolumeClaims(ns).List(labels.Everything(), labels.Everything())
+	c.Validate(t, response, err)
+}
+
+func TestPersistentVolumeClaimUpdate(t *testing.T) {
+	ns := api.NamespaceDefault
+	claim := &api.PersistentVolumeClaim{
+		ObjectMeta: api.ObjectMeta{
+			Name:            "abc",
+			Namespace:       ns,
+			ResourceVersion: "1",
+		},
+		Spec: api.PersistentVolumeClaimSpec{
+			AccessModes: []api.AccessModeType{api.ReadWriteOnce},
+		},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "PUT", Path: buildResourcePath(ns, "/persistentVolumeClaims/abc"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200, Body: claim},
+	}
+	response, err := c.Setup().PersistentVolumeClaims(ns).Update(claim)
+	c.Validate(t, response, err)
+}
+
+func TestPersistentVolumeClaimDelete(t *testing.T) {
+	ns := api.NamespaceDefault
+	c := &testClient{
+		Request:  testRequest{Method: "DELETE", Path: buildResourcePath(ns, "/persistentVolumeClaims/foo"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200},
+	}
+	err := c.Setup().PersistentVolumeClaims(ns).Delete("foo")
+	c.Validate(t, nil, err)
+}
+
+func TestPersistentVolumeClaimWatch(t *testing.T) {
+	c := &testClient{
+		Request:  testRequest{Method: "GET", Path: "/watch/persistentVolumeClaims", Query: url.Values{"resourceVersion": []string{}}},
+		Response: Response{StatusCode: 200},
+	}
+	_, err := c.Setup().PersistentVolumeClaims(api.NamespaceAll).Watch(labels.Everything(), labels.Everything(), "")
+	c.Validate(t, nil, err)
+}
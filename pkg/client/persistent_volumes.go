@@ -0,0 +1,106 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+type PersistentVolumesInterface interface {
+	PersistentVolumes() PersistentVolumeInterface
+}
+
+type PersistentVolumeInterface interface {
+	Create(volume *api.PersistentVolume) (*api.PersistentVolume, error)
+	Update(volume *api.PersistentVolume) (*api.PersistentVolume, error)
+	Delete(name string) error
+	List(label, field labels.Selector) (*api.PersistentVolumeList, error)
+	Get(name string) (*api.PersistentVolume, error)
+	Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// persistentVolumes implements PersistentVolumesInterface
+type persistentVolumes struct {
+	client *Client
+}
+
+// newPersistentVolumes returns a persistentVolumes object.
+func newPersistentVolumes(c *Client) *persistentVolumes {
+	return &persistentVolumes{client: c}
+}
+
+// Create creates a new persistent volume.
+func (c *persistentVolumes) Create(volume *api.PersistentVolume) (*api.PersistentVolume, error) {
+	result := &api.PersistentVolume{}
+	err := c.client.Post().Resource("persistentVolumes").Body(volume).Do().Into(result)
+	return result, err
+}
+
+// List lists all the persistent volumes in the cluster matching the selectors.
+func (c *persistentVolumes) List(label, field labels.Selector) (*api.PersistentVolumeList, error) {
+	result := &api.PersistentVolumeList{}
+	err := c.client.Get().
+		Resource("persistentVolumes").
+		SelectorParam("labels", label).
+		SelectorParam("fields", field).
+		Do().
+		Into(result)
+	return result, err
+}
+
+// Update takes the representation of a persistent volume to update.  Returns the server's representation of the persistent volume, and an error, if it occurs.
+func (c *persistentVolumes) Update(volume *api.PersistentVolume) (result *api.PersistentVolume, err error) {
+	result = &api.PersistentVolume{}
+	if len(volume.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", volume)
+		return
+	}
+	err = c.client.Put().Resource("persistentVolumes").Name(volume.Name).Body(volume).Do().Into(result)
+	return
+}
+
+// Get gets an existing persistent volume.
+func (c *persistentVolumes) Get(name string) (*api.PersistentVolume, error) {
+	if len(name) == 0 {
+		return nil, errors.New("name is required parameter to Get")
+	}
+
+	result := &api.PersistentVolume{}
+	err := c.client.Get().Resource("persistentVolumes").Name(name).Do().Into(result)
+	return result, err
+}
+
+// Delete deletes an existing persistent volume.
+func (c *persistentVolumes) Delete(name string) error {
+	return c.client.Delete().Resource("persistentVolumes").Name(name).Do().Error()
+}
+
+// Watch returns a watch.Interface that watches the requested persistent volumes.
+func (c *persistentVolumes) Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.client.Get().
+		Prefix("watch").
+		Resource("persistentVolumes").
+		Param("resourceVersion", resourceVersion).
+		SelectorParam("labels", label).
+		SelectorParam("fields", field).
+		Watch()
+}
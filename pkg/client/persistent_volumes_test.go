@@ -0,0 +1,116 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func TestPersistentVolumeCreate(t *testing.T) {
+	pv := &api.PersistentVolume{
+		ObjectMeta: api.ObjectMeta{Name: "abc"},
+		Spec: api.PersistentVolumeSpec{
+			Capacity: api.ResourceList{
+				api.ResourceStorage: resource.MustParse("10G"),
+			},
+			PersistentVolumeSource: api.PersistentVolumeSource{
+				HostPath: &api.HostPathVolumeSource{Path: "/foo"},
+			},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "POST",
+			Path:   "/persistentVolumes",
+			Body:   pv,
+		},
+		Response: Response{StatusCode: 200, Body: pv},
+	}
+
+	response, err := c.Setup().PersistentVolumes().Create(pv)
+	c.Validate(t, response, err)
+}
+
+func TestPersistentVolumeGet(t *testing.T) {
+	pv := &api.PersistentVolume{
+		ObjectMeta: api.ObjectMeta{Name: "abc"},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "GET", Path: "/persistentVolumes/abc"},
+		Response: Response{StatusCode: 200, Body: pv},
+	}
+
+	response, err := c.Setup().PersistentVolumes().Get("abc")
+	c.Validate(t, response, err)
+}
+
+func TestPersistentVolumeList(t *testing.T) {
+	pvList := &api.PersistentVolumeList{
+		Items: []api.PersistentVolume{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "foo"},
+			},
+		},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "GET", Path: "/persistentVolumes"},
+		Response: Response{StatusCode: 200, Body: pvList},
+	}
+	response, err := c.Setup().PersistentVolumes().List(labels.Everything(), labels.Everything())
+	c.Validate(t, response, err)
+}
+
+func TestPersistentVolumeUpdate(t *testing.T) {
+	pv := &api.PersistentVolume{
+		ObjectMeta: api.ObjectMeta{
+			Name:            "abc",
+			ResourceVersion: "1",
+		},
+		Spec: api.PersistentVolumeSpec{
+			ClaimRef: &api.ObjectReference{Namespace: "default", Name: "claim"},
+		},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "PUT", Path: "/persistentVolumes/abc"},
+		Response: Response{StatusCode: 200, Body: pv},
+	}
+	response, err := c.Setup().PersistentVolumes().Update(pv)
+	c.Validate(t, response, err)
+}
+
+func TestPersistentVolumeDelete(t *testing.T) {
+	c := &testClient{
+		Request:  testRequest{Method: "DELETE", Path: "/persistentVolumes/foo"},
+		Response: Response{StatusCode: 200},
+	}
+	err := c.Setup().PersistentVolumes().Delete("foo")
+	c.Validate(t, nil, err)
+}
+
+func TestPersistentVolumeWatch(t *testing.T) {
+	c := &testClient{
+		Request:  testRequest{Method: "GET", Path: "/watch/persistentVolumes", Query: url.Values{"resourceVersion": []string{}}},
+		Response: Response{StatusCode: 200},
+	}
+	_, err := c.Setup().PersistentVolumes().Watch(labels.Everything(), labels.Everything(), "")
+	c.Validate(t, nil, err)
+}
@@ -33,9 +33,10 @@ type PodsNamespacer interface {
 type PodInterface interface {
 	List(selector labels.Selector) (*api.PodList, error)
 	Get(name string) (*api.Pod, error)
-	Delete(name string) error
+	Delete(name string, options *api.DeleteOptions) error
 	Create(pod *api.Pod) (*api.Pod, error)
 	Update(pod *api.Pod) (*api.Pod, error)
+	Patch(name string, pt api.PatchType, data []byte) (*api.Pod, error)
 
 	Bind(binding *api.Binding) error
 }
@@ -57,7 +58,7 @@ func newPods(c *Client, namespace string) *pods {
 // List takes a selector, and returns the list of pods that match that selector.
 func (c *pods) List(selector labels.Selector) (result *api.PodList, err error) {
 	result = &api.PodList{}
-	err = c.r.Get().Namespace(c.ns).Resource("pods").SelectorParam("labels", selector).Do().Into(result)
+	err = c.r.Get().Namespace(c.ns).Resource("pods").SelectorParam("labels", selector).PageSize(DefaultPageSize).Do().Into(result)
 	return
 }
 
@@ -72,9 +73,13 @@ func (c *pods) Get(name string) (result *api.Pod, err error) {
 	return
 }
 
-// DeletePod takes the name of the pod, and returns an error if one occurs
-func (c *pods) Delete(name string) error {
-	return c.r.Delete().Namespace(c.ns).Resource("pods").Name(name).Do().Error()
+// DeletePod takes the name of the pod and optional delete options, and returns an error if one occurs
+func (c *pods) Delete(name string, options *api.DeleteOptions) error {
+	req := c.r.Delete().Namespace(c.ns).Resource("pods").Name(name)
+	if options != nil {
+		req = req.Body(options)
+	}
+	return req.Do().Error()
 }
 
 // CreatePod takes the representation of a pod.  Returns the server's representation of the pod, and an error, if it occurs.
@@ -95,6 +100,13 @@ func (c *pods) Update(pod *api.Pod) (result *api.Pod, err error) {
 	return
 }
 
+// Patch applies a patch of the given type to the named pod.
+func (c *pods) Patch(name string, pt api.PatchType, data []byte) (result *api.Pod, err error) {
+	result = &api.Pod{}
+	err = c.r.Patch(pt).Namespace(c.ns).Resource("pods").Name(name).Body(data).Do().Into(result)
+	return
+}
+
 // Bind applies the provided binding to the named pod in the current namespace (binding.Namespace is ignored).
 func (c *pods) Bind(binding *api.Binding) error {
 	return c.r.Post().Namespace(c.ns).Resource("pods").Name(binding.Name).SubResource("binding").Body(binding).Do().Error()
@@ -36,6 +36,7 @@ type ReplicationControllerInterface interface {
 	Get(name string) (*api.ReplicationController, error)
 	Create(ctrl *api.ReplicationController) (*api.ReplicationController, error)
 	Update(ctrl *api.ReplicationController) (*api.ReplicationController, error)
+	Patch(name string, pt api.PatchType, data []byte) (*api.ReplicationController, error)
 	Delete(name string) error
 	Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error)
 }
@@ -54,7 +55,7 @@ func newReplicationControllers(c *Client, namespace string) *replicationControll
 // List takes a selector, and returns the list of replication controllers that match that selector.
 func (c *replicationControllers) List(selector labels.Selector) (result *api.ReplicationControllerList, err error) {
 	result = &api.ReplicationControllerList{}
-	err = c.r.Get().Namespace(c.ns).Resource("replicationControllers").SelectorParam("labels", selector).Do().Into(result)
+	err = c.r.Get().Namespace(c.ns).Resource("replicationControllers").SelectorParam("labels", selector).PageSize(DefaultPageSize).Do().Into(result)
 	return
 }
 
@@ -87,6 +88,13 @@ func (c *replicationControllers) Update(controller *api.ReplicationController) (
 	return
 }
 
+// Patch applies a patch of the given type to the named replication controller.
+func (c *replicationControllers) Patch(name string, pt api.PatchType, data []byte) (result *api.ReplicationController, err error) {
+	result = &api.ReplicationController{}
+	err = c.r.Patch(pt).Namespace(c.ns).Resource("replicationControllers").Name(name).Body(data).Do().Into(result)
+	return
+}
+
 // Delete deletes an existing replication controller.
 func (c *replicationControllers) Delete(name string) error {
 	return c.r.Delete().Namespace(c.ns).Resource("replicationControllers").Name(name).Do().Error()
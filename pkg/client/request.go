@@ -42,7 +42,10 @@ import (
 
 // specialParams lists parameters that are handled specially and which users of Request
 // are therefore not allowed to set manually.
-var specialParams = util.NewStringSet("timeout")
+var specialParams = util.NewStringSet("timeout", "limit", "continue")
+
+// DefaultPageSize is the number of items the typed clients ask for in each chunk of a list.
+const DefaultPageSize = 500
 
 // HTTPClient is an interface for testing a request object.
 type HTTPClient interface {
@@ -99,6 +102,7 @@ type Request struct {
 	path    string
 	subpath string
 	params  url.Values
+	headers http.Header
 
 	// structural elements of the request that are part of the Kubernetes API conventions
 	namespace    string
@@ -109,6 +113,10 @@ type Request struct {
 	selector     labels.Selector
 	timeout      time.Duration
 
+	// paging of list requests, see PageSize
+	pageSize      int
+	continueToken string
+
 	// output
 	err  error
 	body io.Reader
@@ -318,6 +326,15 @@ func (r *Request) setParam(paramName, value string) *Request {
 	return r
 }
 
+// SetHeader sets a header on the request, replacing any value it had.
+func (r *Request) SetHeader(key, value string) *Request {
+	if r.headers == nil {
+		r.headers = http.Header{}
+	}
+	r.headers.Set(key, value)
+	return r
+}
+
 // Timeout makes the request use the given duration as a timeout. Sets the "timeout"
 // parameter.
 func (r *Request) Timeout(d time.Duration) *Request {
@@ -328,6 +345,17 @@ func (r *Request) Timeout(d time.Duration) *Request {
 	return r
 }
 
+// PageSize asks the server to return a list in chunks of at most size items. Do
+// retrieves the remaining chunks transparently and returns them as a single list.
+// A size of zero retrieves the list in one request.
+func (r *Request) PageSize(size int) *Request {
+	if r.err != nil {
+		return r
+	}
+	r.pageSize = size
+	return r
+}
+
 // Body makes the request use obj as the body. Optional.
 // If obj is a string, try to read a file of that name.
 // If obj is a []byte, send it directly.
@@ -398,6 +426,13 @@ func (r *Request) finalURL() string {
 	if r.timeout != 0 {
 		query.Set("timeout", r.timeout.String())
 	}
+	// as are the paging parameters.
+	if r.pageSize > 0 {
+		query.Set("limit", strconv.Itoa(r.pageSize))
+	}
+	if len(r.continueToken) > 0 {
+		query.Set("continue", r.continueToken)
+	}
 	finalURL.RawQuery = query.Encode()
 	return finalURL.String()
 }
@@ -525,7 +560,7 @@ func (r *Request) DoRaw() ([]byte, error) {
 		}
 
 		// TODO: added to catch programmer errors (invoking operations with an object with an empty namespace)
-		if (r.verb == "GET" || r.verb == "PUT" || r.verb == "DELETE") && r.namespaceSet && len(r.resourceName) > 0 && len(r.namespace) == 0 {
+		if (r.verb == "GET" || r.verb == "PUT" || r.verb == "PATCH" || r.verb == "DELETE") && r.namespaceSet && len(r.resourceName) > 0 && len(r.namespace) == 0 {
 			return nil, fmt.Errorf("an empty namespace may not be set when a resource name is provided")
 		}
 		if (r.verb == "POST") && r.namespaceSet && len(r.namespace) == 0 {
@@ -537,6 +572,9 @@ func (r *Request) DoRaw() ([]byte, error) {
 		if err != nil {
 			return nil, err
 		}
+		for key, values := range r.headers {
+			r.req.Header[key] = values
+		}
 
 		r.resp, err = client.Do(r.req)
 		if err != nil {
@@ -576,6 +614,13 @@ func (r *Request) DoRaw() ([]byte, error) {
 //  * If the status code and body don't make sense together: *UnexpectedStatusError
 //  * http.Client.Do errors are returned directly.
 func (r *Request) Do() Result {
+	if r.pageSize > 0 && r.verb == "GET" {
+		return r.doPaged()
+	}
+	return r.doOnce()
+}
+
+func (r *Request) doOnce() Result {
 	body, err := r.DoRaw()
 	if err != nil {
 		return Result{err: err}
@@ -584,6 +629,61 @@ func (r *Request) Do() Result {
 	return Result{respBody, created, err, r.codec}
 }
 
+// doPaged follows the continue tokens of a chunked list and combines the chunks into a
+// single list. Responses that are not lists, or lists that fit in one chunk, are returned
+// unchanged. The server does not keep a snapshot of the list between chunks, so if it
+// changes while it is being paged the continue token expires and the list is retrieved
+// again in a single request.
+func (r *Request) doPaged() Result {
+	result := r.doOnce()
+	if result.err != nil {
+		return result
+	}
+	list, err := r.codec.Decode(result.body)
+	if err != nil {
+		return result
+	}
+	listMeta, err := api.ListMetaFor(list)
+	if err != nil || len(listMeta.Continue) == 0 {
+		return result
+	}
+	items, err := runtime.ExtractList(list)
+	if err != nil {
+		return result
+	}
+
+	for len(listMeta.Continue) > 0 {
+		r.continueToken = listMeta.Continue
+		next := r.doOnce()
+		if next.err != nil {
+			if errors.IsExpired(next.err) {
+				glog.V(4).Infof("List %v expired while paging, retrieving it in a single request", r.finalURL())
+				r.pageSize, r.continueToken = 0, ""
+				return r.doOnce()
+			}
+			return next
+		}
+		if list, err = r.codec.Decode(next.body); err != nil {
+			return Result{err: err}
+		}
+		if listMeta, err = api.ListMetaFor(list); err != nil {
+			return Result{err: err}
+		}
+		chunk, err := runtime.ExtractList(list)
+		if err != nil {
+			return Result{err: err}
+		}
+		items = append(items, chunk...)
+	}
+	r.continueToken = ""
+
+	if err := runtime.SetList(list, items); err != nil {
+		return Result{err: err}
+	}
+	body, err := r.codec.Encode(list)
+	return Result{body, false, err, r.codec}
+}
+
 // transformResponse converts an API response into a structured API object.
 func (r *Request) transformResponse(body []byte, resp *http.Response, req *http.Request) ([]byte, bool, error) {
 	// Did the server give us a status response?
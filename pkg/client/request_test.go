@@ -625,7 +625,7 @@ func TestRequestDo(t *testing.T) {
 
 func TestDoRequestNewWay(t *testing.T) {
 	reqBody := "request body"
-	expectedObj := &api.Service{Spec: api.ServiceSpec{Port: 12345}}
+	expectedObj := &api.Service{Spec: api.ServiceSpec{Ports: []api.ServicePort{{Port: 12345}}}}
 	expectedBody, _ := v1beta2.Codec.Encode(expectedObj)
 	fakeHandler := util.FakeHandler{
 		StatusCode:   200,
@@ -657,10 +657,132 @@ func TestDoRequestNewWay(t *testing.T) {
 	}
 }
 
+func TestDoRequestSetsHeaders(t *testing.T) {
+	reqBody := `{"metadata":{"labels":{"name":"foo"}}}`
+	expectedObj := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}
+	expectedBody, _ := v1beta2.Codec.Encode(expectedObj)
+	fakeHandler := util.FakeHandler{
+		StatusCode:   200,
+		ResponseBody: string(expectedBody),
+		T:            t,
+	}
+	testServer := httptest.NewServer(&fakeHandler)
+	defer testServer.Close()
+	c := NewOrDie(&Config{Host: testServer.URL, Version: "v1beta2"})
+	obj, err := c.Patch(api.StrategicMergePatchType).
+		Namespace("default").
+		Resource("pods").
+		Name("foo").
+		SetHeader("X-Test", "value").
+		Body([]byte(reqBody)).
+		Do().Get()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !api.Semantic.DeepDerivative(expectedObj, obj) {
+		t.Errorf("Expected: %#v, got %#v", expectedObj, obj)
+	}
+	fakeHandler.ValidateRequest(t, "/api/v1beta2/pods/foo?namespace=default", "PATCH", &reqBody)
+	if e, a := string(api.StrategicMergePatchType), fakeHandler.RequestReceived.Header.Get("Content-Type"); e != a {
+		t.Errorf("expected content type %q, got %q", e, a)
+	}
+	if e, a := "value", fakeHandler.RequestReceived.Header.Get("X-Test"); e != a {
+		t.Errorf("expected header %q, got %q", e, a)
+	}
+}
+
+func TestDoRequestPaged(t *testing.T) {
+	pages := map[string]*api.PodList{
+		"": {
+			ListMeta: api.ListMeta{ResourceVersion: "10", Continue: "first"},
+			Items:    []api.Pod{{ObjectMeta: api.ObjectMeta{Name: "a"}}},
+		},
+		"first": {
+			ListMeta: api.ListMeta{ResourceVersion: "10", Continue: "second"},
+			Items:    []api.Pod{{ObjectMeta: api.ObjectMeta{Name: "b"}}},
+		},
+		"second": {
+			ListMeta: api.ListMeta{ResourceVersion: "10"},
+			Items:    []api.Pod{{ObjectMeta: api.ObjectMeta{Name: "c"}}},
+		},
+	}
+	requests := []url.Values{}
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		requests = append(requests, query)
+		page, ok := pages[query.Get("continue")]
+		if !ok {
+			t.Errorf("unexpected continue token: %q", query.Get("continue"))
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		data, _ := v1beta2.Codec.Encode(page)
+		w.Write(data)
+	}))
+	defer testServer.Close()
+
+	c := NewOrDie(&Config{Host: testServer.URL, Version: "v1beta2"})
+	list := &api.PodList{}
+	if err := c.Get().Namespace("default").Resource("pods").PageSize(1).Do().Into(list); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(requests) != 3 {
+		t.Fatalf("expected 3 requests, got %v", requests)
+	}
+	for _, query := range requests {
+		if query.Get("limit") != "1" {
+			t.Errorf("expected a limit on every request, got %v", query)
+		}
+	}
+	names := []string{}
+	for _, pod := range list.Items {
+		names = append(names, pod.Name)
+	}
+	if e, a := []string{"a", "b", "c"}, names; !reflect.DeepEqual(e, a) {
+		t.Errorf("expected %v, got %v", e, a)
+	}
+	if list.ResourceVersion != "10" || len(list.Continue) != 0 {
+		t.Errorf("unexpected list metadata: %#v", list.ListMeta)
+	}
+}
+
+func TestDoRequestPagedExpired(t *testing.T) {
+	requests := []url.Values{}
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		requests = append(requests, query)
+		var obj runtime.Object
+		switch {
+		case len(query.Get("continue")) > 0:
+			w.WriteHeader(http.StatusGone)
+			obj = &apierrors.NewExpired("the list has changed").(*apierrors.StatusError).ErrStatus
+		case len(query.Get("limit")) > 0:
+			obj = &api.PodList{ListMeta: api.ListMeta{Continue: "first"}, Items: []api.Pod{{ObjectMeta: api.ObjectMeta{Name: "a"}}}}
+		default:
+			obj = &api.PodList{Items: []api.Pod{{ObjectMeta: api.ObjectMeta{Name: "a"}}, {ObjectMeta: api.ObjectMeta{Name: "b"}}}}
+		}
+		data, _ := v1beta2.Codec.Encode(obj)
+		w.Write(data)
+	}))
+	defer testServer.Close()
+
+	c := NewOrDie(&Config{Host: testServer.URL, Version: "v1beta2"})
+	list := &api.PodList{}
+	if err := c.Get().Namespace("default").Resource("pods").PageSize(1).Do().Into(list); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(requests) != 3 || len(requests[2].Get("limit")) != 0 || len(requests[2].Get("continue")) != 0 {
+		t.Errorf("expected a single unpaged request after the token expired, got %v", requests)
+	}
+	if len(list.Items) != 2 {
+		t.Errorf("unexpected list: %#v", list)
+	}
+}
+
 func TestDoRequestNewWayReader(t *testing.T) {
 	reqObj := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}
 	reqBodyExpected, _ := v1beta1.Codec.Encode(reqObj)
-	expectedObj := &api.Service{Spec: api.ServiceSpec{Port: 12345}}
+	expectedObj := &api.Service{Spec: api.ServiceSpec{Ports: []api.ServicePort{{Port: 12345}}}}
 	expectedBody, _ := v1beta1.Codec.Encode(expectedObj)
 	fakeHandler := util.FakeHandler{
 		StatusCode:   200,
@@ -696,7 +818,7 @@ func TestDoRequestNewWayReader(t *testing.T) {
 func TestDoRequestNewWayObj(t *testing.T) {
 	reqObj := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}
 	reqBodyExpected, _ := v1beta2.Codec.Encode(reqObj)
-	expectedObj := &api.Service{Spec: api.ServiceSpec{Port: 12345}}
+	expectedObj := &api.Service{Spec: api.ServiceSpec{Ports: []api.ServicePort{{Port: 12345}}}}
 	expectedBody, _ := v1beta2.Codec.Encode(expectedObj)
 	fakeHandler := util.FakeHandler{
 		StatusCode:   200,
@@ -746,7 +868,7 @@ func TestDoRequestNewWayFile(t *testing.T) {
 		t.Errorf("unexpected error: %v", err)
 	}
 
-	expectedObj := &api.Service{Spec: api.ServiceSpec{Port: 12345}}
+	expectedObj := &api.Service{Spec: api.ServiceSpec{Ports: []api.ServicePort{{Port: 12345}}}}
 	expectedBody, _ := v1beta1.Codec.Encode(expectedObj)
 	fakeHandler := util.FakeHandler{
 		StatusCode:   200,
@@ -788,7 +910,7 @@ func TestWasCreated(t *testing.T) {
 		t.Errorf("unexpected error: %v", err)
 	}
 
-	expectedObj := &api.Service{Spec: api.ServiceSpec{Port: 12345}}
+	expectedObj := &api.Service{Spec: api.ServiceSpec{Ports: []api.ServicePort{{Port: 12345}}}}
 	expectedBody, _ := v1beta1.Codec.Encode(expectedObj)
 	fakeHandler := util.FakeHandler{
 		StatusCode:   201,
@@ -838,6 +960,9 @@ func TestVerbs(t *testing.T) {
 	if r := c.Delete(); r.verb != "DELETE" {
 		t.Errorf("Delete verb is wrong")
 	}
+	if r := c.Patch(api.MergePatchType); r.verb != "PATCH" || r.headers.Get("Content-Type") != string(api.MergePatchType) {
+		t.Errorf("Patch verb or content type is wrong")
+	}
 }
 
 func TestAbsPath(t *testing.T) {
@@ -876,6 +1001,8 @@ func TestUnacceptableParamNames(t *testing.T) {
 		expectSuccess bool
 	}{
 		{"timeout", "42", false},
+		{"limit", "10", false},
+		{"continue", "abc", false},
 	}
 
 	for _, item := range table {
@@ -0,0 +1,140 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+type RoleBindingsNamespacer interface {
+	RoleBindings(namespace string) RoleBindingInterface
+}
+
+type RoleBindingInterface interface {
+	Create(binding *api.RoleBinding) (*api.RoleBinding, error)
+	Update(binding *api.RoleBinding) (*api.RoleBinding, error)
+	Delete(name string) error
+	List(label, field labels.Selector) (*api.RoleBindingList, error)
+	Get(name string) (*api.RoleBinding, error)
+	Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// roleBindings implements RoleBindingsNamespacer interface
+type roleBindings struct {
+	client    *Client
+	namespace string
+}
+
+// newRoleBindings returns a new roleBindings object.
+func newRoleBindings(c *Client, ns string) *roleBindings {
+	return &roleBindings{
+		client:    c,
+		namespace: ns,
+	}
+}
+
+func (s *roleBindings) Create(binding *api.RoleBinding) (*api.RoleBinding, error) {
+	if s.namespace != "" && binding.Namespace != s.namespace {
+		return nil, fmt.Errorf("can't create a role binding with namespace '%v' in namespace '%v'", binding.Namespace, s.namespace)
+	}
+
+	result := &api.RoleBinding{}
+	err := s.client.Post().
+		Namespace(binding.Namespace).
+		Resource("roleBindings").
+		Body(binding).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+// List returns a list of role bindings matching the selectors.
+func (s *roleBindings) List(label, field labels.Selector) (*api.RoleBindingList, error) {
+	result := &api.RoleBindingList{}
+
+	err := s.client.Get().
+		Namespace(s.namespace).
+		Resource("roleBindings").
+		SelectorParam("labels", label).
+		SelectorParam("fields", field).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+// Get returns the given role binding, or an error.
+func (s *roleBindings) Get(name string) (*api.RoleBinding, error) {
+	if len(name) == 0 {
+		return nil, errors.New("name is required parameter to Get")
+	}
+
+	result := &api.RoleBinding{}
+	err := s.client.Get().
+		Namespace(s.namespace).
+		Resource("roleBindings").
+		Name(name).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+// Watch starts watching for role bindings matching the given selectors.
+func (s *roleBindings) Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error) {
+	return s.client.Get().
+		Prefix("watch").
+		Namespace(s.namespace).
+		Resource("roleBindings").
+		Param("resourceVersion", resourceVersion).
+		SelectorParam("labels", label).
+		SelectorParam("fields", field).
+		Watch()
+}
+
+func (s *roleBindings) Delete(name string) error {
+	return s.client.Delete().
+		Namespace(s.namespace).
+		Resource("roleBindings").
+		Name(name).
+		Do().
+		Error()
+}
+
+func (s *roleBindings) Update(binding *api.RoleBinding) (result *api.RoleBinding, err error) {
+	result = &api.RoleBinding{}
+	if len(binding.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", binding)
+		return
+	}
+
+	err = s.client.Put().
+		Namespace(s.namespace).
+		Resource("roleBindings").
+		Name(binding.Name).
+		Body(binding).
+		Do().
+		Into(result)
+
+	return
+}
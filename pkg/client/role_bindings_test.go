@@ -0,0 +1,131 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func TestRoleBindingCreate(t *testing.T) {
+	ns := api.NamespaceDefault
+	binding := &api.RoleBinding{
+		ObjectMeta: api.ObjectMeta{Name: "read-pods", Namespace: ns},
+		Subjects:   []api.Subject{{Kind: "User", Name: "alice"}},
+		RoleRef:    api.RoleRef{Kind: "Role", Name: "reader"},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "POST",
+			Path:   buildResourcePath(ns, "/roleBindings"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   binding,
+		},
+		Response: Response{StatusCode: 200, Body: binding},
+	}
+
+	response, err := c.Setup().RoleBindings(ns).Create(binding)
+	c.Validate(t, response, err)
+}
+
+func TestRoleBindingGet(t *testing.T) {
+	ns := api.NamespaceDefault
+	binding := &api.RoleBinding{
+		ObjectMeta: api.ObjectMeta{Name: "read-pods", Namespace: ns},
+		Subjects:   []api.Subject{{Kind: "User", Name: "alice"}},
+		RoleRef:    api.RoleRef{Kind: "Role", Name: "reader"},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   buildResourcePath(ns, "/roleBindings/read-pods"),
+			Query:  buildQueryValues(ns, nil),
+		},
+		Response: Response{StatusCode: 200, Body: binding},
+	}
+
+	response, err := c.Setup().RoleBindings(ns).Get("read-pods")
+	c.Validate(t, response, err)
+}
+
+func TestRoleBindingList(t *testing.T) {
+	ns := api.NamespaceDefault
+	list := &api.RoleBindingList{
+		Items: []api.RoleBinding{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "read-pods", Namespace: ns},
+				Subjects:   []api.Subject{{Kind: "User", Name: "alice"}},
+				RoleRef:    api.RoleRef{Kind: "Role", Name: "reader"},
+			},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   buildResourcePath(ns, "/roleBindings"),
+			Query:  buildQueryValues(ns, nil),
+		},
+		Response: Response{StatusCode: 200, Body: list},
+	}
+	response, err := c.Setup().RoleBindings(ns).List(labels.Everything(), labels.Everything())
+	c.Validate(t, response, err)
+}
+
+func TestRoleBindingUpdate(t *testing.T) {
+	ns := api.NamespaceDefault
+	binding := &api.RoleBinding{
+		ObjectMeta: api.ObjectMeta{Name: "read-pods", Namespace: ns, ResourceVersion: "1"},
+		Subjects:   []api.Subject{{Kind: "User", Name: "alice"}},
+		RoleRef:    api.RoleRef{Kind: "Role", Name: "reader"},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "PUT",
+			Path:   buildResourcePath(ns, "/roleBindings/read-pods"),
+			Query:  buildQueryValues(ns, nil),
+		},
+		Response: Response{StatusCode: 200, Body: binding},
+	}
+	response, err := c.Setup().RoleBindings(ns).Update(binding)
+	c.Validate(t, response, err)
+}
+
+func TestRoleBindingDelete(t *testing.T) {
+	ns := api.NamespaceDefault
+	c := &testClient{
+		Request: testRequest{
+			Method: "DELETE",
+			Path:   buildResourcePath(ns, "/roleBindings/read-pods"),
+			Query:  buildQueryValues(ns, nil),
+		},
+		Response: Response{StatusCode: 200},
+	}
+	err := c.Setup().RoleBindings(ns).Delete("read-pods")
+	c.Validate(t, nil, err)
+}
+
+func TestRoleBindingWatch(t *testing.T) {
+	c := &testClient{
+		Request:  testRequest{Method: "GET", Path: "/watch/roleBindings", Query: url.Values{"resourceVersion": []string{}}},
+		Response: Response{StatusCode: 200},
+	}
+	_, err := c.Setup().RoleBindings(api.NamespaceAll).Watch(labels.Everything(), labels.Everything(), "")
+	c.Validate(t, nil, err)
+}
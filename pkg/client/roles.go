@@ -0,0 +1,140 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+type RolesNamespacer interface {
+	Roles(namespace string) RoleInterface
+}
+
+type RoleInterface interface {
+	Create(role *api.Role) (*api.Role, error)
+	Update(role *api.Role) (*api.Role, error)
+	Delete(name string) error
+	List(label, field labels.Selector) (*api.RoleList, error)
+	Get(name string) (*api.Role, error)
+	Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// roles implements RolesNamespacer interface
+type roles struct {
+	client    *Client
+	namespace string
+}
+
+// newRoles returns a new roles object.
+func newRoles(c *Client, ns string) *roles {
+	return &roles{
+		client:    c,
+		namespace: ns,
+	}
+}
+
+func (s *roles) Create(role *api.Role) (*api.Role, error) {
+	if s.namespace != "" && role.Namespace != s.namespace {
+		return nil, fmt.Errorf("can't create a role with namespace '%v' in namespace '%v'", role.Namespace, s.namespace)
+	}
+
+	result := &api.Role{}
+	err := s.client.Post().
+		Namespace(role.Namespace).
+		Resource("roles").
+		Body(role).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+// List returns a list of roles matching the selectors.
+func (s *roles) List(label, field labels.Selector) (*api.RoleList, error) {
+	result := &api.RoleList{}
+
+	err := s.client.Get().
+		Namespace(s.namespace).
+		Resource("roles").
+		SelectorParam("labels", label).
+		SelectorParam("fields", field).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+// Get returns the given role, or an error.
+func (s *roles) Get(name string) (*api.Role, error) {
+	if len(name) == 0 {
+		return nil, errors.New("name is required parameter to Get")
+	}
+
+	result := &api.Role{}
+	err := s.client.Get().
+		Namespace(s.namespace).
+		Resource("roles").
+		Name(name).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+// Watch starts watching for roles matching the given selectors.
+func (s *roles) Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error) {
+	return s.client.Get().
+		Prefix("watch").
+		Namespace(s.namespace).
+		Resource("roles").
+		Param("resourceVersion", resourceVersion).
+		SelectorParam("labels", label).
+		SelectorParam("fields", field).
+		Watch()
+}
+
+func (s *roles) Delete(name string) error {
+	return s.client.Delete().
+		Namespace(s.namespace).
+		Resource("roles").
+		Name(name).
+		Do().
+		Error()
+}
+
+func (s *roles) Update(role *api.Role) (result *api.Role, err error) {
+	result = &api.Role{}
+	if len(role.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", role)
+		return
+	}
+
+	err = s.client.Put().
+		Namespace(s.namespace).
+		Resource("roles").
+		Name(role.Name).
+		Body(role).
+		Do().
+		Into(result)
+
+	return
+}
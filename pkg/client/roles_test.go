@@ -0,0 +1,127 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func TestRoleCreate(t *testing.T) {
+	ns := api.NamespaceDefault
+	role := &api.Role{
+		ObjectMeta: api.ObjectMeta{Name: "reader", Namespace: ns},
+		Rules:      []api.PolicyRule{{Verbs: []string{"get"}, Resources: []string{"pods"}}},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "POST",
+			Path:   buildResourcePath(ns, "/roles"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   role,
+		},
+		Response: Response{StatusCode: 200, Body: role},
+	}
+
+	response, err := c.Setup().Roles(ns).Create(role)
+	c.Validate(t, response, err)
+}
+
+func TestRoleGet(t *testing.T) {
+	ns := api.NamespaceDefault
+	role := &api.Role{
+		ObjectMeta: api.ObjectMeta{Name: "reader", Namespace: ns},
+		Rules:      []api.PolicyRule{{Verbs: []string{"get"}, Resources: []string{"pods"}}},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   buildResourcePath(ns, "/roles/reader"),
+			Query:  buildQueryValues(ns, nil),
+		},
+		Response: Response{StatusCode: 200, Body: role},
+	}
+
+	response, err := c.Setup().Roles(ns).Get("reader")
+	c.Validate(t, response, err)
+}
+
+func TestRoleList(t *testing.T) {
+	ns := api.NamespaceDefault
+	list := &api.RoleList{
+		Items: []api.Role{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "reader", Namespace: ns},
+				Rules:      []api.PolicyRule{{Verbs: []string{"get"}, Resources: []string{"pods"}}},
+			},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   buildResourcePath(ns, "/roles"),
+			Query:  buildQueryValues(ns, nil),
+		},
+		Response: Response{StatusCode: 200, Body: list},
+	}
+	response, err := c.Setup().Roles(ns).List(labels.Everything(), labels.Everything())
+	c.Validate(t, response, err)
+}
+
+func TestRoleUpdate(t *testing.T) {
+	ns := api.NamespaceDefault
+	role := &api.Role{
+		ObjectMeta: api.ObjectMeta{Name: "reader", Namespace: ns, ResourceVersion: "1"},
+		Rules:      []api.PolicyRule{{Verbs: []string{"get"}, Resources: []string{"pods"}}},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "PUT",
+			Path:   buildResourcePath(ns, "/roles/reader"),
+			Query:  buildQueryValues(ns, nil),
+		},
+		Response: Response{StatusCode: 200, Body: role},
+	}
+	response, err := c.Setup().Roles(ns).Update(role)
+	c.Validate(t, response, err)
+}
+
+func TestRoleDelete(t *testing.T) {
+	ns := api.NamespaceDefault
+	c := &testClient{
+		Request: testRequest{
+			Method: "DELETE",
+			Path:   buildResourcePath(ns, "/roles/reader"),
+			Query:  buildQueryValues(ns, nil),
+		},
+		Response: Response{StatusCode: 200},
+	}
+	err := c.Setup().Roles(ns).Delete("reader")
+	c.Validate(t, nil, err)
+}
+
+func TestRoleWatch(t *testing.T) {
+	c := &testClient{
+		Request:  testRequest{Method: "GET", Path: "/watch/roles", Query: url.Values{"resourceVersion": []string{}}},
+		Response: Response{StatusCode: 200},
+	}
+	_, err := c.Setup().Roles(api.NamespaceAll).Watch(labels.Everything(), labels.Everything(), "")
+	c.Validate(t, nil, err)
+}
@@ -36,6 +36,7 @@ type ServiceInterface interface {
 	Get(name string) (*api.Service, error)
 	Create(srv *api.Service) (*api.Service, error)
 	Update(srv *api.Service) (*api.Service, error)
+	Patch(name string, pt api.PatchType, data []byte) (*api.Service, error)
 	Delete(name string) error
 	Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error)
 }
@@ -54,7 +55,7 @@ func newServices(c *Client, namespace string) *services {
 // List takes a selector, and returns the list of services that match that selector
 func (c *services) List(selector labels.Selector) (result *api.ServiceList, err error) {
 	result = &api.ServiceList{}
-	err = c.r.Get().Namespace(c.ns).Resource("services").SelectorParam("labels", selector).Do().Into(result)
+	err = c.r.Get().Namespace(c.ns).Resource("services").SelectorParam("labels", selector).PageSize(DefaultPageSize).Do().Into(result)
 	return
 }
 
@@ -87,6 +88,13 @@ func (c *services) Update(svc *api.Service) (result *api.Service, err error) {
 	return
 }
 
+// Patch applies a patch of the given type to the named service.
+func (c *services) Patch(name string, pt api.PatchType, data []byte) (result *api.Service, err error) {
+	result = &api.Service{}
+	err = c.r.Patch(pt).Namespace(c.ns).Resource("services").Name(name).Body(data).Do().Into(result)
+	return
+}
+
 // Delete deletes an existing service.
 func (c *services) Delete(name string) error {
 	return c.r.Delete().Namespace(c.ns).Resource("services").Name(name).Do().Error()
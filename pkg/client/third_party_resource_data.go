@@ -0,0 +1,88 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/meta"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/thirdparty"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+)
+
+// ThirdPartyResourceDataNamespacer has methods to work with the objects of third party kinds
+// in a namespace
+type ThirdPartyResourceDataNamespacer interface {
+	ThirdPartyResourceData(group, version, kind, namespace string) ThirdPartyResourceDataInterface
+}
+
+// ThirdPartyResourceDataInterface has methods to work with the objects of a third party kind
+type ThirdPartyResourceDataInterface interface {
+	List(label, field labels.Selector) (*api.ThirdPartyResourceDataList, error)
+	Delete(name string) error
+}
+
+// thirdPartyResourceData implements ThirdPartyResourceDataInterface
+type thirdPartyResourceData struct {
+	client   *Client
+	group    string
+	version  string
+	resource string
+	ns       string
+	codec    runtime.Codec
+}
+
+// newThirdPartyResourceData returns a thirdPartyResourceData object for the objects of kind
+// served under the given third party group and version.
+func newThirdPartyResourceData(c *Client, group, version, kind, namespace string) *thirdPartyResourceData {
+	apiVersion := api.ThirdPartyAPIVersion(group, version)
+	resource, _ := meta.KindToResource(kind, false)
+	return &thirdPartyResourceData{
+		client:   c,
+		group:    group,
+		version:  version,
+		resource: resource,
+		ns:       namespace,
+		codec:    thirdparty.NewCodec(latest.Codec, kind, apiVersion),
+	}
+}
+
+// request starts a request for the objects, which are served under the third party API prefix
+// rather than the prefix of the client.
+func (c *thirdPartyResourceData) request(verb string) *Request {
+	return NewRequest(c.client.Client, verb, c.client.baseURL, c.codec, false, false).
+		AbsPath(api.ThirdPartyAPIPrefix, c.group, c.version).
+		Namespace(c.ns).
+		Resource(c.resource)
+}
+
+// List takes label and field selectors, and returns the list of objects that match them.
+func (c *thirdPartyResourceData) List(label, field labels.Selector) (result *api.ThirdPartyResourceDataList, err error) {
+	result = &api.ThirdPartyResourceDataList{}
+	err = c.request("GET").
+		SelectorParam("labels", label).
+		SelectorParam("fields", field).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete deletes an object by name.
+func (c *thirdPartyResourceData) Delete(name string) error {
+	return c.request("DELETE").Name(name).Do().Error()
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func TestThirdPartyResourceData(t *testing.T) {
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		if req.Method == "DELETE" {
+			fmt.Fprint(w, `{"kind":"Status","apiVersion":"v1beta1","status":"Success"}`)
+			return
+		}
+		fmt.Fprint(w, `{"kind":"DatabaseClusterList","apiVersion":"example.com/v1","items":[`+
+			`{"kind":"DatabaseCluster","apiVersion":"example.com/v1","metadata":{"name":"db","namespace":"test"},"replicas":3}]}`)
+	}))
+	defer server.Close()
+
+	// the legacy version puts the namespace of its own requests in the query
+	c := NewOrDie(&Config{Host: server.URL, Version: "v1beta1"})
+	data := c.ThirdPartyResourceData("example.com", "v1", "DatabaseCluster", "test")
+	list, err := data.List(labels.Everything(), labels.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "db" || list.Items[0].Kind != "DatabaseCluster" {
+		t.Errorf("unexpected list: %#v", list)
+	}
+	if err := data.Delete("db"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	expected := []string{
+		"GET /thirdparty/example.com/v1/namespaces/test/databaseclusters",
+		"DELETE /thirdparty/example.com/v1/namespaces/test/databaseclusters/db",
+	}
+	if fmt.Sprint(expected) != fmt.Sprint(requests) {
+		t.Errorf("expected requests %v, got %v", expected, requests)
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+type ThirdPartyResourcesInterface interface {
+	ThirdPartyResources() ThirdPartyResourceInterface
+}
+
+type ThirdPartyResourceInterface interface {
+	Create(resource *api.ThirdPartyResource) (*api.ThirdPartyResource, error)
+	Update(resource *api.ThirdPartyResource) (*api.ThirdPartyResource, error)
+	Delete(name string) error
+	List(label, field labels.Selector) (*api.ThirdPartyResourceList, error)
+	Get(name string) (*api.ThirdPartyResource, error)
+	Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// thirdPartyResources implements ThirdPartyResourcesInterface
+type thirdPartyResources struct {
+	client *Client
+}
+
+// newThirdPartyResources returns a thirdPartyResources object.
+func newThirdPartyResources(c *Client) *thirdPartyResources {
+	return &thirdPartyResources{client: c}
+}
+
+// Create creates a new third party resource.
+func (c *thirdPartyResources) Create(resource *api.ThirdPartyResource) (*api.ThirdPartyResource, error) {
+	result := &api.ThirdPartyResource{}
+	err := c.client.Post().Resource("thirdPartyResources").Body(resource).Do().Into(result)
+	return result, err
+}
+
+// List lists all the third party resources in the cluster matching the selectors.
+func (c *thirdPartyResources) List(label, field labels.Selector) (*api.ThirdPartyResourceList, error) {
+	result := &api.ThirdPartyResourceList{}
+	err := c.client.Get().
+		Resource("thirdPartyResources").
+		SelectorParam("labels", label).
+		SelectorParam("fields", field).
+		Do().
+		Into(result)
+	return result, err
+}
+
+// Update takes the representation of a third party resource to update.  Returns the server's representation of the third party resource, and an error, if it occurs.
+func (c *thirdPartyResources) Update(resource *api.ThirdPartyResource) (result *api.ThirdPartyResource, err error) {
+	result = &api.ThirdPartyResource{}
+	if len(resource.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", resource)
+		return
+	}
+	err = c.client.Put().Resource("thirdPartyResources").Name(resource.Name).Body(resource).Do().Into(result)
+	return
+}
+
+// Get gets an existing third party resource.
+func (c *thirdPartyResources) Get(name string) (*api.ThirdPartyResource, error) {
+	if len(name) == 0 {
+		return nil, errors.New("name is required parameter to Get")
+	}
+
+	result := &api.ThirdPartyResource{}
+	err := c.client.Get().Resource("thirdPartyResources").Name(name).Do().Into(result)
+	return result, err
+}
+
+// Delete deletes an existing third party resource.
+func (c *thirdPartyResources) Delete(name string) error {
+	return c.client.Delete().Resource("thirdPartyResources").Name(name).Do().Error()
+}
+
+// Watch returns a watch.Interface that watches the requested third party resources.
+func (c *thirdPartyResources) Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.client.Get().
+		Prefix("watch").
+		Resource("thirdPartyResources").
+		Param("resourceVersion", resourceVersion).
+		SelectorParam("labels", label).
+		SelectorParam("fields", field).
+		Watch()
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func TestThirdPartyResourceCreate(t *testing.T) {
+	resource := &api.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "database-cluster.example.com"},
+		Versions:   []api.APIVersion{{Name: "v1"}},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "POST",
+			Path:   "/thirdPartyResources",
+			Body:   resource,
+		},
+		Response: Response{StatusCode: 200, Body: resource},
+	}
+
+	response, err := c.Setup().ThirdPartyResources().Create(resource)
+	c.Validate(t, response, err)
+}
+
+func TestThirdPartyResourceGet(t *testing.T) {
+	resource := &api.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "database-cluster.example.com"},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "GET", Path: "/thirdPartyResources/database-cluster.example.com"},
+		Response: Response{StatusCode: 200, Body: resource},
+	}
+
+	response, err := c.Setup().ThirdPartyResources().Get("database-cluster.example.com")
+	c.Validate(t, response, err)
+}
+
+func TestThirdPartyResourceList(t *testing.T) {
+	resourceList := &api.ThirdPartyResourceList{
+		Items: []api.ThirdPartyResource{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "database-cluster.example.com"},
+			},
+		},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "GET", Path: "/thirdPartyResources"},
+		Response: Response{StatusCode: 200, Body: resourceList},
+	}
+	response, err := c.Setup().ThirdPartyResources().List(labels.Everything(), labels.Everything())
+	c.Validate(t, response, err)
+}
+
+func TestThirdPartyResourceUpdate(t *testing.T) {
+	resource := &api.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{
+			Name:            "database-cluster.example.com",
+			ResourceVersion: "1",
+		},
+		Versions: []api.APIVersion{{Name: "v1"}, {Name: "v2"}},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "PUT", Path: "/thirdPartyResources/database-cluster.example.com"},
+		Response: Response{StatusCode: 200, Body: resource},
+	}
+	response, err := c.Setup().ThirdPartyResources().Update(resource)
+	c.Validate(t, response, err)
+}
+
+func TestThirdPartyResourceDelete(t *testing.T) {
+	c := &testClient{
+		Request:  testRequest{Method: "DELETE", Path: "/thirdPartyResources/database-cluster.example.com"},
+		Response: Response{StatusCode: 200},
+	}
+	err := c.Setup().ThirdPartyResources().Delete("database-cluster.example.com")
+	c.Validate(t, nil, err)
+}
+
+func TestThirdPartyResourceWatch(t *testing.T) {
+	c := &testClient{
+		Request:  testRequest{Method: "GET", Path: "/watch/thirdPartyResources", Query: url.Values{"resourceVersion": []string{}}},
+		Response: Response{StatusCode: 200},
+	}
+	_, err := c.Setup().ThirdPartyResources().Watch(labels.Everything(), labels.Everything(), "")
+	c.Validate(t, nil, err)
+}
@@ -221,8 +221,33 @@ func translateAffinityType(affinityType api.AffinityType) GCEAffinityType {
 	}
 }
 
+// getPortRange returns the smallest contiguous range covering all of the
+// ports, since a forwarding rule only accepts a single range.
+func getPortRange(ports []int) (string, error) {
+	if len(ports) == 0 {
+		return "", fmt.Errorf("no ports specified")
+	}
+	min, max := ports[0], ports[0]
+	for _, port := range ports[1:] {
+		if port < min {
+			min = port
+		}
+		if port > max {
+			max = port
+		}
+	}
+	if min == max {
+		return strconv.Itoa(min), nil
+	}
+	return fmt.Sprintf("%d-%d", min, max), nil
+}
+
 // CreateTCPLoadBalancer is an implementation of TCPLoadBalancer.CreateTCPLoadBalancer.
-func (gce *GCECloud) CreateTCPLoadBalancer(name, region string, externalIP net.IP, port int, hosts []string, affinityType api.AffinityType) (net.IP, error) {
+func (gce *GCECloud) CreateTCPLoadBalancer(name, region string, externalIP net.IP, ports []int, hosts []string, affinityType api.AffinityType) (net.IP, error) {
+	portRange, err := getPortRange(ports)
+	if err != nil {
+		return nil, err
+	}
 	pool, err := gce.makeTargetPool(name, region, hosts, translateAffinityType(affinityType))
 	if err != nil {
 		return nil, err
@@ -230,7 +255,7 @@ func (gce *GCECloud) CreateTCPLoadBalancer(name, region string, externalIP net.I
 	req := &compute.ForwardingRule{
 		Name:       name,
 		IPProtocol: "TCP",
-		PortRange:  strconv.Itoa(port),
+		PortRange:  portRange,
 		Target:     pool,
 	}
 	if len(externalIP) > 0 {
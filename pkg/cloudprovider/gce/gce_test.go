@@ -36,3 +36,30 @@ func TestGetRegion(t *testing.T) {
 		t.Errorf("Unexpected region: %s", zone.Region)
 	}
 }
+
+func TestGetPortRange(t *testing.T) {
+	tests := []struct {
+		ports    []int
+		expected string
+		err      bool
+	}{
+		{ports: []int{}, err: true},
+		{ports: []int{80}, expected: "80"},
+		{ports: []int{443, 80, 8080}, expected: "80-8080"},
+	}
+	for _, test := range tests {
+		portRange, err := getPortRange(test.ports)
+		if test.err {
+			if err == nil {
+				t.Errorf("expected error for %v", test.ports)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for %v: %v", test.ports, err)
+		}
+		if portRange != test.expected {
+			t.Errorf("expected %q for %v, got %q", test.expected, test.ports, portRange)
+		}
+	}
+}
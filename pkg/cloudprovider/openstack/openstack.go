@@ -425,8 +425,12 @@ func (lb *LoadBalancer) TCPLoadBalancerExists(name, region string) (bool, error)
 // a list of regions (from config) and query/create loadbalancers in
 // each region.
 
-func (lb *LoadBalancer) CreateTCPLoadBalancer(name, region string, externalIP net.IP, port int, hosts []string, affinity api.AffinityType) (net.IP, error) {
-	glog.V(2).Infof("CreateTCPLoadBalancer(%v, %v, %v, %v, %v)", name, region, externalIP, port, hosts)
+func (lb *LoadBalancer) CreateTCPLoadBalancer(name, region string, externalIP net.IP, ports []int, hosts []string, affinity api.AffinityType) (net.IP, error) {
+	glog.V(2).Infof("CreateTCPLoadBalancer(%v, %v, %v, %v, %v)", name, region, externalIP, ports, hosts)
+	if len(ports) != 1 {
+		return nil, fmt.Errorf("multiple ports are not yet supported in openstack load balancers")
+	}
+	port := ports[0]
 	if affinity != api.AffinityTypeNone {
 		return nil, fmt.Errorf("unsupported load balancer affinity: %v", affinity)
 	}
@@ -0,0 +1,250 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+	"github.com/golang/glog"
+)
+
+// DaemonManager is responsible for synchronizing DaemonSet objects stored in
+// the system with the pods running on each node. It places the pods of a
+// daemon set itself instead of leaving them to the scheduler, and resyncs
+// whenever a node is added or removed.
+type DaemonManager struct {
+	kubeClient client.Interface
+	podControl DaemonPodControlInterface
+	syncTime   <-chan time.Time
+
+	// To allow injection of syncDaemonSet for testing.
+	syncHandler func(ds *api.DaemonSet, nodes []api.Node) error
+}
+
+// DaemonPodControlInterface is an interface that knows how to add or delete the
+// pods of a daemon set, created as an interface to allow testing.
+type DaemonPodControlInterface interface {
+	// createDaemonPod creates a pod of the daemon set bound to the given node.
+	createDaemonPod(namespace, nodeName string, ds *api.DaemonSet) error
+	// deletePod deletes the pod identified by podID.
+	deletePod(namespace string, podID string) error
+}
+
+func (r RealPodControl) createDaemonPod(namespace, nodeName string, ds *api.DaemonSet) error {
+	pod, err := podFromTemplate(&ds.Spec.Template, ds.Name)
+	if err != nil {
+		return err
+	}
+	// Setting the host keeps the scheduler from placing the pod on any other
+	// node should it see the pod before it is bound below.
+	pod.Spec.Host = nodeName
+	created, err := r.kubeClient.Pods(namespace).Create(pod)
+	if err != nil {
+		return err
+	}
+	return r.kubeClient.Pods(namespace).Bind(&api.Binding{
+		ObjectMeta: api.ObjectMeta{Namespace: namespace, Name: created.Name},
+		Target:     api.ObjectReference{Name: nodeName},
+	})
+}
+
+// NewDaemonManager creates a new DaemonManager.
+func NewDaemonManager(kubeClient client.Interface) *DaemonManager {
+	dm := &DaemonManager{
+		kubeClient: kubeClient,
+		podControl: RealPodControl{
+			kubeClient: kubeClient,
+		},
+	}
+	dm.syncHandler = dm.syncDaemonSet
+	return dm
+}
+
+// Run begins watching nodes and syncing daemon sets.
+func (dm *DaemonManager) Run(period time.Duration) {
+	dm.syncTime = time.Tick(period)
+	resourceVersion := ""
+	go util.Forever(func() { dm.watchNodes(&resourceVersion) }, period)
+}
+
+// watchNodes syncs all daemon sets periodically and whenever a node changes.
+// resourceVersion is a pointer to the resource version to use/update.
+func (dm *DaemonManager) watchNodes(resourceVersion *string) {
+	watching, err := dm.kubeClient.Nodes().Watch(labels.Everything(), labels.Everything(), *resourceVersion)
+	if err != nil {
+		util.HandleError(fmt.Errorf("unable to watch nodes: %v", err))
+		time.Sleep(5 * time.Second)
+		return
+	}
+
+	for {
+		select {
+		case <-dm.syncTime:
+			dm.synchronize()
+		case event, open := <-watching.ResultChan():
+			if !open {
+				// The watch has been closed; let the util.Forever() that
+				// called us call us again.
+				return
+			}
+			if event.Type == watch.Error {
+				util.HandleError(fmt.Errorf("error from node watch: %v", errors.FromObject(event.Object)))
+				// Start over; the next synchronize() call catches anything missed.
+				*resourceVersion = ""
+				continue
+			}
+			node, ok := event.Object.(*api.Node)
+			if !ok {
+				util.HandleError(fmt.Errorf("unexpected object: %#v", event.Object))
+				continue
+			}
+			*resourceVersion = node.ResourceVersion
+			if event.Type == watch.Modified {
+				// Only nodes coming and going change where daemons run, apart
+				// from relabeling, which the periodic sync picks up.
+				continue
+			}
+			glog.V(4).Infof("Node %s was %s, syncing daemon sets", node.Name, event.Type)
+			dm.synchronize()
+		}
+	}
+}
+
+func (dm *DaemonManager) synchronize() {
+	nodeList, err := dm.kubeClient.Nodes().List()
+	if err != nil {
+		util.HandleError(fmt.Errorf("synchronization error: %v", err))
+		return
+	}
+	list, err := dm.kubeClient.DaemonSets(api.NamespaceAll).List(labels.Everything(), labels.Everything())
+	if err != nil {
+		util.HandleError(fmt.Errorf("synchronization error: %v", err))
+		return
+	}
+	wg := sync.WaitGroup{}
+	wg.Add(len(list.Items))
+	for ix := range list.Items {
+		go func(ds *api.DaemonSet) {
+			defer wg.Done()
+			glog.V(4).Infof("periodic sync of daemon set %v", ds.Name)
+			if err := dm.syncHandler(ds, nodeList.Items); err != nil {
+				util.HandleError(fmt.Errorf("error synchronizing daemon set %v: %v", ds.Name, err))
+			}
+		}(&list.Items[ix])
+	}
+	wg.Wait()
+}
+
+// podHost returns the node a pod runs on or has been assigned to.
+func podHost(pod *api.Pod) string {
+	if len(pod.Status.Host) > 0 {
+		return pod.Status.Host
+	}
+	return pod.Spec.Host
+}
+
+func (dm *DaemonManager) syncDaemonSet(ds *api.DaemonSet, nodes []api.Node) error {
+	podList, err := dm.kubeClient.Pods(ds.Namespace).List(labels.Set(ds.Spec.Selector).AsSelector())
+	if err != nil {
+		return err
+	}
+	podsByNode := map[string][]api.Pod{}
+	for _, pod := range FilterActivePods(podList.Items) {
+		host := podHost(&pod)
+		podsByNode[host] = append(podsByNode[host], pod)
+	}
+
+	nodeSelector := labels.SelectorFromSet(ds.Spec.Template.Spec.NodeSelector)
+	var createOn []string
+	var deletePods []string
+	status := api.DaemonSetStatus{}
+	for _, node := range nodes {
+		pods := podsByNode[node.Name]
+		delete(podsByNode, node.Name)
+		shouldRun := nodeSelector.Matches(labels.Set(node.Labels))
+		if shouldRun {
+			status.DesiredNumberScheduled++
+		}
+		switch {
+		case shouldRun && len(pods) == 0:
+			createOn = append(createOn, node.Name)
+		case shouldRun:
+			status.CurrentNumberScheduled++
+			// Keep a single daemon pod per node.
+			for _, pod := range pods[1:] {
+				deletePods = append(deletePods, pod.Name)
+			}
+		case len(pods) > 0:
+			status.NumberMisscheduled++
+			for _, pod := range pods {
+				deletePods = append(deletePods, pod.Name)
+			}
+		}
+	}
+	// Pods left over are on nodes that no longer exist. Pods that have not been
+	// assigned a node were not created by the daemon set, so they are left alone.
+	for host, pods := range podsByNode {
+		if len(host) == 0 {
+			continue
+		}
+		for _, pod := range pods {
+			deletePods = append(deletePods, pod.Name)
+		}
+	}
+
+	wait := sync.WaitGroup{}
+	if len(createOn) > 0 {
+		glog.V(2).Infof("Daemon set %q is missing pods on %d nodes, creating them", ds.Name, len(createOn))
+	}
+	wait.Add(len(createOn))
+	for _, nodeName := range createOn {
+		go func(nodeName string) {
+			defer wait.Done()
+			if err := dm.podControl.createDaemonPod(ds.Namespace, nodeName, ds); err != nil {
+				util.HandleError(fmt.Errorf("unable to create pod of daemon set %v on node %v: %v", ds.Name, nodeName, err))
+			}
+		}(nodeName)
+	}
+	if len(deletePods) > 0 {
+		glog.V(2).Infof("Daemon set %q has %d superfluous pods, deleting them", ds.Name, len(deletePods))
+	}
+	wait.Add(len(deletePods))
+	for _, podName := range deletePods {
+		go func(podName string) {
+			defer wait.Done()
+			if err := dm.podControl.deletePod(ds.Namespace, podName); err != nil {
+				util.HandleError(fmt.Errorf("unable to delete pod %v of daemon set %v: %v", podName, ds.Name, err))
+			}
+		}(podName)
+	}
+	wait.Wait()
+
+	if api.Semantic.DeepEqual(status, ds.Status) {
+		return nil
+	}
+	ds.Status = status
+	_, err = dm.kubeClient.DaemonSets(ds.Namespace).Update(ds)
+	return err
+}
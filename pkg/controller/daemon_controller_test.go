@@ -0,0 +1,227 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+)
+
+type FakeDaemonPodControl struct {
+	createdOn     []string
+	deletePodName []string
+	err           error
+	lock          sync.Mutex
+}
+
+func (f *FakeDaemonPodControl) createDaemonPod(namespace, nodeName string, ds *api.DaemonSet) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.createdOn = append(f.createdOn, nodeName)
+	return nil
+}
+
+func (f *FakeDaemonPodControl) deletePod(namespace string, podName string) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.deletePodName = append(f.deletePodName, podName)
+	return nil
+}
+
+func newDaemonSet(nodeSelector map[string]string) *api.DaemonSet {
+	selector := map[string]string{"name": "logger"}
+	return &api.DaemonSet{
+		ObjectMeta: api.ObjectMeta{Name: "logger", Namespace: api.NamespaceDefault, ResourceVersion: "1"},
+		Spec: api.DaemonSetSpec{
+			Selector: selector,
+			Template: api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{Labels: selector},
+				Spec: api.PodSpec{
+					Containers:    []api.Container{{Name: "logger", Image: "foo/logger"}},
+					NodeSelector:  nodeSelector,
+					RestartPolicy: api.RestartPolicy{Always: &api.RestartPolicyAlways{}},
+				},
+			},
+		},
+	}
+}
+
+func newNodes(names ...string) []api.Node {
+	nodes := []api.Node{}
+	for _, name := range names {
+		nodes = append(nodes, api.Node{ObjectMeta: api.ObjectMeta{Name: name}})
+	}
+	return nodes
+}
+
+// newDaemonPodList returns one running pod per host, in order; a host may be
+// listed more than once.
+func newDaemonPodList(hosts ...string) api.PodList {
+	list := api.PodList{}
+	for i, host := range hosts {
+		list.Items = append(list.Items, api.Pod{
+			ObjectMeta: api.ObjectMeta{Name: fmt.Sprintf("pod-%s-%d", host, i)},
+			Status:     api.PodStatus{Phase: api.PodRunning, Host: host},
+		})
+	}
+	return list
+}
+
+// updatedDaemonSet returns the daemon set the manager last wrote back, if any.
+func updatedDaemonSet(fakeClient *client.Fake) *api.DaemonSet {
+	var ds *api.DaemonSet
+	for _, action := range fakeClient.Actions {
+		if action.Action == "update-daemonset" {
+			ds = action.Value.(*api.DaemonSet)
+		}
+	}
+	return ds
+}
+
+func TestSyncDaemonSet(t *testing.T) {
+	tests := map[string]struct {
+		nodes         []api.Node
+		podHosts      []string
+		nodeSelector  map[string]string
+		expectCreates []string
+		expectDeletes []string
+		expectStatus  api.DaemonSetStatus
+	}{
+		"no nodes": {},
+		"start on every node": {
+			nodes:         newNodes("a", "b"),
+			expectCreates: []string{"a", "b"},
+			expectStatus:  api.DaemonSetStatus{DesiredNumberScheduled: 2},
+		},
+		"steady state": {
+			nodes:        newNodes("a", "b"),
+			podHosts:     []string{"a", "b"},
+			expectStatus: api.DaemonSetStatus{DesiredNumberScheduled: 2, CurrentNumberScheduled: 2},
+		},
+		"new node": {
+			nodes:         newNodes("a", "b", "c"),
+			podHosts:      []string{"a", "b"},
+			expectCreates: []string{"c"},
+			expectStatus:  api.DaemonSetStatus{DesiredNumberScheduled: 3, CurrentNumberScheduled: 2},
+		},
+		"removed node": {
+			nodes:         newNodes("a"),
+			podHosts:      []string{"a", "b"},
+			expectDeletes: []string{"pod-b-1"},
+			expectStatus:  api.DaemonSetStatus{DesiredNumberScheduled: 1, CurrentNumberScheduled: 1},
+		},
+		"extra pods on a node": {
+			nodes:         newNodes("a"),
+			podHosts:      []string{"a", "a", "a"},
+			expectDeletes: []string{"pod-a-1", "pod-a-2"},
+			expectStatus:  api.DaemonSetStatus{DesiredNumberScheduled: 1, CurrentNumberScheduled: 1},
+		},
+		"node selector": {
+			nodes: []api.Node{
+				{ObjectMeta: api.ObjectMeta{Name: "a", Labels: map[string]string{"logging": "true"}}},
+				{ObjectMeta: api.ObjectMeta{Name: "b"}},
+				{ObjectMeta: api.ObjectMeta{Name: "c"}},
+			},
+			podHosts:      []string{"b"},
+			nodeSelector:  map[string]string{"logging": "true"},
+			expectCreates: []string{"a"},
+			expectDeletes: []string{"pod-b-0"},
+			expectStatus:  api.DaemonSetStatus{DesiredNumberScheduled: 1, NumberMisscheduled: 1},
+		},
+		"unassigned pods are left alone": {
+			nodes:        newNodes("a"),
+			podHosts:     []string{"a", ""},
+			expectStatus: api.DaemonSetStatus{DesiredNumberScheduled: 1, CurrentNumberScheduled: 1},
+		},
+	}
+
+	for name, test := range tests {
+		fakeClient := &client.Fake{PodsList: newDaemonPodList(test.podHosts...)}
+		fakePodControl := &FakeDaemonPodControl{}
+		manager := NewDaemonManager(fakeClient)
+		manager.podControl = fakePodControl
+
+		if err := manager.syncDaemonSet(newDaemonSet(test.nodeSelector), test.nodes); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		sort.Strings(fakePodControl.createdOn)
+		sort.Strings(fakePodControl.deletePodName)
+		if !api.Semantic.DeepEqual(fakePodControl.createdOn, test.expectCreates) {
+			t.Errorf("%s: expected creates on %v, got %v", name, test.expectCreates, fakePodControl.createdOn)
+		}
+		if !api.Semantic.DeepEqual(fakePodControl.deletePodName, test.expectDeletes) {
+			t.Errorf("%s: expected deletes of %v, got %v", name, test.expectDeletes, fakePodControl.deletePodName)
+		}
+
+		ds := updatedDaemonSet(fakeClient)
+		if ds == nil {
+			if test.expectStatus != (api.DaemonSetStatus{}) {
+				t.Errorf("%s: expected the daemon set status to be updated", name)
+			}
+			continue
+		}
+		if ds.Status != test.expectStatus {
+			t.Errorf("%s: expected status %#v, got %#v", name, test.expectStatus, ds.Status)
+		}
+	}
+}
+
+func TestDaemonSetSynchronize(t *testing.T) {
+	fakeClient := &client.Fake{
+		MinionsList:   api.NodeList{Items: newNodes("a", "b")},
+		DaemonSetList: api.DaemonSetList{Items: []api.DaemonSet{*newDaemonSet(nil), *newDaemonSet(nil)}},
+	}
+	manager := NewDaemonManager(fakeClient)
+	var lock sync.Mutex
+	synced := 0
+	manager.syncHandler = func(ds *api.DaemonSet, nodes []api.Node) error {
+		lock.Lock()
+		defer lock.Unlock()
+		if len(nodes) != 2 {
+			t.Errorf("expected 2 nodes, got %d", len(nodes))
+		}
+		synced++
+		return nil
+	}
+	manager.synchronize()
+	if synced != 2 {
+		t.Errorf("expected 2 daemon sets to be synced, got %d", synced)
+	}
+}
+
+func TestRealPodControlCreateDaemonPod(t *testing.T) {
+	fakeClient := &client.Fake{}
+	podControl := RealPodControl{kubeClient: fakeClient}
+	if err := podControl.createDaemonPod(api.NamespaceDefault, "a", newDaemonSet(nil)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fakeClient.Actions) != 2 || fakeClient.Actions[0].Action != "create-pod" || fakeClient.Actions[1].Action != "bind-pod" {
+		t.Fatalf("expected a create and a bind, got %#v", fakeClient.Actions)
+	}
+	if pod := fakeClient.Actions[0].Value.(*api.Pod); pod.Spec.Host != "a" {
+		t.Errorf("expected the pod to be placed on node a, got %q", pod.Spec.Host)
+	}
+}
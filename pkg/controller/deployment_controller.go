@@ -0,0 +1,416 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/golang/glog"
+)
+
+const (
+	// DeploymentNameAnnotation ties a replication controller to the
+	// deployment that created it.
+	DeploymentNameAnnotation = "deployment.kubernetes.io/name"
+	// DeploymentRevisionAnnotation records the revision of the pod template
+	// that a replication controller of a deployment runs.
+	DeploymentRevisionAnnotation = "deployment.kubernetes.io/revision"
+	// PodTemplateHashLabel is added to the selector and pod template of each
+	// replication controller of a deployment to keep the pods of different
+	// revisions apart.
+	PodTemplateHashLabel = "deployment.kubernetes.io/pod-template-hash"
+)
+
+// DeploymentManager is responsible for rolling out deployments. It drives the
+// replication controllers of the old and new revisions of each deployment
+// towards the pod template of the deployment.
+type DeploymentManager struct {
+	kubeClient client.Interface
+
+	// To allow injection of syncDeployment for testing.
+	syncHandler func(deployment *api.Deployment) error
+}
+
+// NewDeploymentManager creates a new DeploymentManager.
+func NewDeploymentManager(kubeClient client.Interface) *DeploymentManager {
+	dm := &DeploymentManager{
+		kubeClient: kubeClient,
+	}
+	dm.syncHandler = dm.syncDeployment
+	return dm
+}
+
+// Run begins syncing deployments periodically.
+func (dm *DeploymentManager) Run(period time.Duration) {
+	go util.Forever(func() { dm.synchronize() }, period)
+}
+
+func (dm *DeploymentManager) synchronize() {
+	list, err := dm.kubeClient.Deployments(api.NamespaceAll).List(labels.Everything(), labels.Everything())
+	if err != nil {
+		util.HandleError(fmt.Errorf("synchronization error: %v", err))
+		return
+	}
+	wg := sync.WaitGroup{}
+	wg.Add(len(list.Items))
+	for ix := range list.Items {
+		go func(deployment *api.Deployment) {
+			defer wg.Done()
+			glog.V(4).Infof("periodic sync of deployment %v", deployment.Name)
+			if err := dm.syncHandler(deployment); err != nil {
+				util.HandleError(fmt.Errorf("error synchronizing deployment %v: %v", deployment.Name, err))
+			}
+		}(&list.Items[ix])
+	}
+	wg.Wait()
+}
+
+// deploymentRevision returns the revision recorded on a replication controller
+// of a deployment.
+func deploymentRevision(rc *api.ReplicationController) int64 {
+	revision, err := strconv.ParseInt(rc.Annotations[DeploymentRevisionAnnotation], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return revision
+}
+
+// byRevision sorts replication controllers by ascending revision.
+type byRevision []*api.ReplicationController
+
+func (r byRevision) Len() int           { return len(r) }
+func (r byRevision) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
+func (r byRevision) Less(i, j int) bool { return deploymentRevision(r[i]) < deploymentRevision(r[j]) }
+
+// podTemplateHash returns a short, label-safe hash of a pod template.
+func podTemplateHash(template *api.PodTemplateSpec) string {
+	hasher := fnv.New32a()
+	// Encoding a pod template cannot fail; its fields are all plain data.
+	json.NewEncoder(hasher).Encode(template)
+	return strconv.FormatUint(uint64(hasher.Sum32()), 10)
+}
+
+// templateWithoutHash returns a copy of the pod template of a replication
+// controller as the deployment specified it, i.e. without the hash label.
+func templateWithoutHash(rc *api.ReplicationController) api.PodTemplateSpec {
+	template := *rc.Spec.Template
+	template.Labels = map[string]string{}
+	for k, v := range rc.Spec.Template.Labels {
+		if k != PodTemplateHashLabel {
+			template.Labels[k] = v
+		}
+	}
+	return template
+}
+
+// controllersFor returns the replication controllers of a deployment, sorted
+// by revision.
+func (dm *DeploymentManager) controllersFor(deployment *api.Deployment) ([]*api.ReplicationController, error) {
+	list, err := dm.kubeClient.ReplicationControllers(deployment.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	rcs := []*api.ReplicationController{}
+	for ix := range list.Items {
+		rc := &list.Items[ix]
+		if rc.Annotations[DeploymentNameAnnotation] == deployment.Name && rc.Spec.Template != nil {
+			rcs = append(rcs, rc)
+		}
+	}
+	sort.Sort(byRevision(rcs))
+	return rcs, nil
+}
+
+func (dm *DeploymentManager) syncDeployment(deployment *api.Deployment) error {
+	rcs, err := dm.controllersFor(deployment)
+	if err != nil {
+		return err
+	}
+	if deployment.Spec.RollbackTo != nil {
+		return dm.rollback(deployment, rcs)
+	}
+
+	var newRC *api.ReplicationController
+	oldRCs := []*api.ReplicationController{}
+	for _, rc := range rcs {
+		if newRC == nil && api.Semantic.DeepEqual(templateWithoutHash(rc), deployment.Spec.Template) {
+			newRC = rc
+		} else {
+			oldRCs = append(oldRCs, rc)
+		}
+	}
+	var maxRevision int64
+	if len(rcs) > 0 {
+		maxRevision = deploymentRevision(rcs[len(rcs)-1])
+	}
+
+	if !deployment.Spec.Paused {
+		switch {
+		case newRC == nil:
+			if newRC, err = dm.createController(deployment, maxRevision+1); err != nil {
+				return err
+			}
+		case deploymentRevision(newRC) < maxRevision:
+			// The template went back to that of an older revision, which makes
+			// that revision the newest one.
+			newRC.Annotations[DeploymentRevisionAnnotation] = strconv.FormatInt(maxRevision+1, 10)
+			if newRC, err = dm.kubeClient.ReplicationControllers(newRC.Namespace).Update(newRC); err != nil {
+				return err
+			}
+		}
+	}
+
+	podList, err := dm.kubeClient.Pods(deployment.Namespace).List(labels.Set(deployment.Spec.Selector).AsSelector())
+	if err != nil {
+		return err
+	}
+	pods := FilterActivePods(podList.Items)
+
+	if !deployment.Spec.Paused {
+		switch deployment.Spec.Strategy.Type {
+		case api.RecreateDeploymentStrategyType:
+			err = dm.recreate(deployment, newRC, oldRCs, pods)
+		default:
+			err = dm.rollingUpdate(deployment, newRC, oldRCs, pods)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return dm.updateStatus(deployment, newRC, pods)
+}
+
+// rollback replaces the pod template of the deployment with that of the
+// revision it is asked to roll back to. The rollout itself happens on the
+// next sync.
+func (dm *DeploymentManager) rollback(deployment *api.Deployment, rcs []*api.ReplicationController) error {
+	revision := deployment.Spec.RollbackTo.Revision
+	if revision == 0 {
+		// Roll back to the newest revision before the current one.
+		current := int64(-1)
+		for _, rc := range rcs {
+			if api.Semantic.DeepEqual(templateWithoutHash(rc), deployment.Spec.Template) {
+				current = deploymentRevision(rc)
+			}
+		}
+		for _, rc := range rcs {
+			if r := deploymentRevision(rc); (current < 0 || r < current) && r > revision {
+				revision = r
+			}
+		}
+	}
+	found := false
+	for _, rc := range rcs {
+		if deploymentRevision(rc) == revision {
+			deployment.Spec.Template = templateWithoutHash(rc)
+			found = true
+			break
+		}
+	}
+	if !found {
+		glog.Warningf("Unable to roll back deployment %s/%s: revision %d not found", deployment.Namespace, deployment.Name, deployment.Spec.RollbackTo.Revision)
+	}
+	deployment.Spec.RollbackTo = nil
+	_, err := dm.kubeClient.Deployments(deployment.Namespace).Update(deployment)
+	return err
+}
+
+// createController creates the replication controller of a new revision of
+// the deployment, with no replicas.
+func (dm *DeploymentManager) createController(deployment *api.Deployment, revision int64) (*api.ReplicationController, error) {
+	hash := podTemplateHash(&deployment.Spec.Template)
+	template := deployment.Spec.Template
+	template.Labels = map[string]string{PodTemplateHashLabel: hash}
+	for k, v := range deployment.Spec.Template.Labels {
+		template.Labels[k] = v
+	}
+	selector := map[string]string{PodTemplateHashLabel: hash}
+	for k, v := range deployment.Spec.Selector {
+		selector[k] = v
+	}
+	rc := &api.ReplicationController{
+		ObjectMeta: api.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", deployment.Name, hash),
+			Namespace: deployment.Namespace,
+			Labels:    deployment.Labels,
+			Annotations: map[string]string{
+				DeploymentNameAnnotation:     deployment.Name,
+				DeploymentRevisionAnnotation: strconv.FormatInt(revision, 10),
+			},
+		},
+		Spec: api.ReplicationControllerSpec{
+			Selector: selector,
+			Template: &template,
+		},
+	}
+	glog.V(2).Infof("Creating replication controller %s for revision %d of deployment %s", rc.Name, revision, deployment.Name)
+	created, err := dm.kubeClient.ReplicationControllers(deployment.Namespace).Create(rc)
+	if errors.IsAlreadyExists(err) {
+		// A previous sync created it but it did not show up in the list yet.
+		return dm.kubeClient.ReplicationControllers(deployment.Namespace).Get(rc.Name)
+	}
+	return created, err
+}
+
+func (dm *DeploymentManager) scale(rc *api.ReplicationController, replicas int) error {
+	if rc.Spec.Replicas == replicas {
+		return nil
+	}
+	glog.V(2).Infof("Scaling replication controller %s from %d to %d", rc.Name, rc.Spec.Replicas, replicas)
+	rc.Spec.Replicas = replicas
+	_, err := dm.kubeClient.ReplicationControllers(rc.Namespace).Update(rc)
+	return err
+}
+
+// countPods returns the number of pods of a replication controller, and how
+// many of them are ready.
+func countPods(rc *api.ReplicationController, pods []api.Pod) (total, ready int) {
+	if rc == nil {
+		return 0, 0
+	}
+	selector := labels.Set(rc.Spec.Selector).AsSelector()
+	for i := range pods {
+		if !selector.Matches(labels.Set(pods[i].Labels)) {
+			continue
+		}
+		total++
+		if isPodReady(&pods[i]) {
+			ready++
+		}
+	}
+	return
+}
+
+func isPodReady(pod *api.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == api.PodReady && c.Status == api.ConditionFull {
+			return true
+		}
+	}
+	return false
+}
+
+// recreate scales all old revisions down to zero, and the new revision up once
+// all old pods are gone.
+func (dm *DeploymentManager) recreate(deployment *api.Deployment, newRC *api.ReplicationController, oldRCs []*api.ReplicationController, pods []api.Pod) error {
+	oldPods := 0
+	for _, rc := range oldRCs {
+		if err := dm.scale(rc, 0); err != nil {
+			return err
+		}
+		total, _ := countPods(rc, pods)
+		oldPods += total
+	}
+	if oldPods > 0 {
+		return nil
+	}
+	return dm.scale(newRC, deployment.Spec.Replicas)
+}
+
+// rollingUpdate scales the new revision up and the old revisions down, keeping
+// the number of pods within the surge and the number of ready pods above the
+// unavailability allowed by the deployment.
+func (dm *DeploymentManager) rollingUpdate(deployment *api.Deployment, newRC *api.ReplicationController, oldRCs []*api.ReplicationController, pods []api.Pod) error {
+	maxUnavailable, maxSurge := 1, 1
+	if params := deployment.Spec.Strategy.RollingUpdate; params != nil {
+		maxUnavailable, maxSurge = params.MaxUnavailable, params.MaxSurge
+	}
+	desired := deployment.Spec.Replicas
+
+	// Scale up the new revision as far as the surge allows.
+	total := newRC.Spec.Replicas
+	for _, rc := range oldRCs {
+		total += rc.Spec.Replicas
+	}
+	replicas := newRC.Spec.Replicas
+	if replicas < desired {
+		room := desired + maxSurge - total
+		if room > desired-replicas {
+			room = desired - replicas
+		}
+		if room > 0 {
+			replicas += room
+		}
+	} else {
+		replicas = desired
+	}
+	if err := dm.scale(newRC, replicas); err != nil {
+		return err
+	}
+
+	// Scale down the old revisions, oldest first. Pods that are not ready can
+	// always go; ready ones only as long as enough ready pods remain.
+	ready := 0
+	for i := range pods {
+		if isPodReady(&pods[i]) {
+			ready++
+		}
+	}
+	removable := ready - (desired - maxUnavailable)
+	for _, rc := range oldRCs {
+		if rc.Spec.Replicas == 0 {
+			continue
+		}
+		_, oldReady := countPods(rc, pods)
+		if oldReady > rc.Spec.Replicas {
+			oldReady = rc.Spec.Replicas
+		}
+		scaleDown := rc.Spec.Replicas - oldReady
+		if removable > 0 {
+			more := removable
+			if more > oldReady {
+				more = oldReady
+			}
+			scaleDown += more
+			removable -= more
+		}
+		if err := dm.scale(rc, rc.Spec.Replicas-scaleDown); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (dm *DeploymentManager) updateStatus(deployment *api.Deployment, newRC *api.ReplicationController, pods []api.Pod) error {
+	status := api.DeploymentStatus{Replicas: len(pods)}
+	for i := range pods {
+		if isPodReady(&pods[i]) {
+			status.AvailableReplicas++
+		}
+	}
+	if newRC != nil {
+		status.UpdatedReplicas, _ = countPods(newRC, pods)
+		status.Revision = deploymentRevision(newRC)
+	}
+	if api.Semantic.DeepEqual(status, deployment.Status) {
+		return nil
+	}
+	deployment.Status = status
+	_, err := dm.kubeClient.Deployments(deployment.Namespace).Update(deployment)
+	return err
+}
@@ -0,0 +1,355 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+)
+
+func newDeployment(replicas int, image string) *api.Deployment {
+	selector := map[string]string{"app": "frontend"}
+	return &api.Deployment{
+		ObjectMeta: api.ObjectMeta{Name: "frontend", Namespace: api.NamespaceDefault, ResourceVersion: "1"},
+		Spec: api.DeploymentSpec{
+			Replicas: replicas,
+			Selector: selector,
+			Template: api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{Labels: selector},
+				Spec: api.PodSpec{
+					Containers:    []api.Container{{Name: "frontend", Image: image}},
+					RestartPolicy: api.RestartPolicy{Always: &api.RestartPolicyAlways{}},
+				},
+			},
+			Strategy: api.DeploymentStrategy{
+				Type:          api.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &api.RollingUpdateDeployment{MaxUnavailable: 1, MaxSurge: 1},
+			},
+		},
+	}
+}
+
+// newDeploymentController returns a replication controller of the given
+// revision running the template of the deployment with the given image.
+func newDeploymentController(revision int64, image string, replicas int) api.ReplicationController {
+	deployment := newDeployment(replicas, image)
+	hash := podTemplateHash(&deployment.Spec.Template)
+	template := deployment.Spec.Template
+	template.Labels = map[string]string{"app": "frontend", PodTemplateHashLabel: hash}
+	return api.ReplicationController{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "frontend-" + hash,
+			Namespace: api.NamespaceDefault,
+			Annotations: map[string]string{
+				DeploymentNameAnnotation:     "frontend",
+				DeploymentRevisionAnnotation: strconv.FormatInt(revision, 10),
+			},
+		},
+		Spec: api.ReplicationControllerSpec{
+			Replicas: replicas,
+			Selector: map[string]string{"app": "frontend", PodTemplateHashLabel: hash},
+			Template: &template,
+		},
+	}
+}
+
+// newControllerPods returns running pods of a replication controller, the
+// first ready of which are ready.
+func newControllerPods(rc api.ReplicationController, count, ready int) []api.Pod {
+	pods := []api.Pod{}
+	for i := 0; i < count; i++ {
+		pod := api.Pod{
+			ObjectMeta: api.ObjectMeta{Name: fmt.Sprintf("%s-%d", rc.Name, i), Labels: rc.Spec.Template.Labels},
+			Status:     api.PodStatus{Phase: api.PodRunning},
+		}
+		if i < ready {
+			pod.Status.Conditions = []api.PodCondition{{Type: api.PodReady, Status: api.ConditionFull}}
+		}
+		pods = append(pods, pod)
+	}
+	return pods
+}
+
+// scaledControllers returns the replica counts the manager last wrote for
+// each replication controller it updated.
+func scaledControllers(fakeClient *client.Fake) map[string]int {
+	scaled := map[string]int{}
+	for _, action := range fakeClient.Actions {
+		if action.Action == "update-controller" {
+			rc := action.Value.(*api.ReplicationController)
+			scaled[rc.Name] = rc.Spec.Replicas
+		}
+	}
+	return scaled
+}
+
+// updatedDeployment returns the deployment the manager last wrote back, if any.
+func updatedDeployment(fakeClient *client.Fake) *api.Deployment {
+	var deployment *api.Deployment
+	for _, action := range fakeClient.Actions {
+		if action.Action == "update-deployment" {
+			deployment = action.Value.(*api.Deployment)
+		}
+	}
+	return deployment
+}
+
+func TestSyncDeploymentCreatesController(t *testing.T) {
+	fakeClient := &client.Fake{}
+	manager := NewDeploymentManager(fakeClient)
+	if err := manager.syncDeployment(newDeployment(3, "frontend:v1")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var rc *api.ReplicationController
+	for _, action := range fakeClient.Actions {
+		if action.Action == "create-controller" {
+			rc = action.Value.(*api.ReplicationController)
+		}
+	}
+	if rc == nil {
+		t.Fatalf("expected a replication controller to be created, got %#v", fakeClient.Actions)
+	}
+	hash := rc.Spec.Selector[PodTemplateHashLabel]
+	if len(hash) == 0 || rc.Spec.Selector["app"] != "frontend" || rc.Spec.Template.Labels[PodTemplateHashLabel] != hash {
+		t.Errorf("expected the selector and template to carry the template hash: %#v", rc.Spec)
+	}
+	if rc.Annotations[DeploymentNameAnnotation] != "frontend" || rc.Annotations[DeploymentRevisionAnnotation] != "1" {
+		t.Errorf("unexpected annotations: %v", rc.Annotations)
+	}
+	if rc.Spec.Replicas != 0 {
+		t.Errorf("expected the controller to be created without replicas, got %d", rc.Spec.Replicas)
+	}
+}
+
+func TestSyncDeploymentRollingUpdate(t *testing.T) {
+	oldRC := newDeploymentController(1, "frontend:v1", 3)
+	newRC := newDeploymentController(2, "frontend:v2", 0)
+	tests := map[string]struct {
+		oldReplicas, newReplicas int
+		oldPods, oldReady        int
+		newPods, newReady        int
+		expectOld, expectNew     int
+	}{
+		"start": {
+			oldReplicas: 3, oldPods: 3, oldReady: 3,
+			expectOld: 2, expectNew: 1,
+		},
+		"waiting for new pods to be ready": {
+			oldReplicas: 2, oldPods: 2, oldReady: 2,
+			newReplicas: 2, newPods: 2,
+			expectOld: 2, expectNew: 2,
+		},
+		"new pods ready": {
+			oldReplicas: 2, oldPods: 2, oldReady: 2,
+			newReplicas: 2, newPods: 2, newReady: 2,
+			expectOld: 0, expectNew: 2,
+		},
+		"unready old pods are removed": {
+			oldReplicas: 3, oldPods: 3,
+			expectOld: 0, expectNew: 1,
+		},
+		"finish": {
+			oldPods: 0, newReplicas: 2, newPods: 2, newReady: 2,
+			expectOld: 0, expectNew: 3,
+		},
+	}
+
+	for name, test := range tests {
+		old, current := oldRC, newRC
+		old.Spec.Replicas, current.Spec.Replicas = test.oldReplicas, test.newReplicas
+		pods := append(newControllerPods(old, test.oldPods, test.oldReady), newControllerPods(current, test.newPods, test.newReady)...)
+		fakeClient := &client.Fake{
+			CtrlList: api.ReplicationControllerList{Items: []api.ReplicationController{old, current}},
+			PodsList: api.PodList{Items: pods},
+		}
+		manager := NewDeploymentManager(fakeClient)
+		if err := manager.syncDeployment(newDeployment(3, "frontend:v2")); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		scaled := scaledControllers(fakeClient)
+		if replicas, ok := scaled[old.Name]; !ok && test.expectOld != test.oldReplicas || ok && replicas != test.expectOld {
+			t.Errorf("%s: expected the old controller at %d replicas, got %v", name, test.expectOld, scaled)
+		}
+		if replicas, ok := scaled[current.Name]; !ok && test.expectNew != test.newReplicas || ok && replicas != test.expectNew {
+			t.Errorf("%s: expected the new controller at %d replicas, got %v", name, test.expectNew, scaled)
+		}
+		deployment := updatedDeployment(fakeClient)
+		if deployment == nil {
+			t.Errorf("%s: expected the deployment status to be updated", name)
+			continue
+		}
+		expected := api.DeploymentStatus{
+			Replicas:          test.oldPods + test.newPods,
+			UpdatedReplicas:   test.newPods,
+			AvailableReplicas: test.oldReady + test.newReady,
+			Revision:          2,
+		}
+		if deployment.Status != expected {
+			t.Errorf("%s: expected status %#v, got %#v", name, expected, deployment.Status)
+		}
+	}
+}
+
+func TestSyncDeploymentRecreate(t *testing.T) {
+	oldRC := newDeploymentController(1, "frontend:v1", 3)
+	newRC := newDeploymentController(2, "frontend:v2", 0)
+	for _, oldPods := range []int{3, 0} {
+		fakeClient := &client.Fake{
+			CtrlList: api.ReplicationControllerList{Items: []api.ReplicationController{oldRC, newRC}},
+			PodsList: api.PodList{Items: newControllerPods(oldRC, oldPods, oldPods)},
+		}
+		manager := NewDeploymentManager(fakeClient)
+		deployment := newDeployment(3, "frontend:v2")
+		deployment.Spec.Strategy = api.DeploymentStrategy{Type: api.RecreateDeploymentStrategyType}
+		if err := manager.syncDeployment(deployment); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		scaled := scaledControllers(fakeClient)
+		if scaled[oldRC.Name] != 0 {
+			t.Errorf("expected the old controller to be scaled to 0, got %v", scaled)
+		}
+		replicas, ok := scaled[newRC.Name]
+		if oldPods > 0 && ok {
+			t.Errorf("expected the new controller to wait for old pods to go away, got %v", scaled)
+		}
+		if oldPods == 0 && replicas != 3 {
+			t.Errorf("expected the new controller to be scaled to 3, got %v", scaled)
+		}
+	}
+}
+
+func TestSyncDeploymentPaused(t *testing.T) {
+	oldRC := newDeploymentController(1, "frontend:v1", 3)
+	fakeClient := &client.Fake{
+		CtrlList: api.ReplicationControllerList{Items: []api.ReplicationController{oldRC}},
+		PodsList: api.PodList{Items: newControllerPods(oldRC, 3, 3)},
+	}
+	manager := NewDeploymentManager(fakeClient)
+	deployment := newDeployment(3, "frontend:v2")
+	deployment.Spec.Paused = true
+	if err := manager.syncDeployment(deployment); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, action := range fakeClient.Actions {
+		if action.Action == "create-controller" || action.Action == "update-controller" {
+			t.Errorf("expected no changes to controllers while paused, got %#v", action)
+		}
+	}
+	if updated := updatedDeployment(fakeClient); updated == nil || updated.Status.Replicas != 3 || updated.Status.UpdatedReplicas != 0 {
+		t.Errorf("expected the status to be updated while paused, got %#v", updated)
+	}
+}
+
+func TestSyncDeploymentRollback(t *testing.T) {
+	v1 := newDeploymentController(1, "frontend:v1", 0)
+	v2 := newDeploymentController(2, "frontend:v2", 0)
+	v3 := newDeploymentController(3, "frontend:v3", 3)
+	tests := map[string]struct {
+		revision    int64
+		expectImage string
+	}{
+		"previous revision": {0, "frontend:v2"},
+		"given revision":    {1, "frontend:v1"},
+		"unknown revision":  {7, "frontend:v3"},
+	}
+
+	for name, test := range tests {
+		fakeClient := &client.Fake{
+			CtrlList: api.ReplicationControllerList{Items: []api.ReplicationController{v3, v1, v2}},
+		}
+		manager := NewDeploymentManager(fakeClient)
+		deployment := newDeployment(3, "frontend:v3")
+		deployment.Spec.RollbackTo = &api.RollbackConfig{Revision: test.revision}
+		if err := manager.syncDeployment(deployment); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		updated := updatedDeployment(fakeClient)
+		if updated == nil {
+			t.Errorf("%s: expected the deployment to be updated", name)
+			continue
+		}
+		if updated.Spec.RollbackTo != nil {
+			t.Errorf("%s: expected the rollback to be cleared", name)
+		}
+		if image := updated.Spec.Template.Spec.Containers[0].Image; image != test.expectImage {
+			t.Errorf("%s: expected image %s, got %s", name, test.expectImage, image)
+		}
+		if _, ok := updated.Spec.Template.Labels[PodTemplateHashLabel]; ok {
+			t.Errorf("%s: expected the template hash label to be dropped, got %v", name, updated.Spec.Template.Labels)
+		}
+		if len(scaledControllers(fakeClient)) != 0 {
+			t.Errorf("%s: expected no controllers to be scaled", name)
+		}
+	}
+}
+
+func TestSyncDeploymentRevisitedRevision(t *testing.T) {
+	v1 := newDeploymentController(1, "frontend:v1", 0)
+	v2 := newDeploymentController(2, "frontend:v2", 3)
+	fakeClient := &client.Fake{
+		CtrlList: api.ReplicationControllerList{Items: []api.ReplicationController{v1, v2}},
+	}
+	manager := NewDeploymentManager(fakeClient)
+	if err := manager.syncDeployment(newDeployment(3, "frontend:v1")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, action := range fakeClient.Actions {
+		if action.Action == "create-controller" {
+			t.Errorf("expected the controller of revision 1 to be reused")
+		}
+	}
+	updates := 0
+	for _, action := range fakeClient.Actions {
+		if action.Action != "update-controller" {
+			continue
+		}
+		rc := action.Value.(*api.ReplicationController)
+		if rc.Name == v1.Name && updates == 0 && rc.Annotations[DeploymentRevisionAnnotation] != "3" {
+			t.Errorf("expected the revisited template to become revision 3, got %v", rc.Annotations)
+		}
+		updates++
+	}
+	if updates == 0 {
+		t.Errorf("expected the revision to be bumped")
+	}
+}
+
+func TestDeploymentSynchronize(t *testing.T) {
+	fakeClient := &client.Fake{
+		DeploymentList: api.DeploymentList{Items: []api.Deployment{*newDeployment(1, "a"), *newDeployment(2, "b")}},
+	}
+	manager := NewDeploymentManager(fakeClient)
+	var lock sync.Mutex
+	synced := 0
+	manager.syncHandler = func(deployment *api.Deployment) error {
+		lock.Lock()
+		defer lock.Unlock()
+		synced++
+		return nil
+	}
+	manager.synchronize()
+	if synced != 2 {
+		t.Errorf("expected 2 deployments to be synced, got %d", synced)
+	}
+}
@@ -15,5 +15,5 @@ limitations under the License.
 */
 
 // Package controller contains logic for watching and synchronizing
-// replicationControllers.
+// replicationControllers, jobs, daemon sets and deployments.
 package controller
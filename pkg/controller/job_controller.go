@@ -0,0 +1,192 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/golang/glog"
+)
+
+// JobManager is responsible for synchronizing Job objects stored in the system
+// with the pods that run them. Unlike a replication controller, a job stops
+// creating pods once enough of them have finished successfully.
+type JobManager struct {
+	kubeClient client.Interface
+	podControl JobPodControlInterface
+
+	// To allow injection of syncJob for testing.
+	syncHandler func(job *api.Job) error
+}
+
+// JobPodControlInterface is an interface that knows how to add or delete the
+// pods of a job, created as an interface to allow testing.
+type JobPodControlInterface interface {
+	// createJobPod creates a new pod according to the template of the job.
+	createJobPod(namespace string, job *api.Job) error
+	// deletePod deletes the pod identified by podID.
+	deletePod(namespace string, podID string) error
+}
+
+func (r RealPodControl) createJobPod(namespace string, job *api.Job) error {
+	return r.createPodFromTemplate(namespace, &job.Spec.Template, job.Name)
+}
+
+// NewJobManager creates a new JobManager.
+func NewJobManager(kubeClient client.Interface) *JobManager {
+	jm := &JobManager{
+		kubeClient: kubeClient,
+		podControl: RealPodControl{
+			kubeClient: kubeClient,
+		},
+	}
+	jm.syncHandler = jm.syncJob
+	return jm
+}
+
+// Run begins syncing jobs periodically.
+func (jm *JobManager) Run(period time.Duration) {
+	go util.Forever(func() { jm.synchronize() }, period)
+}
+
+func (jm *JobManager) synchronize() {
+	list, err := jm.kubeClient.Jobs(api.NamespaceAll).List(labels.Everything(), labels.Everything())
+	if err != nil {
+		util.HandleError(fmt.Errorf("synchronization error: %v", err))
+		return
+	}
+	wg := sync.WaitGroup{}
+	wg.Add(len(list.Items))
+	for ix := range list.Items {
+		go func(job *api.Job) {
+			defer wg.Done()
+			glog.V(4).Infof("periodic sync of job %v", job.Name)
+			if err := jm.syncHandler(job); err != nil {
+				util.HandleError(fmt.Errorf("error synchronizing job %v: %v", job.Name, err))
+			}
+		}(&list.Items[ix])
+	}
+	wg.Wait()
+}
+
+// countTerminatedPods returns the number of pods that succeeded and failed.
+func countTerminatedPods(pods []api.Pod) (succeeded, failed int) {
+	for _, pod := range pods {
+		switch pod.Status.Phase {
+		case api.PodSucceeded:
+			succeeded++
+		case api.PodFailed:
+			failed++
+		}
+	}
+	return
+}
+
+func (jm *JobManager) syncJob(job *api.Job) error {
+	// A finished job is never run again, even if its pods are deleted.
+	if job.Status.CompletionTime != nil {
+		return nil
+	}
+	s := labels.Set(job.Spec.Selector).AsSelector()
+	podList, err := jm.kubeClient.Pods(job.Namespace).List(s)
+	if err != nil {
+		return err
+	}
+	activePods := FilterActivePods(podList.Items)
+	succeeded, failed := countTerminatedPods(podList.Items)
+	// Succeeded pods may have been deleted since they were last counted.
+	if succeeded < job.Status.Succeeded {
+		succeeded = job.Status.Succeeded
+	}
+
+	completions, parallelism := 1, 1
+	if job.Spec.Completions != nil {
+		completions = *job.Spec.Completions
+	}
+	if job.Spec.Parallelism != nil {
+		parallelism = *job.Spec.Parallelism
+	}
+
+	// Never run more pods than are needed to reach the desired completions.
+	wantActive := completions - succeeded
+	if wantActive < 0 {
+		wantActive = 0
+	}
+	if wantActive > parallelism {
+		wantActive = parallelism
+	}
+	active := len(activePods)
+	if diff := wantActive - active; diff > 0 {
+		glog.V(2).Infof("Too few pods for job %q, creating %d", job.Name, diff)
+		active += jm.forEach(diff, func(int) error {
+			return jm.podControl.createJobPod(job.Namespace, job)
+		})
+	} else if diff < 0 {
+		diff *= -1
+		glog.V(2).Infof("Too many pods for job %q, deleting %d", job.Name, diff)
+		active -= jm.forEach(diff, func(ix int) error {
+			return jm.podControl.deletePod(job.Namespace, activePods[ix].Name)
+		})
+	}
+
+	status := job.Status
+	status.Active = active
+	status.Succeeded = succeeded
+	status.Failed = failed
+	now := util.Now()
+	if status.StartTime == nil {
+		status.StartTime = &now
+	}
+	if succeeded >= completions && status.CompletionTime == nil {
+		status.CompletionTime = &now
+	}
+	if api.Semantic.DeepEqual(status, job.Status) {
+		return nil
+	}
+	job.Status = status
+	_, err = jm.kubeClient.Jobs(job.Namespace).Update(job)
+	return err
+}
+
+// forEach calls fn concurrently for each index below count and returns the
+// number of calls that succeeded.
+func (jm *JobManager) forEach(count int, fn func(ix int) error) int {
+	var lock sync.Mutex
+	done := 0
+	wait := sync.WaitGroup{}
+	wait.Add(count)
+	for i := 0; i < count; i++ {
+		go func(ix int) {
+			defer wait.Done()
+			if err := fn(ix); err != nil {
+				util.HandleError(err)
+				return
+			}
+			lock.Lock()
+			defer lock.Unlock()
+			done++
+		}(i)
+	}
+	wait.Wait()
+	return done
+}
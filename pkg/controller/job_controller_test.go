@@ -0,0 +1,258 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+type FakeJobPodControl struct {
+	created       int
+	deletePodName []string
+	err           error
+	lock          sync.Mutex
+}
+
+func (f *FakeJobPodControl) createJobPod(namespace string, job *api.Job) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.created++
+	return nil
+}
+
+func (f *FakeJobPodControl) deletePod(namespace string, podName string) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.deletePodName = append(f.deletePodName, podName)
+	return nil
+}
+
+func newJob(completions, parallelism int) *api.Job {
+	selector := map[string]string{"job": "foo"}
+	return &api.Job{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault, ResourceVersion: "1"},
+		Spec: api.JobSpec{
+			Completions: &completions,
+			Parallelism: &parallelism,
+			Selector:    selector,
+			Template: api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{Labels: selector},
+				Spec: api.PodSpec{
+					Containers: []api.Container{{Name: "foo", Image: "foo/bar"}},
+					RestartPolicy: api.RestartPolicy{
+						Never: &api.RestartPolicyNever{},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newJobPodList(pending, succeeded, failed int) api.PodList {
+	list := api.PodList{}
+	add := func(count int, phase api.PodPhase) {
+		for i := 0; i < count; i++ {
+			list.Items = append(list.Items, api.Pod{
+				ObjectMeta: api.ObjectMeta{Name: fmt.Sprintf("pod-%s-%d", phase, i)},
+				Status:     api.PodStatus{Phase: phase},
+			})
+		}
+	}
+	add(pending, api.PodPending)
+	add(succeeded, api.PodSucceeded)
+	add(failed, api.PodFailed)
+	return list
+}
+
+// updatedJob returns the job the manager last wrote back, if any.
+func updatedJob(fakeClient *client.Fake) *api.Job {
+	var job *api.Job
+	for _, action := range fakeClient.Actions {
+		if action.Action == "update-job" {
+			job = action.Value.(*api.Job)
+		}
+	}
+	return job
+}
+
+func TestSyncJob(t *testing.T) {
+	tests := map[string]struct {
+		completions, parallelism     int
+		pending, succeeded, failed   int
+		podControlErr                error
+		expectCreates, expectDeletes int
+		expectActive                 int
+		expectComplete               bool
+	}{
+		"start job": {
+			completions: 5, parallelism: 2,
+			expectCreates: 2, expectActive: 2,
+		},
+		"steady state": {
+			completions: 5, parallelism: 2,
+			pending: 2, succeeded: 1,
+			expectActive: 2,
+		},
+		"replace failed pods": {
+			completions: 5, parallelism: 2,
+			succeeded: 1, failed: 2,
+			expectCreates: 2, expectActive: 2,
+		},
+		"only run the remaining completions": {
+			completions: 5, parallelism: 3,
+			pending: 1, succeeded: 3,
+			expectCreates: 1, expectActive: 2,
+		},
+		"too many pods": {
+			completions: 5, parallelism: 1,
+			pending:       3,
+			expectDeletes: 2, expectActive: 1,
+		},
+		"complete": {
+			completions: 2, parallelism: 2,
+			succeeded: 2, failed: 1,
+			expectComplete: true,
+		},
+		"pod creation fails": {
+			completions: 2, parallelism: 2,
+			podControlErr: fmt.Errorf("fake error"),
+		},
+	}
+
+	for name, test := range tests {
+		fakeClient := &client.Fake{PodsList: newJobPodList(test.pending, test.succeeded, test.failed)}
+		fakePodControl := &FakeJobPodControl{err: test.podControlErr}
+		manager := NewJobManager(fakeClient)
+		manager.podControl = fakePodControl
+
+		if err := manager.syncJob(newJob(test.completions, test.parallelism)); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if fakePodControl.created != test.expectCreates {
+			t.Errorf("%s: expected %d creates, got %d", name, test.expectCreates, fakePodControl.created)
+		}
+		if len(fakePodControl.deletePodName) != test.expectDeletes {
+			t.Errorf("%s: expected %d deletes, got %d", name, test.expectDeletes, len(fakePodControl.deletePodName))
+		}
+
+		job := updatedJob(fakeClient)
+		if job == nil {
+			t.Errorf("%s: expected the job status to be updated", name)
+			continue
+		}
+		status := job.Status
+		if status.Active != test.expectActive || status.Succeeded != test.succeeded || status.Failed != test.failed {
+			t.Errorf("%s: unexpected status counts: %#v", name, status)
+		}
+		if status.StartTime == nil {
+			t.Errorf("%s: expected the start time to be set", name)
+		}
+		if complete := status.CompletionTime != nil; complete != test.expectComplete {
+			t.Errorf("%s: expected complete %v, got %v", name, test.expectComplete, complete)
+		}
+	}
+}
+
+func TestSyncJobUnchangedStatus(t *testing.T) {
+	fakeClient := &client.Fake{PodsList: newJobPodList(1, 0, 0)}
+	manager := NewJobManager(fakeClient)
+	manager.podControl = &FakeJobPodControl{}
+
+	job := newJob(1, 1)
+	if err := manager.syncJob(job); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	updated := updatedJob(fakeClient)
+	if updated == nil {
+		t.Fatalf("expected the job status to be updated")
+	}
+
+	fakeClient.Actions = nil
+	if err := manager.syncJob(updated); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if job := updatedJob(fakeClient); job != nil {
+		t.Errorf("expected no update when the status is unchanged, got %#v", job.Status)
+	}
+}
+
+func TestSyncJobCompleted(t *testing.T) {
+	fakeClient := &client.Fake{}
+	fakePodControl := &FakeJobPodControl{}
+	manager := NewJobManager(fakeClient)
+	manager.podControl = fakePodControl
+
+	// the succeeded pods of a finished job were deleted
+	job := newJob(2, 2)
+	now := util.Now()
+	job.Status = api.JobStatus{Succeeded: 2, StartTime: &now, CompletionTime: &now}
+	if err := manager.syncJob(job); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fakePodControl.created != 0 || len(fakeClient.Actions) != 0 {
+		t.Errorf("expected a finished job to be left alone, got %d creates and %v", fakePodControl.created, fakeClient.Actions)
+	}
+}
+
+func TestSyncJobKeepsSucceeded(t *testing.T) {
+	fakeClient := &client.Fake{PodsList: newJobPodList(0, 1, 0)}
+	fakePodControl := &FakeJobPodControl{}
+	manager := NewJobManager(fakeClient)
+	manager.podControl = fakePodControl
+
+	// two succeeded pods were counted before, one of them has been deleted since
+	job := newJob(3, 3)
+	job.Status.Succeeded = 2
+	if err := manager.syncJob(job); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fakePodControl.created != 1 {
+		t.Errorf("expected 1 create, got %d", fakePodControl.created)
+	}
+	if updated := updatedJob(fakeClient); updated == nil || updated.Status.Succeeded != 2 {
+		t.Errorf("expected the succeeded count to be kept, got %#v", updated)
+	}
+}
+
+func TestJobSynchronize(t *testing.T) {
+	fakeClient := &client.Fake{
+		JobList: api.JobList{Items: []api.Job{*newJob(1, 1), *newJob(2, 1)}},
+	}
+	manager := NewJobManager(fakeClient)
+	var lock sync.Mutex
+	synced := 0
+	manager.syncHandler = func(job *api.Job) error {
+		lock.Lock()
+		defer lock.Unlock()
+		synced++
+		return nil
+	}
+	manager.synchronize()
+	if synced != 2 {
+		t.Errorf("expected 2 jobs to be synced, got %d", synced)
+	}
+}
@@ -60,19 +60,38 @@ type RealPodControl struct {
 const DefaultSyncPeriod = 10 * time.Second
 
 func (r RealPodControl) createReplica(namespace string, controller api.ReplicationController) {
+	if err := r.createPodFromTemplate(namespace, controller.Spec.Template, controller.Name); err != nil {
+		util.HandleError(fmt.Errorf("unable to create pod replica: %v", err))
+	}
+}
+
+// createPodFromTemplate creates a pod from template, generating its name from
+// the name of the object that owns it.
+func (r RealPodControl) createPodFromTemplate(namespace string, template *api.PodTemplateSpec, ownerName string) error {
+	pod, err := podFromTemplate(template, ownerName)
+	if err != nil {
+		return err
+	}
+	_, err = r.kubeClient.Pods(namespace).Create(pod)
+	return err
+}
+
+// podFromTemplate returns a new pod described by template, with a name
+// generated from the name of the object that owns it.
+func podFromTemplate(template *api.PodTemplateSpec, ownerName string) (*api.Pod, error) {
 	desiredLabels := make(labels.Set)
-	for k, v := range controller.Spec.Template.Labels {
+	for k, v := range template.Labels {
 		desiredLabels[k] = v
 	}
 	desiredAnnotations := make(labels.Set)
-	for k, v := range controller.Spec.Template.Annotations {
+	for k, v := range template.Annotations {
 		desiredAnnotations[k] = v
 	}
 
 	// use the dash (if the name isn't too long) to make the pod name a bit prettier
-	prefix := fmt.Sprintf("%s-", controller.Name)
+	prefix := fmt.Sprintf("%s-", ownerName)
 	if ok, _ := validation.ValidatePodName(prefix, true); !ok {
-		prefix = controller.Name
+		prefix = ownerName
 	}
 
 	pod := &api.Pod{
@@ -82,21 +101,17 @@ func (r RealPodControl) createReplica(namespace string, controller api.Replicati
 			GenerateName: prefix,
 		},
 	}
-	if err := api.Scheme.Convert(&controller.Spec.Template.Spec, &pod.Spec); err != nil {
-		util.HandleError(fmt.Errorf("unable to convert pod template: %v", err))
-		return
+	if err := api.Scheme.Convert(&template.Spec, &pod.Spec); err != nil {
+		return nil, fmt.Errorf("unable to convert pod template: %v", err)
 	}
 	if labels.Set(pod.Labels).AsSelector().Empty() {
-		util.HandleError(fmt.Errorf("unable to create pod replica, no labels"))
-		return
-	}
-	if _, err := r.kubeClient.Pods(namespace).Create(pod); err != nil {
-		util.HandleError(fmt.Errorf("unable to create pod replica: %v", err))
+		return nil, fmt.Errorf("no labels")
 	}
+	return pod, nil
 }
 
 func (r RealPodControl) deletePod(namespace, podID string) error {
-	return r.kubeClient.Pods(namespace).Delete(podID)
+	return r.kubeClient.Pods(namespace).Delete(podID, nil)
 }
 
 // NewReplicationManager creates a new ReplicationManager.
@@ -178,11 +193,14 @@ func (rm *ReplicationManager) watchControllers(resourceVersion *string) {
 }
 
 // Helper function. Also used in pkg/registry/controller, for now.
+// Pods that are being deleted are not active, so that they are replaced while
+// they shut down.
 func FilterActivePods(pods []api.Pod) []api.Pod {
 	var result []api.Pod
 	for _, value := range pods {
 		if api.PodSucceeded != value.Status.Phase &&
-			api.PodFailed != value.Status.Phase {
+			api.PodFailed != value.Status.Phase &&
+			value.DeletionTimestamp == nil {
 			result = append(result, value)
 		}
 	}
@@ -178,6 +178,30 @@ func TestSyncReplicationControllerCreates(t *testing.T) {
 	validateSyncReplication(t, &fakePodControl, 2, 0)
 }
 
+func TestSyncReplicationControllerReplacesTerminating(t *testing.T) {
+	pods := newPodList(2)
+	now := util.Now()
+	pods.Items[0].DeletionTimestamp = &now
+	body := runtime.EncodeOrDie(testapi.Codec(), pods)
+	fakeHandler := util.FakeHandler{
+		StatusCode:   200,
+		ResponseBody: string(body),
+	}
+	testServer := httptest.NewServer(&fakeHandler)
+	defer testServer.Close()
+	client := client.NewOrDie(&client.Config{Host: testServer.URL, Version: testapi.Version()})
+
+	fakePodControl := FakePodControl{}
+
+	manager := NewReplicationManager(client)
+	manager.podControl = &fakePodControl
+
+	controllerSpec := newReplicationController(2)
+
+	manager.syncReplicationController(controllerSpec)
+	validateSyncReplication(t, &fakePodControl, 1, 0)
+}
+
 func TestCreateReplica(t *testing.T) {
 	ns := api.NamespaceDefault
 	body := runtime.EncodeOrDie(testapi.Codec(), &api.Pod{})
@@ -21,6 +21,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	docker "github.com/fsouza/go-dockerclient"
 	"github.com/golang/glog"
 )
@@ -144,6 +145,55 @@ func (dk *lazyDockerKeyring) Lookup(image string) (docker.AuthConfiguration, boo
 	return keyring.Lookup(image)
 }
 
+// unionDockerKeyring is an implementation of DockerKeyring that looks an image
+// up in a list of keyrings, returning the credentials of the first one that has them.
+type unionDockerKeyring struct {
+	keyrings []DockerKeyring
+}
+
+// Lookup implements the DockerKeyring method for fetching credentials
+// based on image name.
+func (k *unionDockerKeyring) Lookup(image string) (docker.AuthConfiguration, bool) {
+	for _, subKeyring := range k.keyrings {
+		if subKeyring == nil {
+			continue
+		}
+		if auth, ok := subKeyring.Lookup(image); ok {
+			return auth, true
+		}
+	}
+	return docker.AuthConfiguration{}, false
+}
+
+// MakeDockerKeyring returns a keyring built from the dockercfg secrets passed in,
+// which takes precedence over defaultKeyring. Secrets of other types are ignored.
+func MakeDockerKeyring(passedSecrets []api.Secret, defaultKeyring DockerKeyring) (DockerKeyring, error) {
+	passedCredentials := []DockerConfig{}
+	for _, passedSecret := range passedSecrets {
+		if passedSecret.Type != api.SecretTypeDockercfg {
+			continue
+		}
+		dockercfgBytes, exists := passedSecret.Data[api.DockerConfigKey]
+		if !exists || len(dockercfgBytes) == 0 {
+			continue
+		}
+		dockercfg, err := readDockerConfigFileFromBytes(dockercfgBytes)
+		if err != nil {
+			return nil, err
+		}
+		passedCredentials = append(passedCredentials, dockercfg)
+	}
+
+	if len(passedCredentials) == 0 {
+		return defaultKeyring, nil
+	}
+	basicKeyring := &BasicDockerKeyring{}
+	for _, currCredentials := range passedCredentials {
+		basicKeyring.Add(currCredentials)
+	}
+	return &unionDockerKeyring{[]DockerKeyring{basicKeyring, defaultKeyring}}, nil
+}
+
 type FakeKeyring struct {
 	auth docker.AuthConfiguration
 	ok   bool
@@ -20,6 +20,9 @@ import (
 	"encoding/base64"
 	"fmt"
 	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	docker "github.com/fsouza/go-dockerclient"
 )
 
 func TestDockerKeyringFromBytes(t *testing.T) {
@@ -259,3 +262,74 @@ func TestLazyKeyring(t *testing.T) {
 		t.Errorf("Unexpected number of Provide calls: %v", provider.Count)
 	}
 }
+
+func dockercfgSecret(url, username, password string) api.Secret {
+	auth := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", username, password)))
+	return api.Secret{
+		ObjectMeta: api.ObjectMeta{Name: "registry-key", Namespace: "default"},
+		Type:       api.SecretTypeDockercfg,
+		Data: map[string][]byte{
+			api.DockerConfigKey: []byte(fmt.Sprintf(`{"https://%s": {"email": "foo@bar.baz", "auth": %q}}`, url, auth)),
+		},
+	}
+}
+
+func TestMakeDockerKeyring(t *testing.T) {
+	defaultKeyring := &BasicDockerKeyring{}
+	defaultKeyring.Add(DockerConfig{
+		"https://node.kubernetes.io": DockerConfigEntry{Username: "node", Password: "nodepass"},
+		"https://both.kubernetes.io": DockerConfigEntry{Username: "node", Password: "nodepass"},
+	})
+	opaque := dockercfgSecret("opaque.kubernetes.io", "opaque", "opaquepass")
+	opaque.Type = api.SecretTypeOpaque
+
+	keyring, err := MakeDockerKeyring([]api.Secret{
+		dockercfgSecret("pod.kubernetes.io", "pod", "podpass"),
+		dockercfgSecret("both.kubernetes.io", "pod", "podpass"),
+		opaque,
+	}, defaultKeyring)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	testCases := []struct {
+		image    string
+		username string
+		ok       bool
+	}{
+		{"pod.kubernetes.io/foo/bar", "pod", true},
+		{"node.kubernetes.io/foo/bar", "node", true},
+		{"both.kubernetes.io/foo/bar", "pod", true},
+		{"opaque.kubernetes.io/foo/bar", "", false},
+		{"other.kubernetes.io/foo/bar", "", false},
+	}
+	for _, tc := range testCases {
+		auth, ok := keyring.Lookup(tc.image)
+		if ok != tc.ok {
+			t.Errorf("%s: expected ok=%v, got %v", tc.image, tc.ok, ok)
+			continue
+		}
+		if auth.Username != tc.username {
+			t.Errorf("%s: expected username %q, got %q", tc.image, tc.username, auth.Username)
+		}
+	}
+}
+
+func TestMakeDockerKeyringWithoutSecrets(t *testing.T) {
+	defaultKeyring := &FakeKeyring{auth: docker.AuthConfiguration{Username: "node"}, ok: true}
+	keyring, err := MakeDockerKeyring(nil, defaultKeyring)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if keyring != defaultKeyring {
+		t.Errorf("Expected the default keyring to be used, got %#v", keyring)
+	}
+}
+
+func TestMakeDockerKeyringMalformedSecret(t *testing.T) {
+	secret := dockercfgSecret("pod.kubernetes.io", "pod", "podpass")
+	secret.Data[api.DockerConfigKey] = []byte("{")
+	if _, err := MakeDockerKeyring([]api.Secret{secret}, &BasicDockerKeyring{}); err == nil {
+		t.Errorf("Expected an error for a malformed dockercfg")
+	}
+}
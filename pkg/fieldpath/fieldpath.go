@@ -0,0 +1,62 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fieldpath
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/meta"
+)
+
+// FormatMap formats map[string]string to a string with one key="value"
+// pair per line, sorted by key.
+func FormatMap(m map[string]string) string {
+	lines := make([]string, 0, len(m))
+	for k, v := range m {
+		lines = append(lines, fmt.Sprintf("%s=%q\n", k, v))
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "")
+}
+
+// ExtractFieldPathAsString extracts the field from the given object
+// and returns it as a string. The object must be a pointer to an
+// API type.
+//
+// Currently, only the name, namespace, labels and annotations of an
+// object's metadata are supported.
+func ExtractFieldPathAsString(obj interface{}, fieldPath string) (string, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return "", err
+	}
+
+	switch fieldPath {
+	case "metadata.annotations":
+		return FormatMap(accessor.Annotations()), nil
+	case "metadata.labels":
+		return FormatMap(accessor.Labels()), nil
+	case "metadata.name":
+		return accessor.Name(), nil
+	case "metadata.namespace":
+		return accessor.Namespace(), nil
+	}
+
+	return "", fmt.Errorf("unsupported fieldPath: %v", fieldPath)
+}
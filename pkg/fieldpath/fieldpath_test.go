@@ -0,0 +1,95 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fieldpath
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+)
+
+func TestExtractFieldPathAsString(t *testing.T) {
+	cases := []struct {
+		name      string
+		fieldPath string
+		obj       interface{}
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:      "not an API object",
+			fieldPath: "metadata.name",
+			obj:       "",
+			expectErr: true,
+		},
+		{
+			name:      "ok - namespace",
+			fieldPath: "metadata.namespace",
+			obj: &api.Pod{
+				ObjectMeta: api.ObjectMeta{Namespace: "object-namespace"},
+			},
+			expected: "object-namespace",
+		},
+		{
+			name:      "ok - name",
+			fieldPath: "metadata.name",
+			obj: &api.BoundPod{
+				ObjectMeta: api.ObjectMeta{Name: "object-name"},
+			},
+			expected: "object-name",
+		},
+		{
+			name:      "ok - labels",
+			fieldPath: "metadata.labels",
+			obj: &api.Pod{
+				ObjectMeta: api.ObjectMeta{Labels: map[string]string{"zone": "us-east", "app": "web"}},
+			},
+			expected: "app=\"web\"\nzone=\"us-east\"\n",
+		},
+		{
+			name:      "ok - annotations",
+			fieldPath: "metadata.annotations",
+			obj: &api.Pod{
+				ObjectMeta: api.ObjectMeta{Annotations: map[string]string{"note": "line one\nline two"}},
+			},
+			expected: "note=\"line one\\nline two\"\n",
+		},
+		{
+			name:      "invalid fieldPath",
+			fieldPath: "metadata.whoops",
+			obj:       &api.Pod{},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := ExtractFieldPathAsString(tc.obj, tc.fieldPath)
+		if err != nil {
+			if !tc.expectErr {
+				t.Errorf("%v: unexpected error: %v", tc.name, err)
+			}
+			continue
+		}
+		if tc.expectErr {
+			t.Errorf("%v: expected error, got none", tc.name)
+			continue
+		}
+		if e, a := tc.expected, actual; e != a {
+			t.Errorf("%v: unexpected result; got %q, expected %q", tc.name, a, e)
+		}
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
@@ -31,6 +32,7 @@ import (
 	cmdconfig "github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/cmd/config"
 	cmdutil "github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/cmd/util"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 
@@ -77,8 +79,6 @@ type Factory struct {
 // if optionalClientConfig is nil, then flags will be bound to a new clientcmd.ClientConfig.
 // if optionalClientConfig is not nil, then this factory will make use of it.
 func NewFactory(optionalClientConfig clientcmd.ClientConfig) *Factory {
-	mapper := kubectl.ShortcutExpander{latest.RESTMapper}
-
 	flags := pflag.NewFlagSet("", pflag.ContinueOnError)
 
 	clientConfig := optionalClientConfig
@@ -91,6 +91,17 @@ func NewFactory(optionalClientConfig clientcmd.ClientConfig) *Factory {
 		loader:  clientConfig,
 	}
 
+	thirdPartyMapper := &kubectl.DeferredThirdPartyResourceMapper{
+		Load: func() (*api.ThirdPartyResourceList, error) {
+			client, err := clients.ClientForVersion("")
+			if err != nil {
+				return nil, err
+			}
+			return client.ThirdPartyResources().List(labels.Everything(), labels.Everything())
+		},
+	}
+	mapper := kubectl.ShortcutExpander{RESTMapper: meta.MultiRESTMapper{latest.RESTMapper, thirdPartyMapper}}
+
 	return &Factory{
 		clients: clients,
 		flags:   flags,
@@ -100,7 +111,7 @@ func NewFactory(optionalClientConfig clientcmd.ClientConfig) *Factory {
 			cmdutil.CheckErr(err)
 			cmdApiVersion := cfg.Version
 
-			return kubectl.OutputVersionMapper{mapper, cmdApiVersion}, api.Scheme
+			return kubectl.OutputVersionMapper{RESTMapper: mapper, OutputVersion: cmdApiVersion}, kubectl.ThirdPartyResourceTyper{ObjectTyper: api.Scheme}
 		},
 		Client: func(cmd *cobra.Command) (*client.Client, error) {
 			return clients.ClientForVersion("")
@@ -109,6 +120,9 @@ func NewFactory(optionalClientConfig clientcmd.ClientConfig) *Factory {
 			return clients.ClientConfigForVersion("")
 		},
 		RESTClient: func(cmd *cobra.Command, mapping *meta.RESTMapping) (resource.RESTClient, error) {
+			if group, version, ok := api.SplitThirdPartyAPIVersion(mapping.APIVersion); ok {
+				return clients.RESTClientForThirdParty(group, version, mapping.Codec)
+			}
 			client, err := clients.ClientForVersion(mapping.APIVersion)
 			if err != nil {
 				return nil, err
@@ -396,6 +410,20 @@ func (c *clientCache) ClientConfigForVersion(version string) (*client.Config, er
 	return &config, nil
 }
 
+// RESTClientForThirdParty returns a RESTClient for the kinds served under the given third
+// party group and version, which encodes objects with codec.
+func (c *clientCache) RESTClientForThirdParty(group, version string, codec runtime.Codec) (*client.RESTClient, error) {
+	config, err := c.ClientConfigForVersion("")
+	if err != nil {
+		return nil, err
+	}
+	config.Prefix = path.Join(api.ThirdPartyAPIPrefix, group)
+	config.Version = version
+	config.Codec = codec
+	config.LegacyBehavior = false
+	return client.RESTClientFor(config)
+}
+
 // ClientForVersion initializes or reuses a client for the specified version, or returns an
 // error if that is not possible
 func (c *clientCache) ClientForVersion(version string) (*client.Client, error) {
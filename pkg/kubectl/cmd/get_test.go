@@ -64,7 +64,7 @@ func testData() (*api.PodList, *api.ServiceList, *api.ReplicationControllerList)
 			{
 				ObjectMeta: api.ObjectMeta{Name: "baz", Namespace: "test", ResourceVersion: "12"},
 				Spec: api.ServiceSpec{
-					Protocol:        "TCP",
+					Ports:           []api.ServicePort{{Protocol: "TCP"}},
 					SessionAffinity: "None",
 				},
 			},
@@ -51,6 +51,8 @@ func DescriberFor(kind string, c *client.Client) (Describer, bool) {
 		return &LimitRangeDescriber{c}, true
 	case "ResourceQuota":
 		return &ResourceQuotaDescriber{c}, true
+	case "Job":
+		return &JobDescriber{c}, true
 	}
 	return nil, false
 }
@@ -223,7 +225,7 @@ func (d *ReplicationControllerDescriber) Describe(namespace, name string) (strin
 		return "", err
 	}
 
-	running, waiting, succeeded, failed, err := getPodStatusForReplicationController(pc, controller)
+	running, waiting, succeeded, failed, err := getPodStatusForSelector(pc, controller.Spec.Selector)
 	if err != nil {
 		return "", err
 	}
@@ -244,6 +246,53 @@ func (d *ReplicationControllerDescriber) Describe(namespace, name string) (strin
 	})
 }
 
+// JobDescriber generates information about a job and the pods it has created.
+type JobDescriber struct {
+	client.Interface
+}
+
+func (d *JobDescriber) Describe(namespace, name string) (string, error) {
+	job, err := d.Jobs(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	running, waiting, succeeded, failed, err := getPodStatusForSelector(d.Pods(namespace), job.Spec.Selector)
+	if err != nil {
+		return "", err
+	}
+
+	events, _ := d.Events(namespace).Search(job)
+
+	return tabbedString(func(out io.Writer) error {
+		fmt.Fprintf(out, "Name:\t%s\n", job.Name)
+		fmt.Fprintf(out, "Image(s):\t%s\n", makeImageList(&job.Spec.Template.Spec))
+		fmt.Fprintf(out, "Selector:\t%s\n", formatLabels(job.Spec.Selector))
+		fmt.Fprintf(out, "Labels:\t%s\n", formatLabels(job.Labels))
+		fmt.Fprintf(out, "Parallelism:\t%s\n", formatOptionalInt(job.Spec.Parallelism))
+		fmt.Fprintf(out, "Completions:\t%s\n", formatOptionalInt(job.Spec.Completions))
+		if job.Status.StartTime != nil {
+			fmt.Fprintf(out, "Start Time:\t%s\n", job.Status.StartTime.Time.Format(time.RFC1123Z))
+		}
+		if job.Status.CompletionTime != nil {
+			fmt.Fprintf(out, "Completion Time:\t%s\n", job.Status.CompletionTime.Time.Format(time.RFC1123Z))
+		}
+		fmt.Fprintf(out, "Job Status:\t%d Active / %d Succeeded / %d Failed\n", job.Status.Active, job.Status.Succeeded, job.Status.Failed)
+		fmt.Fprintf(out, "Pods Status:\t%d Running / %d Waiting / %d Succeeded / %d Failed\n", running, waiting, succeeded, failed)
+		if events != nil {
+			describeEvents(events, out)
+		}
+		return nil
+	})
+}
+
+func formatOptionalInt(i *int) string {
+	if i == nil {
+		return "<unset>"
+	}
+	return fmt.Sprintf("%d", *i)
+}
+
 // ServiceDescriber generates information about a service.
 type ServiceDescriber struct {
 	client.Interface
@@ -273,8 +322,16 @@ func (d *ServiceDescriber) Describe(namespace, name string) (string, error) {
 			list := strings.Join(service.Spec.PublicIPs, ", ")
 			fmt.Fprintf(out, "Public IPs:\t%s\n", list)
 		}
-		fmt.Fprintf(out, "Port:\t%d\n", service.Spec.Port)
-		fmt.Fprintf(out, "Endpoints:\t%s\n", formatEndpoints(endpoints.Endpoints))
+		for i := range service.Spec.Ports {
+			sp := &service.Spec.Ports[i]
+
+			name := sp.Name
+			if name == "" {
+				name = "<unnamed>"
+			}
+			fmt.Fprintf(out, "Port:\t%s\t%d/%s\n", name, sp.Port, sp.Protocol)
+			fmt.Fprintf(out, "Endpoints:\t%s\t%s\n", name, formatEndpoints(lookupEndpoints(endpoints, sp.Name)))
+		}
 		fmt.Fprintf(out, "Session Affinity:\t%s\n", service.Spec.SessionAffinity)
 		if events != nil {
 			describeEvents(events, out)
@@ -283,6 +340,16 @@ func (d *ServiceDescriber) Describe(namespace, name string) (string, error) {
 	})
 }
 
+// lookupEndpoints returns the endpoints of the named port, if any.
+func lookupEndpoints(endpoints *api.Endpoints, portName string) []api.Endpoint {
+	for i := range endpoints.Ports {
+		if endpoints.Ports[i].Name == portName {
+			return endpoints.Ports[i].Endpoints
+		}
+	}
+	return nil
+}
+
 // MinionDescriber generates information about a minion.
 type MinionDescriber struct {
 	client.Interface
@@ -396,12 +463,12 @@ func getReplicationControllersForLabels(c client.ReplicationControllerInterface,
 	return list
 }
 
-func getPodStatusForReplicationController(c client.PodInterface, controller *api.ReplicationController) (running, waiting, succeeded, failed int, err error) {
-	rcPods, err := c.List(labels.SelectorFromSet(controller.Spec.Selector))
+func getPodStatusForSelector(c client.PodInterface, selector map[string]string) (running, waiting, succeeded, failed int, err error) {
+	pods, err := c.List(labels.SelectorFromSet(selector))
 	if err != nil {
 		return
 	}
-	for _, pod := range rcPods.Items {
+	for _, pod := range pods.Items {
 		switch pod.Status.Phase {
 		case api.PodRunning:
 			running++
@@ -63,6 +63,26 @@ func TestDescribeService(t *testing.T) {
 	}
 }
 
+func TestDescribeJob(t *testing.T) {
+	completions := 3
+	fake := &client.Fake{
+		Job: api.Job{
+			ObjectMeta: api.ObjectMeta{Name: "bar", Namespace: "foo"},
+			Spec:       api.JobSpec{Completions: &completions},
+			Status:     api.JobStatus{Active: 1, Succeeded: 2},
+		},
+	}
+	c := &describeClient{T: t, Namespace: "foo", Fake: fake}
+	d := JobDescriber{c}
+	out, err := d.Describe("foo", "bar")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "bar") || !strings.Contains(out, "1 Active / 2 Succeeded / 0 Failed") {
+		t.Errorf("unexpected out: %s", out)
+	}
+}
+
 func TestPodDescribeResultsSorted(t *testing.T) {
 	// Arrange
 	fake := &client.Fake{
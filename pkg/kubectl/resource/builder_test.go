@@ -364,8 +364,8 @@ func TestResourceByNameAndEmptySelector(t *testing.T) {
 func TestSelector(t *testing.T) {
 	pods, svc := testData()
 	b := NewBuilder(latest.RESTMapper, api.Scheme, fakeClientWith(t, map[string]string{
-		"/namespaces/test/pods?labels=a%3Db":     runtime.EncodeOrDie(latest.Codec, pods),
-		"/namespaces/test/services?labels=a%3Db": runtime.EncodeOrDie(latest.Codec, svc),
+		"/namespaces/test/pods?labels=a%3Db&limit=500":     runtime.EncodeOrDie(latest.Codec, pods),
+		"/namespaces/test/services?labels=a%3Db&limit=500": runtime.EncodeOrDie(latest.Codec, svc),
 	})).
 		SelectorParam("a=b").
 		NamespaceParam("test").
@@ -494,7 +494,7 @@ func TestSingularObject(t *testing.T) {
 func TestListObject(t *testing.T) {
 	pods, _ := testData()
 	b := NewBuilder(latest.RESTMapper, api.Scheme, fakeClientWith(t, map[string]string{
-		"/namespaces/test/pods?labels=a%3Db": runtime.EncodeOrDie(latest.Codec, pods),
+		"/namespaces/test/pods?labels=a%3Db&limit=500": runtime.EncodeOrDie(latest.Codec, pods),
 	})).
 		SelectorParam("a=b").
 		NamespaceParam("test").
@@ -523,11 +523,39 @@ func TestListObject(t *testing.T) {
 	}
 }
 
+func TestListObjectPaged(t *testing.T) {
+	pods, _ := testData()
+	first, second := *pods, *pods
+	first.Continue = "next"
+	first.Items = pods.Items[:1]
+	second.Items = pods.Items[1:]
+	obj, err := NewBuilder(latest.RESTMapper, api.Scheme, fakeClientWith(t, map[string]string{
+		"/namespaces/test/pods?labels=a%3Db&limit=500":               runtime.EncodeOrDie(latest.Codec, &first),
+		"/namespaces/test/pods?continue=next&labels=a%3Db&limit=500": runtime.EncodeOrDie(latest.Codec, &second),
+	})).
+		SelectorParam("a=b").
+		NamespaceParam("test").
+		ResourceTypeOrNameArgs(true, "pods").
+		Flatten().
+		Do().Object()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	list, ok := obj.(*api.List)
+	if !ok {
+		t.Fatalf("unexpected object: %#v", obj)
+	}
+	if list.ResourceVersion != pods.ResourceVersion || len(list.Items) != 2 {
+		t.Errorf("unexpected list: %#v", list)
+	}
+}
+
 func TestListObjectWithDifferentVersions(t *testing.T) {
 	pods, svc := testData()
 	obj, err := NewBuilder(latest.RESTMapper, api.Scheme, fakeClientWith(t, map[string]string{
-		"/namespaces/test/pods?labels=a%3Db":     runtime.EncodeOrDie(latest.Codec, pods),
-		"/namespaces/test/services?labels=a%3Db": runtime.EncodeOrDie(latest.Codec, svc),
+		"/namespaces/test/pods?labels=a%3Db&limit=500":     runtime.EncodeOrDie(latest.Codec, pods),
+		"/namespaces/test/services?labels=a%3Db&limit=500": runtime.EncodeOrDie(latest.Codec, svc),
 	})).
 		SelectorParam("a=b").
 		NamespaceParam("test").
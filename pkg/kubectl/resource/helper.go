@@ -18,6 +18,7 @@ package resource
 
 import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/meta"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
@@ -65,6 +66,7 @@ func (m *Helper) List(namespace string, selector labels.Selector) (runtime.Objec
 		NamespaceIfScoped(namespace, m.NamespaceScoped).
 		Resource(m.Resource).
 		SelectorParam("labels", selector).
+		PageSize(client.DefaultPageSize).
 		Do().
 		Get()
 }
@@ -23,6 +23,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -333,6 +334,10 @@ func TestHelperList(t *testing.T) {
 					t.Errorf("url doesn't contain query parameters: %#v", req.URL)
 					return false
 				}
+				if req.URL.Query().Get("limit") != strconv.Itoa(client.DefaultPageSize) {
+					t.Errorf("url doesn't request a page: %#v", req.URL)
+					return false
+				}
 				return true
 			},
 		},
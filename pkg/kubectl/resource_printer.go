@@ -237,8 +237,19 @@ var statusColumns = []string{"STATUS"}
 var eventColumns = []string{"FIRSTSEEN", "LASTSEEN", "COUNT", "NAME", "KIND", "SUBOBJECT", "REASON", "SOURCE", "MESSAGE"}
 var limitRangeColumns = []string{"NAME"}
 var resourceQuotaColumns = []string{"NAME"}
-var namespaceColumns = []string{"NAME", "LABELS"}
+var namespaceColumns = []string{"NAME", "LABELS", "STATUS"}
 var secretColumns = []string{"NAME", "DATA"}
+var serviceAccountColumns = []string{"NAME", "SECRETS"}
+var persistentVolumeColumns = []string{"NAME", "LABELS", "CAPACITY", "ACCESSMODES", "STATUS", "CLAIM"}
+var persistentVolumeClaimColumns = []string{"NAME", "LABELS", "STATUS", "VOLUME"}
+var jobColumns = []string{"JOB", "CONTAINER(S)", "IMAGE(S)", "SELECTOR", "SUCCESSFUL"}
+var daemonSetColumns = []string{"NAME", "CONTAINER(S)", "IMAGE(S)", "SELECTOR", "NODE-SELECTOR"}
+var deploymentColumns = []string{"NAME", "CONTAINER(S)", "IMAGE(S)", "SELECTOR", "UPDATED", "REVISION"}
+var horizontalPodAutoscalerColumns = []string{"NAME", "REFERENCE", "TARGET", "CURRENT", "MINPODS", "MAXPODS"}
+var thirdPartyResourceColumns = []string{"NAME", "DESCRIPTION", "VERSION(S)"}
+var thirdPartyResourceDataColumns = []string{"NAME", "LABELS"}
+var roleColumns = []string{"NAME", "RULES"}
+var roleBindingColumns = []string{"NAME", "ROLE", "SUBJECTS"}
 
 // addDefaultHandlers adds print handlers for default Kubernetes types.
 func (h *HumanReadablePrinter) addDefaultHandlers() {
@@ -263,6 +274,32 @@ func (h *HumanReadablePrinter) addDefaultHandlers() {
 	h.Handler(namespaceColumns, printNamespaceList)
 	h.Handler(secretColumns, printSecret)
 	h.Handler(secretColumns, printSecretList)
+	h.Handler(serviceAccountColumns, printServiceAccount)
+	h.Handler(serviceAccountColumns, printServiceAccountList)
+	h.Handler(persistentVolumeColumns, printPersistentVolume)
+	h.Handler(persistentVolumeColumns, printPersistentVolumeList)
+	h.Handler(persistentVolumeClaimColumns, printPersistentVolumeClaim)
+	h.Handler(persistentVolumeClaimColumns, printPersistentVolumeClaimList)
+	h.Handler(jobColumns, printJob)
+	h.Handler(jobColumns, printJobList)
+	h.Handler(daemonSetColumns, printDaemonSet)
+	h.Handler(daemonSetColumns, printDaemonSetList)
+	h.Handler(deploymentColumns, printDeployment)
+	h.Handler(deploymentColumns, printDeploymentList)
+	h.Handler(horizontalPodAutoscalerColumns, printHorizontalPodAutoscaler)
+	h.Handler(horizontalPodAutoscalerColumns, printHorizontalPodAutoscalerList)
+	h.Handler(thirdPartyResourceColumns, printThirdPartyResource)
+	h.Handler(thirdPartyResourceColumns, printThirdPartyResourceList)
+	h.Handler(thirdPartyResourceDataColumns, printThirdPartyResourceData)
+	h.Handler(thirdPartyResourceDataColumns, printThirdPartyResourceDataList)
+	h.Handler(roleColumns, printRole)
+	h.Handler(roleColumns, printRoleList)
+	h.Handler(roleColumns, printClusterRole)
+	h.Handler(roleColumns, printClusterRoleList)
+	h.Handler(roleBindingColumns, printRoleBinding)
+	h.Handler(roleBindingColumns, printRoleBindingList)
+	h.Handler(roleBindingColumns, printClusterRoleBinding)
+	h.Handler(roleBindingColumns, printClusterRoleBindingList)
 }
 
 func (h *HumanReadablePrinter) unknown(data []byte, w io.Writer) error {
@@ -289,6 +326,15 @@ func formatEndpoints(endpoints []api.Endpoint) string {
 	return strings.Join(list, ",")
 }
 
+// formatEndpointPorts flattens the endpoints of every port into a single list.
+func formatEndpointPorts(ports []api.EndpointPort) string {
+	endpoints := []api.Endpoint{}
+	for i := range ports {
+		endpoints = append(endpoints, ports[i].Endpoints...)
+	}
+	return formatEndpoints(endpoints)
+}
+
 func podHostString(host, ip string) string {
 	if host == "" && ip == "" {
 		return "<unassigned>"
@@ -373,9 +419,24 @@ func printReplicationControllerList(list *api.ReplicationControllerList, w io.Wr
 }
 
 func printService(svc *api.Service, w io.Writer) error {
+	ports := svc.Spec.Ports
+	var firstPort api.ServicePort
+	if len(ports) > 0 {
+		firstPort, ports = ports[0], ports[1:]
+	}
 	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\n", svc.Name, formatLabels(svc.Labels),
-		formatLabels(svc.Spec.Selector), svc.Spec.PortalIP, svc.Spec.Port)
-	return err
+		formatLabels(svc.Spec.Selector), svc.Spec.PortalIP, firstPort.Port)
+	if err != nil {
+		return err
+	}
+	// Lay out all the other ports on separate lines.
+	for _, port := range ports {
+		_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\n", "", "", "", "", port.Port)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func printServiceList(list *api.ServiceList, w io.Writer) error {
@@ -388,7 +449,7 @@ func printServiceList(list *api.ServiceList, w io.Writer) error {
 }
 
 func printEndpoints(endpoint *api.Endpoints, w io.Writer) error {
-	_, err := fmt.Fprintf(w, "%s\t%s\n", endpoint.Name, formatEndpoints(endpoint.Endpoints))
+	_, err := fmt.Fprintf(w, "%s\t%s\n", endpoint.Name, formatEndpointPorts(endpoint.Ports))
 	return err
 }
 
@@ -402,7 +463,7 @@ func printEndpointsList(list *api.EndpointsList, w io.Writer) error {
 }
 
 func printNamespace(item *api.Namespace, w io.Writer) error {
-	_, err := fmt.Fprintf(w, "%s\t%s\n", item.Name, formatLabels(item.Labels))
+	_, err := fmt.Fprintf(w, "%s\t%s\t%s\n", item.Name, formatLabels(item.Labels), item.Status.Phase)
 	return err
 }
 
@@ -430,6 +491,293 @@ func printSecretList(list *api.SecretList, w io.Writer) error {
 	return nil
 }
 
+func printServiceAccount(item *api.ServiceAccount, w io.Writer) error {
+	_, err := fmt.Fprintf(w, "%s\t%v\n", item.Name, len(item.Secrets))
+	return err
+}
+
+func printServiceAccountList(list *api.ServiceAccountList, w io.Writer) error {
+	for _, item := range list.Items {
+		if err := printServiceAccount(&item, w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func printPersistentVolume(pv *api.PersistentVolume, w io.Writer) error {
+	claimRef := "<none>"
+	if pv.Spec.ClaimRef != nil {
+		claimRef = pv.Spec.ClaimRef.Namespace + "/" + pv.Spec.ClaimRef.Name
+	}
+	modes := []string{}
+	for _, mode := range pv.Spec.AccessModes {
+		modes = append(modes, string(mode))
+	}
+	capacity := pv.Spec.Capacity[api.ResourceStorage]
+	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", pv.Name, formatLabels(pv.Labels), capacity.String(), strings.Join(modes, ","), pv.Status.Phase, claimRef)
+	return err
+}
+
+func printPersistentVolumeList(list *api.PersistentVolumeList, w io.Writer) error {
+	for _, pv := range list.Items {
+		if err := printPersistentVolume(&pv, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printPersistentVolumeClaim(pvc *api.PersistentVolumeClaim, w io.Writer) error {
+	volumeRef := "<none>"
+	if pvc.Status.VolumeRef != nil {
+		volumeRef = pvc.Status.VolumeRef.Name
+	}
+	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", pvc.Name, formatLabels(pvc.Labels), pvc.Status.Phase, volumeRef)
+	return err
+}
+
+func printPersistentVolumeClaimList(list *api.PersistentVolumeClaimList, w io.Writer) error {
+	for _, pvc := range list.Items {
+		if err := printPersistentVolumeClaim(&pvc, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printJob(job *api.Job, w io.Writer) error {
+	containers := job.Spec.Template.Spec.Containers
+	var firstContainer api.Container
+	if len(containers) > 0 {
+		firstContainer, containers = containers[0], containers[1:]
+	}
+	completions := 1
+	if job.Spec.Completions != nil {
+		completions = *job.Spec.Completions
+	}
+	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d/%d\n",
+		job.Name,
+		firstContainer.Name,
+		firstContainer.Image,
+		formatLabels(job.Spec.Selector),
+		job.Status.Succeeded,
+		completions)
+	if err != nil {
+		return err
+	}
+	// Lay out all the other containers on separate lines.
+	for _, container := range containers {
+		_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", "", container.Name, container.Image, "", "")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printJobList(list *api.JobList, w io.Writer) error {
+	for _, job := range list.Items {
+		if err := printJob(&job, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printDaemonSet(ds *api.DaemonSet, w io.Writer) error {
+	containers := ds.Spec.Template.Spec.Containers
+	var firstContainer api.Container
+	if len(containers) > 0 {
+		firstContainer, containers = containers[0], containers[1:]
+	}
+	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+		ds.Name,
+		firstContainer.Name,
+		firstContainer.Image,
+		formatLabels(ds.Spec.Selector),
+		formatLabels(ds.Spec.Template.Spec.NodeSelector))
+	if err != nil {
+		return err
+	}
+	// Lay out all the other containers on separate lines.
+	for _, container := range containers {
+		_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", "", container.Name, container.Image, "", "")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printDaemonSetList(list *api.DaemonSetList, w io.Writer) error {
+	for _, ds := range list.Items {
+		if err := printDaemonSet(&ds, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printDeployment(deployment *api.Deployment, w io.Writer) error {
+	containers := deployment.Spec.Template.Spec.Containers
+	var firstContainer api.Container
+	if len(containers) > 0 {
+		firstContainer, containers = containers[0], containers[1:]
+	}
+	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d/%d\t%d\n",
+		deployment.Name,
+		firstContainer.Name,
+		firstContainer.Image,
+		formatLabels(deployment.Spec.Selector),
+		deployment.Status.UpdatedReplicas,
+		deployment.Spec.Replicas,
+		deployment.Status.Revision)
+	if err != nil {
+		return err
+	}
+	// Lay out all the other containers on separate lines.
+	for _, container := range containers {
+		_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", "", container.Name, container.Image, "", "", "")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printDeploymentList(list *api.DeploymentList, w io.Writer) error {
+	for _, deployment := range list.Items {
+		if err := printDeployment(&deployment, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printHorizontalPodAutoscaler(autoscaler *api.HorizontalPodAutoscaler, w io.Writer) error {
+	current := "<waiting>"
+	if autoscaler.Status.CurrentCPUUtilizationPercentage != nil {
+		current = fmt.Sprintf("%d%%", *autoscaler.Status.CurrentCPUUtilizationPercentage)
+	}
+	_, err := fmt.Fprintf(w, "%s\t%s/%s\t%d%%\t%s\t%d\t%d\n",
+		autoscaler.Name,
+		autoscaler.Spec.ScaleRef.Kind,
+		autoscaler.Spec.ScaleRef.Name,
+		autoscaler.Spec.TargetCPUUtilizationPercentage,
+		current,
+		autoscaler.Spec.MinReplicas,
+		autoscaler.Spec.MaxReplicas)
+	return err
+}
+
+func printHorizontalPodAutoscalerList(list *api.HorizontalPodAutoscalerList, w io.Writer) error {
+	for _, autoscaler := range list.Items {
+		if err := printHorizontalPodAutoscaler(&autoscaler, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printThirdPartyResource(resource *api.ThirdPartyResource, w io.Writer) error {
+	versions := []string{}
+	for _, version := range resource.Versions {
+		versions = append(versions, version.Name)
+	}
+	_, err := fmt.Fprintf(w, "%s\t%s\t%s\n", resource.Name, resource.Description, strings.Join(versions, ","))
+	return err
+}
+
+func printThirdPartyResourceList(list *api.ThirdPartyResourceList, w io.Writer) error {
+	for _, resource := range list.Items {
+		if err := printThirdPartyResource(&resource, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printThirdPartyResourceData(data *api.ThirdPartyResourceData, w io.Writer) error {
+	_, err := fmt.Fprintf(w, "%s\t%s\n", data.Name, formatLabels(data.Labels))
+	return err
+}
+
+func printThirdPartyResourceDataList(list *api.ThirdPartyResourceDataList, w io.Writer) error {
+	for _, data := range list.Items {
+		if err := printThirdPartyResourceData(&data, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printRole(role *api.Role, w io.Writer) error {
+	_, err := fmt.Fprintf(w, "%s\t%d\n", role.Name, len(role.Rules))
+	return err
+}
+
+func printRoleList(list *api.RoleList, w io.Writer) error {
+	for _, role := range list.Items {
+		if err := printRole(&role, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printClusterRole(role *api.ClusterRole, w io.Writer) error {
+	_, err := fmt.Fprintf(w, "%s\t%d\n", role.Name, len(role.Rules))
+	return err
+}
+
+func printClusterRoleList(list *api.ClusterRoleList, w io.Writer) error {
+	for _, role := range list.Items {
+		if err := printClusterRole(&role, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// formatSubjects prints subjects as kind/name pairs, separated by commas.
+func formatSubjects(subjects []api.Subject) string {
+	list := []string{}
+	for _, subject := range subjects {
+		list = append(list, subject.Kind+"/"+subject.Name)
+	}
+	return strings.Join(list, ",")
+}
+
+func printRoleBinding(binding *api.RoleBinding, w io.Writer) error {
+	_, err := fmt.Fprintf(w, "%s\t%s/%s\t%s\n", binding.Name, binding.RoleRef.Kind, binding.RoleRef.Name, formatSubjects(binding.Subjects))
+	return err
+}
+
+func printRoleBindingList(list *api.RoleBindingList, w io.Writer) error {
+	for _, binding := range list.Items {
+		if err := printRoleBinding(&binding, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printClusterRoleBinding(binding *api.ClusterRoleBinding, w io.Writer) error {
+	_, err := fmt.Fprintf(w, "%s\t%s/%s\t%s\n", binding.Name, binding.RoleRef.Kind, binding.RoleRef.Name, formatSubjects(binding.Subjects))
+	return err
+}
+
+func printClusterRoleBindingList(list *api.ClusterRoleBindingList, w io.Writer) error {
+	for _, binding := range list.Items {
+		if err := printClusterRoleBinding(&binding, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func printNode(node *api.Node, w io.Writer) error {
 	conditionMap := make(map[api.NodeConditionType]*api.NodeCondition)
 	NodeAllConditions := []api.NodeConditionType{api.NodeReady, api.NodeReachable}
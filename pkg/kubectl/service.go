@@ -62,20 +62,24 @@ func (ServiceGenerator) Generate(params map[string]string) (runtime.Object, erro
 			Name: name,
 		},
 		Spec: api.ServiceSpec{
-			Port:     port,
-			Protocol: api.Protocol(params["protocol"]),
 			Selector: selector,
+			Ports: []api.ServicePort{
+				{
+					Port:     port,
+					Protocol: api.Protocol(params["protocol"]),
+				},
+			},
 		},
 	}
 	containerPort, found := params["container-port"]
 	if found && len(containerPort) > 0 {
 		if cPort, err := strconv.Atoi(containerPort); err != nil {
-			service.Spec.ContainerPort = util.NewIntOrStringFromString(containerPort)
+			service.Spec.Ports[0].ContainerPort = util.NewIntOrStringFromString(containerPort)
 		} else {
-			service.Spec.ContainerPort = util.NewIntOrStringFromInt(cPort)
+			service.Spec.Ports[0].ContainerPort = util.NewIntOrStringFromInt(cPort)
 		}
 	} else {
-		service.Spec.ContainerPort = util.NewIntOrStringFromInt(port)
+		service.Spec.Ports[0].ContainerPort = util.NewIntOrStringFromInt(port)
 	}
 	if params["create-external-load-balancer"] == "true" {
 		service.Spec.CreateExternalLoadBalancer = true
@@ -46,9 +46,13 @@ func TestGenerateService(t *testing.T) {
 						"foo": "bar",
 						"baz": "blah",
 					},
-					Port:          80,
-					Protocol:      "TCP",
-					ContainerPort: util.NewIntOrStringFromInt(1234),
+					Ports: []api.ServicePort{
+						{
+							Port:          80,
+							Protocol:      "TCP",
+							ContainerPort: util.NewIntOrStringFromInt(1234),
+						},
+					},
 				},
 			},
 		},
@@ -69,9 +73,13 @@ func TestGenerateService(t *testing.T) {
 						"foo": "bar",
 						"baz": "blah",
 					},
-					Port:          80,
-					Protocol:      "UDP",
-					ContainerPort: util.NewIntOrStringFromString("foobar"),
+					Ports: []api.ServicePort{
+						{
+							Port:          80,
+							Protocol:      "UDP",
+							ContainerPort: util.NewIntOrStringFromString("foobar"),
+						},
+					},
 				},
 			},
 		},
@@ -93,10 +101,14 @@ func TestGenerateService(t *testing.T) {
 						"foo": "bar",
 						"baz": "blah",
 					},
-					Port:          80,
-					Protocol:      "UDP",
-					PublicIPs:     []string{"1.2.3.4"},
-					ContainerPort: util.NewIntOrStringFromString("foobar"),
+					Ports: []api.ServicePort{
+						{
+							Port:          80,
+							Protocol:      "UDP",
+							ContainerPort: util.NewIntOrStringFromString("foobar"),
+						},
+					},
+					PublicIPs: []string{"1.2.3.4"},
 				},
 			},
 		},
@@ -119,10 +131,14 @@ func TestGenerateService(t *testing.T) {
 						"foo": "bar",
 						"baz": "blah",
 					},
-					Port:                       80,
-					Protocol:                   "UDP",
+					Ports: []api.ServicePort{
+						{
+							Port:          80,
+							Protocol:      "UDP",
+							ContainerPort: util.NewIntOrStringFromString("foobar"),
+						},
+					},
 					PublicIPs:                  []string{"1.2.3.4"},
-					ContainerPort:              util.NewIntOrStringFromString("foobar"),
 					CreateExternalLoadBalancer: true,
 				},
 			},
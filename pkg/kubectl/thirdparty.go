@@ -0,0 +1,141 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"sync"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/meta"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/thirdparty"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+)
+
+// ThirdPartyResourceMapper returns a RESTMapper for the kinds declared by the given
+// ThirdPartyResources. Each kind is mapped in every version it declares, under the
+// apiVersion <group>/<version>.
+func ThirdPartyResourceMapper(resources []api.ThirdPartyResource) meta.RESTMapper {
+	mapper := meta.MultiRESTMapper{}
+	for _, resource := range resources {
+		kind, group, err := api.ThirdPartyResourceKindAndGroup(resource.Name)
+		if err != nil {
+			continue
+		}
+		versions := []string{}
+		for _, version := range resource.Versions {
+			versions = append(versions, api.ThirdPartyAPIVersion(group, version.Name))
+		}
+		kindMapper := meta.NewDefaultRESTMapper(versions, func(apiVersion string) (*meta.VersionInterfaces, bool) {
+			codec := thirdparty.NewCodec(latest.Codec, kind, apiVersion)
+			return &meta.VersionInterfaces{
+				Codec:            codec,
+				ObjectConvertor:  thirdPartyResourceDataConvertor{codec},
+				MetadataAccessor: meta.NewAccessor(),
+			}, true
+		})
+		for _, version := range versions {
+			kindMapper.Add(meta.RESTScopeNamespace, kind, version, false)
+		}
+		mapper = append(mapper, kindMapper)
+	}
+	return mapper
+}
+
+// DeferredThirdPartyResourceMapper is a RESTMapper for the kinds declared by the
+// ThirdPartyResources returned by Load. Load is only invoked the first time a
+// mapping is requested, so commands that never need a third party kind do not
+// contact the server.
+type DeferredThirdPartyResourceMapper struct {
+	Load func() (*api.ThirdPartyResourceList, error)
+
+	once   sync.Once
+	mapper meta.RESTMapper
+	err    error
+}
+
+func (m *DeferredThirdPartyResourceMapper) load() (meta.RESTMapper, error) {
+	m.once.Do(func() {
+		list, err := m.Load()
+		if err != nil {
+			m.err = err
+			return
+		}
+		m.mapper = ThirdPartyResourceMapper(list.Items)
+	})
+	return m.mapper, m.err
+}
+
+// VersionAndKindForResource implements meta.RESTMapper.
+func (m *DeferredThirdPartyResourceMapper) VersionAndKindForResource(resource string) (defaultVersion, kind string, err error) {
+	mapper, err := m.load()
+	if err != nil {
+		return "", "", err
+	}
+	return mapper.VersionAndKindForResource(resource)
+}
+
+// RESTMapping implements meta.RESTMapper.
+func (m *DeferredThirdPartyResourceMapper) RESTMapping(kind string, versions ...string) (*meta.RESTMapping, error) {
+	mapper, err := m.load()
+	if err != nil {
+		return nil, err
+	}
+	return mapper.RESTMapping(kind, versions...)
+}
+
+// ThirdPartyResourceTyper is an ObjectTyper that reports the kind and apiVersion
+// recorded in the objects of third party kinds, and defers to the wrapped
+// ObjectTyper for all other objects.
+type ThirdPartyResourceTyper struct {
+	runtime.ObjectTyper
+}
+
+// ObjectVersionAndKind implements runtime.ObjectTyper.
+func (t ThirdPartyResourceTyper) ObjectVersionAndKind(obj runtime.Object) (version, kind string, err error) {
+	switch obj := obj.(type) {
+	case *api.ThirdPartyResourceData:
+		return obj.APIVersion, obj.Kind, nil
+	case *api.ThirdPartyResourceDataList:
+		return obj.APIVersion, obj.Kind, nil
+	}
+	return t.ObjectTyper.ObjectVersionAndKind(obj)
+}
+
+// thirdPartyResourceDataConvertor "converts" the objects of a third party kind to
+// their serialized form. They have no versioned Go types, so printers that need a
+// versioned object are handed the JSON the server would return.
+type thirdPartyResourceDataConvertor struct {
+	codec runtime.Codec
+}
+
+func (c thirdPartyResourceDataConvertor) ConvertToVersion(in runtime.Object, _ string) (runtime.Object, error) {
+	data, err := c.codec.Encode(in)
+	if err != nil {
+		return nil, err
+	}
+	return serializedObject(data), nil
+}
+
+// serializedObject is an already encoded object, which marshals to itself.
+type serializedObject []byte
+
+func (serializedObject) IsAnAPIObject() {}
+
+func (o serializedObject) MarshalJSON() ([]byte, error) {
+	return []byte(o), nil
+}
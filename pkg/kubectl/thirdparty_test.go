@@ -0,0 +1,118 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/meta"
+)
+
+func TestThirdPartyResourceMapper(t *testing.T) {
+	mapper := ThirdPartyResourceMapper([]api.ThirdPartyResource{
+		{
+			ObjectMeta: api.ObjectMeta{Name: "database-cluster.example.com"},
+			Versions:   []api.APIVersion{{Name: "v1"}, {Name: "v2"}},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "invalid"},
+			Versions:   []api.APIVersion{{Name: "v1"}},
+		},
+	})
+
+	version, kind, err := mapper.VersionAndKindForResource("databaseclusters")
+	if err != nil || version != "example.com/v1" || kind != "DatabaseCluster" {
+		t.Fatalf("unexpected result: %s %s %v", version, kind, err)
+	}
+	mapping, err := mapper.RESTMapping("DatabaseCluster", "example.com/v2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mapping.Resource != "databaseclusters" || mapping.APIVersion != "example.com/v2" || mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		t.Errorf("unexpected mapping: %#v", mapping)
+	}
+
+	obj, err := mapping.Codec.Decode([]byte(`{"kind":"DatabaseCluster","apiVersion":"example.com/v2","metadata":{"name":"test"},"replicas":3}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	typer := ThirdPartyResourceTyper{api.Scheme}
+	if version, kind, err := typer.ObjectVersionAndKind(obj); err != nil || version != "example.com/v2" || kind != "DatabaseCluster" {
+		t.Errorf("unexpected result: %s %s %v", version, kind, err)
+	}
+	if name, err := mapping.MetadataAccessor.Name(obj); err != nil || name != "test" {
+		t.Errorf("unexpected name: %s %v", name, err)
+	}
+
+	converted, err := mapping.ConvertToVersion(obj, "example.com/v2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data, err := json.Marshal(converted)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := map[string]interface{}{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out["replicas"] != float64(3) || out["kind"] != "DatabaseCluster" {
+		t.Errorf("unexpected serialized object: %s", string(data))
+	}
+
+	if _, _, err := mapper.VersionAndKindForResource("invalids"); err == nil {
+		t.Errorf("unexpected non-error")
+	}
+}
+
+func TestDeferredThirdPartyResourceMapper(t *testing.T) {
+	calls := 0
+	mapper := &DeferredThirdPartyResourceMapper{
+		Load: func() (*api.ThirdPartyResourceList, error) {
+			calls++
+			return &api.ThirdPartyResourceList{
+				Items: []api.ThirdPartyResource{{
+					ObjectMeta: api.ObjectMeta{Name: "cron.example.com"},
+					Versions:   []api.APIVersion{{Name: "v1"}},
+				}},
+			}, nil
+		},
+	}
+	if calls != 0 {
+		t.Errorf("expected no calls before the first mapping")
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := mapper.RESTMapping("Cron"); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected one call, got %d", calls)
+	}
+
+	failing := &DeferredThirdPartyResourceMapper{
+		Load: func() (*api.ThirdPartyResourceList, error) {
+			return nil, fmt.Errorf("server unavailable")
+		},
+	}
+	if _, _, err := failing.VersionAndKindForResource("crons"); err == nil {
+		t.Errorf("unexpected non-error")
+	}
+}
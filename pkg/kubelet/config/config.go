@@ -210,9 +210,8 @@ func (s *podStorage) merge(source string, change interface{}) (adds, updates, de
 		for _, ref := range filtered {
 			name := kubelet.GetPodFullName(ref)
 			if existing, found := pods[name]; found {
-				if !reflect.DeepEqual(existing.Spec, ref.Spec) {
+				if updatePod(existing, ref) {
 					// this is an update
-					existing.Spec = ref.Spec
 					updates.Pods = append(updates.Pods, *existing)
 					continue
 				}
@@ -253,9 +252,8 @@ func (s *podStorage) merge(source string, change interface{}) (adds, updates, de
 			name := kubelet.GetPodFullName(ref)
 			if existing, found := oldPods[name]; found {
 				pods[name] = existing
-				if !reflect.DeepEqual(existing.Spec, ref.Spec) {
+				if updatePod(existing, ref) {
 					// this is an update
-					existing.Spec = ref.Spec
 					updates.Pods = append(updates.Pods, *existing)
 					continue
 				}
@@ -286,6 +284,28 @@ func (s *podStorage) merge(source string, change interface{}) (adds, updates, de
 	return adds, updates, deletes
 }
 
+// updatePod copies the fields of ref that the kubelet acts upon into existing,
+// and returns true if any of them changed.
+func updatePod(existing, ref *api.BoundPod) bool {
+	// The config source annotation is owned by the kubelet, preserve it.
+	annotations := make(map[string]string, len(ref.Annotations)+1)
+	for k, v := range ref.Annotations {
+		annotations[k] = v
+	}
+	annotations[kubelet.ConfigSourceAnnotationKey] = existing.Annotations[kubelet.ConfigSourceAnnotationKey]
+	if reflect.DeepEqual(existing.Spec, ref.Spec) &&
+		reflect.DeepEqual(existing.DeletionTimestamp, ref.DeletionTimestamp) &&
+		reflect.DeepEqual(existing.Labels, ref.Labels) &&
+		reflect.DeepEqual(existing.Annotations, annotations) {
+		return false
+	}
+	existing.Spec = ref.Spec
+	existing.DeletionTimestamp = ref.DeletionTimestamp
+	existing.Labels = ref.Labels
+	existing.Annotations = annotations
+	return true
+}
+
 func (s *podStorage) markSourceSet(source string) {
 	s.sourcesSeenLock.Lock()
 	defer s.sourcesSeenLock.Unlock()
@@ -224,6 +224,28 @@ func TestNewPodAddedUpdatedRemoved(t *testing.T) {
 	expectPodUpdate(t, ch, CreatePodUpdate(kubelet.REMOVE, NoneSource, pod))
 }
 
+func TestNewPodUpdatedLabelsAndAnnotations(t *testing.T) {
+	channel, ch, _ := createPodConfigTester(PodConfigNotificationIncremental)
+
+	// should register an add
+	podUpdate := CreatePodUpdate(kubelet.ADD, NoneSource, CreateValidPod("foo", "new", ""))
+	channel <- podUpdate
+	expectPodUpdate(t, ch, CreatePodUpdate(kubelet.ADD, NoneSource, CreateValidPod("foo", "new", "test")))
+
+	// a change in labels or annotations alone should register an update,
+	// keeping the config source annotation
+	pod := CreateValidPod("foo", "new", "")
+	pod.Labels = map[string]string{"key": "value"}
+	pod.Annotations = map[string]string{"note": "changed"}
+	podUpdate = CreatePodUpdate(kubelet.UPDATE, NoneSource, pod)
+	channel <- podUpdate
+
+	expected := CreateValidPod("foo", "new", "test")
+	expected.Labels = map[string]string{"key": "value"}
+	expected.Annotations["note"] = "changed"
+	expectPodUpdate(t, ch, CreatePodUpdate(kubelet.UPDATE, NoneSource, expected))
+}
+
 func TestNewPodAddedUpdatedSet(t *testing.T) {
 	channel, ch, _ := createPodConfigTester(PodConfigNotificationIncremental)
 
@@ -67,7 +67,9 @@ type DockerID string
 
 // DockerPuller is an abstract interface for testability.  It abstracts image pull operations.
 type DockerPuller interface {
-	Pull(image string) error
+	// Pull pulls the image, using the credentials in any dockercfg secrets passed
+	// in before those configured on the node.
+	Pull(image string, secrets []api.Secret) error
 	IsImagePresent(image string) (bool, error)
 }
 
@@ -325,7 +327,12 @@ func NewDockerContainerCommandRunner(client DockerInterface) ContainerCommandRun
 	return &dockerContainerCommandRunner{client: client}
 }
 
-func (p dockerPuller) Pull(image string) error {
+func (p dockerPuller) Pull(image string, secrets []api.Secret) error {
+	keyring, err := credentialprovider.MakeDockerKeyring(secrets, p.keyring)
+	if err != nil {
+		return err
+	}
+
 	image, tag := parseImageName(image)
 
 	// If no tag was specified, use the default "latest".
@@ -338,12 +345,12 @@ func (p dockerPuller) Pull(image string) error {
 		Tag:        tag,
 	}
 
-	creds, ok := p.keyring.Lookup(image)
+	creds, ok := keyring.Lookup(image)
 	if !ok {
 		glog.V(1).Infof("Pulling image %s without credentials", image)
 	}
 
-	err := p.client.PullImage(opts, creds)
+	err = p.client.PullImage(opts, creds)
 	// If there was no error, or we had credentials, just return the error.
 	if err == nil || ok {
 		return err
@@ -360,9 +367,9 @@ func (p dockerPuller) Pull(image string) error {
 	return err
 }
 
-func (p throttledDockerPuller) Pull(image string) error {
+func (p throttledDockerPuller) Pull(image string, secrets []api.Secret) error {
 	if p.limiter.CanAccept() {
-		return p.puller.Pull(image)
+		return p.puller.Pull(image, secrets)
 	}
 	return fmt.Errorf("pull QPS exceeded.")
 }
@@ -30,30 +30,38 @@ func TestFromServices(t *testing.T) {
 			{
 				ObjectMeta: api.ObjectMeta{Name: "foo-bar"},
 				Spec: api.ServiceSpec{
-					Port:     8080,
 					Selector: map[string]string{"bar": "baz"},
-					Protocol: "TCP",
+					Ports:    []api.ServicePort{{Port: 8080, Protocol: "TCP"}},
 					PortalIP: "1.2.3.4",
 				},
 			},
 			{
 				ObjectMeta: api.ObjectMeta{Name: "abc-123"},
 				Spec: api.ServiceSpec{
-					Port:     8081,
 					Selector: map[string]string{"bar": "baz"},
-					Protocol: "UDP",
+					Ports:    []api.ServicePort{{Port: 8081, Protocol: "UDP"}},
 					PortalIP: "5.6.7.8",
 				},
 			},
 			{
 				ObjectMeta: api.ObjectMeta{Name: "q-u-u-x"},
 				Spec: api.ServiceSpec{
-					Port:     8082,
 					Selector: map[string]string{"bar": "baz"},
-					Protocol: "TCP",
+					Ports:    []api.ServicePort{{Port: 8082, Protocol: "TCP"}},
 					PortalIP: "9.8.7.6",
 				},
 			},
+			{
+				ObjectMeta: api.ObjectMeta{Name: "multi-port"},
+				Spec: api.ServiceSpec{
+					Selector: map[string]string{"bar": "baz"},
+					PortalIP: "5.4.3.2",
+					Ports: []api.ServicePort{
+						{Name: "http", Port: 80, Protocol: "TCP"},
+						{Name: "dns-udp", Port: 53, Protocol: "UDP"},
+					},
+				},
+			},
 		},
 	}
 	vars := envvars.FromServices(&sl)
@@ -79,6 +87,19 @@ func TestFromServices(t *testing.T) {
 		{Name: "Q_U_U_X_PORT_8082_TCP_PROTO", Value: "tcp"},
 		{Name: "Q_U_U_X_PORT_8082_TCP_PORT", Value: "8082"},
 		{Name: "Q_U_U_X_PORT_8082_TCP_ADDR", Value: "9.8.7.6"},
+		{Name: "MULTI_PORT_SERVICE_HOST", Value: "5.4.3.2"},
+		{Name: "MULTI_PORT_SERVICE_PORT", Value: "80"},
+		{Name: "MULTI_PORT_SERVICE_PORT_HTTP", Value: "80"},
+		{Name: "MULTI_PORT_SERVICE_PORT_DNS_UDP", Value: "53"},
+		{Name: "MULTI_PORT_PORT", Value: "tcp://5.4.3.2:80"},
+		{Name: "MULTI_PORT_PORT_80_TCP", Value: "tcp://5.4.3.2:80"},
+		{Name: "MULTI_PORT_PORT_80_TCP_PROTO", Value: "tcp"},
+		{Name: "MULTI_PORT_PORT_80_TCP_PORT", Value: "80"},
+		{Name: "MULTI_PORT_PORT_80_TCP_ADDR", Value: "5.4.3.2"},
+		{Name: "MULTI_PORT_PORT_53_UDP", Value: "udp://5.4.3.2:53"},
+		{Name: "MULTI_PORT_PORT_53_UDP_PROTO", Value: "udp"},
+		{Name: "MULTI_PORT_PORT_53_UDP_PORT", Value: "53"},
+		{Name: "MULTI_PORT_PORT_53_UDP_ADDR", Value: "5.4.3.2"},
 	}
 	if len(vars) != len(expected) {
 		t.Errorf("Expected %d env vars, got: %+v", len(expected), vars)
@@ -32,11 +32,13 @@ import (
 	"time"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	apierrors "github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/capabilities"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fieldpath"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/cadvisor"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/dockertools"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/envvars"
@@ -61,6 +63,10 @@ const (
 	sharesPerCPU  = 1024
 	milliCPUToCPU = 1000
 
+	// 100000 is equivalent to 100ms
+	quotaPeriod    = 100000
+	minQuotaPeriod = 1000
+
 	// The oom_score_adj of the POD infrastructure container. The default is 0, so
 	// any value below that makes it *less* likely to get OOM killed.
 	podOomScoreAdj = -100
@@ -585,6 +591,21 @@ func milliCPUToShares(milliCPU int64) int64 {
 	return shares
 }
 
+// milliCPUToQuota converts milliCPU to a CFS quota and period, which cap the
+// CPU time a container can use in each period. Zero milliCPU means no limit.
+func milliCPUToQuota(milliCPU int64) (quota int64, period int64) {
+	if milliCPU == 0 {
+		return 0, 0
+	}
+	// Conceptually (milliCPU / milliCPUToCPU) * quotaPeriod, but factored to improve rounding.
+	period = quotaPeriod
+	quota = (milliCPU * quotaPeriod) / milliCPUToCPU
+	if quota < minQuotaPeriod {
+		quota = minQuotaPeriod
+	}
+	return quota, period
+}
+
 func makeCapabilites(capAdd []api.CapabilityType, capDrop []api.CapabilityType) ([]string, []string) {
 	var (
 		addCaps  []string
@@ -599,6 +620,62 @@ func makeCapabilites(capAdd []api.CapabilityType, capDrop []api.CapabilityType)
 	return addCaps, dropCaps
 }
 
+// makeSELinuxSecurityOpts returns the docker security options that label a
+// container with the given SELinux options.
+func makeSELinuxSecurityOpts(opts *api.SELinuxOptions) []string {
+	if opts == nil {
+		return nil
+	}
+	var securityOpts []string
+	for _, label := range []struct{ name, value string }{
+		{"user", opts.User},
+		{"role", opts.Role},
+		{"type", opts.Type},
+		{"level", opts.Level},
+	} {
+		if len(label.value) > 0 {
+			securityOpts = append(securityOpts, fmt.Sprintf("label:%s:%s", label.name, label.value))
+		}
+	}
+	return securityOpts
+}
+
+// verifyRunAsNonRoot returns an error if the container must run as a non-root
+// user but would run as root, either because of its security context or the
+// user its image runs as.
+func (kl *Kubelet) verifyRunAsNonRoot(container *api.Container) error {
+	sc := container.SecurityContext
+	if sc == nil || !sc.RunAsNonRoot {
+		return nil
+	}
+	if sc.RunAsUser != nil {
+		if *sc.RunAsUser == 0 {
+			return fmt.Errorf("container %q must run as non-root, but runAsUser is 0", container.Name)
+		}
+		return nil
+	}
+	image, err := kl.dockerClient.InspectImage(container.Image)
+	if err != nil {
+		return err
+	}
+	user := ""
+	if image != nil && image.Config != nil {
+		user = image.Config.User
+	}
+	// The user may be given as "user:group"; only the user matters here.
+	if i := strings.Index(user, ":"); i >= 0 {
+		user = user[:i]
+	}
+	switch user {
+	case "", "root", "0":
+		return fmt.Errorf("container %q must run as non-root, but image %q runs as root", container.Name, container.Image)
+	}
+	if _, err := strconv.ParseInt(user, 10, 64); err != nil {
+		return fmt.Errorf("container %q must run as non-root, but the UID of user %q of image %q cannot be verified", container.Name, user, container.Image)
+	}
+	return nil
+}
+
 // A basic interface that knows how to execute handlers
 type actionHandler interface {
 	Run(podFullName string, uid types.UID, container *api.Container, handler *api.Handler) error
@@ -686,19 +763,28 @@ func (kl *Kubelet) getRef(id dockertools.DockerID) (ref *api.ObjectReference, ok
 }
 
 // Run a single container from a pod. Returns the docker container ID
-func (kl *Kubelet) runContainer(pod *api.BoundPod, container *api.Container, podVolumes volumeMap, netMode, ipcMode string) (id dockertools.DockerID, err error) {
+func (kl *Kubelet) runContainer(pod *api.BoundPod, container *api.Container, podVolumes volumeMap, netMode, ipcMode, podIP string) (id dockertools.DockerID, err error) {
 	ref, err := containerRef(pod, container)
 	if err != nil {
 		glog.Errorf("Couldn't make a ref to pod %v, container %v: '%v'", pod.Name, container.Name, err)
 	}
 
-	envVariables, err := kl.makeEnvironmentVariables(pod.Namespace, container)
+	envVariables, err := kl.makeEnvironmentVariables(pod, container, podIP)
 	if err != nil {
 		return "", err
 	}
 	binds := makeBinds(pod, container, podVolumes)
 	exposedPorts, portBindings := makePortsAndBindings(container)
 
+	if err := kl.verifyRunAsNonRoot(container); err != nil {
+		if ref != nil {
+			kl.recorder.Eventf(ref, "failed", "Failed to create docker container with error: %v", err)
+		}
+		return "", err
+	}
+
+	// The CPU request sets the container's relative weight when the node is
+	// contended, while the CPU and memory limits are enforced as hard caps.
 	opts := docker.CreateContainerOptions{
 		Name: dockertools.BuildDockerName(pod.UID, GetPodFullName(pod), container),
 		Config: &docker.Config{
@@ -708,10 +794,13 @@ func (kl *Kubelet) runContainer(pod *api.BoundPod, container *api.Container, pod
 			Hostname:     pod.Name,
 			Image:        container.Image,
 			Memory:       container.Resources.Limits.Memory().Value(),
-			CPUShares:    milliCPUToShares(container.Resources.Limits.Cpu().MilliValue()),
+			CPUShares:    milliCPUToShares(container.Resources.Requests.Cpu().MilliValue()),
 			WorkingDir:   container.WorkingDir,
 		},
 	}
+	if sc := container.SecurityContext; sc != nil && sc.RunAsUser != nil {
+		opts.Config.User = strconv.FormatInt(*sc.RunAsUser, 10)
+	}
 	dockerContainer, err := kl.dockerClient.CreateContainer(opts)
 	if err != nil {
 		if ref != nil {
@@ -750,6 +839,7 @@ func (kl *Kubelet) runContainer(pod *api.BoundPod, container *api.Container, pod
 	}
 
 	capAdd, capDrop := makeCapabilites(container.Capabilities.Add, container.Capabilities.Drop)
+	cpuQuota, cpuPeriod := milliCPUToQuota(container.Resources.Limits.Cpu().MilliValue())
 	hc := &docker.HostConfig{
 		PortBindings: portBindings,
 		Binds:        binds,
@@ -758,6 +848,12 @@ func (kl *Kubelet) runContainer(pod *api.BoundPod, container *api.Container, pod
 		Privileged:   privileged,
 		CapAdd:       capAdd,
 		CapDrop:      capDrop,
+		CPUQuota:     cpuQuota,
+		CPUPeriod:    cpuPeriod,
+	}
+	if sc := container.SecurityContext; sc != nil {
+		hc.SecurityOpt = makeSELinuxSecurityOpts(sc.SELinuxOptions)
+		hc.ReadonlyRootfs = sc.ReadOnlyRootFilesystem
 	}
 	if pod.Spec.DNSPolicy == api.DNSClusterFirst {
 		if err := kl.applyClusterDNS(hc, pod); err != nil {
@@ -837,8 +933,10 @@ func (kl *Kubelet) getServiceEnvVarMap(ns string) (map[string]string, error) {
 	return m, nil
 }
 
-// Make the service environment variables for a pod in the given namespace.
-func (kl *Kubelet) makeEnvironmentVariables(ns string, container *api.Container) ([]string, error) {
+// Make the environment variables for a container in the given pod: the
+// container's own env, with values sourced from the pod resolved, followed by
+// the service environment variables for the pod's namespace.
+func (kl *Kubelet) makeEnvironmentVariables(pod *api.BoundPod, container *api.Container, podIP string) ([]string, error) {
 	var result []string
 	// Note:  These are added to the docker.Config, but are not included in the checksum computed
 	// by dockertools.BuildDockerName(...).  That way, we can still determine whether an
@@ -849,7 +947,7 @@ func (kl *Kubelet) makeEnvironmentVariables(ns string, container *api.Container)
 	// To avoid this users can: (1) wait between starting a service and starting; or (2) detect
 	// missing service env var and exit and be restarted; or (3) use DNS instead of env vars
 	// and keep trying to resolve the DNS name of the service (recommended).
-	serviceEnv, err := kl.getServiceEnvVarMap(ns)
+	serviceEnv, err := kl.getServiceEnvVarMap(pod.Namespace)
 	if err != nil {
 		return result, err
 	}
@@ -861,7 +959,14 @@ func (kl *Kubelet) makeEnvironmentVariables(ns string, container *api.Container)
 		// env vars.
 		// TODO: remove this net line once all platforms use apiserver+Pods.
 		delete(serviceEnv, value.Name)
-		result = append(result, fmt.Sprintf("%s=%s", value.Name, value.Value))
+		runtimeValue := value.Value
+		if value.ValueFrom != nil && value.ValueFrom.FieldRef != nil {
+			runtimeValue, err = podFieldSelectorRuntimeValue(value.ValueFrom.FieldRef, pod, podIP)
+			if err != nil {
+				return result, err
+			}
+		}
+		result = append(result, fmt.Sprintf("%s=%s", value.Name, runtimeValue))
 	}
 
 	// Append remaining service env vars.
@@ -871,6 +976,16 @@ func (kl *Kubelet) makeEnvironmentVariables(ns string, container *api.Container)
 	return result, nil
 }
 
+// podFieldSelectorRuntimeValue returns the runtime value of the given
+// selector for a pod.
+func podFieldSelectorRuntimeValue(fs *api.ObjectFieldSelector, pod *api.BoundPod, podIP string) (string, error) {
+	switch fs.FieldPath {
+	case "status.podIP":
+		return podIP, nil
+	}
+	return fieldpath.ExtractFieldPathAsString(pod, fs.FieldPath)
+}
+
 func (kl *Kubelet) applyClusterDNS(hc *docker.HostConfig, pod *api.BoundPod) error {
 	// Get host DNS settings and append them to cluster DNS settings.
 	f, err := os.Open("/etc/resolv.conf")
@@ -933,9 +1048,18 @@ func (kl *Kubelet) killContainer(dockerContainer *docker.APIContainers) error {
 }
 
 func (kl *Kubelet) killContainerByID(ID string) error {
+	return kl.killContainerByIDWithGracePeriod(ID, 10)
+}
+
+// killContainerByIDWithGracePeriod stops a container, giving it gracePeriod
+// seconds to exit after SIGTERM before it is sent SIGKILL.
+func (kl *Kubelet) killContainerByIDWithGracePeriod(ID string, gracePeriod int64) error {
 	glog.V(2).Infof("Killing container with id %q", ID)
 	kl.readiness.remove(ID)
-	err := kl.dockerClient.StopContainer(ID, 10)
+	if gracePeriod < minimumGracePeriodInSeconds {
+		gracePeriod = minimumGracePeriodInSeconds
+	}
+	err := kl.dockerClient.StopContainer(ID, uint(gracePeriod))
 
 	ref, ok := kl.getRef(dockertools.DockerID(ID))
 	if !ok {
@@ -947,8 +1071,56 @@ func (kl *Kubelet) killContainerByID(ID string) error {
 	return err
 }
 
+// killContainerWithGracePeriod runs the PreStop hook of container, if any, and
+// then stops dockerContainer. The time spent in the hook counts against the
+// grace period.
+func (kl *Kubelet) killContainerWithGracePeriod(pod *api.BoundPod, container *api.Container, dockerContainer *docker.APIContainers, gracePeriod int64) error {
+	start := time.Now()
+	if container.Lifecycle != nil && container.Lifecycle.PreStop != nil {
+		podFullName := GetPodFullName(pod)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			defer util.HandleCrash()
+			if err := kl.runHandler(podFullName, pod.UID, container, container.Lifecycle.PreStop); err != nil {
+				glog.Errorf("PreStop hook for container %q in pod %q failed: %v", container.Name, podFullName, err)
+			}
+		}()
+		select {
+		case <-time.After(time.Duration(gracePeriod) * time.Second):
+			glog.V(2).Infof("PreStop hook for container %q in pod %q did not complete in %d seconds", container.Name, podFullName, gracePeriod)
+		case <-done:
+		}
+		gracePeriod -= int64(time.Since(start).Seconds())
+	}
+	return kl.killContainerByIDWithGracePeriod(dockerContainer.ID, gracePeriod)
+}
+
+// terminationGracePeriod returns the number of seconds the containers of pod
+// have left to shut down.
+func terminationGracePeriod(pod *api.BoundPod) int64 {
+	gracePeriod := int64(api.DefaultTerminationGracePeriodSeconds)
+	if pod.Spec.TerminationGracePeriodSeconds != nil {
+		gracePeriod = *pod.Spec.TerminationGracePeriodSeconds
+	}
+	if pod.DeletionTimestamp != nil {
+		remaining := int64(pod.DeletionTimestamp.Sub(time.Now()).Seconds())
+		if remaining < gracePeriod {
+			gracePeriod = remaining
+		}
+	}
+	if gracePeriod < 0 {
+		gracePeriod = 0
+	}
+	return gracePeriod
+}
+
 const (
 	PodInfraContainerImage = "kubernetes/pause:latest"
+
+	// minimumGracePeriodInSeconds is the least time a container is given to
+	// handle SIGTERM before it is killed.
+	minimumGracePeriodInSeconds = 2
 )
 
 // createPodInfraContainer starts the pod infra container for a pod. Returns the docker container ID of the newly created container.
@@ -977,14 +1149,14 @@ func (kl *Kubelet) createPodInfraContainer(pod *api.BoundPod) (dockertools.Docke
 		return "", err
 	}
 	if !ok {
-		if err := kl.pullImage(container.Image, ref); err != nil {
+		if err := kl.pullImage(pod, container.Image, ref); err != nil {
 			return "", err
 		}
 	}
 	if ref != nil {
 		kl.recorder.Eventf(ref, "pulled", "Successfully pulled image %q", container.Image)
 	}
-	id, err := kl.runContainer(pod, container, nil, "", "")
+	id, err := kl.runContainer(pod, container, nil, "", "", "")
 	if err != nil {
 		return "", err
 	}
@@ -1004,13 +1176,31 @@ func (kl *Kubelet) createPodInfraContainer(pod *api.BoundPod) (dockertools.Docke
 	return id, util.ApplyOomScoreAdj(containerInfo.State.Pid, podOomScoreAdj)
 }
 
-func (kl *Kubelet) pullImage(img string, ref *api.ObjectReference) error {
+// getPullSecretsForPod retrieves the image pull secrets referenced by the pod. Secrets
+// that cannot be retrieved are skipped, so the pull is attempted without them.
+func (kl *Kubelet) getPullSecretsForPod(pod *api.BoundPod) []api.Secret {
+	pullSecrets := []api.Secret{}
+	if kl.kubeClient == nil {
+		return pullSecrets
+	}
+	for _, secretRef := range pod.Spec.ImagePullSecrets {
+		secret, err := kl.kubeClient.Secrets(pod.Namespace).Get(secretRef.Name)
+		if err != nil {
+			glog.Warningf("Unable to retrieve pull secret %s/%s for %s due to %v.  The image pull may not succeed.", pod.Namespace, secretRef.Name, GetPodFullName(pod), err)
+			continue
+		}
+		pullSecrets = append(pullSecrets, *secret)
+	}
+	return pullSecrets
+}
+
+func (kl *Kubelet) pullImage(pod *api.BoundPod, img string, ref *api.ObjectReference) error {
 	start := time.Now()
 	defer func() {
 		metrics.ImagePullLatency.Observe(metrics.SinceInMicroseconds(start))
 	}()
 
-	if err := kl.dockerPuller.Pull(img); err != nil {
+	if err := kl.dockerPuller.Pull(img, kl.getPullSecretsForPod(pod)); err != nil {
 		if ref != nil {
 			kl.recorder.Eventf(ref, "failed", "Failed to pull image %q", img)
 		}
@@ -1026,17 +1216,19 @@ func (kl *Kubelet) pullImage(img string, ref *api.ObjectReference) error {
 func (kl *Kubelet) killContainersInPod(pod *api.BoundPod, dockerContainers dockertools.DockerContainers) (int, error) {
 	podFullName := GetPodFullName(pod)
 
+	gracePeriod := terminationGracePeriod(pod)
 	count := 0
 	errs := make(chan error, len(pod.Spec.Containers))
 	wg := sync.WaitGroup{}
-	for _, container := range pod.Spec.Containers {
+	for i := range pod.Spec.Containers {
+		container := &pod.Spec.Containers[i]
 		// TODO: Consider being more aggressive: kill all containers with this pod UID, period.
 		if dockerContainer, found, _ := dockerContainers.FindPodContainer(podFullName, pod.UID, container.Name); found {
 			count++
 			wg.Add(1)
 			go func() {
 				defer util.HandleCrash()
-				err := kl.killContainer(dockerContainer)
+				err := kl.killContainerWithGracePeriod(pod, container, dockerContainer, gracePeriod)
 				if err != nil {
 					glog.Errorf("Failed to delete container: %v; Skipping pod %q", err, podFullName)
 					errs <- err
@@ -1119,7 +1311,7 @@ func (kl *Kubelet) getPodInfraContainer(podFullName string, uid types.UID,
 // Attempts to start a container pulling the image before that if necessary. It returns DockerID of a started container
 // if it was successful, and a non-nil error otherwise.
 func (kl *Kubelet) pullImageAndRunContainer(pod *api.BoundPod, container *api.Container, podVolumes *volumeMap,
-	podInfraContainerID dockertools.DockerID) (dockertools.DockerID, error) {
+	podInfraContainerID dockertools.DockerID, podIP string) (dockertools.DockerID, error) {
 	podFullName := GetPodFullName(pod)
 	ref, err := containerRef(pod, container)
 	if err != nil {
@@ -1136,14 +1328,14 @@ func (kl *Kubelet) pullImageAndRunContainer(pod *api.BoundPod, container *api.Co
 		}
 		if container.ImagePullPolicy == api.PullAlways ||
 			(container.ImagePullPolicy == api.PullIfNotPresent && (!present)) {
-			if err := kl.pullImage(container.Image, ref); err != nil {
+			if err := kl.pullImage(pod, container.Image, ref); err != nil {
 				return "", err
 			}
 		}
 	}
 	// TODO(dawnchen): Check RestartPolicy.DelaySeconds before restart a container
 	namespaceMode := fmt.Sprintf("container:%v", podInfraContainerID)
-	containerID, err := kl.runContainer(pod, container, *podVolumes, namespaceMode, namespaceMode)
+	containerID, err := kl.runContainer(pod, container, *podVolumes, namespaceMode, namespaceMode, podIP)
 	if err != nil {
 		// TODO(bburns) : Perhaps blacklist a container after N failures?
 		glog.Errorf("Error running pod %q container %q: %v", podFullName, container.Name, err)
@@ -1157,6 +1349,10 @@ func (kl *Kubelet) syncPod(pod *api.BoundPod, containersInPod dockertools.Docker
 	uid := pod.UID
 	glog.V(4).Infof("Syncing Pod, podFullName: %q, uid: %q", podFullName, uid)
 
+	if pod.DeletionTimestamp != nil {
+		return kl.terminatePod(pod, containersInPod)
+	}
+
 	err := kl.makePodDataDirs(pod)
 	if err != nil {
 		return err
@@ -1253,7 +1449,7 @@ func (kl *Kubelet) syncPod(pod *api.BoundPod, containersInPod dockertools.Docker
 
 		glog.V(3).Infof("Container with name %s doesn't exist, creating", dockerContainerName)
 
-		containerID, err := kl.pullImageAndRunContainer(pod, &container, &podVolumes, podInfraContainerID)
+		containerID, err := kl.pullImageAndRunContainer(pod, &container, &podVolumes, podInfraContainerID, podStatus.PodIP)
 		if err == nil {
 			containersInPod.RemoveContainerWithID(containerID)
 		}
@@ -1271,6 +1467,35 @@ func (kl *Kubelet) syncPod(pod *api.BoundPod, containersInPod dockertools.Docker
 	return nil
 }
 
+// terminatePod stops all containers of a pod that has been marked for deletion,
+// and then tells the apiserver that the pod may be removed.
+func (kl *Kubelet) terminatePod(pod *api.BoundPod, containersInPod dockertools.DockerContainers) error {
+	podFullName := GetPodFullName(pod)
+	glog.V(2).Infof("Pod %q is marked for deletion, stopping its containers", podFullName)
+	if _, err := kl.killContainersInPod(pod, containersInPod); err != nil {
+		return err
+	}
+	if podInfraContainerID, found := kl.getPodInfraContainer(podFullName, pod.UID, containersInPod); found {
+		if err := kl.killContainerByID(string(podInfraContainerID)); err != nil {
+			return err
+		}
+	}
+	if kl.kubeClient == nil {
+		return nil
+	}
+	// Only pods that came from the master can be deleted there.
+	switch pod.Annotations[ConfigSourceAnnotationKey] {
+	case ApiserverSource, EtcdSource:
+	default:
+		return nil
+	}
+	err := kl.kubeClient.Pods(pod.Namespace).Delete(pod.Name, api.NewDeleteOptions(0))
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
 type podContainer struct {
 	podFullName   string
 	uid           types.UID
@@ -26,6 +26,7 @@ import (
 	"path"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -33,6 +34,8 @@ import (
 	"time"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/cadvisor"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/dockertools"
@@ -472,6 +475,174 @@ func TestSyncPodsWithTerminationLog(t *testing.T) {
 	fakeDocker.Unlock()
 }
 
+func TestSyncPodsWithSecurityContext(t *testing.T) {
+	kubelet, fakeDocker, waitGroup, _ := newTestKubelet(t)
+	runAsUser := int64(1001)
+	container := api.Container{
+		Name: "bar",
+		SecurityContext: &api.SecurityContext{
+			RunAsUser:    &runAsUser,
+			RunAsNonRoot: true,
+			SELinuxOptions: &api.SELinuxOptions{
+				User:  "user_u",
+				Level: "s0:c1,c2",
+			},
+			ReadOnlyRootFilesystem: true,
+		},
+	}
+	fakeDocker.ContainerList = []docker.APIContainers{}
+	kubelet.pods = []api.BoundPod{
+		{
+			ObjectMeta: api.ObjectMeta{
+				UID:       "12345678",
+				Name:      "foo",
+				Namespace: "new",
+			},
+			Spec: api.PodSpec{
+				Containers: []api.Container{
+					container,
+				},
+			},
+		},
+	}
+	waitGroup.Add(1)
+	err := kubelet.SyncPods(kubelet.pods, emptyPodUIDs, time.Now())
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	waitGroup.Wait()
+
+	fakeDocker.Lock()
+	defer fakeDocker.Unlock()
+	hc := fakeDocker.Container.HostConfig
+	expectedOpts := []string{"label:user:user_u", "label:level:s0:c1,c2"}
+	if !reflect.DeepEqual(hc.SecurityOpt, expectedOpts) {
+		t.Errorf("expected security options %v, got %v", expectedOpts, hc.SecurityOpt)
+	}
+	if !hc.ReadonlyRootfs {
+		t.Errorf("expected a read-only root filesystem")
+	}
+}
+
+func TestSyncPodsWithResourceLimits(t *testing.T) {
+	kubelet, fakeDocker, waitGroup, _ := newTestKubelet(t)
+	fakeDocker.ContainerList = []docker.APIContainers{}
+	kubelet.pods = []api.BoundPod{
+		{
+			ObjectMeta: api.ObjectMeta{
+				UID:       "12345678",
+				Name:      "foo",
+				Namespace: "new",
+			},
+			Spec: api.PodSpec{
+				Containers: []api.Container{
+					{
+						Name: "bar",
+						Resources: api.ResourceRequirements{
+							Requests: api.ResourceList{
+								api.ResourceCPU: resource.MustParse("250m"),
+							},
+							Limits: api.ResourceList{
+								api.ResourceCPU:    resource.MustParse("500m"),
+								api.ResourceMemory: resource.MustParse("64Mi"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	waitGroup.Add(1)
+	err := kubelet.SyncPods(kubelet.pods, emptyPodUIDs, time.Now())
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	waitGroup.Wait()
+
+	fakeDocker.Lock()
+	defer fakeDocker.Unlock()
+	hc := fakeDocker.Container.HostConfig
+	if hc.CPUQuota != 50000 || hc.CPUPeriod != 100000 {
+		t.Errorf("expected a CPU quota of 50000 per 100000, got %d per %d", hc.CPUQuota, hc.CPUPeriod)
+	}
+}
+
+func TestMilliCPUToQuota(t *testing.T) {
+	tests := []struct {
+		milliCPU int64
+		quota    int64
+		period   int64
+	}{
+		{0, 0, 0},
+		{5, 1000, 100000},
+		{9, 1000, 100000},
+		{10, 1000, 100000},
+		{200, 20000, 100000},
+		{500, 50000, 100000},
+		{1000, 100000, 100000},
+		{1500, 150000, 100000},
+	}
+	for _, test := range tests {
+		quota, period := milliCPUToQuota(test.milliCPU)
+		if quota != test.quota || period != test.period {
+			t.Errorf("milliCPU %d: expected quota %d and period %d, got %d and %d", test.milliCPU, test.quota, test.period, quota, period)
+		}
+	}
+}
+
+func TestMakeSELinuxSecurityOpts(t *testing.T) {
+	tests := []struct {
+		opts     *api.SELinuxOptions
+		expected []string
+	}{
+		{nil, nil},
+		{&api.SELinuxOptions{}, nil},
+		{
+			&api.SELinuxOptions{User: "user_u", Role: "role_r", Type: "type_t", Level: "s0"},
+			[]string{"label:user:user_u", "label:role:role_r", "label:type:type_t", "label:level:s0"},
+		},
+		{&api.SELinuxOptions{Type: "svirt_lxc_net_t"}, []string{"label:type:svirt_lxc_net_t"}},
+	}
+	for i, test := range tests {
+		if opts := makeSELinuxSecurityOpts(test.opts); !reflect.DeepEqual(opts, test.expected) {
+			t.Errorf("%d: expected %v, got %v", i, test.expected, opts)
+		}
+	}
+}
+
+func TestVerifyRunAsNonRoot(t *testing.T) {
+	kubelet, fakeDocker, _, _ := newTestKubelet(t)
+	root := int64(0)
+	nonRoot := int64(1001)
+	tests := []struct {
+		sc        *api.SecurityContext
+		imageUser string
+		expectErr bool
+	}{
+		{nil, "", false},
+		{&api.SecurityContext{}, "", false},
+		{&api.SecurityContext{RunAsNonRoot: true, RunAsUser: &nonRoot}, "", false},
+		{&api.SecurityContext{RunAsNonRoot: true, RunAsUser: &root}, "1001", true},
+		{&api.SecurityContext{RunAsNonRoot: true}, "", true},
+		{&api.SecurityContext{RunAsNonRoot: true}, "root", true},
+		{&api.SecurityContext{RunAsNonRoot: true}, "0:0", true},
+		{&api.SecurityContext{RunAsNonRoot: true}, "nobody", true},
+		{&api.SecurityContext{RunAsNonRoot: true}, "1001", false},
+		{&api.SecurityContext{RunAsNonRoot: true}, "1001:1001", false},
+	}
+	for i, test := range tests {
+		fakeDocker.Image = &docker.Image{Config: &docker.Config{User: test.imageUser}}
+		container := &api.Container{Name: "bar", Image: "foo", SecurityContext: test.sc}
+		err := kubelet.verifyRunAsNonRoot(container)
+		if test.expectErr && err == nil {
+			t.Errorf("%d: expected an error", i)
+		}
+		if !test.expectErr && err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+		}
+	}
+}
+
 func matchString(t *testing.T, pattern, str string) bool {
 	match, err := regexp.MatchString(pattern, str)
 	if err != nil {
@@ -840,6 +1011,116 @@ func TestSyncPodDeletesDuplicate(t *testing.T) {
 	}
 }
 
+func TestSyncPodTerminating(t *testing.T) {
+	kubelet, fakeDocker, _, _ := newTestKubelet(t)
+	fakeCommandRunner := fakeContainerCommandRunner{}
+	kubelet.runner = &fakeCommandRunner
+	fakeClient := &client.Fake{}
+	kubelet.kubeClient = fakeClient
+	fakeDocker.ContainerList = []docker.APIContainers{
+		{
+			// the k8s prefix is required for the kubelet to manage the container
+			Names: []string{"/k8s_foo_bar_new_12345678_1111"},
+			ID:    "1234",
+		},
+		{
+			// pod infra container
+			Names: []string{"/k8s_POD_bar_new_12345678_2222"},
+			ID:    "9876",
+		},
+	}
+	dockerContainers := dockertools.DockerContainers{
+		"1234": &fakeDocker.ContainerList[0],
+		"9876": &fakeDocker.ContainerList[1],
+	}
+	deadline := util.NewTime(time.Now().Add(time.Minute))
+	bound := api.BoundPod{
+		ObjectMeta: api.ObjectMeta{
+			UID:               "12345678",
+			Name:              "bar",
+			Namespace:         "new",
+			DeletionTimestamp: &deadline,
+			Annotations:       map[string]string{ConfigSourceAnnotationKey: ApiserverSource},
+		},
+		Spec: api.PodSpec{
+			Containers: []api.Container{
+				{
+					Name: "foo",
+					Lifecycle: &api.Lifecycle{
+						PreStop: &api.Handler{
+							Exec: &api.ExecAction{Command: []string{"drain"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	kubelet.pods = append(kubelet.pods, bound)
+	err := kubelet.syncPod(&bound, dockerContainers)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(fakeCommandRunner.Cmd, []string{"drain"}) || fakeCommandRunner.ID != "1234" {
+		t.Errorf("expected PreStop hook to run, got %#v", fakeCommandRunner)
+	}
+	sort.Strings(fakeDocker.Stopped)
+	if !reflect.DeepEqual(fakeDocker.Stopped, []string{"1234", "9876"}) {
+		t.Errorf("Wrong containers were stopped: %v", fakeDocker.Stopped)
+	}
+	if len(fakeClient.Actions) != 1 || fakeClient.Actions[0].Action != "delete-pod" || fakeClient.Actions[0].Value != "bar" {
+		t.Errorf("expected pod deletion to be confirmed, got %#v", fakeClient.Actions)
+	}
+}
+
+func TestGetPullSecretsForPod(t *testing.T) {
+	kubelet, _, _, _ := newTestKubelet(t)
+	secret := api.Secret{
+		ObjectMeta: api.ObjectMeta{Name: "pull", Namespace: "new"},
+		Type:       api.SecretTypeDockercfg,
+		Data:       map[string][]byte{api.DockerConfigKey: []byte("{}")},
+	}
+	fakeClient := &client.Fake{Secret: secret}
+	kubelet.kubeClient = fakeClient
+	bound := api.BoundPod{
+		ObjectMeta: api.ObjectMeta{
+			UID:       "12345678",
+			Name:      "bar",
+			Namespace: "new",
+		},
+		Spec: api.PodSpec{
+			ImagePullSecrets: []api.LocalObjectReference{{Name: "pull"}},
+		},
+	}
+	secrets := kubelet.getPullSecretsForPod(&bound)
+	if !reflect.DeepEqual(secrets, []api.Secret{secret}) {
+		t.Errorf("unexpected pull secrets: %#v", secrets)
+	}
+	if len(fakeClient.Actions) != 1 || fakeClient.Actions[0].Action != "get-secret" || fakeClient.Actions[0].Value != "pull" {
+		t.Errorf("unexpected actions: %#v", fakeClient.Actions)
+	}
+}
+
+func TestTerminationGracePeriod(t *testing.T) {
+	grace := int64(60)
+	soon := util.NewTime(time.Now().Add(20 * time.Second))
+	past := util.NewTime(time.Now().Add(-time.Minute))
+	tests := []struct {
+		pod      api.BoundPod
+		expected int64
+	}{
+		{api.BoundPod{}, api.DefaultTerminationGracePeriodSeconds},
+		{api.BoundPod{Spec: api.PodSpec{TerminationGracePeriodSeconds: &grace}}, 60},
+		{api.BoundPod{ObjectMeta: api.ObjectMeta{DeletionTimestamp: &soon}, Spec: api.PodSpec{TerminationGracePeriodSeconds: &grace}}, 19},
+		{api.BoundPod{ObjectMeta: api.ObjectMeta{DeletionTimestamp: &past}}, 0},
+	}
+	for i, test := range tests {
+		if actual := terminationGracePeriod(&test.pod); actual != test.expected {
+			t.Errorf("%d: expected %d, got %d", i, test.expected, actual)
+		}
+	}
+}
+
 func TestSyncPodBadHash(t *testing.T) {
 	kubelet, fakeDocker, _, _ := newTestKubelet(t)
 	dockerContainers := dockertools.DockerContainers{
@@ -2019,56 +2300,56 @@ func TestMakeEnvironmentVariables(t *testing.T) {
 		{
 			ObjectMeta: api.ObjectMeta{Name: "kubernetes", Namespace: api.NamespaceDefault},
 			Spec: api.ServiceSpec{
-				Port:     8081,
+				Ports:    []api.ServicePort{{Protocol: "TCP", Port: 8081}},
 				PortalIP: "1.2.3.1",
 			},
 		},
 		{
 			ObjectMeta: api.ObjectMeta{Name: "kubernetes-ro", Namespace: api.NamespaceDefault},
 			Spec: api.ServiceSpec{
-				Port:     8082,
+				Ports:    []api.ServicePort{{Protocol: "TCP", Port: 8082}},
 				PortalIP: "1.2.3.2",
 			},
 		},
 		{
 			ObjectMeta: api.ObjectMeta{Name: "test", Namespace: "test1"},
 			Spec: api.ServiceSpec{
-				Port:     8083,
+				Ports:    []api.ServicePort{{Protocol: "TCP", Port: 8083}},
 				PortalIP: "1.2.3.3",
 			},
 		},
 		{
 			ObjectMeta: api.ObjectMeta{Name: "kubernetes", Namespace: "test2"},
 			Spec: api.ServiceSpec{
-				Port:     8084,
+				Ports:    []api.ServicePort{{Protocol: "TCP", Port: 8084}},
 				PortalIP: "1.2.3.4",
 			},
 		},
 		{
 			ObjectMeta: api.ObjectMeta{Name: "test", Namespace: "test2"},
 			Spec: api.ServiceSpec{
-				Port:     8085,
+				Ports:    []api.ServicePort{{Protocol: "TCP", Port: 8085}},
 				PortalIP: "1.2.3.5",
 			},
 		},
 		{
 			ObjectMeta: api.ObjectMeta{Name: "kubernetes", Namespace: "kubernetes"},
 			Spec: api.ServiceSpec{
-				Port:     8086,
+				Ports:    []api.ServicePort{{Protocol: "TCP", Port: 8086}},
 				PortalIP: "1.2.3.6",
 			},
 		},
 		{
 			ObjectMeta: api.ObjectMeta{Name: "kubernetes-ro", Namespace: "kubernetes"},
 			Spec: api.ServiceSpec{
-				Port:     8087,
+				Ports:    []api.ServicePort{{Protocol: "TCP", Port: 8087}},
 				PortalIP: "1.2.3.7",
 			},
 		},
 		{
 			ObjectMeta: api.ObjectMeta{Name: "not-special", Namespace: "kubernetes"},
 			Spec: api.ServiceSpec{
-				Port:     8088,
+				Ports:    []api.ServicePort{{Protocol: "TCP", Port: 8088}},
 				PortalIP: "1.2.3.8",
 			},
 		},
@@ -2249,6 +2530,39 @@ func TestMakeEnvironmentVariables(t *testing.T) {
 				"KUBERNETES_RO_PORT_8087_TCP_ADDR=1.2.3.7"),
 			21,
 		},
+		{
+			"downward api",
+			"downward-ns",
+			&api.Container{
+				Env: []api.EnvVar{
+					{
+						Name: "POD_NAME",
+						ValueFrom: &api.EnvVarSource{
+							FieldRef: &api.ObjectFieldSelector{FieldPath: "metadata.name"},
+						},
+					},
+					{
+						Name: "POD_NAMESPACE",
+						ValueFrom: &api.EnvVarSource{
+							FieldRef: &api.ObjectFieldSelector{FieldPath: "metadata.namespace"},
+						},
+					},
+					{
+						Name: "POD_IP",
+						ValueFrom: &api.EnvVarSource{
+							FieldRef: &api.ObjectFieldSelector{FieldPath: "status.podIP"},
+						},
+					},
+				},
+			},
+			"nothing",
+			true,
+			util.NewStringSet(
+				"POD_NAME=dapi-test-pod-name",
+				"POD_NAMESPACE=downward-ns",
+				"POD_IP=1.2.3.4"),
+			3,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2260,7 +2574,15 @@ func TestMakeEnvironmentVariables(t *testing.T) {
 			kl.serviceLister = testServiceLister{services}
 		}
 
-		result, err := kl.makeEnvironmentVariables(tc.ns, tc.container)
+		testPod := &api.BoundPod{
+			ObjectMeta: api.ObjectMeta{
+				Name:      "dapi-test-pod-name",
+				Namespace: tc.ns,
+			},
+		}
+		podIP := "1.2.3.4"
+
+		result, err := kl.makeEnvironmentVariables(testPod, tc.container, podIP)
 		if err != nil {
 			t.Errorf("[%v] Unexpected error: %v", tc.name, err)
 		}
@@ -0,0 +1,143 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package downward_api
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fieldpath"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/volume"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/golang/glog"
+)
+
+// ProbeVolumePlugins is the entry point for plugin detection in a package.
+func ProbeVolumePlugins() []volume.Plugin {
+	return []volume.Plugin{&downwardAPIPlugin{}}
+}
+
+const (
+	downwardAPIPluginName = "kubernetes.io/downward-api"
+)
+
+// downwardAPIPlugin implements the VolumePlugin interface.
+type downwardAPIPlugin struct {
+	host volume.Host
+}
+
+func (plugin *downwardAPIPlugin) Init(host volume.Host) {
+	plugin.host = host
+}
+
+func (plugin *downwardAPIPlugin) Name() string {
+	return downwardAPIPluginName
+}
+
+func (plugin *downwardAPIPlugin) CanSupport(spec *api.Volume) bool {
+	return spec.DownwardAPI != nil
+}
+
+func (plugin *downwardAPIPlugin) NewBuilder(spec *api.Volume, pod *api.BoundPod) (volume.Builder, error) {
+	return &downwardAPIVolume{spec.Name, pod.UID, plugin, spec.DownwardAPI.Items, pod}, nil
+}
+
+func (plugin *downwardAPIPlugin) NewCleaner(volName string, podUID types.UID) (volume.Cleaner, error) {
+	return &downwardAPIVolume{volName, podUID, plugin, nil, nil}, nil
+}
+
+// downwardAPIVolume projects fields of the enclosing pod into files
+// on the host.
+type downwardAPIVolume struct {
+	volName string
+	podUID  types.UID
+	plugin  *downwardAPIPlugin
+	items   []api.DownwardAPIVolumeFile
+	pod     *api.BoundPod
+}
+
+// SetUp writes one file per item. It is called on every pod sync, so only
+// files whose content changed are rewritten; each write goes through a
+// temporary file and a rename so readers never observe a partial file.
+func (dv *downwardAPIVolume) SetUp() error {
+	hostPath := dv.GetPath()
+	glog.V(3).Infof("Setting up volume %v for pod %v at %v", dv.volName, dv.podUID, hostPath)
+	if err := os.MkdirAll(hostPath, 0750); err != nil {
+		return err
+	}
+
+	for _, item := range dv.items {
+		value, err := fieldpath.ExtractFieldPathAsString(dv.pod, item.FieldRef.FieldPath)
+		if err != nil {
+			glog.Errorf("Unable to extract field %q for pod %v: %v", item.FieldRef.FieldPath, dv.podUID, err)
+			return err
+		}
+		hostFilePath := path.Join(hostPath, item.Path)
+		if err := writeFileIfChanged(hostFilePath, []byte(value)); err != nil {
+			glog.Errorf("Error writing downward API data to host path: %v, %v", hostFilePath, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeFileIfChanged atomically replaces the file at filePath with data,
+// unless it already holds exactly that data.
+func writeFileIfChanged(filePath string, data []byte) error {
+	if existing, err := ioutil.ReadFile(filePath); err == nil && bytes.Equal(existing, data) {
+		return nil
+	}
+	dir := path.Dir(filePath)
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(dir, "."+path.Base(filePath)+".")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), 0644)
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), filePath)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
+
+func (dv *downwardAPIVolume) GetPath() string {
+	return dv.plugin.host.GetPodVolumeDir(dv.podUID, volume.EscapePluginName(downwardAPIPluginName), dv.volName)
+}
+
+func (dv *downwardAPIVolume) TearDown() error {
+	glog.V(3).Infof("Tearing down volume %v for pod %v at %v", dv.volName, dv.podUID, dv.GetPath())
+	tmpDir, err := volume.RenameDirectory(dv.GetPath(), dv.volName+".deleting~")
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(tmpDir)
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package downward_api
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/volume"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+)
+
+func newTestHost(t *testing.T) volume.Host {
+	tempDir, err := ioutil.TempDir("/tmp", "downward_api_volume_test.")
+	if err != nil {
+		t.Fatalf("can't make a temp rootdir: %v", err)
+	}
+
+	return &volume.FakeHost{RootDir: tempDir}
+}
+
+func TestCanSupport(t *testing.T) {
+	pluginMgr := volume.PluginMgr{}
+	pluginMgr.InitPlugins(ProbeVolumePlugins(), newTestHost(t))
+
+	plugin, err := pluginMgr.FindPluginByName(downwardAPIPluginName)
+	if err != nil {
+		t.Errorf("Can't find the plugin by name")
+	}
+	if plugin.Name() != downwardAPIPluginName {
+		t.Errorf("Wrong name: %s", plugin.Name())
+	}
+	if !plugin.CanSupport(&api.Volume{VolumeSource: api.VolumeSource{DownwardAPI: &api.DownwardAPIVolumeSource{}}}) {
+		t.Errorf("Expected true")
+	}
+	if plugin.CanSupport(&api.Volume{VolumeSource: api.VolumeSource{}}) {
+		t.Errorf("Expected false")
+	}
+}
+
+func readFile(t *testing.T, filePath string) string {
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("Couldn't read file %s: %v", filePath, err)
+	}
+	return string(data)
+}
+
+func TestPlugin(t *testing.T) {
+	var (
+		testPodUID     = "test_pod_uid"
+		testVolumeName = "test_volume_name"
+	)
+
+	volumeSpec := &api.Volume{
+		Name: testVolumeName,
+		VolumeSource: api.VolumeSource{
+			DownwardAPI: &api.DownwardAPIVolumeSource{
+				Items: []api.DownwardAPIVolumeFile{
+					{Path: "name", FieldRef: api.ObjectFieldSelector{FieldPath: "metadata.name"}},
+					{Path: "meta/labels", FieldRef: api.ObjectFieldSelector{FieldPath: "metadata.labels"}},
+					{Path: "annotations", FieldRef: api.ObjectFieldSelector{FieldPath: "metadata.annotations"}},
+				},
+			},
+		},
+	}
+	pod := &api.BoundPod{
+		ObjectMeta: api.ObjectMeta{
+			Name:        "foo",
+			Namespace:   "bar",
+			UID:         types.UID(testPodUID),
+			Labels:      map[string]string{"b": "2", "a": "1"},
+			Annotations: map[string]string{"note": "hello \"world\""},
+		},
+	}
+
+	pluginMgr := volume.PluginMgr{}
+	pluginMgr.InitPlugins(ProbeVolumePlugins(), newTestHost(t))
+
+	plugin, err := pluginMgr.FindPluginByName(downwardAPIPluginName)
+	if err != nil {
+		t.Errorf("Can't find the plugin by name")
+	}
+
+	builder, err := plugin.NewBuilder(volumeSpec, pod)
+	if err != nil {
+		t.Errorf("Failed to make a new Builder: %v", err)
+	}
+	if builder == nil {
+		t.Fatalf("Got a nil Builder")
+	}
+
+	volumePath := builder.GetPath()
+	if !strings.HasSuffix(volumePath, "pods/test_pod_uid/volumes/kubernetes.io~downward-api/test_volume_name") {
+		t.Errorf("Got unexpected path: %s", volumePath)
+	}
+
+	if err := builder.SetUp(); err != nil {
+		t.Errorf("Failed to setup volume: %v", err)
+	}
+	if actual := readFile(t, path.Join(volumePath, "name")); actual != "foo" {
+		t.Errorf("Unexpected name; expected %q, got %q", "foo", actual)
+	}
+	if actual, expected := readFile(t, path.Join(volumePath, "meta/labels")), "a=\"1\"\nb=\"2\"\n"; actual != expected {
+		t.Errorf("Unexpected labels; expected %q, got %q", expected, actual)
+	}
+	if actual, expected := readFile(t, path.Join(volumePath, "annotations")), "note=\"hello \\\"world\\\"\"\n"; actual != expected {
+		t.Errorf("Unexpected annotations; expected %q, got %q", expected, actual)
+	}
+
+	// Labels changed on the pod; the next sync should refresh the file.
+	pod.Labels = map[string]string{"c": "3"}
+	builder, err = plugin.NewBuilder(volumeSpec, pod)
+	if err != nil {
+		t.Errorf("Failed to make a new Builder: %v", err)
+	}
+	if err := builder.SetUp(); err != nil {
+		t.Errorf("Failed to setup volume: %v", err)
+	}
+	if actual, expected := readFile(t, path.Join(volumePath, "meta/labels")), "c=\"3\"\n"; actual != expected {
+		t.Errorf("Unexpected labels after update; expected %q, got %q", expected, actual)
+	}
+
+	cleaner, err := plugin.NewCleaner(testVolumeName, types.UID(testPodUID))
+	if err != nil {
+		t.Errorf("Failed to make a new Cleaner: %v", err)
+	}
+	if cleaner == nil {
+		t.Fatalf("Got a nil Cleaner")
+	}
+
+	if err := cleaner.TearDown(); err != nil {
+		t.Errorf("Expected success, got: %v", err)
+	}
+	if _, err := os.Stat(volumePath); err == nil {
+		t.Errorf("TearDown() failed, volume path still exists: %s", volumePath)
+	} else if !os.IsNotExist(err) {
+		t.Errorf("TearDown() failed: %v", err)
+	}
+}
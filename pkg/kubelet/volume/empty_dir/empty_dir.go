@@ -21,9 +21,12 @@ import (
 	"os"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/volume"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/mount"
+	"github.com/golang/glog"
 )
 
 // This is the primary entrypoint for volume plugins.
@@ -69,29 +72,84 @@ func (plugin *emptyDirPlugin) CanSupport(spec *api.Volume) bool {
 	return false
 }
 
-func (plugin *emptyDirPlugin) NewBuilder(spec *api.Volume, podUID types.UID) (volume.Builder, error) {
+func (plugin *emptyDirPlugin) NewBuilder(spec *api.Volume, pod *api.BoundPod) (volume.Builder, error) {
+	// Inject real implementations here, test through the internal function.
+	return plugin.newBuilderInternal(spec, pod, mount.New(), &realMountDetector{})
+}
+
+func (plugin *emptyDirPlugin) newBuilderInternal(spec *api.Volume, pod *api.BoundPod, mounter mount.Interface, mountDetector mountDetector) (volume.Builder, error) {
 	if plugin.legacyMode {
 		// Legacy mode instances can be cleaned up but not created anew.
 		return nil, fmt.Errorf("legacy mode: can not create new instances")
 	}
-	return &emptyDir{podUID, spec.Name, plugin, false}, nil
+	ed := &emptyDir{
+		podUID:        pod.UID,
+		volName:       spec.Name,
+		medium:        api.StorageTypeDefault,
+		mounter:       mounter,
+		mountDetector: mountDetector,
+		plugin:        plugin,
+		legacyMode:    false,
+	}
+	if spec.EmptyDir != nil {
+		ed.medium = spec.EmptyDir.Medium
+		ed.sizeLimit = spec.EmptyDir.SizeLimit
+	}
+	return ed, nil
 }
 
 func (plugin *emptyDirPlugin) NewCleaner(volName string, podUID types.UID) (volume.Cleaner, error) {
+	// Inject real implementations here, test through the internal function.
+	return plugin.newCleanerInternal(volName, podUID, mount.New(), &realMountDetector{})
+}
+
+func (plugin *emptyDirPlugin) newCleanerInternal(volName string, podUID types.UID, mounter mount.Interface, mountDetector mountDetector) (volume.Cleaner, error) {
 	legacy := false
 	if plugin.legacyMode {
 		legacy = true
 	}
-	return &emptyDir{podUID, volName, plugin, legacy}, nil
+	return &emptyDir{
+		podUID:        podUID,
+		volName:       volName,
+		medium:        api.StorageTypeDefault, // might be changed later
+		mounter:       mounter,
+		mountDetector: mountDetector,
+		plugin:        plugin,
+		legacyMode:    legacy,
+	}, nil
+}
+
+// mediumType is the kind of filesystem a path is found to be backed by.
+type mediumType int
+
+const (
+	mediumUnknown mediumType = 0 // assume anything we don't explicitly handle is this
+	mediumMemory  mediumType = 1 // memory (e.g. tmpfs on linux)
+)
+
+// mountDetector abstracts how to find what kind of mount a path is backed by.
+type mountDetector interface {
+	// GetMountMedium determines what type of medium a given path is backed
+	// by and whether that path is a mount point.  For example, if this
+	// returns (mediumMemory, false, nil), the caller knows that the path is
+	// on a memory FS (tmpfs on Linux) but is not the root mountpoint of
+	// that tmpfs.
+	GetMountMedium(path string) (mediumType, bool, error)
 }
 
 // EmptyDir volumes are temporary directories exposed to the pod.
 // These do not persist beyond the lifetime of a pod.
 type emptyDir struct {
-	podUID     types.UID
-	volName    string
-	plugin     *emptyDirPlugin
-	legacyMode bool
+	podUID  types.UID
+	volName string
+	// medium is the storage backing the directory.
+	medium api.StorageType
+	// sizeLimit caps the size of a Memory medium, if set.
+	sizeLimit     *resource.Quantity
+	mounter       mount.Interface
+	mountDetector mountDetector
+	plugin        *emptyDirPlugin
+	legacyMode    bool
 }
 
 // SetUp creates new directory.
@@ -99,8 +157,45 @@ func (ed *emptyDir) SetUp() error {
 	if ed.legacyMode {
 		return fmt.Errorf("legacy mode: can not create new instances")
 	}
+	switch ed.medium {
+	case api.StorageTypeDefault:
+		return ed.setupDefault()
+	case api.StorageTypeMemory:
+		return ed.setupTmpfs()
+	default:
+		return fmt.Errorf("unknown storage medium %q", ed.medium)
+	}
+}
+
+func (ed *emptyDir) setupDefault() error {
+	return os.MkdirAll(ed.GetPath(), 0750)
+}
+
+// setupTmpfs creates a tmpfs mount at the volume path, limited to the
+// requested size when one is set.
+func (ed *emptyDir) setupTmpfs() error {
+	if ed.mounter == nil {
+		return fmt.Errorf("memory storage requested, but mounter is nil")
+	}
 	path := ed.GetPath()
-	return os.MkdirAll(path, 0750)
+	if err := os.MkdirAll(path, 0750); err != nil {
+		return err
+	}
+	// Make SetUp idempotent.
+	medium, isMnt, err := ed.mountDetector.GetMountMedium(path)
+	if err != nil {
+		return err
+	}
+	if isMnt && medium == mediumMemory {
+		return nil // current state is what we expect
+	}
+
+	data := ""
+	if ed.sizeLimit != nil {
+		data = fmt.Sprintf("size=%d", ed.sizeLimit.Value())
+	}
+	glog.V(3).Infof("pod %v: mounting tmpfs for volume %v with %q", ed.podUID, ed.volName, data)
+	return ed.mounter.Mount("tmpfs", path, "tmpfs", 0, data)
 }
 
 func (ed *emptyDir) GetPath() string {
@@ -113,6 +208,18 @@ func (ed *emptyDir) GetPath() string {
 
 // TearDown simply deletes everything in the directory.
 func (ed *emptyDir) TearDown() error {
+	// Figure out the medium.
+	if medium, isMnt, err := ed.mountDetector.GetMountMedium(ed.GetPath()); err == nil {
+		if isMnt && medium == mediumMemory {
+			ed.medium = api.StorageTypeMemory
+			return ed.teardownTmpfs()
+		}
+	}
+	// assume StorageTypeDefault
+	return ed.teardownDefault()
+}
+
+func (ed *emptyDir) teardownDefault() error {
 	tmpDir, err := volume.RenameDirectory(ed.GetPath(), ed.volName+".deleting~")
 	if err != nil {
 		return err
@@ -123,3 +230,16 @@ func (ed *emptyDir) TearDown() error {
 	}
 	return nil
 }
+
+func (ed *emptyDir) teardownTmpfs() error {
+	if ed.mounter == nil {
+		return fmt.Errorf("memory storage requested, but mounter is nil")
+	}
+	if err := ed.mounter.Unmount(ed.GetPath(), 0); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(ed.GetPath()); err != nil {
+		return err
+	}
+	return nil
+}
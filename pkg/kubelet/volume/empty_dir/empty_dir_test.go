@@ -21,8 +21,10 @@ import (
 	"testing"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/volume"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/mount"
 )
 
 func TestCanSupport(t *testing.T) {
@@ -56,7 +58,7 @@ func TestPlugin(t *testing.T) {
 		Name:         "vol1",
 		VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}},
 	}
-	builder, err := plug.NewBuilder(spec, types.UID("poduid"))
+	builder, err := plug.NewBuilder(spec, &api.BoundPod{ObjectMeta: api.ObjectMeta{UID: types.UID("poduid")}})
 	if err != nil {
 		t.Errorf("Failed to make a new Builder: %v", err)
 	}
@@ -98,6 +100,94 @@ func TestPlugin(t *testing.T) {
 	}
 }
 
+type fakeMountDetector struct {
+	medium  mediumType
+	isMount bool
+}
+
+func (fake *fakeMountDetector) GetMountMedium(path string) (mediumType, bool, error) {
+	return fake.medium, fake.isMount, nil
+}
+
+func TestPluginTmpfs(t *testing.T) {
+	plugMgr := volume.PluginMgr{}
+	plugMgr.InitPlugins(ProbeVolumePlugins(), &volume.FakeHost{RootDir: "/tmp/fake"})
+
+	plug, err := plugMgr.FindPluginByName("kubernetes.io/empty-dir")
+	if err != nil {
+		t.Errorf("Can't find the plugin by name")
+	}
+	sizeLimit := resource.MustParse("64Mi")
+	spec := &api.Volume{
+		Name:         "vol1",
+		VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{Medium: api.StorageTypeMemory, SizeLimit: &sizeLimit}},
+	}
+	mounter := &mount.FakeMounter{}
+	mountDetector := &fakeMountDetector{}
+	pod := &api.BoundPod{ObjectMeta: api.ObjectMeta{UID: types.UID("poduid")}}
+	builder, err := plug.(*emptyDirPlugin).newBuilderInternal(spec, pod, mounter, mountDetector)
+	if err != nil {
+		t.Errorf("Failed to make a new Builder: %v", err)
+	}
+	if builder == nil {
+		t.Fatalf("Got a nil Builder")
+	}
+
+	path := builder.GetPath()
+	if path != "/tmp/fake/pods/poduid/volumes/kubernetes.io~empty-dir/vol1" {
+		t.Errorf("Got unexpected path: %s", path)
+	}
+
+	if err := builder.SetUp(); err != nil {
+		t.Errorf("Expected success, got: %v", err)
+	}
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			t.Errorf("SetUp() failed, volume path not created: %s", path)
+		} else {
+			t.Errorf("SetUp() failed: %v", err)
+		}
+	}
+	if len(mounter.Log) != 1 {
+		t.Fatalf("Expected 1 mounter call, got %#v", mounter.Log)
+	}
+	expected := mount.FakeAction{Action: mount.FakeActionMount, Target: path, Source: "tmpfs", FSType: "tmpfs", Data: "size=67108864"}
+	if mounter.Log[0] != expected {
+		t.Errorf("Unexpected mount: %#v", mounter.Log[0])
+	}
+
+	// A second SetUp of a mounted tmpfs does nothing.
+	mounter.ResetLog()
+	mountDetector.medium = mediumMemory
+	mountDetector.isMount = true
+	if err := builder.SetUp(); err != nil {
+		t.Errorf("Expected success, got: %v", err)
+	}
+	if len(mounter.Log) != 0 {
+		t.Errorf("Expected no mounter calls, got %#v", mounter.Log)
+	}
+
+	cleaner, err := plug.(*emptyDirPlugin).newCleanerInternal("vol1", types.UID("poduid"), mounter, mountDetector)
+	if err != nil {
+		t.Errorf("Failed to make a new Cleaner: %v", err)
+	}
+	if cleaner == nil {
+		t.Fatalf("Got a nil Cleaner")
+	}
+
+	if err := cleaner.TearDown(); err != nil {
+		t.Errorf("Expected success, got: %v", err)
+	}
+	if _, err := os.Stat(path); err == nil {
+		t.Errorf("TearDown() failed, volume path still exists: %s", path)
+	} else if !os.IsNotExist(err) {
+		t.Errorf("TearDown() failed: %v", err)
+	}
+	if len(mounter.Log) != 1 || mounter.Log[0].Action != mount.FakeActionUnmount || mounter.Log[0].Target != path {
+		t.Errorf("Expected the tmpfs to be unmounted, got %#v", mounter.Log)
+	}
+}
+
 func TestPluginBackCompat(t *testing.T) {
 	plugMgr := volume.PluginMgr{}
 	plugMgr.InitPlugins(ProbeVolumePlugins(), &volume.FakeHost{"/tmp/fake", nil})
@@ -109,7 +199,7 @@ func TestPluginBackCompat(t *testing.T) {
 	spec := &api.Volume{
 		Name: "vol1",
 	}
-	builder, err := plug.NewBuilder(spec, types.UID("poduid"))
+	builder, err := plug.NewBuilder(spec, &api.BoundPod{ObjectMeta: api.ObjectMeta{UID: types.UID("poduid")}})
 	if err != nil {
 		t.Errorf("Failed to make a new Builder: %v", err)
 	}
@@ -138,7 +228,7 @@ func TestPluginLegacy(t *testing.T) {
 		t.Errorf("Expected false")
 	}
 
-	if _, err := plug.NewBuilder(&api.Volume{VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}}}, types.UID("poduid")); err == nil {
+	if _, err := plug.NewBuilder(&api.Volume{VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}}}, &api.BoundPod{ObjectMeta: api.ObjectMeta{UID: types.UID("poduid")}}); err == nil {
 		t.Errorf("Expected failiure")
 	}
 
@@ -0,0 +1,263 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iscsi
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/volume"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/exec"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/mount"
+	"github.com/golang/glog"
+)
+
+// This is the primary entrypoint for volume plugins.
+func ProbeVolumePlugins() []volume.Plugin {
+	return []volume.Plugin{&iscsiPlugin{nil}}
+}
+
+type iscsiPlugin struct {
+	host volume.Host
+}
+
+var _ volume.Plugin = &iscsiPlugin{}
+
+const (
+	iscsiPluginName = "kubernetes.io/iscsi"
+)
+
+func (plugin *iscsiPlugin) Init(host volume.Host) {
+	plugin.host = host
+}
+
+func (plugin *iscsiPlugin) Name() string {
+	return iscsiPluginName
+}
+
+func (plugin *iscsiPlugin) CanSupport(spec *api.Volume) bool {
+	if spec.ISCSI != nil {
+		return true
+	}
+	return false
+}
+
+func (plugin *iscsiPlugin) NewBuilder(spec *api.Volume, pod *api.BoundPod) (volume.Builder, error) {
+	// Inject real implementations here, test through the internal function.
+	return plugin.newBuilderInternal(spec, pod.UID, &ISCSIUtil{}, mount.New(), exec.New())
+}
+
+func (plugin *iscsiPlugin) newBuilderInternal(spec *api.Volume, podUID types.UID, manager diskManager, mounter mount.Interface, runner exec.Interface) (volume.Builder, error) {
+	iscsi := spec.ISCSI
+	return &iscsiDisk{
+		podUID:      podUID,
+		volName:     spec.Name,
+		portal:      iscsi.TargetPortal,
+		iqn:         iscsi.IQN,
+		lun:         strconv.Itoa(iscsi.Lun),
+		fsType:      iscsi.FSType,
+		readOnly:    iscsi.ReadOnly,
+		manager:     manager,
+		mounter:     mounter,
+		diskMounter: &iscsiSafeFormatAndMount{mounter, runner},
+		runner:      runner,
+		plugin:      plugin,
+	}, nil
+}
+
+func (plugin *iscsiPlugin) NewCleaner(volName string, podUID types.UID) (volume.Cleaner, error) {
+	// Inject real implementations here, test through the internal function.
+	return plugin.newCleanerInternal(volName, podUID, &ISCSIUtil{}, mount.New(), exec.New())
+}
+
+func (plugin *iscsiPlugin) newCleanerInternal(volName string, podUID types.UID, manager diskManager, mounter mount.Interface, runner exec.Interface) (volume.Cleaner, error) {
+	return &iscsiDisk{
+		podUID:      podUID,
+		volName:     volName,
+		manager:     manager,
+		mounter:     mounter,
+		diskMounter: &iscsiSafeFormatAndMount{mounter, runner},
+		runner:      runner,
+		plugin:      plugin,
+	}, nil
+}
+
+// Abstract interface to iSCSI disk operations.
+type diskManager interface {
+	// Logs into the target, then formats and mounts the disk at its global path.
+	AttachDisk(disk *iscsiDisk, globalPDPath string) error
+	// Unmounts the disk from its global path and logs out of the target.
+	DetachDisk(disk *iscsiDisk, globalPDPath string) error
+}
+
+// iscsiDisk volumes are disk resources exposed by an iSCSI target that are
+// attached to the kubelet's host machine and exposed to the pod.
+type iscsiDisk struct {
+	volName string
+	podUID  types.UID
+	// iSCSI target portal, an IP or ip_addr:port.
+	portal string
+	// iSCSI Qualified Name of the target.
+	iqn string
+	// Logical unit number of the disk on the target.
+	lun string
+	// Filesystem type, optional.
+	fsType string
+	// Specifies whether the disk will be mounted as read-only.
+	readOnly bool
+	// Utility interface that logs into the target and attaches the disk.
+	manager diskManager
+	// Mounter interface that provides system calls to mount the global path to the pod local path.
+	mounter mount.Interface
+	// diskMounter provides the interface that is used to mount the actual block device.
+	diskMounter mount.Interface
+	// runner executes the iscsiadm commands.
+	runner exec.Interface
+	plugin *iscsiPlugin
+}
+
+// SetUp attaches the disk and bind mounts to the volume path.
+func (disk *iscsiDisk) SetUp() error {
+	// TODO: handle failed mounts here.
+	mountpoint, err := mount.IsMountPoint(disk.GetPath())
+	glog.V(4).Infof("iSCSI disk set up: %s %v %v", disk.GetPath(), mountpoint, err)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if mountpoint {
+		return nil
+	}
+
+	globalPDPath := makeGlobalPDName(disk.plugin.host, disk.portal, disk.iqn, disk.lun)
+	if err := disk.manager.AttachDisk(disk, globalPDPath); err != nil {
+		glog.Errorf("Failed to attach iSCSI disk %s: %v", globalPDPath, err)
+		return err
+	}
+
+	flags := uintptr(0)
+	if disk.readOnly {
+		flags = mount.FlagReadOnly
+	}
+
+	volPath := disk.GetPath()
+	if err := os.MkdirAll(volPath, 0750); err != nil {
+		return err
+	}
+
+	// Perform a bind mount to the full path to allow duplicate mounts of the same disk.
+	err = disk.mounter.Mount(globalPDPath, volPath, "", mount.FlagBind|flags, "")
+	if err != nil {
+		glog.Errorf("Failed to bind mount iSCSI disk %s to %s: %v", globalPDPath, volPath, err)
+		os.Remove(volPath)
+		return err
+	}
+	return nil
+}
+
+func (disk *iscsiDisk) GetPath() string {
+	return disk.plugin.host.GetPodVolumeDir(disk.podUID, volume.EscapePluginName(iscsiPluginName), disk.volName)
+}
+
+// TearDown unmounts the bind mount, and detaches the disk only if the disk
+// resource was the last reference to that disk on the kubelet.
+func (disk *iscsiDisk) TearDown() error {
+	volPath := disk.GetPath()
+	mountpoint, err := mount.IsMountPoint(volPath)
+	if err != nil {
+		return err
+	}
+	if !mountpoint {
+		return os.Remove(volPath)
+	}
+
+	refs, err := mount.GetMountRefs(disk.mounter, volPath)
+	if err != nil {
+		return err
+	}
+	// Unmount the bind-mount inside this pod
+	if err := disk.mounter.Unmount(volPath, 0); err != nil {
+		return err
+	}
+	// If len(refs) is 1, then all bind mounts have been removed, and the
+	// remaining reference is the global mount. It is safe to detach.
+	if len(refs) == 1 {
+		if err := disk.manager.DetachDisk(disk, refs[0]); err != nil {
+			return err
+		}
+	}
+	mountpoint, err = mount.IsMountPoint(volPath)
+	if err != nil {
+		return err
+	}
+	if !mountpoint {
+		if err := os.Remove(volPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// iscsiSafeFormatAndMount formats a block device with the requested filesystem
+// if it does not already hold one, then mounts it.
+type iscsiSafeFormatAndMount struct {
+	mount.Interface
+	runner exec.Interface
+}
+
+// Mount formats source if it is unformatted and the mount is not read-only,
+// then mounts it at target.
+func (mounter *iscsiSafeFormatAndMount) Mount(source string, target string, fstype string, flags uintptr, data string) error {
+	// Don't attempt to format if mounting as readonly. Go straight to mounting.
+	if (flags & mount.FlagReadOnly) == 0 {
+		formatted, err := mounter.isFormatted(source)
+		if err != nil {
+			return err
+		}
+		if !formatted {
+			if len(fstype) == 0 {
+				return fmt.Errorf("cannot format %s without a filesystem type", source)
+			}
+			args := []string{source}
+			if fstype == "ext4" || fstype == "ext3" {
+				// Don't prompt when the device is not a partition.
+				args = []string{"-F", source}
+			}
+			glog.V(5).Infof("exec-ing: mkfs.%s %v", fstype, args)
+			out, err := mounter.runner.Command("mkfs."+fstype, args...).CombinedOutput()
+			if err != nil {
+				return fmt.Errorf("failed to format %s as %s: %v (%s)", source, fstype, err, string(out))
+			}
+		}
+	}
+	return mounter.Interface.Mount(source, target, fstype, flags, data)
+}
+
+// isFormatted reports whether blkid finds a filesystem on device.
+func (mounter *iscsiSafeFormatAndMount) isFormatted(device string) (bool, error) {
+	out, err := mounter.runner.Command("blkid", "-p", "-s", "TYPE", "-o", "value", device).CombinedOutput()
+	if err != nil {
+		// blkid exits with status 2 when no filesystem could be identified.
+		if ee, ok := err.(exec.ExitError); ok && ee.ExitStatus() == 2 {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to probe %s: %v (%s)", device, err, string(out))
+	}
+	return len(out) > 0, nil
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iscsi
+
+import (
+	"os"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/volume"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/exec"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/mount"
+)
+
+func TestCanSupport(t *testing.T) {
+	plugMgr := volume.PluginMgr{}
+	plugMgr.InitPlugins(ProbeVolumePlugins(), &volume.FakeHost{RootDir: "/tmp/fake"})
+
+	plug, err := plugMgr.FindPluginByName("kubernetes.io/iscsi")
+	if err != nil {
+		t.Errorf("Can't find the plugin by name")
+	}
+	if plug.Name() != "kubernetes.io/iscsi" {
+		t.Errorf("Wrong name: %s", plug.Name())
+	}
+	if !plug.CanSupport(&api.Volume{VolumeSource: api.VolumeSource{ISCSI: &api.ISCSIVolumeSource{}}}) {
+		t.Errorf("Expected true")
+	}
+	if plug.CanSupport(&api.Volume{VolumeSource: api.VolumeSource{}}) {
+		t.Errorf("Expected false")
+	}
+}
+
+type fakeDiskManager struct {
+	attachCalled bool
+	detachCalled bool
+}
+
+func (fake *fakeDiskManager) AttachDisk(disk *iscsiDisk, globalPDPath string) error {
+	fake.attachCalled = true
+	return os.MkdirAll(globalPDPath, 0750)
+}
+
+func (fake *fakeDiskManager) DetachDisk(disk *iscsiDisk, globalPDPath string) error {
+	fake.detachCalled = true
+	return os.RemoveAll(globalPDPath)
+}
+
+func TestPlugin(t *testing.T) {
+	plugMgr := volume.PluginMgr{}
+	plugMgr.InitPlugins(ProbeVolumePlugins(), &volume.FakeHost{RootDir: "/tmp/fake"})
+
+	plug, err := plugMgr.FindPluginByName("kubernetes.io/iscsi")
+	if err != nil {
+		t.Errorf("Can't find the plugin by name")
+	}
+	spec := &api.Volume{
+		Name: "vol1",
+		VolumeSource: api.VolumeSource{
+			ISCSI: &api.ISCSIVolumeSource{
+				TargetPortal: "127.0.0.1:3260",
+				IQN:          "iqn.2015-02.example.com:test",
+				Lun:          0,
+				FSType:       "ext4",
+				ReadOnly:     true,
+			},
+		},
+	}
+	manager := &fakeDiskManager{}
+	fakeMounter := &mount.FakeMounter{}
+	builder, err := plug.(*iscsiPlugin).newBuilderInternal(spec, types.UID("poduid"), manager, fakeMounter, &exec.FakeExec{})
+	if err != nil {
+		t.Errorf("Failed to make a new Builder: %v", err)
+	}
+	if builder == nil {
+		t.Fatalf("Got a nil Builder")
+	}
+
+	path := builder.GetPath()
+	if path != "/tmp/fake/pods/poduid/volumes/kubernetes.io~iscsi/vol1" {
+		t.Errorf("Got unexpected path: %s", path)
+	}
+
+	if err := builder.SetUp(); err != nil {
+		t.Errorf("Expected success, got: %v", err)
+	}
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			t.Errorf("SetUp() failed, volume path not created: %s", path)
+		} else {
+			t.Errorf("SetUp() failed: %v", err)
+		}
+	}
+	if !manager.attachCalled {
+		t.Errorf("Attach was not called")
+	}
+	globalPDPath := "/tmp/fake/plugins/kubernetes.io/iscsi/iscsi/127.0.0.1:3260-iqn-iqn.2015-02.example.com:test-lun-0"
+	if len(fakeMounter.Log) != 1 {
+		t.Fatalf("Mount was not called exactly one time. It was called %d times.", len(fakeMounter.Log))
+	}
+	action := fakeMounter.Log[0]
+	if action.Action != mount.FakeActionMount || action.Source != globalPDPath || action.Target != path {
+		t.Errorf("Unexpected mount: %#v", action)
+	}
+	if action.Flags != mount.FlagBind|mount.FlagReadOnly {
+		t.Errorf("Expected a read only bind mount, got flags %v", action.Flags)
+	}
+
+	cleaner, err := plug.(*iscsiPlugin).newCleanerInternal("vol1", types.UID("poduid"), manager, fakeMounter, &exec.FakeExec{})
+	if err != nil {
+		t.Errorf("Failed to make a new Cleaner: %v", err)
+	}
+	if cleaner == nil {
+		t.Fatalf("Got a nil Cleaner")
+	}
+
+	if err := cleaner.TearDown(); err != nil {
+		t.Errorf("Expected success, got: %v", err)
+	}
+	if _, err := os.Stat(path); err == nil {
+		t.Errorf("TearDown() failed, volume path still exists: %s", path)
+	} else if !os.IsNotExist(err) {
+		t.Errorf("TearDown() failed: %v", err)
+	}
+	os.RemoveAll(globalPDPath)
+}
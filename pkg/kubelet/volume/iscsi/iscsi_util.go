@@ -0,0 +1,181 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iscsi
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/volume"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/exec"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/mount"
+	"github.com/golang/glog"
+)
+
+type ISCSIUtil struct{}
+
+// Logs into the target of an iSCSI disk, waits for its block device to
+// appear and mounts the device at its global path.
+func (util *ISCSIUtil) AttachDisk(disk *iscsiDisk, globalPDPath string) error {
+	devicePath := makeDevicePath(disk.portal, disk.iqn, disk.lun)
+	if !waitForPathToExist(devicePath, 1) {
+		if err := loginTarget(disk.runner, disk.portal, disk.iqn); err != nil {
+			return err
+		}
+		if !waitForPathToExist(devicePath, 10) {
+			return errors.New("Could not attach disk: Timeout after 10s")
+		}
+	}
+
+	flags := uintptr(0)
+	if disk.readOnly {
+		flags = mount.FlagReadOnly
+	}
+
+	// Only mount the disk globally once.
+	mountpoint, err := mount.IsMountPoint(globalPDPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			if err := os.MkdirAll(globalPDPath, 0750); err != nil {
+				return err
+			}
+			mountpoint = false
+		} else {
+			return err
+		}
+	}
+	if !mountpoint {
+		err = disk.diskMounter.Mount(devicePath, globalPDPath, disk.fsType, flags, "")
+		if err != nil {
+			os.Remove(globalPDPath)
+			return err
+		}
+	}
+	return nil
+}
+
+// Unmounts the global mount of the disk and logs out of its target once no
+// other lun of the target is mounted.
+func (util *ISCSIUtil) DetachDisk(disk *iscsiDisk, globalPDPath string) error {
+	if err := disk.mounter.Unmount(globalPDPath, 0); err != nil {
+		return err
+	}
+	if err := os.Remove(globalPDPath); err != nil {
+		return err
+	}
+	portal, iqn, err := parseGlobalPDName(globalPDPath)
+	if err != nil {
+		return err
+	}
+	inUse, err := targetInUse(disk.mounter, globalPDPath, portal, iqn)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		glog.V(2).Infof("iSCSI target %s at %s is still in use, not logging out", iqn, portal)
+		return nil
+	}
+	return logoutTarget(disk.runner, portal, iqn)
+}
+
+// targetInUse returns true if a global mount other than globalPDPath belongs
+// to the same portal and IQN, i.e. the session is still needed by another lun.
+func targetInUse(mounter mount.Interface, globalPDPath, portal, iqn string) (bool, error) {
+	mps, err := mounter.List()
+	if err != nil {
+		return false, err
+	}
+	dir := path.Dir(globalPDPath)
+	for i := range mps {
+		if mps[i].Path == globalPDPath || path.Dir(mps[i].Path) != dir {
+			continue
+		}
+		p, q, err := parseGlobalPDName(mps[i].Path)
+		if err != nil {
+			continue
+		}
+		if p == portal && q == iqn {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// loginTarget discovers the targets behind portal and logs into iqn.
+func loginTarget(runner exec.Interface, portal, iqn string) error {
+	out, err := runner.Command("iscsiadm", "-m", "discovery", "-t", "sendtargets", "-p", portal).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("iscsiadm: failed to discover targets at %s: %v (%s)", portal, err, string(out))
+	}
+	out, err = runner.Command("iscsiadm", "-m", "node", "-p", portal, "-T", iqn, "--login").CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("iscsiadm: failed to log into %s at %s: %v (%s)", iqn, portal, err, string(out))
+	}
+	return nil
+}
+
+// logoutTarget ends the session with iqn at portal.
+func logoutTarget(runner exec.Interface, portal, iqn string) error {
+	out, err := runner.Command("iscsiadm", "-m", "node", "-p", portal, "-T", iqn, "--logout").CombinedOutput()
+	if err != nil {
+		glog.Errorf("iscsiadm: failed to log out of %s at %s: %v (%s)", iqn, portal, err, string(out))
+		return err
+	}
+	return nil
+}
+
+// makeDevicePath returns the path udev creates for a lun of an iSCSI target.
+func makeDevicePath(portal, iqn, lun string) string {
+	return strings.Join([]string{"/dev/disk/by-path/ip", portal, "iscsi", iqn, "lun", lun}, "-")
+}
+
+func waitForPathToExist(devicePath string, maxRetries int) bool {
+	//TODO(jonesdl) There should probably be better method than busy-waiting here.
+	for i := 0; i < maxRetries; i++ {
+		if _, err := os.Stat(devicePath); err == nil {
+			return true
+		} else if !os.IsNotExist(err) {
+			return false
+		}
+		if i < maxRetries-1 {
+			time.Sleep(time.Second)
+		}
+	}
+	return false
+}
+
+// makeGlobalPDName returns the path the disk is mounted at before it is bind
+// mounted into pods. The name encodes the portal and IQN so that the disk can
+// be logged out of when only the path is known.
+func makeGlobalPDName(host volume.Host, portal, iqn, lun string) string {
+	return path.Join(host.GetPluginDir(iscsiPluginName), "iscsi", portal+"-iqn-"+iqn+"-lun-"+lun)
+}
+
+// parseGlobalPDName extracts the portal and IQN from a path made by makeGlobalPDName.
+func parseGlobalPDName(globalPDPath string) (string, string, error) {
+	name := path.Base(globalPDPath)
+	iqnIndex := strings.Index(name, "-iqn-")
+	lunIndex := strings.LastIndex(name, "-lun-")
+	if iqnIndex < 0 || lunIndex < iqnIndex {
+		return "", "", fmt.Errorf("unexpected iSCSI mount path %q", globalPDPath)
+	}
+	return name[:iqnIndex], name[iqnIndex+len("-iqn-") : lunIndex], nil
+}
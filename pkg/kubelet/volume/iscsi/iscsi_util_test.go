@@ -0,0 +1,248 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iscsi
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/volume"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/exec"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/mount"
+)
+
+// fakeRunner returns an exec.Interface that runs one command per output and
+// records the command lines it was asked to run.
+func fakeRunner(log *[][]string, outputs ...exec.FakeCombinedOutputAction) *exec.FakeExec {
+	fake := &exec.FakeExec{}
+	for i := range outputs {
+		output := outputs[i]
+		fake.CommandScript = append(fake.CommandScript, func(cmd string, args ...string) exec.Cmd {
+			*log = append(*log, append([]string{cmd}, args...))
+			fakeCmd := &exec.FakeCmd{
+				CombinedOutputScript: []exec.FakeCombinedOutputAction{output},
+			}
+			return exec.InitFakeCmd(fakeCmd, cmd, args...)
+		})
+	}
+	return fake
+}
+
+func succeed(out string) exec.FakeCombinedOutputAction {
+	return func() ([]byte, error) { return []byte(out), nil }
+}
+
+func fail(status int) exec.FakeCombinedOutputAction {
+	return func() ([]byte, error) { return nil, &exec.FakeExitError{Status: status} }
+}
+
+func TestLoginTarget(t *testing.T) {
+	log := [][]string{}
+	runner := fakeRunner(&log, succeed(""), succeed(""))
+	if err := loginTarget(runner, "127.0.0.1:3260", "iqn.2015-02.example.com:test"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := [][]string{
+		{"iscsiadm", "-m", "discovery", "-t", "sendtargets", "-p", "127.0.0.1:3260"},
+		{"iscsiadm", "-m", "node", "-p", "127.0.0.1:3260", "-T", "iqn.2015-02.example.com:test", "--login"},
+	}
+	if !reflect.DeepEqual(expected, log) {
+		t.Errorf("expected commands %v, got %v", expected, log)
+	}
+
+	log = [][]string{}
+	runner = fakeRunner(&log, fail(1))
+	if err := loginTarget(runner, "127.0.0.1:3260", "iqn.2015-02.example.com:test"); err == nil {
+		t.Errorf("expected an error when discovery fails")
+	}
+	if len(log) != 1 {
+		t.Errorf("expected login to stop after failed discovery, ran %v", log)
+	}
+}
+
+func TestLogoutTarget(t *testing.T) {
+	log := [][]string{}
+	runner := fakeRunner(&log, succeed(""))
+	if err := logoutTarget(runner, "127.0.0.1:3260", "iqn.2015-02.example.com:test"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := [][]string{
+		{"iscsiadm", "-m", "node", "-p", "127.0.0.1:3260", "-T", "iqn.2015-02.example.com:test", "--logout"},
+	}
+	if !reflect.DeepEqual(expected, log) {
+		t.Errorf("expected commands %v, got %v", expected, log)
+	}
+}
+
+func TestDetachDisk(t *testing.T) {
+	tmpDir, err := ioutil.TempDir(os.TempDir(), "iscsi_test")
+	if err != nil {
+		t.Fatalf("can't make a temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	portal, iqn := "127.0.0.1:3260", "iqn.2015-02.example.com:test"
+	globalPath := func(target, lun string) string {
+		return path.Join(tmpDir, portal+"-iqn-"+target+"-lun-"+lun)
+	}
+	tests := []struct {
+		name           string
+		otherMounts    []string
+		expectedLogout bool
+	}{
+		{
+			name:           "last lun",
+			expectedLogout: true,
+		},
+		{
+			name:           "other target mounted",
+			otherMounts:    []string{globalPath("iqn.2015-02.example.com:other", "0")},
+			expectedLogout: true,
+		},
+		{
+			name:        "other lun mounted",
+			otherMounts: []string{globalPath(iqn, "1")},
+		},
+	}
+	for _, test := range tests {
+		globalPDPath := globalPath(iqn, "0")
+		if err := os.MkdirAll(globalPDPath, 0750); err != nil {
+			t.Fatalf("%s: can't make the global path: %v", test.name, err)
+		}
+		fakeMounter := &mount.FakeMounter{MountPoints: []mount.MountPoint{{Path: globalPDPath}}}
+		for _, p := range test.otherMounts {
+			fakeMounter.MountPoints = append(fakeMounter.MountPoints, mount.MountPoint{Path: p})
+		}
+		log := [][]string{}
+		disk := &iscsiDisk{mounter: fakeMounter, runner: fakeRunner(&log, succeed(""))}
+
+		if err := (&ISCSIUtil{}).DetachDisk(disk, globalPDPath); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if _, err := os.Stat(globalPDPath); !os.IsNotExist(err) {
+			t.Errorf("%s: expected the global path to be removed, got %v", test.name, err)
+		}
+		if logout := len(log) == 1; logout != test.expectedLogout {
+			t.Errorf("%s: expected logout %v, ran %v", test.name, test.expectedLogout, log)
+		}
+	}
+}
+
+func TestSafeFormatAndMount(t *testing.T) {
+	tests := []struct {
+		name             string
+		fstype           string
+		flags            uintptr
+		outputs          []exec.FakeCombinedOutputAction
+		expectedCommands [][]string
+		expectErr        bool
+	}{
+		{
+			name:             "already formatted",
+			fstype:           "ext4",
+			outputs:          []exec.FakeCombinedOutputAction{succeed("ext4\n")},
+			expectedCommands: [][]string{{"blkid", "-p", "-s", "TYPE", "-o", "value", "/dev/foo"}},
+		},
+		{
+			name:    "unformatted ext4",
+			fstype:  "ext4",
+			outputs: []exec.FakeCombinedOutputAction{fail(2), succeed("")},
+			expectedCommands: [][]string{
+				{"blkid", "-p", "-s", "TYPE", "-o", "value", "/dev/foo"},
+				{"mkfs.ext4", "-F", "/dev/foo"},
+			},
+		},
+		{
+			name:    "unformatted xfs",
+			fstype:  "xfs",
+			outputs: []exec.FakeCombinedOutputAction{fail(2), succeed("")},
+			expectedCommands: [][]string{
+				{"blkid", "-p", "-s", "TYPE", "-o", "value", "/dev/foo"},
+				{"mkfs.xfs", "/dev/foo"},
+			},
+		},
+		{
+			name:             "read only",
+			fstype:           "ext4",
+			flags:            mount.FlagReadOnly,
+			expectedCommands: [][]string{},
+		},
+		{
+			name:             "probe error",
+			fstype:           "ext4",
+			outputs:          []exec.FakeCombinedOutputAction{fail(4)},
+			expectedCommands: [][]string{{"blkid", "-p", "-s", "TYPE", "-o", "value", "/dev/foo"}},
+			expectErr:        true,
+		},
+		{
+			name:    "format error",
+			fstype:  "ext4",
+			outputs: []exec.FakeCombinedOutputAction{fail(2), func() ([]byte, error) { return nil, fmt.Errorf("test error") }},
+			expectedCommands: [][]string{
+				{"blkid", "-p", "-s", "TYPE", "-o", "value", "/dev/foo"},
+				{"mkfs.ext4", "-F", "/dev/foo"},
+			},
+			expectErr: true,
+		},
+	}
+	for _, test := range tests {
+		log := [][]string{}
+		fakeMounter := &mount.FakeMounter{}
+		mounter := iscsiSafeFormatAndMount{fakeMounter, fakeRunner(&log, test.outputs...)}
+
+		err := mounter.Mount("/dev/foo", "/mnt/bar", test.fstype, test.flags, "")
+		if test.expectErr != (err != nil) {
+			t.Errorf("%s: unexpected error result: %v", test.name, err)
+		}
+		if !reflect.DeepEqual(test.expectedCommands, log) {
+			t.Errorf("%s: expected commands %v, got %v", test.name, test.expectedCommands, log)
+		}
+		mounted := len(fakeMounter.Log) == 1 && fakeMounter.Log[0].Source == "/dev/foo" && fakeMounter.Log[0].FSType == test.fstype
+		if mounted == test.expectErr {
+			t.Errorf("%s: unexpected mounts: %v", test.name, fakeMounter.Log)
+		}
+	}
+}
+
+func TestGlobalPDName(t *testing.T) {
+	host := &volume.FakeHost{RootDir: "/tmp/fake"}
+	name := makeGlobalPDName(host, "127.0.0.1:3260", "iqn.2015-02.example.com:test-disk", "3")
+	if name != "/tmp/fake/plugins/kubernetes.io/iscsi/iscsi/127.0.0.1:3260-iqn-iqn.2015-02.example.com:test-disk-lun-3" {
+		t.Errorf("unexpected global path: %s", name)
+	}
+	portal, iqn, err := parseGlobalPDName(name)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if portal != "127.0.0.1:3260" || iqn != "iqn.2015-02.example.com:test-disk" {
+		t.Errorf("unexpected portal %q and iqn %q", portal, iqn)
+	}
+	if _, _, err := parseGlobalPDName("/tmp/fake/plugins/kubernetes.io/iscsi/iscsi/bogus"); err == nil {
+		t.Errorf("expected an error for a path that was not made by makeGlobalPDName")
+	}
+}
+
+func TestMakeDevicePath(t *testing.T) {
+	path := makeDevicePath("127.0.0.1:3260", "iqn.2015-02.example.com:test", "0")
+	if path != "/dev/disk/by-path/ip-127.0.0.1:3260-iscsi-iqn.2015-02.example.com:test-lun-0" {
+		t.Errorf("unexpected device path: %s", path)
+	}
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nfs
+
+import (
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/volume"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/mount"
+	"github.com/golang/glog"
+)
+
+// This is the primary entrypoint for volume plugins.
+func ProbeVolumePlugins() []volume.Plugin {
+	return []volume.Plugin{&nfsPlugin{nil}}
+}
+
+type nfsPlugin struct {
+	host volume.Host
+}
+
+var _ volume.Plugin = &nfsPlugin{}
+
+const (
+	nfsPluginName = "kubernetes.io/nfs"
+)
+
+func (plugin *nfsPlugin) Init(host volume.Host) {
+	plugin.host = host
+}
+
+func (plugin *nfsPlugin) Name() string {
+	return nfsPluginName
+}
+
+func (plugin *nfsPlugin) CanSupport(spec *api.Volume) bool {
+	if spec.NFS != nil {
+		return true
+	}
+	return false
+}
+
+func (plugin *nfsPlugin) NewBuilder(spec *api.Volume, pod *api.BoundPod) (volume.Builder, error) {
+	// Inject real implementations here, test through the internal function.
+	return plugin.newBuilderInternal(spec, pod.UID, mount.New())
+}
+
+func (plugin *nfsPlugin) newBuilderInternal(spec *api.Volume, podUID types.UID, mounter mount.Interface) (volume.Builder, error) {
+	return &nfs{
+		volName:    spec.Name,
+		podUID:     podUID,
+		server:     spec.NFS.Server,
+		exportPath: spec.NFS.Path,
+		readOnly:   spec.NFS.ReadOnly,
+		mounter:    mounter,
+		plugin:     plugin,
+	}, nil
+}
+
+func (plugin *nfsPlugin) NewCleaner(volName string, podUID types.UID) (volume.Cleaner, error) {
+	// Inject real implementations here, test through the internal function.
+	return plugin.newCleanerInternal(volName, podUID, mount.New())
+}
+
+func (plugin *nfsPlugin) newCleanerInternal(volName string, podUID types.UID, mounter mount.Interface) (volume.Cleaner, error) {
+	return &nfs{
+		volName: volName,
+		podUID:  podUID,
+		mounter: mounter,
+		plugin:  plugin,
+	}, nil
+}
+
+// nfs volumes represent a bare host file or directory mount of an NFS export.
+type nfs struct {
+	volName string
+	podUID  types.UID
+	// Hostname or IP address of the NFS server.
+	server string
+	// Path of the export on the NFS server.
+	exportPath string
+	// Specifies whether the export will be mounted read-only.
+	readOnly bool
+	// Mounter interface that provides system calls to mount the export.
+	mounter mount.Interface
+	plugin  *nfsPlugin
+}
+
+// SetUp mounts the NFS export at the volume path.
+func (nfsVolume *nfs) SetUp() error {
+	mountpoint, err := mount.IsMountPoint(nfsVolume.GetPath())
+	glog.V(4).Infof("NFS mount set up: %s %v %v", nfsVolume.GetPath(), mountpoint, err)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if mountpoint {
+		return nil
+	}
+
+	data, err := mountData(nfsVolume.server)
+	if err != nil {
+		return err
+	}
+
+	volPath := nfsVolume.GetPath()
+	if err := os.MkdirAll(volPath, 0750); err != nil {
+		return err
+	}
+
+	flags := uintptr(0)
+	if nfsVolume.readOnly {
+		flags = mount.FlagReadOnly
+	}
+	source := fmt.Sprintf("%s:%s", nfsVolume.server, nfsVolume.exportPath)
+	err = nfsVolume.mounter.Mount(source, volPath, "nfs", flags, data)
+	if err != nil {
+		mountpoint, mntErr := mount.IsMountPoint(volPath)
+		if mntErr != nil {
+			glog.Errorf("isMountpoint check failed: %v", mntErr)
+			return err
+		}
+		if mountpoint {
+			if mntErr = nfsVolume.mounter.Unmount(volPath, 0); mntErr != nil {
+				glog.Errorf("Failed to unmount: %v", mntErr)
+				return err
+			}
+			mountpoint, mntErr := mount.IsMountPoint(volPath)
+			if mntErr != nil {
+				glog.Errorf("isMountpoint check failed: %v", mntErr)
+				return err
+			}
+			if mountpoint {
+				// This is very odd, we don't expect it.  We'll try again next sync loop.
+				glog.Errorf("%s is still mounted, despite call to unmount().  Will try again next sync loop.", volPath)
+				return err
+			}
+		}
+		os.Remove(volPath)
+		return err
+	}
+	return nil
+}
+
+// mountData returns the options passed to the kernel when mounting an export
+// from server. The kernel expects the address of the server rather than its
+// hostname, so hostnames are resolved here.
+func mountData(server string) (string, error) {
+	if ip := net.ParseIP(server); ip != nil {
+		return "addr=" + ip.String(), nil
+	}
+	addrs, err := net.LookupHost(server)
+	if err != nil {
+		return "", err
+	}
+	if len(addrs) == 0 {
+		return "", fmt.Errorf("no addresses found for NFS server %q", server)
+	}
+	return "addr=" + addrs[0], nil
+}
+
+func (nfsVolume *nfs) GetPath() string {
+	return nfsVolume.plugin.host.GetPodVolumeDir(nfsVolume.podUID, volume.EscapePluginName(nfsPluginName), nfsVolume.volName)
+}
+
+// TearDown unmounts the NFS export and removes the volume path.
+func (nfsVolume *nfs) TearDown() error {
+	volPath := nfsVolume.GetPath()
+	mountpoint, err := mount.IsMountPoint(volPath)
+	if err != nil {
+		return err
+	}
+	if !mountpoint {
+		return os.Remove(volPath)
+	}
+
+	if err := nfsVolume.mounter.Unmount(volPath, 0); err != nil {
+		return err
+	}
+	mountpoint, mntErr := mount.IsMountPoint(volPath)
+	if mntErr != nil {
+		glog.Errorf("isMountpoint check failed: %v", mntErr)
+		return mntErr
+	}
+	if !mountpoint {
+		if err := os.Remove(volPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
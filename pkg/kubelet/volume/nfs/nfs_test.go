@@ -0,0 +1,152 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nfs
+
+import (
+	"os"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/volume"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/mount"
+)
+
+func TestCanSupport(t *testing.T) {
+	plugMgr := volume.PluginMgr{}
+	plugMgr.InitPlugins(ProbeVolumePlugins(), &volume.FakeHost{RootDir: "/tmp/fake"})
+
+	plug, err := plugMgr.FindPluginByName("kubernetes.io/nfs")
+	if err != nil {
+		t.Errorf("Can't find the plugin by name")
+	}
+	if plug.Name() != "kubernetes.io/nfs" {
+		t.Errorf("Wrong name: %s", plug.Name())
+	}
+	if !plug.CanSupport(&api.Volume{VolumeSource: api.VolumeSource{NFS: &api.NFSVolumeSource{}}}) {
+		t.Errorf("Expected true")
+	}
+	if plug.CanSupport(&api.Volume{VolumeSource: api.VolumeSource{}}) {
+		t.Errorf("Expected false")
+	}
+}
+
+func doTestPlugin(t *testing.T, readOnly bool) {
+	plugMgr := volume.PluginMgr{}
+	plugMgr.InitPlugins(ProbeVolumePlugins(), &volume.FakeHost{RootDir: "/tmp/fake"})
+
+	plug, err := plugMgr.FindPluginByName("kubernetes.io/nfs")
+	if err != nil {
+		t.Errorf("Can't find the plugin by name")
+	}
+	spec := &api.Volume{
+		Name: "vol1",
+		VolumeSource: api.VolumeSource{
+			NFS: &api.NFSVolumeSource{
+				Server:   "127.0.0.1",
+				Path:     "/exports/data",
+				ReadOnly: readOnly,
+			},
+		},
+	}
+	fake := &mount.FakeMounter{}
+	builder, err := plug.(*nfsPlugin).newBuilderInternal(spec, types.UID("poduid"), fake)
+	if err != nil {
+		t.Errorf("Failed to make a new Builder: %v", err)
+	}
+	if builder == nil {
+		t.Fatalf("Got a nil Builder")
+	}
+
+	path := builder.GetPath()
+	if path != "/tmp/fake/pods/poduid/volumes/kubernetes.io~nfs/vol1" {
+		t.Errorf("Got unexpected path: %s", path)
+	}
+
+	if err := builder.SetUp(); err != nil {
+		t.Errorf("Expected success, got: %v", err)
+	}
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			t.Errorf("SetUp() failed, volume path not created: %s", path)
+		} else {
+			t.Errorf("SetUp() failed: %v", err)
+		}
+	}
+
+	if len(fake.Log) != 1 {
+		t.Fatalf("Mount was not called exactly one time. It was called %d times.", len(fake.Log))
+	}
+	action := fake.Log[0]
+	if action.Action != mount.FakeActionMount {
+		t.Errorf("Unexpected mounter action: %#v", action)
+	}
+	if action.Source != "127.0.0.1:/exports/data" || action.Target != path || action.FSType != "nfs" {
+		t.Errorf("Unexpected mount: %#v", action)
+	}
+	if action.Data != "addr=127.0.0.1" {
+		t.Errorf("Unexpected mount data: %s", action.Data)
+	}
+	expectedFlags := uintptr(0)
+	if readOnly {
+		expectedFlags = mount.FlagReadOnly
+	}
+	if action.Flags != expectedFlags {
+		t.Errorf("Expected mount flags %v, got %v", expectedFlags, action.Flags)
+	}
+	fake.ResetLog()
+
+	cleaner, err := plug.(*nfsPlugin).newCleanerInternal("vol1", types.UID("poduid"), fake)
+	if err != nil {
+		t.Errorf("Failed to make a new Cleaner: %v", err)
+	}
+	if cleaner == nil {
+		t.Fatalf("Got a nil Cleaner")
+	}
+
+	if err := cleaner.TearDown(); err != nil {
+		t.Errorf("Expected success, got: %v", err)
+	}
+	if _, err := os.Stat(path); err == nil {
+		t.Errorf("TearDown() failed, volume path still exists: %s", path)
+	} else if !os.IsNotExist(err) {
+		t.Errorf("TearDown() failed: %v", err)
+	}
+	// The fake mounter does not create real mount points, so the cleaner
+	// finds nothing mounted and only removes the directory.
+	if len(fake.Log) != 0 {
+		t.Errorf("Unmount was called %d times, expected none", len(fake.Log))
+	}
+}
+
+func TestPlugin(t *testing.T) {
+	doTestPlugin(t, false)
+}
+
+func TestPluginReadOnly(t *testing.T) {
+	doTestPlugin(t, true)
+}
+
+func TestMountData(t *testing.T) {
+	data, err := mountData("10.0.0.1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if data != "addr=10.0.0.1" {
+		t.Errorf("Unexpected mount data: %s", data)
+	}
+}
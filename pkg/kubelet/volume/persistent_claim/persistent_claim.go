@@ -0,0 +1,116 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistent_claim
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/volume"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/golang/glog"
+)
+
+// ProbeVolumePlugins is the entry point for plugin detection in a package.
+func ProbeVolumePlugins() []volume.Plugin {
+	return []volume.Plugin{&persistentClaimPlugin{nil}}
+}
+
+// persistentClaimPlugin resolves a PersistentVolumeClaim to the PersistentVolume
+// bound to it and delegates to the plugin that supports that volume.
+type persistentClaimPlugin struct {
+	host volume.Host
+}
+
+var _ volume.Plugin = &persistentClaimPlugin{}
+
+const (
+	persistentClaimPluginName = "kubernetes.io/persistent-claim"
+)
+
+func (plugin *persistentClaimPlugin) Init(host volume.Host) {
+	plugin.host = host
+}
+
+func (plugin *persistentClaimPlugin) Name() string {
+	return persistentClaimPluginName
+}
+
+func (plugin *persistentClaimPlugin) CanSupport(spec *api.Volume) bool {
+	return spec.PersistentVolumeClaimVolumeSource != nil
+}
+
+func (plugin *persistentClaimPlugin) NewBuilder(spec *api.Volume, pod *api.BoundPod) (volume.Builder, error) {
+	source := spec.PersistentVolumeClaimVolumeSource
+	kubeClient := plugin.host.GetKubeClient()
+	if kubeClient == nil {
+		return nil, fmt.Errorf("cannot resolve persistent volume claim %s/%s without a client", pod.Namespace, source.ClaimName)
+	}
+
+	claim, err := kubeClient.PersistentVolumeClaims(pod.Namespace).Get(source.ClaimName)
+	if err != nil {
+		glog.Errorf("Error finding claim %s/%s for pod %s: %v", pod.Namespace, source.ClaimName, pod.UID, err)
+		return nil, err
+	}
+	if claim.Status.VolumeRef == nil {
+		return nil, fmt.Errorf("persistent volume claim %s/%s is not bound to a volume", pod.Namespace, source.ClaimName)
+	}
+
+	pv, err := kubeClient.PersistentVolumes().Get(claim.Status.VolumeRef.Name)
+	if err != nil {
+		glog.Errorf("Error finding persistent volume %s for claim %s/%s: %v", claim.Status.VolumeRef.Name, pod.Namespace, source.ClaimName, err)
+		return nil, err
+	}
+	if ref := pv.Spec.ClaimRef; ref == nil || ref.Namespace != claim.Namespace || ref.Name != claim.Name || (len(ref.UID) != 0 && ref.UID != claim.UID) {
+		return nil, fmt.Errorf("persistent volume %s is not bound to claim %s/%s", pv.Name, pod.Namespace, source.ClaimName)
+	}
+
+	wrapped := &api.Volume{
+		Name:         spec.Name,
+		VolumeSource: volumeSourceFor(&pv.Spec.PersistentVolumeSource, source.ReadOnly),
+	}
+	return plugin.host.NewWrapperBuilder(wrapped, pod)
+}
+
+// volumeSourceFor returns the pod VolumeSource that mounts the persistent volume source.
+func volumeSourceFor(source *api.PersistentVolumeSource, readOnly bool) api.VolumeSource {
+	out := api.VolumeSource{
+		HostPath: source.HostPath,
+	}
+	if source.GCEPersistentDisk != nil {
+		disk := *source.GCEPersistentDisk
+		disk.ReadOnly = disk.ReadOnly || readOnly
+		out.GCEPersistentDisk = &disk
+	}
+	if source.NFS != nil {
+		nfs := *source.NFS
+		nfs.ReadOnly = nfs.ReadOnly || readOnly
+		out.NFS = &nfs
+	}
+	if source.ISCSI != nil {
+		iscsi := *source.ISCSI
+		iscsi.ReadOnly = iscsi.ReadOnly || readOnly
+		out.ISCSI = &iscsi
+	}
+	return out
+}
+
+func (plugin *persistentClaimPlugin) NewCleaner(volName string, podUID types.UID) (volume.Cleaner, error) {
+	// Claims are mounted by the plugin of the volume they are bound to, so the
+	// directories on disk belong to that plugin and it cleans them up.
+	return nil, fmt.Errorf("volume %s of pod %s is cleaned up by the plugin of its persistent volume", volName, podUID)
+}
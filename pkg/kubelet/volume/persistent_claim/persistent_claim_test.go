@@ -0,0 +1,164 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistent_claim
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/volume"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/volume/gce_pd"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/volume/host_path"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+)
+
+// fakeHost resolves wrapped volumes through the plugins it was initialized with.
+type fakeHost struct {
+	volume.FakeHost
+	plugMgr volume.PluginMgr
+}
+
+func (f *fakeHost) NewWrapperBuilder(spec *api.Volume, pod *api.BoundPod) (volume.Builder, error) {
+	plug, err := f.plugMgr.FindPluginBySpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	return plug.NewBuilder(spec, pod)
+}
+
+func newHost(t *testing.T, kubeClient client.Interface) *fakeHost {
+	host := &fakeHost{FakeHost: volume.FakeHost{RootDir: "/tmp/fake", KubeClient: kubeClient}}
+	plugins := append(ProbeVolumePlugins(), host_path.ProbeVolumePlugins()...)
+	plugins = append(plugins, gce_pd.ProbeVolumePlugins()...)
+	if err := host.plugMgr.InitPlugins(plugins, host); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return host
+}
+
+func boundClaimAndVolume() (api.PersistentVolumeClaim, api.PersistentVolume) {
+	claim := api.PersistentVolumeClaim{
+		ObjectMeta: api.ObjectMeta{Name: "claim", Namespace: "test", UID: "claim-uid"},
+		Status: api.PersistentVolumeClaimStatus{
+			Phase:     api.ClaimBound,
+			VolumeRef: &api.ObjectReference{Kind: "PersistentVolume", Name: "volume"},
+		},
+	}
+	pv := api.PersistentVolume{
+		ObjectMeta: api.ObjectMeta{Name: "volume"},
+		Spec: api.PersistentVolumeSpec{
+			PersistentVolumeSource: api.PersistentVolumeSource{
+				HostPath: &api.HostPathVolumeSource{Path: "/somepath"},
+			},
+			ClaimRef: &api.ObjectReference{Namespace: "test", Name: "claim", UID: "claim-uid"},
+		},
+	}
+	return claim, pv
+}
+
+func claimVolume(claimName string) *api.Volume {
+	return &api.Volume{
+		Name: "vol1",
+		VolumeSource: api.VolumeSource{
+			PersistentVolumeClaimVolumeSource: &api.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+		},
+	}
+}
+
+func testPod() *api.BoundPod {
+	return &api.BoundPod{ObjectMeta: api.ObjectMeta{UID: types.UID("poduid"), Namespace: "test"}}
+}
+
+func TestCanSupport(t *testing.T) {
+	host := newHost(t, nil)
+	plug, err := host.plugMgr.FindPluginByName("kubernetes.io/persistent-claim")
+	if err != nil {
+		t.Fatalf("Can't find the plugin by name")
+	}
+	if plug.Name() != "kubernetes.io/persistent-claim" {
+		t.Errorf("Wrong name: %s", plug.Name())
+	}
+	if !plug.CanSupport(claimVolume("claim")) {
+		t.Errorf("Expected true")
+	}
+	if plug.CanSupport(&api.Volume{VolumeSource: api.VolumeSource{HostPath: &api.HostPathVolumeSource{}}}) {
+		t.Errorf("Expected false")
+	}
+}
+
+func TestNewBuilderResolvesClaim(t *testing.T) {
+	claim, pv := boundClaimAndVolume()
+	fakeClient := &client.Fake{PersistentVolumeClaim: claim, PersistentVolume: pv}
+	host := newHost(t, fakeClient)
+	plug, err := host.plugMgr.FindPluginByName("kubernetes.io/persistent-claim")
+	if err != nil {
+		t.Fatalf("Can't find the plugin by name")
+	}
+
+	builder, err := plug.NewBuilder(claimVolume("claim"), testPod())
+	if err != nil {
+		t.Fatalf("Failed to make a new Builder: %v", err)
+	}
+	if path := builder.GetPath(); path != "/somepath" {
+		t.Errorf("Expected the host path of the bound volume, got: %s", path)
+	}
+	if len(fakeClient.Actions) != 2 || fakeClient.Actions[0].Value != "claim" || fakeClient.Actions[1].Value != "volume" {
+		t.Errorf("Unexpected actions: %v", fakeClient.Actions)
+	}
+}
+
+func TestNewBuilderRejectsUnboundClaim(t *testing.T) {
+	claim, pv := boundClaimAndVolume()
+	claim.Status.VolumeRef = nil
+	host := newHost(t, &client.Fake{PersistentVolumeClaim: claim, PersistentVolume: pv})
+	plug, _ := host.plugMgr.FindPluginByName("kubernetes.io/persistent-claim")
+	if _, err := plug.NewBuilder(claimVolume("claim"), testPod()); err == nil {
+		t.Errorf("Expected an error for an unbound claim")
+	}
+}
+
+func TestNewBuilderRejectsVolumeBoundElsewhere(t *testing.T) {
+	claim, pv := boundClaimAndVolume()
+	pv.Spec.ClaimRef.UID = "other-uid"
+	host := newHost(t, &client.Fake{PersistentVolumeClaim: claim, PersistentVolume: pv})
+	plug, _ := host.plugMgr.FindPluginByName("kubernetes.io/persistent-claim")
+	if _, err := plug.NewBuilder(claimVolume("claim"), testPod()); err == nil {
+		t.Errorf("Expected an error for a volume bound to another claim")
+	}
+}
+
+func TestVolumeSourceForReadOnly(t *testing.T) {
+	source := &api.PersistentVolumeSource{
+		GCEPersistentDisk: &api.GCEPersistentDiskVolumeSource{PDName: "disk", FSType: "ext4"},
+	}
+	out := volumeSourceFor(source, true)
+	if out.GCEPersistentDisk == nil || !out.GCEPersistentDisk.ReadOnly {
+		t.Errorf("Expected a read only disk, got %#v", out.GCEPersistentDisk)
+	}
+	if source.GCEPersistentDisk.ReadOnly {
+		t.Errorf("Expected the persistent volume source to be left unchanged")
+	}
+
+	source = &api.PersistentVolumeSource{
+		NFS: &api.NFSVolumeSource{Server: "nfs.example.com", Path: "/exports"},
+	}
+	out = volumeSourceFor(source, true)
+	if out.NFS == nil || !out.NFS.ReadOnly {
+		t.Errorf("Expected a read only NFS export, got %#v", out.NFS)
+	}
+}
@@ -49,8 +49,8 @@ type Plugin interface {
 	// NewBuilder creates a new volume.Builder from an API specification.
 	// Ownership of the spec pointer in *not* transferred.
 	// - spec: The api.Volume spec
-	// - podUID: The UID of the enclosing pod
-	NewBuilder(spec *api.Volume, podUID types.UID) (Builder, error)
+	// - pod: The enclosing pod
+	NewBuilder(spec *api.Volume, pod *api.BoundPod) (Builder, error)
 
 	// NewCleaner creates a new volume.Cleaner from recoverable state.
 	// - name: The volume name, as per the api.Volume spec.
@@ -80,6 +80,13 @@ type Host interface {
 
 	// GetKubeClient returns a client interface
 	GetKubeClient() client.Interface
+
+	// NewWrapperBuilder finds an appropriate plugin with which to handle
+	// the provided spec.  This is used to implement volume plugins which
+	// "wrap" other plugins.  For example, the "persistent-claim" volume
+	// plugin resolves a claim to the volume it is bound to and hands that
+	// volume to the plugin which supports it.
+	NewWrapperBuilder(spec *api.Volume, pod *api.BoundPod) (Builder, error)
 }
 
 // PluginMgr tracks registered plugins.
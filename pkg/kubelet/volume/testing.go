@@ -47,6 +47,13 @@ func (f *FakeHost) GetKubeClient() client.Interface {
 	return f.KubeClient
 }
 
+// NewWrapperBuilder returns a FakeVolume for the spec, as FakeHost does not
+// track the plugins that were initialized with it.
+func (f *FakeHost) NewWrapperBuilder(spec *api.Volume, pod *api.BoundPod) (Builder, error) {
+	plugin := &FakePlugin{"fake", f}
+	return plugin.NewBuilder(spec, pod)
+}
+
 // FakePlugin is useful for for testing.  It tries to be a fully compliant
 // plugin, but all it does is make empty directories.
 // Use as:
@@ -71,8 +78,8 @@ func (plugin *FakePlugin) CanSupport(spec *api.Volume) bool {
 	return true
 }
 
-func (plugin *FakePlugin) NewBuilder(spec *api.Volume, podUID types.UID) (Builder, error) {
-	return &FakeVolume{podUID, spec.Name, plugin}, nil
+func (plugin *FakePlugin) NewBuilder(spec *api.Volume, pod *api.BoundPod) (Builder, error) {
+	return &FakeVolume{pod.UID, spec.Name, plugin}, nil
 }
 
 func (plugin *FakePlugin) NewCleaner(volName string, podUID types.UID) (Cleaner, error) {
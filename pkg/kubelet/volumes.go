@@ -53,7 +53,15 @@ func (vh *volumeHost) GetKubeClient() client.Interface {
 	return vh.kubelet.kubeClient
 }
 
-func (kl *Kubelet) newVolumeBuilderFromPlugins(spec *api.Volume, podUID types.UID) volume.Builder {
+func (vh *volumeHost) NewWrapperBuilder(spec *api.Volume, pod *api.BoundPod) (volume.Builder, error) {
+	builder := vh.kubelet.newVolumeBuilderFromPlugins(spec, pod)
+	if builder == nil {
+		return nil, errUnsupportedVolumeType
+	}
+	return builder, nil
+}
+
+func (kl *Kubelet) newVolumeBuilderFromPlugins(spec *api.Volume, pod *api.BoundPod) volume.Builder {
 	plugin, err := kl.volumePluginMgr.FindPluginBySpec(spec)
 	if err != nil {
 		glog.Warningf("Can't use volume plugins for %s: %v", spew.Sprintf("%#v", *spec), err)
@@ -63,7 +71,7 @@ func (kl *Kubelet) newVolumeBuilderFromPlugins(spec *api.Volume, podUID types.UI
 		glog.Errorf("No error, but nil volume plugin for %s", spew.Sprintf("%#v", *spec))
 		return nil
 	}
-	builder, err := plugin.NewBuilder(spec, podUID)
+	builder, err := plugin.NewBuilder(spec, pod)
 	if err != nil {
 		glog.Warningf("Error instantiating volume plugin for %s: %v", spew.Sprintf("%#v", *spec), err)
 		return nil
@@ -78,7 +86,7 @@ func (kl *Kubelet) mountExternalVolumes(pod *api.BoundPod) (volumeMap, error) {
 		volSpec := &pod.Spec.Volumes[i]
 
 		// Try to use a plugin for this volume.
-		builder := kl.newVolumeBuilderFromPlugins(volSpec, pod.UID)
+		builder := kl.newVolumeBuilderFromPlugins(volSpec, pod)
 		if builder == nil {
 			return nil, errUnsupportedVolumeType
 		}
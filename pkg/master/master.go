@@ -19,6 +19,7 @@ package master
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"net/url"
@@ -34,26 +35,39 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/v1beta2"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/v1beta3"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/apiserver"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/apiserver/audit"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authenticator"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authorizer"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/handlers"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/cloudprovider"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/master/ports"
+	clusterroleetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/clusterrole/etcd"
+	clusterrolebindingetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/clusterrolebinding/etcd"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/controller"
+	dsetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/daemonset/etcd"
+	deploymentetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/deployment/etcd"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/endpoint"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/etcd"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/event"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	hpaetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/horizontalpodautoscaler/etcd"
+	jobetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/job/etcd"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/limitrange"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/minion"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/namespace"
+	pvetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/persistentvolume/etcd"
+	pvcetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/persistentvolumeclaim/etcd"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/pod"
 	podetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/pod/etcd"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/resourcequota"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/resourcequotausage"
+	roleetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/role/etcd"
+	rolebindingetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/rolebinding/etcd"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/secret"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/service"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/serviceaccount"
+	tpretcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/thirdpartyresource/etcd"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/ui"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
@@ -116,6 +130,15 @@ type Config struct {
 
 	// If true we will periodically probe pods statuses.
 	SyncPodStatus bool
+
+	// The number of recent changes remembered for each resource whose lists and watches
+	// are served from memory. Zero disables the watch cache.
+	WatchCacheSize int
+
+	// If specified, a record of every request is written to AuditWriter, and
+	// AuditPolicy selects the requests whose bodies are included.
+	AuditWriter io.Writer
+	AuditPolicy audit.Policy
 }
 
 // Master contains state for a Kubernetes cluster master/api server.
@@ -377,10 +400,23 @@ func (m *Master) init(c *Config) {
 	limitRangeRegistry := limitrange.NewEtcdRegistry(c.EtcdHelper)
 	resourceQuotaRegistry := resourcequota.NewEtcdRegistry(c.EtcdHelper)
 	secretRegistry := secret.NewEtcdRegistry(c.EtcdHelper)
+	serviceAccountRegistry := serviceaccount.NewEtcdRegistry(c.EtcdHelper)
+	persistentVolumeStorage := pvetcd.NewREST(c.EtcdHelper)
+	persistentVolumeClaimStorage := pvcetcd.NewREST(c.EtcdHelper)
+	jobStorage := jobetcd.NewREST(c.EtcdHelper)
+	daemonSetStorage := dsetcd.NewREST(c.EtcdHelper)
+	deploymentStorage := deploymentetcd.NewREST(c.EtcdHelper)
+	horizontalPodAutoscalerStorage := hpaetcd.NewREST(c.EtcdHelper)
+	thirdPartyResourceStorage := tpretcd.NewREST(c.EtcdHelper)
+	roleStorage := roleetcd.NewREST(c.EtcdHelper, m.authorizer)
+	clusterRoleStorage := clusterroleetcd.NewREST(c.EtcdHelper, m.authorizer)
 	m.namespaceRegistry = namespace.NewEtcdRegistry(c.EtcdHelper)
 
 	// TODO: split me up into distinct storage registries
 	registry := etcd.NewRegistry(c.EtcdHelper, podRegistry)
+	if c.WatchCacheSize > 0 {
+		registry.EnableWatchCache(c.WatchCacheSize)
+	}
 
 	m.serviceRegistry = registry
 	m.endpointRegistry = registry
@@ -401,6 +437,9 @@ func (m *Master) init(c *Config) {
 
 	// TODO: refactor podCache to sit on top of podStorage via status calls
 	podStorage = podStorage.WithPodStatus(podCache)
+	if c.WatchCacheSize > 0 {
+		podStorage = podStorage.WithWatchCache(c.WatchCacheSize)
+	}
 
 	// TODO: Factor out the core API registration
 	m.storage = map[string]apiserver.RESTStorage{
@@ -420,7 +459,24 @@ func (m *Master) init(c *Config) {
 		"resourceQuotas":      resourcequota.NewREST(resourceQuotaRegistry),
 		"resourceQuotaUsages": resourcequotausage.NewREST(resourceQuotaRegistry),
 		"namespaces":          namespace.NewREST(m.namespaceRegistry),
+		"namespaces/finalize": namespace.NewFinalizeREST(m.namespaceRegistry),
 		"secrets":             secret.NewREST(secretRegistry),
+		"serviceAccounts":     serviceaccount.NewREST(serviceAccountRegistry),
+
+		"persistentVolumes":      persistentVolumeStorage,
+		"persistentVolumeClaims": persistentVolumeClaimStorage,
+
+		"jobs":                     jobStorage,
+		"daemonSets":               daemonSetStorage,
+		"deployments":              deploymentStorage,
+		"horizontalPodAutoscalers": horizontalPodAutoscalerStorage,
+
+		"thirdPartyResources": thirdPartyResourceStorage,
+
+		"roles":               roleStorage,
+		"roleBindings":        rolebindingetcd.NewREST(c.EtcdHelper, m.authorizer, roleStorage, clusterRoleStorage),
+		"clusterRoles":        clusterRoleStorage,
+		"clusterRoleBindings": clusterrolebindingetcd.NewREST(c.EtcdHelper, m.authorizer, clusterRoleStorage),
 	}
 
 	apiVersions := []string{"v1beta1", "v1beta2"}
@@ -440,6 +496,15 @@ func (m *Master) init(c *Config) {
 	apiserver.InstallSupport(m.muxHelper, m.rootWebService)
 	apiserver.AddApiWebService(m.handlerContainer, c.APIPrefix, apiVersions)
 
+	// Serve the kinds declared by third party resources, following changes to their declarations.
+	thirdPartyResources := newThirdPartyResourceServer(c.EtcdHelper, thirdPartyResourceStorage, m.admissionControl, m.requestContextMapper)
+	m.muxHelper.Handle(api.ThirdPartyAPIPrefix+"/", thirdPartyResources)
+	go util.Forever(func() {
+		if err := thirdPartyResources.sync(); err != nil {
+			glog.Errorf("Unable to sync third party resources: %v", err)
+		}
+	}, thirdPartyResourceSyncPeriod)
+
 	// Register root handler.
 	// We do not register this using restful Webservice since we do not want to surface this in api docs.
 	// Allow master to be embedded in contexts which already have something registered at the root
@@ -475,7 +540,12 @@ func (m *Master) init(c *Config) {
 
 	m.InsecureHandler = handler
 
-	attributeGetter := apiserver.NewRequestAttributeGetter(m.requestContextMapper, latest.RESTMapper, "api")
+	resolver := &apiserver.APIRequestInfoResolver{
+		APIPrefixes:      util.NewStringSet("api"),
+		APIGroupPrefixes: util.NewStringSet(strings.TrimPrefix(api.ThirdPartyAPIPrefix, "/")),
+		RestMapper:       latest.RESTMapper,
+	}
+	attributeGetter := apiserver.NewRequestAttributeGetter(m.requestContextMapper, resolver)
 	handler = apiserver.WithAuthorizationCheck(handler, attributeGetter, m.authorizer)
 
 	// Install Authenticator
@@ -487,6 +557,13 @@ func (m *Master) init(c *Config) {
 		handler = authenticatedHandler
 	}
 
+	// Audit outside the authenticator, so that requests that fail to authenticate
+	// are recorded too. The user is read once the request has been served.
+	if c.AuditWriter != nil {
+		handler = audit.WithAudit(handler, m.requestContextMapper, resolver, c.AuditPolicy, c.AuditWriter)
+		m.InsecureHandler = audit.WithAudit(m.InsecureHandler, m.requestContextMapper, resolver, c.AuditPolicy, c.AuditWriter)
+	}
+
 	// Install root web services
 	m.handlerContainer.Add(m.rootWebService)
 
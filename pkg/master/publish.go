@@ -109,11 +109,10 @@ func (m *Master) createMasterServiceIfNeeded(serviceName string, serviceIP net.I
 			Labels:    map[string]string{"provider": "kubernetes", "component": "apiserver"},
 		},
 		Spec: api.ServiceSpec{
-			Port: servicePort,
+			Ports: []api.ServicePort{{Port: servicePort, Protocol: api.ProtocolTCP}},
 			// maintained by this code, not by the pod selector
 			Selector:        nil,
 			PortalIP:        serviceIP.String(),
-			Protocol:        api.ProtocolTCP,
 			SessionAffinity: api.AffinityTypeNone,
 		},
 	}
@@ -128,29 +127,31 @@ func (m *Master) createMasterServiceIfNeeded(serviceName string, serviceIP net.I
 func (m *Master) ensureEndpointsContain(serviceName string, ip net.IP, port int) error {
 	ctx := api.NewDefaultContext()
 	e, err := m.endpointRegistry.GetEndpoints(ctx, serviceName)
-	if err != nil || e.Protocol != api.ProtocolTCP {
+	if err != nil || len(e.Ports) != 1 || e.Ports[0].Name != "" || e.Ports[0].Protocol != api.ProtocolTCP {
 		e = &api.Endpoints{
 			ObjectMeta: api.ObjectMeta{
 				Name:      serviceName,
 				Namespace: api.NamespaceDefault,
 			},
-			Protocol: api.ProtocolTCP,
+			Ports: []api.EndpointPort{{Protocol: api.ProtocolTCP}},
 		}
 	}
+	// The master services have a single, unnamed port.
+	p := &e.Ports[0]
 	found := false
-	for i := range e.Endpoints {
-		ep := &e.Endpoints[i]
+	for i := range p.Endpoints {
+		ep := &p.Endpoints[i]
 		if ep.IP == ip.String() && ep.Port == port {
 			found = true
 			break
 		}
 	}
 	if !found {
-		e.Endpoints = append(e.Endpoints, api.Endpoint{IP: ip.String(), Port: port})
-		if len(e.Endpoints) > m.masterCount {
+		p.Endpoints = append(p.Endpoints, api.Endpoint{IP: ip.String(), Port: port})
+		if len(p.Endpoints) > m.masterCount {
 			// We append to the end and remove from the beginning, so this should
 			// converge rapidly with all masters performing this operation.
-			e.Endpoints = e.Endpoints[len(e.Endpoints)-m.masterCount:]
+			p.Endpoints = p.Endpoints[len(p.Endpoints)-m.masterCount:]
 		}
 		return m.endpointRegistry.UpdateEndpoints(ctx, e)
 	}
@@ -58,13 +58,12 @@ func TestEnsureEndpointsContain(t *testing.T) {
 						ObjectMeta: api.ObjectMeta{
 							Name: "foo",
 						},
-						Endpoints: []api.Endpoint{
+						Ports: []api.EndpointPort{{Protocol: api.ProtocolTCP, Endpoints: []api.Endpoint{
 							{
 								IP:   "1.2.3.4",
 								Port: 8080,
 							},
-						},
-						Protocol: api.ProtocolTCP,
+						}}},
 					},
 				},
 			},
@@ -84,13 +83,12 @@ func TestEnsureEndpointsContain(t *testing.T) {
 							Name:      "foo",
 							Namespace: api.NamespaceDefault,
 						},
-						Endpoints: []api.Endpoint{
+						Ports: []api.EndpointPort{{Protocol: api.ProtocolTCP, Endpoints: []api.Endpoint{
 							{
 								IP:   "4.3.2.1",
 								Port: 8080,
 							},
-						},
-						Protocol: api.ProtocolTCP,
+						}}},
 					},
 				},
 			},
@@ -109,13 +107,12 @@ func TestEnsureEndpointsContain(t *testing.T) {
 							Name:      "foo",
 							Namespace: api.NamespaceDefault,
 						},
-						Endpoints: []api.Endpoint{
+						Ports: []api.EndpointPort{{Protocol: api.ProtocolTCP, Endpoints: []api.Endpoint{
 							{
 								IP:   "4.3.2.1",
 								Port: 9090,
 							},
-						},
-						Protocol: api.ProtocolTCP,
+						}}},
 					},
 				},
 			},
@@ -135,7 +132,7 @@ func TestEnsureEndpointsContain(t *testing.T) {
 							Name:      "foo",
 							Namespace: api.NamespaceDefault,
 						},
-						Endpoints: []api.Endpoint{
+						Ports: []api.EndpointPort{{Protocol: api.ProtocolTCP, Endpoints: []api.Endpoint{
 							{
 								IP:   "4.3.2.1",
 								Port: 9090,
@@ -144,8 +141,7 @@ func TestEnsureEndpointsContain(t *testing.T) {
 								IP:   "1.2.3.4",
 								Port: 8000,
 							},
-						},
-						Protocol: api.ProtocolTCP,
+						}}},
 					},
 				},
 			},
@@ -177,8 +173,7 @@ func TestEnsureEndpointsContain(t *testing.T) {
 					Name:      test.serviceName,
 					Namespace: "default",
 				},
-				Endpoints: test.expectedEndpoints,
-				Protocol:  "TCP",
+				Ports: []api.EndpointPort{{Protocol: "TCP", Endpoints: test.expectedEndpoints}},
 			}
 			if len(registry.Updates) != 1 {
 				t.Errorf("unexpected updates: %v", registry.Updates)
@@ -202,7 +197,7 @@ func TestEnsureEndpointsContainConverges(t *testing.T) {
 						Name:      "foo",
 						Namespace: api.NamespaceDefault,
 					},
-					Endpoints: []api.Endpoint{
+					Ports: []api.EndpointPort{{Protocol: api.ProtocolTCP, Endpoints: []api.Endpoint{
 						{
 							IP:   "4.3.2.1",
 							Port: 9000,
@@ -211,8 +206,7 @@ func TestEnsureEndpointsContainConverges(t *testing.T) {
 							IP:   "1.2.3.4",
 							Port: 8000,
 						},
-					},
-					Protocol: api.ProtocolTCP,
+					}}},
 				},
 			},
 		},
@@ -249,10 +243,10 @@ func TestEnsureEndpointsContainConverges(t *testing.T) {
 	}
 	// Pick up the last update and validate.
 	endpoints := registry.Updates[len(registry.Updates)-1]
-	if len(endpoints.Endpoints) != 2 {
-		t.Errorf("unexpected update: %v", endpoints)
+	if len(endpoints.Ports) != 1 || len(endpoints.Ports[0].Endpoints) != 2 {
+		t.Fatalf("unexpected update: %v", endpoints)
 	}
-	for _, endpoint := range endpoints.Endpoints {
+	for _, endpoint := range endpoints.Ports[0].Endpoints {
 		if endpoint.IP == "4.3.2.1" && endpoint.Port != 9090 {
 			t.Errorf("unexpected endpoint state: %v", endpoint)
 		}
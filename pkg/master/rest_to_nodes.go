@@ -23,6 +23,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/apiserver"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
 )
 
 // RESTStorageToNodes will take a RESTStorage object and return a client interface
@@ -91,3 +92,14 @@ func (n *nodeAdaptor) Delete(name string) error {
 func (n *nodeAdaptor) Update(minion *api.Node) (*api.Node, error) {
 	return nil, errors.New("direct update not implemented")
 }
+
+// Patch patches an existing node.
+func (n *nodeAdaptor) Patch(name string, pt api.PatchType, data []byte) (*api.Node, error) {
+	return nil, errors.New("direct patch not implemented")
+}
+
+// Watch watches for nodes matching the given selectors.
+func (n *nodeAdaptor) Watch(label, field labels.Selector, resourceVersion string) (watch.Interface, error) {
+	ctx := api.NewContext()
+	return n.storage.(apiserver.ResourceWatcher).Watch(ctx, label, field, resourceVersion)
+}
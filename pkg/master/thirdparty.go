@@ -0,0 +1,201 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/admission"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/meta"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/thirdparty"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/v1beta3"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/apiserver"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	tprdataetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/thirdpartyresourcedata/etcd"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+
+	"github.com/emicklei/go-restful"
+	"github.com/golang/glog"
+)
+
+// thirdPartyResourceSyncPeriod is how often the served kinds are synced with the
+// ThirdPartyResource objects.
+const thirdPartyResourceSyncPeriod = 10 * time.Second
+
+// thirdPartyResourceServer serves the kinds declared by ThirdPartyResource objects.
+// go-restful cannot remove a web service once it is added, so each kind in each
+// version is installed into a container of its own, and requests are dispatched
+// to the container by group, version and resource.
+type thirdPartyResourceServer struct {
+	helper  tools.EtcdHelper
+	lister  apiserver.RESTLister
+	admit   admission.Interface
+	context api.RequestContextMapper
+
+	// apis maps the key of each served resource (see thirdPartyResourceKey) to the
+	// container serving it. It is only replaced by sync.
+	lock sync.RWMutex
+	apis map[string]*restful.Container
+}
+
+// newThirdPartyResourceServer creates a server for the kinds declared by the
+// ThirdPartyResources in lister, whose objects are stored through helper.
+func newThirdPartyResourceServer(helper tools.EtcdHelper, lister apiserver.RESTLister, admit admission.Interface, context api.RequestContextMapper) *thirdPartyResourceServer {
+	return &thirdPartyResourceServer{
+		helper:  helper,
+		lister:  lister,
+		admit:   admit,
+		context: context,
+		apis:    map[string]*restful.Container{},
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (s *thirdPartyResourceServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	var container *restful.Container
+	if key, ok := thirdPartyResourcePathKey(req.URL.Path); ok {
+		s.lock.RLock()
+		container = s.apis[key]
+		s.lock.RUnlock()
+	}
+	if container == nil {
+		http.NotFound(w, req)
+		return
+	}
+	container.ServeHTTP(w, req)
+}
+
+// sync installs the kinds declared by the current ThirdPartyResources, and stops
+// serving the kinds and versions that are no longer declared. It must not be called
+// concurrently.
+func (s *thirdPartyResourceServer) sync() error {
+	obj, err := s.lister.List(api.NewContext(), labels.Everything(), labels.Everything())
+	if err != nil {
+		return err
+	}
+	apis := map[string]*restful.Container{}
+	for _, resource := range obj.(*api.ThirdPartyResourceList).Items {
+		kind, group, err := api.ThirdPartyResourceKindAndGroup(resource.Name)
+		if err != nil {
+			glog.Errorf("Unable to serve third party resource %q: %v", resource.Name, err)
+			continue
+		}
+		for _, version := range resource.Versions {
+			apiGroup, resourceName, err := s.apiGroupVersion(group, version.Name, kind)
+			if err != nil {
+				glog.Errorf("Unable to serve third party resource %q in version %q: %v", resource.Name, version.Name, err)
+				continue
+			}
+			key := thirdPartyResourceKey(group, version.Name, resourceName)
+			if container, ok := s.apis[key]; ok {
+				apis[key] = container
+				continue
+			}
+			container := restful.NewContainer()
+			container.Router(restful.CurlyRouter{})
+			container.RecoverHandler(logStackOnRecover)
+			if err := apiGroup.InstallREST(container); err != nil {
+				glog.Errorf("Unable to serve third party resource %q in version %q: %v", resource.Name, version.Name, err)
+				continue
+			}
+			glog.V(2).Infof("Serving third party resource %q at %s", resource.Name, path.Join(apiGroup.Root, apiGroup.Version, resourceName))
+			apis[key] = container
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.apis = apis
+	return nil
+}
+
+// apiGroupVersion returns the APIGroupVersion that serves the given third party kind
+// in the given group and version, and the resource name the kind is served under.
+func (s *thirdPartyResourceServer) apiGroupVersion(group, version, kind string) (*apiserver.APIGroupVersion, string, error) {
+	apiVersion := api.ThirdPartyAPIVersion(group, version)
+	codec := thirdparty.NewCodec(v1beta3.Codec, kind, apiVersion)
+	typer := thirdparty.NewObjectTyper(api.Scheme, kind, apiVersion)
+
+	mapper := meta.NewDefaultRESTMapper([]string{version}, func(string) (*meta.VersionInterfaces, bool) {
+		return &meta.VersionInterfaces{
+			Codec:            codec,
+			ObjectConvertor:  api.Scheme,
+			MetadataAccessor: meta.NewAccessor(),
+		}, true
+	})
+	mapper.Add(meta.RESTScopeNamespace, kind, version, false)
+	mapping, err := mapper.RESTMapping(kind, version)
+	if err != nil {
+		return nil, "", err
+	}
+
+	helper := s.helper
+	helper.Codec = codec
+	storage := tprdataetcd.NewREST(helper, group, mapping.Resource, typer)
+
+	return &apiserver.APIGroupVersion{
+		Storage: map[string]apiserver.RESTStorage{mapping.Resource: storage},
+
+		Root:    path.Join(api.ThirdPartyAPIPrefix, group),
+		Version: version,
+
+		Mapper: mapper,
+
+		Codec:   codec,
+		Typer:   typer,
+		Creater: thirdparty.NewObjectCreater(api.Scheme, kind),
+		Linker:  latest.SelfLinker,
+
+		Admit:   s.admit,
+		Context: s.context,
+	}, mapping.Resource, nil
+}
+
+// thirdPartyResourceKey identifies a third party resource served in a version of a group.
+func thirdPartyResourceKey(group, version, resource string) string {
+	return fmt.Sprintf("%s/%s/%s", group, version, resource)
+}
+
+// thirdPartyResourcePathKey returns the key of the third party resource a request path
+// addresses. The path has the form <ThirdPartyAPIPrefix>/<group>/<version>/[watch/][namespaces/<namespace>/]<resource>/...
+func thirdPartyResourcePathKey(requestPath string) (string, bool) {
+	if !strings.HasPrefix(requestPath, api.ThirdPartyAPIPrefix+"/") {
+		return "", false
+	}
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(requestPath, api.ThirdPartyAPIPrefix), "/"), "/")
+	if len(parts) < 3 {
+		return "", false
+	}
+	group, version, parts := parts[0], parts[1], parts[2:]
+	if parts[0] == "watch" {
+		parts = parts[1:]
+	}
+	if len(parts) >= 3 && parts[0] == "namespaces" {
+		parts = parts[2:]
+	}
+	if len(parts) == 0 || len(parts[0]) == 0 {
+		return "", false
+	}
+	return thirdPartyResourceKey(group, version, parts[0]), true
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	tpretcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/thirdpartyresource/etcd"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/admission/admit"
+
+	"github.com/coreos/go-etcd/etcd"
+)
+
+func TestThirdPartyResourcePathKey(t *testing.T) {
+	tests := map[string]string{
+		"/thirdparty/example.com/v1/databaseclusters":                          "example.com/v1/databaseclusters",
+		"/thirdparty/example.com/v1/databaseclusters/db":                       "example.com/v1/databaseclusters",
+		"/thirdparty/example.com/v1/namespaces/default/databaseclusters":       "example.com/v1/databaseclusters",
+		"/thirdparty/example.com/v1/namespaces/default/databaseclusters/db":    "example.com/v1/databaseclusters",
+		"/thirdparty/example.com/v1/watch/databaseclusters":                    "example.com/v1/databaseclusters",
+		"/thirdparty/example.com/v1/watch/namespaces/default/databaseclusters": "example.com/v1/databaseclusters",
+		"/thirdparty/example.com/v1":                                           "",
+		"/thirdparty/example.com/v1/":                                          "",
+		"/thirdparty/example.com":                                              "",
+		"/api/v1beta3/pods":                                                    "",
+	}
+	for path, expected := range tests {
+		key, ok := thirdPartyResourcePathKey(path)
+		if ok != (len(expected) > 0) || key != expected {
+			t.Errorf("%s: expected %q, got %q (%t)", path, expected, key, ok)
+		}
+	}
+}
+
+func setThirdPartyResources(fakeEtcdClient *tools.FakeEtcdClient, resources ...*api.ThirdPartyResource) {
+	nodes := []*etcd.Node{}
+	for _, resource := range resources {
+		nodes = append(nodes, &etcd.Node{Value: runtime.EncodeOrDie(latest.Codec, resource)})
+	}
+	fakeEtcdClient.Data["/registry/thirdpartyresources"] = tools.EtcdResponseWithError{
+		R: &etcd.Response{Node: &etcd.Node{Nodes: nodes}},
+	}
+}
+
+func TestThirdPartyResourceServer(t *testing.T) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.EtcdHelper{Client: fakeEtcdClient, Codec: latest.Codec, ResourceVersioner: tools.RuntimeVersionAdapter{Versioner: latest.ResourceVersioner}}
+	setThirdPartyResources(fakeEtcdClient, &api.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "database-cluster.example.com"},
+		Versions:   []api.APIVersion{{Name: "v1"}},
+	})
+
+	mapper := api.NewRequestContextMapper()
+	server := newThirdPartyResourceServer(helper, tpretcd.NewREST(helper), admit.NewAlwaysAdmit(), mapper)
+	if err := server.sync(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	handler, err := api.NewRequestContextFilter(mapper, server)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s := httptest.NewServer(handler)
+	defer s.Close()
+
+	body := []byte(`{"kind":"DatabaseCluster","apiVersion":"example.com/v1","metadata":{"name":"db"},"replicas":3}`)
+	resp, err := http.Post(s.URL+"/thirdparty/example.com/v1/namespaces/default/databaseclusters", "application/json", bytes.NewBuffer(body))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("unexpected status: %d", resp.StatusCode)
+	}
+
+	resp, err = http.Get(s.URL + "/thirdparty/example.com/v1/namespaces/default/databaseclusters/db")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected response: %d %v", resp.StatusCode, err)
+	}
+	object := struct {
+		Kind       string         `json:"kind"`
+		APIVersion string         `json:"apiVersion"`
+		Metadata   api.ObjectMeta `json:"metadata"`
+		Replicas   int            `json:"replicas"`
+	}{}
+	if err := json.Unmarshal(data, &object); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if object.Kind != "DatabaseCluster" || object.APIVersion != "example.com/v1" || object.Replicas != 3 {
+		t.Errorf("unexpected object: %s", string(data))
+	}
+	if object.Metadata.Name != "db" || object.Metadata.Namespace != api.NamespaceDefault || len(object.Metadata.UID) == 0 {
+		t.Errorf("unexpected metadata: %s", string(data))
+	}
+	if object.Metadata.SelfLink != "/thirdparty/example.com/v1/namespaces/default/databaseclusters/db" {
+		t.Errorf("unexpected self link: %s", object.Metadata.SelfLink)
+	}
+
+	resp, err = http.Get(s.URL + "/thirdparty/example.com/v2/namespaces/default/databaseclusters/db")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected an undeclared version not to be served, got %d", resp.StatusCode)
+	}
+
+	// removing the declaration stops serving the kind
+	setThirdPartyResources(fakeEtcdClient)
+	if err := server.sync(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp, err = http.Get(s.URL + "/thirdparty/example.com/v1/namespaces/default/databaseclusters/db")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected a removed kind not to be served, got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,423 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package namespace
+
+import (
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/golang/glog"
+)
+
+// NamespaceManager is responsible for performing actions dependent upon a namespace phase
+type NamespaceManager struct {
+	kubeClient client.Interface
+
+	// To allow injection of syncNamespace for testing.
+	syncHandler func(namespace api.Namespace) error
+}
+
+// NewNamespaceManager creates a new NamespaceManager
+func NewNamespaceManager(kubeClient client.Interface) *NamespaceManager {
+	nm := &NamespaceManager{
+		kubeClient: kubeClient,
+	}
+
+	// set the synchronization handler
+	nm.syncHandler = nm.syncNamespace
+	return nm
+}
+
+// Run begins syncing namespaces that are being terminated.
+func (nm *NamespaceManager) Run(period time.Duration) {
+	go util.Forever(func() { nm.synchronize() }, period)
+}
+
+func (nm *NamespaceManager) synchronize() {
+	list, err := nm.kubeClient.Namespaces().List(labels.Everything())
+	if err != nil {
+		glog.Errorf("Synchronization error: %v (%#v)", err, err)
+		return
+	}
+	for ix := range list.Items {
+		namespace := list.Items[ix]
+		if namespace.DeletionTimestamp == nil {
+			continue
+		}
+		glog.V(4).Infof("periodic sync of terminating namespace %v", namespace.Name)
+		if err := nm.syncHandler(namespace); err != nil {
+			glog.Errorf("Error synchronizing namespace %v: %v", namespace.Name, err)
+		}
+	}
+}
+
+// finalized returns true if the namespace has no finalizers left.
+func finalized(namespace api.Namespace) bool {
+	return len(namespace.Spec.Finalizers) == 0
+}
+
+// finalize removes the kubernetes finalizer from the namespace.
+func (nm *NamespaceManager) finalize(namespace api.Namespace) (*api.Namespace, error) {
+	namespaceFinalize := api.Namespace{
+		ObjectMeta: namespace.ObjectMeta,
+		Spec:       api.NamespaceSpec{},
+	}
+	finalizerSet := util.NewStringSet()
+	for i := range namespace.Spec.Finalizers {
+		if namespace.Spec.Finalizers[i] != api.FinalizerKubernetes {
+			finalizerSet.Insert(string(namespace.Spec.Finalizers[i]))
+		}
+	}
+	namespaceFinalize.Spec.Finalizers = make([]api.FinalizerName, 0, len(finalizerSet))
+	for _, value := range finalizerSet.List() {
+		namespaceFinalize.Spec.Finalizers = append(namespaceFinalize.Spec.Finalizers, api.FinalizerName(value))
+	}
+	return nm.kubeClient.Namespaces().Finalize(&namespaceFinalize)
+}
+
+// syncNamespace removes all content from a terminating namespace, releases
+// the kubernetes finalizer and deletes the namespace once nothing else holds it.
+func (nm *NamespaceManager) syncNamespace(namespace api.Namespace) error {
+	if namespace.DeletionTimestamp == nil {
+		return nil
+	}
+
+	// if the namespace is already finalized, delete it
+	if finalized(namespace) {
+		return ignoreNotFound(nm.kubeClient.Namespaces().Delete(namespace.Name))
+	}
+
+	// there may still be content for us to remove
+	done, err := nm.deleteAllContent(namespace.Name)
+	if err != nil {
+		return err
+	}
+	if !done {
+		glog.V(4).Infof("namespace %v still has content that is terminating", namespace.Name)
+		return nil
+	}
+
+	// we have removed content, so mark it finalized by us
+	result, err := nm.finalize(namespace)
+	if err != nil {
+		return err
+	}
+
+	// now check if all finalizers have reported that we delete now
+	if finalized(*result) {
+		return ignoreNotFound(nm.kubeClient.Namespaces().Delete(namespace.Name))
+	}
+	return nil
+}
+
+// deleteAllContent will delete all content known to the system in a namespace.
+// It returns false if some of that content is still being gracefully terminated.
+func (nm *NamespaceManager) deleteAllContent(namespace string) (bool, error) {
+	if err := nm.deleteServices(namespace); err != nil {
+		return false, err
+	}
+	if err := nm.deleteHorizontalPodAutoscalers(namespace); err != nil {
+		return false, err
+	}
+	if err := nm.deleteJobs(namespace); err != nil {
+		return false, err
+	}
+	if err := nm.deleteDaemonSets(namespace); err != nil {
+		return false, err
+	}
+	if err := nm.deleteDeployments(namespace); err != nil {
+		return false, err
+	}
+	if err := nm.deleteReplicationControllers(namespace); err != nil {
+		return false, err
+	}
+	if err := nm.deleteRoleBindings(namespace); err != nil {
+		return false, err
+	}
+	if err := nm.deleteRoles(namespace); err != nil {
+		return false, err
+	}
+	if err := nm.deleteServiceAccounts(namespace); err != nil {
+		return false, err
+	}
+	if err := nm.deleteSecrets(namespace); err != nil {
+		return false, err
+	}
+	if err := nm.deleteLimitRanges(namespace); err != nil {
+		return false, err
+	}
+	if err := nm.deleteResourceQuotas(namespace); err != nil {
+		return false, err
+	}
+	if err := nm.deletePersistentVolumeClaims(namespace); err != nil {
+		return false, err
+	}
+	if err := nm.deleteThirdPartyResourceData(namespace); err != nil {
+		return false, err
+	}
+	if err := nm.deleteEvents(namespace); err != nil {
+		return false, err
+	}
+	return nm.deletePods(namespace)
+}
+
+func (nm *NamespaceManager) deleteServices(ns string) error {
+	items, err := nm.kubeClient.Services(ns).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range items.Items {
+		if err := ignoreNotFound(nm.kubeClient.Services(ns).Delete(items.Items[i].Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (nm *NamespaceManager) deleteReplicationControllers(ns string) error {
+	items, err := nm.kubeClient.ReplicationControllers(ns).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range items.Items {
+		if err := ignoreNotFound(nm.kubeClient.ReplicationControllers(ns).Delete(items.Items[i].Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (nm *NamespaceManager) deleteServiceAccounts(ns string) error {
+	items, err := nm.kubeClient.ServiceAccounts(ns).List(labels.Everything(), labels.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range items.Items {
+		if err := ignoreNotFound(nm.kubeClient.ServiceAccounts(ns).Delete(items.Items[i].Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (nm *NamespaceManager) deleteSecrets(ns string) error {
+	items, err := nm.kubeClient.Secrets(ns).List(labels.Everything(), labels.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range items.Items {
+		if err := ignoreNotFound(nm.kubeClient.Secrets(ns).Delete(items.Items[i].Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (nm *NamespaceManager) deleteLimitRanges(ns string) error {
+	items, err := nm.kubeClient.LimitRanges(ns).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range items.Items {
+		if err := ignoreNotFound(nm.kubeClient.LimitRanges(ns).Delete(items.Items[i].Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (nm *NamespaceManager) deleteResourceQuotas(ns string) error {
+	items, err := nm.kubeClient.ResourceQuotas(ns).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range items.Items {
+		if err := ignoreNotFound(nm.kubeClient.ResourceQuotas(ns).Delete(items.Items[i].Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (nm *NamespaceManager) deletePersistentVolumeClaims(ns string) error {
+	items, err := nm.kubeClient.PersistentVolumeClaims(ns).List(labels.Everything(), labels.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range items.Items {
+		if err := ignoreNotFound(nm.kubeClient.PersistentVolumeClaims(ns).Delete(items.Items[i].Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (nm *NamespaceManager) deleteJobs(ns string) error {
+	items, err := nm.kubeClient.Jobs(ns).List(labels.Everything(), labels.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range items.Items {
+		if err := ignoreNotFound(nm.kubeClient.Jobs(ns).Delete(items.Items[i].Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (nm *NamespaceManager) deleteDaemonSets(ns string) error {
+	items, err := nm.kubeClient.DaemonSets(ns).List(labels.Everything(), labels.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range items.Items {
+		if err := ignoreNotFound(nm.kubeClient.DaemonSets(ns).Delete(items.Items[i].Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (nm *NamespaceManager) deleteDeployments(ns string) error {
+	items, err := nm.kubeClient.Deployments(ns).List(labels.Everything(), labels.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range items.Items {
+		if err := ignoreNotFound(nm.kubeClient.Deployments(ns).Delete(items.Items[i].Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (nm *NamespaceManager) deleteHorizontalPodAutoscalers(ns string) error {
+	items, err := nm.kubeClient.HorizontalPodAutoscalers(ns).List(labels.Everything(), labels.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range items.Items {
+		if err := ignoreNotFound(nm.kubeClient.HorizontalPodAutoscalers(ns).Delete(items.Items[i].Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteThirdPartyResourceData deletes the objects of every third party kind in the namespace.
+// The objects of a kind are shared by all of its versions, so they are listed in the first one.
+func (nm *NamespaceManager) deleteThirdPartyResourceData(ns string) error {
+	resources, err := nm.kubeClient.ThirdPartyResources().List(labels.Everything(), labels.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range resources.Items {
+		resource := &resources.Items[i]
+		kind, group, err := api.ThirdPartyResourceKindAndGroup(resource.Name)
+		if err != nil || len(resource.Versions) == 0 {
+			continue
+		}
+		data := nm.kubeClient.ThirdPartyResourceData(group, resource.Versions[0].Name, kind, ns)
+		items, err := data.List(labels.Everything(), labels.Everything())
+		if err != nil {
+			// the kind is not served yet, so it has no objects
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		for j := range items.Items {
+			if err := ignoreNotFound(data.Delete(items.Items[j].Name)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (nm *NamespaceManager) deleteRoleBindings(ns string) error {
+	items, err := nm.kubeClient.RoleBindings(ns).List(labels.Everything(), labels.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range items.Items {
+		if err := ignoreNotFound(nm.kubeClient.RoleBindings(ns).Delete(items.Items[i].Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (nm *NamespaceManager) deleteRoles(ns string) error {
+	items, err := nm.kubeClient.Roles(ns).List(labels.Everything(), labels.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range items.Items {
+		if err := ignoreNotFound(nm.kubeClient.Roles(ns).Delete(items.Items[i].Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (nm *NamespaceManager) deleteEvents(ns string) error {
+	items, err := nm.kubeClient.Events(ns).List(labels.Everything(), labels.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range items.Items {
+		if err := ignoreNotFound(nm.kubeClient.Events(ns).Delete(items.Items[i].Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deletePods requests deletion of every pod in the namespace. Pods that have
+// been scheduled are terminated gracefully, so it returns false while any of
+// them are still present.
+func (nm *NamespaceManager) deletePods(ns string) (bool, error) {
+	items, err := nm.kubeClient.Pods(ns).List(labels.Everything())
+	if err != nil {
+		return false, err
+	}
+	remaining := 0
+	for i := range items.Items {
+		pod := &items.Items[i]
+		if pod.DeletionTimestamp == nil {
+			if err := ignoreNotFound(nm.kubeClient.Pods(ns).Delete(pod.Name, nil)); err != nil {
+				return false, err
+			}
+		}
+		if len(pod.Status.Host) > 0 {
+			remaining++
+		}
+	}
+	return remaining == 0, nil
+}
+
+func ignoreNotFound(err error) error {
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
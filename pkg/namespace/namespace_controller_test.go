@@ -0,0 +1,225 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package namespace
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+func TestFinalized(t *testing.T) {
+	testNamespace := api.Namespace{
+		Spec: api.NamespaceSpec{
+			Finalizers: []api.FinalizerName{"a", "b"},
+		},
+	}
+	if finalized(testNamespace) {
+		t.Errorf("Unexpected result, namespace is not finalized")
+	}
+	testNamespace.Spec.Finalizers = []api.FinalizerName{}
+	if !finalized(testNamespace) {
+		t.Errorf("Expected object to be finalized")
+	}
+}
+
+func TestFinalize(t *testing.T) {
+	mockClient := &client.Fake{}
+	nm := NewNamespaceManager(mockClient)
+	testNamespace := api.Namespace{
+		ObjectMeta: api.ObjectMeta{
+			Name:            "test",
+			ResourceVersion: "1",
+		},
+		Spec: api.NamespaceSpec{
+			Finalizers: []api.FinalizerName{"kubernetes", "other"},
+		},
+	}
+	nm.finalize(testNamespace)
+	if len(mockClient.Actions) != 1 {
+		t.Errorf("Expected 1 mock client action, but got %v", len(mockClient.Actions))
+	}
+	if mockClient.Actions[0].Action != "finalize-namespace" {
+		t.Errorf("Expected finalize-namespace action %v", mockClient.Actions[0].Action)
+	}
+}
+
+func TestSyncNamespaceThatIsTerminating(t *testing.T) {
+	mockClient := &client.Fake{}
+	nm := NewNamespaceManager(mockClient)
+	now := util.Now()
+	testNamespace := api.Namespace{
+		ObjectMeta: api.ObjectMeta{
+			Name:              "test",
+			ResourceVersion:   "1",
+			DeletionTimestamp: &now,
+		},
+		Spec: api.NamespaceSpec{
+			Finalizers: []api.FinalizerName{"kubernetes"},
+		},
+		Status: api.NamespaceStatus{
+			Phase: api.NamespaceTerminating,
+		},
+	}
+	err := nm.syncNamespace(testNamespace)
+	if err != nil {
+		t.Errorf("Unexpected error when synching namespace %v", err)
+	}
+	expectedActionSet := util.NewStringSet(
+		"list-services",
+		"list-pods",
+		"list-resourceQuotas",
+		"list-controllers",
+		"list-serviceaccounts",
+		"list-secrets",
+		"list-limitRanges",
+		"list-events",
+		"list-roles",
+		"list-rolebindings",
+		"list-horizontalpodautoscalers",
+		"list-deployments",
+		"list-daemonsets",
+		"list-jobs",
+		"list-persistentvolumeclaims",
+		"list-thirdpartyresources",
+		"finalize-namespace",
+		"delete-namespace")
+	actionSet := util.NewStringSet()
+	for i := range mockClient.Actions {
+		actionSet.Insert(mockClient.Actions[i].Action)
+	}
+	if !actionSet.HasAll(expectedActionSet.List()...) {
+		t.Errorf("Expected actions: %v, but got: %v", expectedActionSet, actionSet)
+	}
+}
+
+func TestSyncNamespaceDeletesThirdPartyResourceData(t *testing.T) {
+	mockClient := &client.Fake{
+		ThirdPartyResourceList: api.ThirdPartyResourceList{
+			Items: []api.ThirdPartyResource{
+				{
+					ObjectMeta: api.ObjectMeta{Name: "database-cluster.example.com"},
+					Versions:   []api.APIVersion{{Name: "v1"}, {Name: "v2"}},
+				},
+			},
+		},
+		ThirdPartyResourceDataList: api.ThirdPartyResourceDataList{
+			Items: []api.ThirdPartyResourceData{
+				{ObjectMeta: api.ObjectMeta{Name: "db", Namespace: "test"}},
+			},
+		},
+	}
+	nm := NewNamespaceManager(mockClient)
+	now := util.Now()
+	testNamespace := api.Namespace{
+		ObjectMeta: api.ObjectMeta{
+			Name:              "test",
+			ResourceVersion:   "1",
+			DeletionTimestamp: &now,
+		},
+		Spec: api.NamespaceSpec{
+			Finalizers: []api.FinalizerName{"kubernetes"},
+		},
+	}
+	if err := nm.syncNamespace(testNamespace); err != nil {
+		t.Errorf("Unexpected error when synching namespace %v", err)
+	}
+	listed, deleted := []interface{}{}, []interface{}{}
+	for _, action := range mockClient.Actions {
+		switch action.Action {
+		case "list-thirdpartyresourcedata":
+			listed = append(listed, action.Value)
+		case "delete-thirdpartyresourcedata":
+			deleted = append(deleted, action.Value)
+		}
+	}
+	if len(listed) != 1 || listed[0] != "example.com/v1/DatabaseCluster" {
+		t.Errorf("Expected the objects to be listed once in the first version, got %v", listed)
+	}
+	if len(deleted) != 1 || deleted[0] != "db" {
+		t.Errorf("Expected the object to be deleted, got %v", deleted)
+	}
+}
+
+func TestSyncNamespaceWaitsForScheduledPods(t *testing.T) {
+	mockClient := &client.Fake{
+		PodsList: api.PodList{
+			Items: []api.Pod{
+				{
+					ObjectMeta: api.ObjectMeta{Name: "scheduled", Namespace: "test"},
+					Status:     api.PodStatus{Host: "machine"},
+				},
+				{
+					ObjectMeta: api.ObjectMeta{Name: "pending", Namespace: "test"},
+				},
+			},
+		},
+	}
+	nm := NewNamespaceManager(mockClient)
+	now := util.Now()
+	testNamespace := api.Namespace{
+		ObjectMeta: api.ObjectMeta{
+			Name:              "test",
+			ResourceVersion:   "1",
+			DeletionTimestamp: &now,
+		},
+		Spec: api.NamespaceSpec{
+			Finalizers: []api.FinalizerName{"kubernetes"},
+		},
+	}
+	if err := nm.syncNamespace(testNamespace); err != nil {
+		t.Errorf("Unexpected error when synching namespace %v", err)
+	}
+	deletedPods := util.NewStringSet()
+	for _, action := range mockClient.Actions {
+		switch action.Action {
+		case "delete-pod":
+			deletedPods.Insert(action.Value.(string))
+		case "finalize-namespace", "delete-namespace":
+			t.Errorf("Unexpected action %v while pods are terminating", action.Action)
+		}
+	}
+	if !deletedPods.HasAll("scheduled", "pending") {
+		t.Errorf("Expected all pods to be deleted, got %v", deletedPods)
+	}
+}
+
+func TestSyncNamespaceThatIsActive(t *testing.T) {
+	mockClient := &client.Fake{}
+	nm := NewNamespaceManager(mockClient)
+	testNamespace := api.Namespace{
+		ObjectMeta: api.ObjectMeta{
+			Name:            "test",
+			ResourceVersion: "1",
+		},
+		Spec: api.NamespaceSpec{
+			Finalizers: []api.FinalizerName{"kubernetes"},
+		},
+		Status: api.NamespaceStatus{
+			Phase: api.NamespaceActive,
+		},
+	}
+	err := nm.syncNamespace(testNamespace)
+	if err != nil {
+		t.Errorf("Unexpected error when synching namespace %v", err)
+	}
+	if len(mockClient.Actions) != 0 {
+		t.Errorf("Expected no action from controller, but got: %v", mockClient.Actions)
+	}
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podautoscaler
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/golang/glog"
+
+	cadvisorApi "github.com/google/cadvisor/info/v1"
+)
+
+const (
+	// tolerance is how far, as a fraction of the target, the CPU utilization
+	// may stray from the target before the autoscaler acts.
+	tolerance = 0.1
+
+	// upscaleForbiddenWindow is how long after a resize the autoscaler waits
+	// before adding pods, to let the usage settle.
+	upscaleForbiddenWindow = 3 * time.Minute
+
+	// downscaleForbiddenWindow is how long after a resize the autoscaler
+	// waits before removing pods, to avoid thrashing on short dips in usage.
+	downscaleForbiddenWindow = 5 * time.Minute
+)
+
+// HorizontalController is responsible for resizing the replication controllers
+// referenced by horizontal pod autoscalers to keep the CPU usage of their pods
+// near the target of the autoscaler.
+type HorizontalController struct {
+	kubeClient client.Interface
+	infoGetter client.ContainerInfoGetter
+
+	// To allow injection of reconcileAutoscaler for testing.
+	syncHandler func(autoscaler *api.HorizontalPodAutoscaler) error
+}
+
+// NewHorizontalController creates a new HorizontalController. CPU usage is read
+// from the kubelets through infoGetter.
+func NewHorizontalController(kubeClient client.Interface, infoGetter client.ContainerInfoGetter) *HorizontalController {
+	a := &HorizontalController{
+		kubeClient: kubeClient,
+		infoGetter: infoGetter,
+	}
+	a.syncHandler = a.reconcileAutoscaler
+	return a
+}
+
+// Run begins syncing autoscalers periodically.
+func (a *HorizontalController) Run(period time.Duration) {
+	go util.Forever(func() { a.synchronize() }, period)
+}
+
+func (a *HorizontalController) synchronize() {
+	list, err := a.kubeClient.HorizontalPodAutoscalers(api.NamespaceAll).List(labels.Everything(), labels.Everything())
+	if err != nil {
+		util.HandleError(fmt.Errorf("synchronization error: %v", err))
+		return
+	}
+	wg := sync.WaitGroup{}
+	wg.Add(len(list.Items))
+	for ix := range list.Items {
+		go func(autoscaler *api.HorizontalPodAutoscaler) {
+			defer wg.Done()
+			glog.V(4).Infof("periodic sync of autoscaler %v/%v", autoscaler.Namespace, autoscaler.Name)
+			if err := a.syncHandler(autoscaler); err != nil {
+				util.HandleError(fmt.Errorf("error synchronizing autoscaler %v: %v", autoscaler.Name, err))
+			}
+		}(&list.Items[ix])
+	}
+	wg.Wait()
+}
+
+// podCPUUsage returns the CPU usage of a pod and the sum of the CPU requests of
+// its containers, both in millicores. The usage is the rate between the last
+// two samples the kubelet has for each container.
+func (a *HorizontalController) podCPUUsage(pod *api.Pod) (usage, request int64, err error) {
+	host := pod.Status.HostIP
+	if len(host) == 0 {
+		host = pod.Status.Host
+	}
+	podID := fmt.Sprintf("%s/%s/%s", pod.Namespace, pod.Name, pod.UID)
+	for _, container := range pod.Spec.Containers {
+		cpu, ok := container.Resources.Requests[api.ResourceCPU]
+		if !ok || cpu.MilliValue() == 0 {
+			return 0, 0, fmt.Errorf("container %s of pod %s has no CPU request", container.Name, pod.Name)
+		}
+		request += cpu.MilliValue()
+
+		info, err := a.infoGetter.GetContainerInfo(host, podID, container.Name, &cadvisorApi.ContainerInfoRequest{NumStats: 2})
+		if err != nil {
+			return 0, 0, err
+		}
+		if len(info.Stats) < 2 {
+			return 0, 0, fmt.Errorf("not enough samples for container %s of pod %s", container.Name, pod.Name)
+		}
+		prev, last := info.Stats[len(info.Stats)-2], info.Stats[len(info.Stats)-1]
+		elapsed := last.Timestamp.Sub(prev.Timestamp)
+		if elapsed <= 0 || last.Cpu.Usage.Total < prev.Cpu.Usage.Total {
+			// The container restarted or the samples are out of order.
+			return 0, 0, fmt.Errorf("unusable samples for container %s of pod %s", container.Name, pod.Name)
+		}
+		usage += int64(last.Cpu.Usage.Total-prev.Cpu.Usage.Total) * 1000 / elapsed.Nanoseconds()
+	}
+	return usage, request, nil
+}
+
+// desiredReplicas returns the number of pods needed to bring the utilization
+// to the target of the autoscaler, within its limits.
+func desiredReplicas(spec *api.HorizontalPodAutoscalerSpec, currentReplicas, utilization int) int {
+	desired := currentReplicas
+	ratio := float64(utilization) / float64(spec.TargetCPUUtilizationPercentage)
+	if math.Abs(1.0-ratio) > tolerance {
+		desired = int(math.Ceil(ratio * float64(currentReplicas)))
+	}
+	if desired < spec.MinReplicas {
+		desired = spec.MinReplicas
+	}
+	if desired > spec.MaxReplicas {
+		desired = spec.MaxReplicas
+	}
+	return desired
+}
+
+// shouldResize reports whether the autoscaler may go from current to desired
+// pods now, given when it last resized.
+func shouldResize(status *api.HorizontalPodAutoscalerStatus, current, desired int, now time.Time) bool {
+	if desired == current {
+		return false
+	}
+	if status.LastScaleTime == nil {
+		return true
+	}
+	if desired > current {
+		return !status.LastScaleTime.Add(upscaleForbiddenWindow).After(now)
+	}
+	return !status.LastScaleTime.Add(downscaleForbiddenWindow).After(now)
+}
+
+func (a *HorizontalController) reconcileAutoscaler(autoscaler *api.HorizontalPodAutoscaler) error {
+	namespace := autoscaler.Namespace
+	rc, err := a.kubeClient.ReplicationControllers(namespace).Get(autoscaler.Spec.ScaleRef.Name)
+	if err != nil {
+		return err
+	}
+	currentReplicas := rc.Spec.Replicas
+	podList, err := a.kubeClient.Pods(namespace).List(labels.Set(rc.Spec.Selector).AsSelector())
+	if err != nil {
+		return err
+	}
+
+	var usage, request int64
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.Status.Phase != api.PodRunning {
+			continue
+		}
+		podUsage, podRequest, err := a.podCPUUsage(pod)
+		if err != nil {
+			// Pods without usage do not count towards the utilization.
+			glog.V(2).Infof("Unable to get CPU usage of pod %s/%s: %v", namespace, pod.Name, err)
+			continue
+		}
+		usage += podUsage
+		request += podRequest
+	}
+
+	status := api.HorizontalPodAutoscalerStatus{
+		CurrentReplicas: currentReplicas,
+		DesiredReplicas: currentReplicas,
+		LastScaleTime:   autoscaler.Status.LastScaleTime,
+	}
+	// A replication controller resized to zero is left alone, as is one whose
+	// pods report no usage at all.
+	if currentReplicas > 0 && request > 0 {
+		utilization := int(usage * 100 / request)
+		status.CurrentCPUUtilizationPercentage = &utilization
+		status.DesiredReplicas = desiredReplicas(&autoscaler.Spec, currentReplicas, utilization)
+	}
+
+	now := util.Now()
+	if shouldResize(&autoscaler.Status, currentReplicas, status.DesiredReplicas, now.Time) {
+		resizer, err := kubectl.ResizerFor("ReplicationController", a.kubeClient)
+		if err != nil {
+			return err
+		}
+		precondition := &kubectl.ResizePrecondition{Size: currentReplicas, ResourceVersion: ""}
+		if _, err := resizer.Resize(namespace, rc.Name, precondition, uint(status.DesiredReplicas)); err != nil {
+			return err
+		}
+		glog.Infof("Resized replication controller %s/%s from %d to %d pods for autoscaler %s", namespace, rc.Name, currentReplicas, status.DesiredReplicas, autoscaler.Name)
+		status.LastScaleTime = &now
+	}
+
+	if api.Semantic.DeepEqual(status, autoscaler.Status) {
+		return nil
+	}
+	autoscaler.Status = status
+	_, err = a.kubeClient.HorizontalPodAutoscalers(namespace).Update(autoscaler)
+	return err
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podautoscaler
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+
+	cadvisorApi "github.com/google/cadvisor/info/v1"
+)
+
+// fakeContainerInfoGetter reports a fixed CPU usage, in millicores, for every
+// container of the pods it knows about.
+type fakeContainerInfoGetter struct {
+	usage map[string]uint64
+}
+
+func (f *fakeContainerInfoGetter) GetContainerInfo(host, podID, containerID string, req *cadvisorApi.ContainerInfoRequest) (*cadvisorApi.ContainerInfo, error) {
+	usage, ok := f.usage[podID]
+	if !ok {
+		return nil, fmt.Errorf("no stats for %s", podID)
+	}
+	start := time.Date(2015, time.May, 1, 10, 0, 0, 0, time.UTC)
+	first, second := &cadvisorApi.ContainerStats{Timestamp: start}, &cadvisorApi.ContainerStats{Timestamp: start.Add(10 * time.Second)}
+	first.Cpu.Usage.Total = 5000000000
+	// Usage in millicores over ten seconds, in nanoseconds of CPU time.
+	second.Cpu.Usage.Total = first.Cpu.Usage.Total + usage*10*1000000
+	return &cadvisorApi.ContainerInfo{Stats: []*cadvisorApi.ContainerStats{first, second}}, nil
+}
+
+func (f *fakeContainerInfoGetter) GetRootInfo(host string, req *cadvisorApi.ContainerInfoRequest) (*cadvisorApi.ContainerInfo, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (f *fakeContainerInfoGetter) GetMachineInfo(host string) (*cadvisorApi.MachineInfo, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func newAutoscaler(min, max, target int) *api.HorizontalPodAutoscaler {
+	return &api.HorizontalPodAutoscaler{
+		ObjectMeta: api.ObjectMeta{Name: "frontend", Namespace: api.NamespaceDefault, ResourceVersion: "1"},
+		Spec: api.HorizontalPodAutoscalerSpec{
+			ScaleRef:                       api.ObjectReference{Kind: "ReplicationController", Name: "frontend"},
+			MinReplicas:                    min,
+			MaxReplicas:                    max,
+			TargetCPUUtilizationPercentage: target,
+		},
+	}
+}
+
+// newFakeClient returns a client that knows a replication controller with one
+// running pod per entry of usage, each with a CPU request of 1000 millicores.
+// Negative usages are not reported by the fake kubelet.
+func newFakeClient(usage []int) (*client.Fake, *fakeContainerInfoGetter) {
+	selector := map[string]string{"app": "frontend"}
+	fakeClient := &client.Fake{
+		Ctrl: api.ReplicationController{
+			ObjectMeta: api.ObjectMeta{Name: "frontend", Namespace: api.NamespaceDefault},
+			Spec:       api.ReplicationControllerSpec{Replicas: len(usage), Selector: selector},
+		},
+	}
+	infoGetter := &fakeContainerInfoGetter{usage: map[string]uint64{}}
+	for i, u := range usage {
+		pod := api.Pod{
+			ObjectMeta: api.ObjectMeta{Name: fmt.Sprintf("frontend-%d", i), Namespace: api.NamespaceDefault, UID: util.NewUUID()},
+			Spec: api.PodSpec{
+				Containers: []api.Container{{
+					Name: "frontend",
+					Resources: api.ResourceRequirements{
+						Requests: api.ResourceList{api.ResourceCPU: resource.MustParse("1")},
+					},
+				}},
+			},
+			Status: api.PodStatus{Phase: api.PodRunning, Host: "node"},
+		}
+		fakeClient.PodsList.Items = append(fakeClient.PodsList.Items, pod)
+		if u >= 0 {
+			infoGetter.usage[fmt.Sprintf("%s/%s/%s", pod.Namespace, pod.Name, pod.UID)] = uint64(u)
+		}
+	}
+	return fakeClient, infoGetter
+}
+
+// resizedTo returns the size the controller resized the replication
+// controller to, or -1.
+func resizedTo(fakeClient *client.Fake) int {
+	for _, action := range fakeClient.Actions {
+		if action.Action == "update-controller" {
+			return action.Value.(*api.ReplicationController).Spec.Replicas
+		}
+	}
+	return -1
+}
+
+// updatedAutoscaler returns the autoscaler the controller last wrote back, if any.
+func updatedAutoscaler(fakeClient *client.Fake) *api.HorizontalPodAutoscaler {
+	var autoscaler *api.HorizontalPodAutoscaler
+	for _, action := range fakeClient.Actions {
+		if action.Action == "update-horizontalpodautoscaler" {
+			autoscaler = action.Value.(*api.HorizontalPodAutoscaler)
+		}
+	}
+	return autoscaler
+}
+
+func TestReconcileAutoscaler(t *testing.T) {
+	recently := util.NewTime(time.Now().Add(-time.Minute))
+	longAgo := util.NewTime(time.Now().Add(-time.Hour))
+	tests := map[string]struct {
+		usage             []int
+		min, max, target  int
+		lastScaleTime     *util.Time
+		expectResize      int
+		expectDesired     int
+		expectUtilization int
+	}{
+		"scale up": {
+			usage: []int{900, 900}, min: 1, max: 10, target: 50,
+			expectResize: 4, expectDesired: 4, expectUtilization: 90,
+		},
+		"within tolerance": {
+			usage: []int{520, 520}, min: 1, max: 10, target: 50,
+			expectResize: -1, expectDesired: 2, expectUtilization: 52,
+		},
+		"scale down": {
+			usage: []int{100, 100, 100}, min: 1, max: 10, target: 50, lastScaleTime: &longAgo,
+			expectResize: 1, expectDesired: 1, expectUtilization: 10,
+		},
+		"scale down too soon": {
+			usage: []int{100, 100, 100}, min: 1, max: 10, target: 50, lastScaleTime: &recently,
+			expectResize: -1, expectDesired: 1, expectUtilization: 10,
+		},
+		"scale up too soon": {
+			usage: []int{900, 900}, min: 1, max: 10, target: 50, lastScaleTime: &recently,
+			expectResize: -1, expectDesired: 4, expectUtilization: 90,
+		},
+		"capped at max": {
+			usage: []int{1000, 1000}, min: 1, max: 3, target: 10,
+			expectResize: 3, expectDesired: 3, expectUtilization: 100,
+		},
+		"raised to min": {
+			usage: []int{500}, min: 2, max: 3, target: 50,
+			expectResize: 2, expectDesired: 2, expectUtilization: 50,
+		},
+		"pods without usage are ignored": {
+			usage: []int{800, -1}, min: 1, max: 10, target: 40,
+			expectResize: 4, expectDesired: 4, expectUtilization: 80,
+		},
+	}
+
+	for name, test := range tests {
+		fakeClient, infoGetter := newFakeClient(test.usage)
+		autoscaler := newAutoscaler(test.min, test.max, test.target)
+		autoscaler.Status.LastScaleTime = test.lastScaleTime
+		controller := NewHorizontalController(fakeClient, infoGetter)
+		if err := controller.reconcileAutoscaler(autoscaler); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if resized := resizedTo(fakeClient); resized != test.expectResize {
+			t.Errorf("%s: expected a resize to %d, got %d", name, test.expectResize, resized)
+		}
+		updated := updatedAutoscaler(fakeClient)
+		if updated == nil {
+			t.Errorf("%s: expected the autoscaler status to be updated", name)
+			continue
+		}
+		status := updated.Status
+		if status.CurrentReplicas != len(test.usage) || status.DesiredReplicas != test.expectDesired {
+			t.Errorf("%s: unexpected replica counts: %#v", name, status)
+		}
+		if status.CurrentCPUUtilizationPercentage == nil || *status.CurrentCPUUtilizationPercentage != test.expectUtilization {
+			t.Errorf("%s: expected utilization %d, got %v", name, test.expectUtilization, status.CurrentCPUUtilizationPercentage)
+		}
+		if resized := test.expectResize >= 0; resized != (status.LastScaleTime != test.lastScaleTime) {
+			t.Errorf("%s: expected the last scale time to change only on resize, got %v", name, status.LastScaleTime)
+		}
+	}
+}
+
+func TestReconcileAutoscalerWithoutUsage(t *testing.T) {
+	fakeClient, infoGetter := newFakeClient([]int{-1, -1})
+	controller := NewHorizontalController(fakeClient, infoGetter)
+	if err := controller.reconcileAutoscaler(newAutoscaler(1, 10, 50)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resized := resizedTo(fakeClient); resized != -1 {
+		t.Errorf("expected no resize without usage, got %d", resized)
+	}
+	updated := updatedAutoscaler(fakeClient)
+	if updated == nil || updated.Status.CurrentCPUUtilizationPercentage != nil || updated.Status.DesiredReplicas != 2 {
+		t.Errorf("unexpected status: %#v", updated)
+	}
+}
+
+func TestPodCPUUsageRequiresRequest(t *testing.T) {
+	fakeClient, infoGetter := newFakeClient([]int{500})
+	controller := NewHorizontalController(fakeClient, infoGetter)
+	pod := fakeClient.PodsList.Items[0]
+	if usage, request, err := controller.podCPUUsage(&pod); err != nil || usage != 500 || request != 1000 {
+		t.Errorf("expected 500 of 1000 millicores, got %d of %d: %v", usage, request, err)
+	}
+	// The limit plays no part in the utilization.
+	pod.Spec.Containers[0].Resources.Limits = api.ResourceList{api.ResourceCPU: resource.MustParse("2")}
+	if usage, request, err := controller.podCPUUsage(&pod); err != nil || usage != 500 || request != 1000 {
+		t.Errorf("expected 500 of 1000 millicores with a limit, got %d of %d: %v", usage, request, err)
+	}
+	pod.Spec.Containers[0].Resources.Requests = nil
+	if _, _, err := controller.podCPUUsage(&pod); err == nil {
+		t.Errorf("expected an error for a container without a CPU request")
+	}
+}
+
+func TestHorizontalControllerSynchronize(t *testing.T) {
+	fakeClient := &client.Fake{
+		HorizontalPodAutoscalerList: api.HorizontalPodAutoscalerList{Items: []api.HorizontalPodAutoscaler{*newAutoscaler(1, 2, 50), *newAutoscaler(1, 3, 50)}},
+	}
+	controller := NewHorizontalController(fakeClient, &fakeContainerInfoGetter{})
+	var lock sync.Mutex
+	synced := 0
+	controller.syncHandler = func(autoscaler *api.HorizontalPodAutoscaler) error {
+		lock.Lock()
+		defer lock.Unlock()
+		synced++
+		return nil
+	}
+	controller.synchronize()
+	if synced != 2 {
+		t.Errorf("expected 2 autoscalers to be synced, got %d", synced)
+	}
+}
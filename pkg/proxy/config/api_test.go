@@ -185,7 +185,7 @@ func TestServicesFromZeroError(t *testing.T) {
 func TestEndpoints(t *testing.T) {
 	endpoint := api.Endpoints{
 		ObjectMeta: api.ObjectMeta{Name: "bar", ResourceVersion: "2"},
-		Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: 9000}},
+		Ports:      []api.EndpointPort{{Endpoints: []api.Endpoint{{IP: "127.0.0.1", Port: 9000}}}},
 	}
 
 	fakeWatch := watch.NewFake()
@@ -235,7 +235,7 @@ func TestEndpoints(t *testing.T) {
 func TestEndpointsFromZero(t *testing.T) {
 	endpoint := api.Endpoints{
 		ObjectMeta: api.ObjectMeta{Name: "bar", ResourceVersion: "2"},
-		Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: 9000}},
+		Ports:      []api.EndpointPort{{Endpoints: []api.Endpoint{{IP: "127.0.0.1", Port: 9000}}}},
 	}
 
 	fakeWatch := watch.NewFake()
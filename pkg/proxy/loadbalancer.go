@@ -17,16 +17,28 @@ limitations under the License.
 package proxy
 
 import (
+	"fmt"
 	"net"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 )
 
+// ServicePortName carries a service name and one of its port names.  This is
+// the unique identifier for a load-balanced service port.
+type ServicePortName struct {
+	Name string
+	Port string
+}
+
+func (spn ServicePortName) String() string {
+	return fmt.Sprintf("%s:%s", spn.Name, spn.Port)
+}
+
 // LoadBalancer is an interface for distributing incoming requests to service endpoints.
 type LoadBalancer interface {
 	// NextEndpoint returns the endpoint to handle a request for the given
 	// service and source address.
-	NextEndpoint(service string, srcAddr net.Addr) (string, error)
-	NewService(service string, sessionAffinityType api.AffinityType, stickyMaxAgeMinutes int) error
-	CleanupStaleStickySessions(service string)
+	NextEndpoint(service ServicePortName, srcAddr net.Addr) (string, error)
+	NewService(service ServicePortName, sessionAffinityType api.AffinityType, stickyMaxAgeMinutes int) error
+	CleanupStaleStickySessions(service ServicePortName)
 }
@@ -193,18 +193,19 @@ func waitForNumProxyLoops(t *testing.T, p *Proxier, want int32) {
 }
 
 func TestTCPProxy(t *testing.T) {
+	service := ServicePortName{Name: "echo", Port: "p"}
 	lb := NewLoadBalancerRR()
 	lb.OnUpdate([]api.Endpoints{
 		{
 			ObjectMeta: api.ObjectMeta{Name: "echo"},
-			Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: tcpServerPort}},
+			Ports:      []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{{IP: "127.0.0.1", Port: tcpServerPort}}}},
 		},
 	})
 
 	p := NewProxier(lb, net.ParseIP("0.0.0.0"), &fakeIptables{})
 	waitForNumProxyLoops(t, p, 0)
 
-	svcInfo, err := p.addServiceOnPort("echo", "TCP", 0, time.Second)
+	svcInfo, err := p.addServiceOnPort(service, "TCP", 0, time.Second)
 	if err != nil {
 		t.Fatalf("error adding new service: %#v", err)
 	}
@@ -213,18 +214,19 @@ func TestTCPProxy(t *testing.T) {
 }
 
 func TestUDPProxy(t *testing.T) {
+	service := ServicePortName{Name: "echo", Port: "p"}
 	lb := NewLoadBalancerRR()
 	lb.OnUpdate([]api.Endpoints{
 		{
 			ObjectMeta: api.ObjectMeta{Name: "echo"},
-			Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: udpServerPort}},
+			Ports:      []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{{IP: "127.0.0.1", Port: udpServerPort}}}},
 		},
 	})
 
 	p := NewProxier(lb, net.ParseIP("0.0.0.0"), &fakeIptables{})
 	waitForNumProxyLoops(t, p, 0)
 
-	svcInfo, err := p.addServiceOnPort("echo", "UDP", 0, time.Second)
+	svcInfo, err := p.addServiceOnPort(service, "UDP", 0, time.Second)
 	if err != nil {
 		t.Fatalf("error adding new service: %#v", err)
 	}
@@ -233,7 +235,7 @@ func TestUDPProxy(t *testing.T) {
 }
 
 // Helper: Stops the proxy for the named service.
-func stopProxyByName(proxier *Proxier, service string) error {
+func stopProxyByName(proxier *Proxier, service ServicePortName) error {
 	info, found := proxier.getServiceInfo(service)
 	if !found {
 		return fmt.Errorf("unknown service: %s", service)
@@ -242,18 +244,19 @@ func stopProxyByName(proxier *Proxier, service string) error {
 }
 
 func TestTCPProxyStop(t *testing.T) {
+	service := ServicePortName{Name: "echo", Port: "p"}
 	lb := NewLoadBalancerRR()
 	lb.OnUpdate([]api.Endpoints{
 		{
 			ObjectMeta: api.ObjectMeta{Name: "echo"},
-			Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: tcpServerPort}},
+			Ports:      []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{{IP: "127.0.0.1", Port: tcpServerPort}}}},
 		},
 	})
 
 	p := NewProxier(lb, net.ParseIP("0.0.0.0"), &fakeIptables{})
 	waitForNumProxyLoops(t, p, 0)
 
-	svcInfo, err := p.addServiceOnPort("echo", "TCP", 0, time.Second)
+	svcInfo, err := p.addServiceOnPort(service, "TCP", 0, time.Second)
 	if err != nil {
 		t.Fatalf("error adding new service: %#v", err)
 	}
@@ -264,7 +267,7 @@ func TestTCPProxyStop(t *testing.T) {
 	conn.Close()
 	waitForNumProxyLoops(t, p, 1)
 
-	stopProxyByName(p, "echo")
+	stopProxyByName(p, service)
 	// Wait for the port to really close.
 	if err := waitForClosedPortTCP(p, svcInfo.proxyPort); err != nil {
 		t.Fatalf(err.Error())
@@ -273,18 +276,19 @@ func TestTCPProxyStop(t *testing.T) {
 }
 
 func TestUDPProxyStop(t *testing.T) {
+	service := ServicePortName{Name: "echo", Port: "p"}
 	lb := NewLoadBalancerRR()
 	lb.OnUpdate([]api.Endpoints{
 		{
 			ObjectMeta: api.ObjectMeta{Name: "echo"},
-			Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: udpServerPort}},
+			Ports:      []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{{IP: "127.0.0.1", Port: udpServerPort}}}},
 		},
 	})
 
 	p := NewProxier(lb, net.ParseIP("0.0.0.0"), &fakeIptables{})
 	waitForNumProxyLoops(t, p, 0)
 
-	svcInfo, err := p.addServiceOnPort("echo", "UDP", 0, time.Second)
+	svcInfo, err := p.addServiceOnPort(service, "UDP", 0, time.Second)
 	if err != nil {
 		t.Fatalf("error adding new service: %#v", err)
 	}
@@ -295,7 +299,7 @@ func TestUDPProxyStop(t *testing.T) {
 	conn.Close()
 	waitForNumProxyLoops(t, p, 1)
 
-	stopProxyByName(p, "echo")
+	stopProxyByName(p, service)
 	// Wait for the port to really close.
 	if err := waitForClosedPortUDP(p, svcInfo.proxyPort); err != nil {
 		t.Fatalf(err.Error())
@@ -304,18 +308,19 @@ func TestUDPProxyStop(t *testing.T) {
 }
 
 func TestTCPProxyUpdateDelete(t *testing.T) {
+	service := ServicePortName{Name: "echo", Port: "p"}
 	lb := NewLoadBalancerRR()
 	lb.OnUpdate([]api.Endpoints{
 		{
 			ObjectMeta: api.ObjectMeta{Name: "echo"},
-			Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: tcpServerPort}},
+			Ports:      []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{{IP: "127.0.0.1", Port: tcpServerPort}}}},
 		},
 	})
 
 	p := NewProxier(lb, net.ParseIP("0.0.0.0"), &fakeIptables{})
 	waitForNumProxyLoops(t, p, 0)
 
-	svcInfo, err := p.addServiceOnPort("echo", "TCP", 0, time.Second)
+	svcInfo, err := p.addServiceOnPort(service, "TCP", 0, time.Second)
 	if err != nil {
 		t.Fatalf("error adding new service: %#v", err)
 	}
@@ -334,18 +339,19 @@ func TestTCPProxyUpdateDelete(t *testing.T) {
 }
 
 func TestUDPProxyUpdateDelete(t *testing.T) {
+	service := ServicePortName{Name: "echo", Port: "p"}
 	lb := NewLoadBalancerRR()
 	lb.OnUpdate([]api.Endpoints{
 		{
 			ObjectMeta: api.ObjectMeta{Name: "echo"},
-			Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: udpServerPort}},
+			Ports:      []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{{IP: "127.0.0.1", Port: udpServerPort}}}},
 		},
 	})
 
 	p := NewProxier(lb, net.ParseIP("0.0.0.0"), &fakeIptables{})
 	waitForNumProxyLoops(t, p, 0)
 
-	svcInfo, err := p.addServiceOnPort("echo", "UDP", 0, time.Second)
+	svcInfo, err := p.addServiceOnPort(service, "UDP", 0, time.Second)
 	if err != nil {
 		t.Fatalf("error adding new service: %#v", err)
 	}
@@ -364,18 +370,19 @@ func TestUDPProxyUpdateDelete(t *testing.T) {
 }
 
 func TestTCPProxyUpdateDeleteUpdate(t *testing.T) {
+	service := ServicePortName{Name: "echo", Port: "p"}
 	lb := NewLoadBalancerRR()
 	lb.OnUpdate([]api.Endpoints{
 		{
 			ObjectMeta: api.ObjectMeta{Name: "echo"},
-			Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: tcpServerPort}},
+			Ports:      []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{{IP: "127.0.0.1", Port: tcpServerPort}}}},
 		},
 	})
 
 	p := NewProxier(lb, net.ParseIP("0.0.0.0"), &fakeIptables{})
 	waitForNumProxyLoops(t, p, 0)
 
-	svcInfo, err := p.addServiceOnPort("echo", "TCP", 0, time.Second)
+	svcInfo, err := p.addServiceOnPort(service, "TCP", 0, time.Second)
 	if err != nil {
 		t.Fatalf("error adding new service: %#v", err)
 	}
@@ -392,9 +399,9 @@ func TestTCPProxyUpdateDeleteUpdate(t *testing.T) {
 	}
 	waitForNumProxyLoops(t, p, 0)
 	p.OnUpdate([]api.Service{
-		{ObjectMeta: api.ObjectMeta{Name: "echo"}, Spec: api.ServiceSpec{Port: svcInfo.proxyPort, Protocol: "TCP"}, Status: api.ServiceStatus{}},
+		{ObjectMeta: api.ObjectMeta{Name: "echo"}, Spec: api.ServiceSpec{Ports: []api.ServicePort{{Name: "p", Port: svcInfo.proxyPort, Protocol: "TCP"}}}, Status: api.ServiceStatus{}},
 	})
-	svcInfo, exists := p.getServiceInfo("echo")
+	svcInfo, exists := p.getServiceInfo(service)
 	if !exists {
 		t.Fatalf("can't find serviceInfo")
 	}
@@ -403,18 +410,19 @@ func TestTCPProxyUpdateDeleteUpdate(t *testing.T) {
 }
 
 func TestUDPProxyUpdateDeleteUpdate(t *testing.T) {
+	service := ServicePortName{Name: "echo", Port: "p"}
 	lb := NewLoadBalancerRR()
 	lb.OnUpdate([]api.Endpoints{
 		{
 			ObjectMeta: api.ObjectMeta{Name: "echo"},
-			Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: udpServerPort}},
+			Ports:      []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{{IP: "127.0.0.1", Port: udpServerPort}}}},
 		},
 	})
 
 	p := NewProxier(lb, net.ParseIP("0.0.0.0"), &fakeIptables{})
 	waitForNumProxyLoops(t, p, 0)
 
-	svcInfo, err := p.addServiceOnPort("echo", "UDP", 0, time.Second)
+	svcInfo, err := p.addServiceOnPort(service, "UDP", 0, time.Second)
 	if err != nil {
 		t.Fatalf("error adding new service: %#v", err)
 	}
@@ -431,9 +439,9 @@ func TestUDPProxyUpdateDeleteUpdate(t *testing.T) {
 	}
 	waitForNumProxyLoops(t, p, 0)
 	p.OnUpdate([]api.Service{
-		{ObjectMeta: api.ObjectMeta{Name: "echo"}, Spec: api.ServiceSpec{Port: svcInfo.proxyPort, Protocol: "UDP"}, Status: api.ServiceStatus{}},
+		{ObjectMeta: api.ObjectMeta{Name: "echo"}, Spec: api.ServiceSpec{Ports: []api.ServicePort{{Name: "p", Port: svcInfo.proxyPort, Protocol: "UDP"}}}, Status: api.ServiceStatus{}},
 	})
-	svcInfo, exists := p.getServiceInfo("echo")
+	svcInfo, exists := p.getServiceInfo(service)
 	if !exists {
 		t.Fatalf("can't find serviceInfo")
 	}
@@ -442,18 +450,19 @@ func TestUDPProxyUpdateDeleteUpdate(t *testing.T) {
 }
 
 func TestTCPProxyUpdatePort(t *testing.T) {
+	service := ServicePortName{Name: "echo", Port: "p"}
 	lb := NewLoadBalancerRR()
 	lb.OnUpdate([]api.Endpoints{
 		{
 			ObjectMeta: api.ObjectMeta{Name: "echo"},
-			Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: tcpServerPort}},
+			Ports:      []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{{IP: "127.0.0.1", Port: tcpServerPort}}}},
 		},
 	})
 
 	p := NewProxier(lb, net.ParseIP("0.0.0.0"), &fakeIptables{})
 	waitForNumProxyLoops(t, p, 0)
 
-	svcInfo, err := p.addServiceOnPort("echo", "TCP", 0, time.Second)
+	svcInfo, err := p.addServiceOnPort(service, "TCP", 0, time.Second)
 	if err != nil {
 		t.Fatalf("error adding new service: %#v", err)
 	}
@@ -461,13 +470,13 @@ func TestTCPProxyUpdatePort(t *testing.T) {
 	waitForNumProxyLoops(t, p, 1)
 
 	p.OnUpdate([]api.Service{
-		{ObjectMeta: api.ObjectMeta{Name: "echo"}, Spec: api.ServiceSpec{Port: 99, Protocol: "TCP"}, Status: api.ServiceStatus{}},
+		{ObjectMeta: api.ObjectMeta{Name: "echo"}, Spec: api.ServiceSpec{Ports: []api.ServicePort{{Name: "p", Port: 99, Protocol: "TCP"}}}, Status: api.ServiceStatus{}},
 	})
 	// Wait for the socket to actually get free.
 	if err := waitForClosedPortTCP(p, svcInfo.proxyPort); err != nil {
 		t.Fatalf(err.Error())
 	}
-	svcInfo, exists := p.getServiceInfo("echo")
+	svcInfo, exists := p.getServiceInfo(service)
 	if !exists {
 		t.Fatalf("can't find serviceInfo")
 	}
@@ -478,31 +487,32 @@ func TestTCPProxyUpdatePort(t *testing.T) {
 }
 
 func TestUDPProxyUpdatePort(t *testing.T) {
+	service := ServicePortName{Name: "echo", Port: "p"}
 	lb := NewLoadBalancerRR()
 	lb.OnUpdate([]api.Endpoints{
 		{
 			ObjectMeta: api.ObjectMeta{Name: "echo"},
-			Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: udpServerPort}},
+			Ports:      []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{{IP: "127.0.0.1", Port: udpServerPort}}}},
 		},
 	})
 
 	p := NewProxier(lb, net.ParseIP("0.0.0.0"), &fakeIptables{})
 	waitForNumProxyLoops(t, p, 0)
 
-	svcInfo, err := p.addServiceOnPort("echo", "UDP", 0, time.Second)
+	svcInfo, err := p.addServiceOnPort(service, "UDP", 0, time.Second)
 	if err != nil {
 		t.Fatalf("error adding new service: %#v", err)
 	}
 	waitForNumProxyLoops(t, p, 1)
 
 	p.OnUpdate([]api.Service{
-		{ObjectMeta: api.ObjectMeta{Name: "echo"}, Spec: api.ServiceSpec{Port: 99, Protocol: "UDP"}, Status: api.ServiceStatus{}},
+		{ObjectMeta: api.ObjectMeta{Name: "echo"}, Spec: api.ServiceSpec{Ports: []api.ServicePort{{Name: "p", Port: 99, Protocol: "UDP"}}}, Status: api.ServiceStatus{}},
 	})
 	// Wait for the socket to actually get free.
 	if err := waitForClosedPortUDP(p, svcInfo.proxyPort); err != nil {
 		t.Fatalf(err.Error())
 	}
-	svcInfo, exists := p.getServiceInfo("echo")
+	svcInfo, exists := p.getServiceInfo(service)
 	if !exists {
 		t.Fatalf("can't find serviceInfo")
 	}
@@ -510,4 +520,55 @@ func TestUDPProxyUpdatePort(t *testing.T) {
 	waitForNumProxyLoops(t, p, 1)
 }
 
+func TestProxyUpdateMultiplePorts(t *testing.T) {
+	serviceP := ServicePortName{Name: "echo", Port: "p"}
+	serviceQ := ServicePortName{Name: "echo", Port: "q"}
+	lb := NewLoadBalancerRR()
+	lb.OnUpdate([]api.Endpoints{
+		{
+			ObjectMeta: api.ObjectMeta{Name: "echo"},
+			Ports: []api.EndpointPort{
+				{Name: "p", Endpoints: []api.Endpoint{{IP: "127.0.0.1", Port: tcpServerPort}}},
+				{Name: "q", Endpoints: []api.Endpoint{{IP: "127.0.0.1", Port: udpServerPort}}},
+			},
+		},
+	})
+
+	p := NewProxier(lb, net.ParseIP("0.0.0.0"), &fakeIptables{})
+	waitForNumProxyLoops(t, p, 0)
+
+	p.OnUpdate([]api.Service{
+		{ObjectMeta: api.ObjectMeta{Name: "echo"}, Spec: api.ServiceSpec{Ports: []api.ServicePort{
+			{Name: "p", Port: 98, Protocol: "TCP"},
+			{Name: "q", Port: 99, Protocol: "UDP"},
+		}}, Status: api.ServiceStatus{}},
+	})
+	svcInfoP, exists := p.getServiceInfo(serviceP)
+	if !exists {
+		t.Fatalf("can't find serviceInfo for %s", serviceP)
+	}
+	svcInfoQ, exists := p.getServiceInfo(serviceQ)
+	if !exists {
+		t.Fatalf("can't find serviceInfo for %s", serviceQ)
+	}
+	testEchoTCP(t, "127.0.0.1", svcInfoP.proxyPort)
+	testEchoUDP(t, "127.0.0.1", svcInfoQ.proxyPort)
+	waitForNumProxyLoops(t, p, 2)
+
+	// Drop port q; port p must keep working.
+	p.OnUpdate([]api.Service{
+		{ObjectMeta: api.ObjectMeta{Name: "echo"}, Spec: api.ServiceSpec{Ports: []api.ServicePort{
+			{Name: "p", Port: 98, Protocol: "TCP"},
+		}}, Status: api.ServiceStatus{}},
+	})
+	if err := waitForClosedPortUDP(p, svcInfoQ.proxyPort); err != nil {
+		t.Fatalf("%v", err)
+	}
+	if _, exists := p.getServiceInfo(serviceQ); exists {
+		t.Fatalf("expected serviceInfo for %s to be removed", serviceQ)
+	}
+	testEchoTCP(t, "127.0.0.1", svcInfoP.proxyPort)
+	waitForNumProxyLoops(t, p, 1)
+}
+
 // TODO: Test UDP timeouts.
@@ -63,10 +63,11 @@ func TestFilterWorks(t *testing.T) {
 }
 
 func TestLoadBalanceFailsWithNoEndpoints(t *testing.T) {
+	fooService := ServicePortName{Name: "foo", Port: "p"}
 	loadBalancer := NewLoadBalancerRR()
 	var endpoints []api.Endpoints
 	loadBalancer.OnUpdate(endpoints)
-	endpoint, err := loadBalancer.NextEndpoint("foo", nil)
+	endpoint, err := loadBalancer.NextEndpoint(fooService, nil)
 	if err == nil {
 		t.Errorf("Didn't fail with non-existent service")
 	}
@@ -75,7 +76,7 @@ func TestLoadBalanceFailsWithNoEndpoints(t *testing.T) {
 	}
 }
 
-func expectEndpoint(t *testing.T, loadBalancer *LoadBalancerRR, service string, expected string, netaddr net.Addr) {
+func expectEndpoint(t *testing.T, loadBalancer *LoadBalancerRR, service ServicePortName, expected string, netaddr net.Addr) {
 	endpoint, err := loadBalancer.NextEndpoint(service, netaddr)
 	if err != nil {
 		t.Errorf("Didn't find a service for %s, expected %s, failed with: %v", service, expected, err)
@@ -86,229 +87,274 @@ func expectEndpoint(t *testing.T, loadBalancer *LoadBalancerRR, service string,
 }
 
 func TestLoadBalanceWorksWithSingleEndpoint(t *testing.T) {
+	fooService := ServicePortName{Name: "foo", Port: "p"}
 	loadBalancer := NewLoadBalancerRR()
-	endpoint, err := loadBalancer.NextEndpoint("foo", nil)
+	endpoint, err := loadBalancer.NextEndpoint(fooService, nil)
 	if err == nil || len(endpoint) != 0 {
 		t.Errorf("Didn't fail with non-existent service")
 	}
 	endpoints := make([]api.Endpoints, 1)
 	endpoints[0] = api.Endpoints{
-		ObjectMeta: api.ObjectMeta{Name: "foo"},
-		Endpoints:  []api.Endpoint{{IP: "endpoint1", Port: 40}},
+		ObjectMeta: api.ObjectMeta{Name: fooService.Name},
+		Ports:      []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{{IP: "endpoint1", Port: 40}}}},
 	}
 	loadBalancer.OnUpdate(endpoints)
-	expectEndpoint(t, loadBalancer, "foo", "endpoint1:40", nil)
-	expectEndpoint(t, loadBalancer, "foo", "endpoint1:40", nil)
-	expectEndpoint(t, loadBalancer, "foo", "endpoint1:40", nil)
-	expectEndpoint(t, loadBalancer, "foo", "endpoint1:40", nil)
+	expectEndpoint(t, loadBalancer, fooService, "endpoint1:40", nil)
+	expectEndpoint(t, loadBalancer, fooService, "endpoint1:40", nil)
+	expectEndpoint(t, loadBalancer, fooService, "endpoint1:40", nil)
+	expectEndpoint(t, loadBalancer, fooService, "endpoint1:40", nil)
 }
 
 func TestLoadBalanceWorksWithMultipleEndpoints(t *testing.T) {
+	fooService := ServicePortName{Name: "foo", Port: "p"}
 	loadBalancer := NewLoadBalancerRR()
-	endpoint, err := loadBalancer.NextEndpoint("foo", nil)
+	endpoint, err := loadBalancer.NextEndpoint(fooService, nil)
 	if err == nil || len(endpoint) != 0 {
 		t.Errorf("Didn't fail with non-existent service")
 	}
 	endpoints := make([]api.Endpoints, 1)
 	endpoints[0] = api.Endpoints{
-		ObjectMeta: api.ObjectMeta{Name: "foo"},
-		Endpoints: []api.Endpoint{
+		ObjectMeta: api.ObjectMeta{Name: fooService.Name},
+		Ports: []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{
 			{IP: "endpoint", Port: 1},
 			{IP: "endpoint", Port: 2},
 			{IP: "endpoint", Port: 3},
+		}}},
+	}
+	loadBalancer.OnUpdate(endpoints)
+	shuffledEndpoints := loadBalancer.services[fooService].endpoints
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[0], nil)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[1], nil)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[2], nil)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[0], nil)
+}
+
+func TestLoadBalanceWorksWithMultipleEndpointsMultiplePorts(t *testing.T) {
+	loadBalancer := NewLoadBalancerRR()
+	serviceP := ServicePortName{Name: "foo", Port: "p"}
+	serviceQ := ServicePortName{Name: "foo", Port: "q"}
+	endpoint, err := loadBalancer.NextEndpoint(serviceP, nil)
+	if err == nil || len(endpoint) != 0 {
+		t.Errorf("Didn't fail with non-existent service")
+	}
+	endpoints := make([]api.Endpoints, 1)
+	endpoints[0] = api.Endpoints{
+		ObjectMeta: api.ObjectMeta{Name: serviceP.Name},
+		Ports: []api.EndpointPort{
+			{Name: "p", Endpoints: []api.Endpoint{{IP: "endpoint1", Port: 1}, {IP: "endpoint2", Port: 2}}},
+			{Name: "q", Endpoints: []api.Endpoint{{IP: "endpoint1", Port: 10}}},
 		},
 	}
 	loadBalancer.OnUpdate(endpoints)
-	shuffledEndpoints := loadBalancer.services["foo"].endpoints
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[0], nil)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[1], nil)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[2], nil)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[0], nil)
+
+	shuffledEndpoints := loadBalancer.services[serviceP].endpoints
+	expectEndpoint(t, loadBalancer, serviceP, shuffledEndpoints[0], nil)
+	expectEndpoint(t, loadBalancer, serviceP, shuffledEndpoints[1], nil)
+	expectEndpoint(t, loadBalancer, serviceP, shuffledEndpoints[0], nil)
+
+	expectEndpoint(t, loadBalancer, serviceQ, "endpoint1:10", nil)
+	expectEndpoint(t, loadBalancer, serviceQ, "endpoint1:10", nil)
+
+	// Then update the configuration by removing port q.
+	endpoints[0].Ports = endpoints[0].Ports[:1]
+	loadBalancer.OnUpdate(endpoints)
+	endpoint, err = loadBalancer.NextEndpoint(serviceQ, nil)
+	if err == nil || len(endpoint) != 0 {
+		t.Errorf("Didn't fail with removed service port")
+	}
+	expectEndpoint(t, loadBalancer, serviceP, shuffledEndpoints[1], nil)
 }
 
 func TestLoadBalanceWorksWithMultipleEndpointsAndUpdates(t *testing.T) {
+	fooService := ServicePortName{Name: "foo", Port: "p"}
 	loadBalancer := NewLoadBalancerRR()
-	endpoint, err := loadBalancer.NextEndpoint("foo", nil)
+	endpoint, err := loadBalancer.NextEndpoint(fooService, nil)
 	if err == nil || len(endpoint) != 0 {
 		t.Errorf("Didn't fail with non-existent service")
 	}
 	endpoints := make([]api.Endpoints, 1)
 	endpoints[0] = api.Endpoints{
-		ObjectMeta: api.ObjectMeta{Name: "foo"},
-		Endpoints: []api.Endpoint{
+		ObjectMeta: api.ObjectMeta{Name: fooService.Name},
+		Ports: []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{
 			{IP: "endpoint", Port: 1},
 			{IP: "endpoint", Port: 2},
 			{IP: "endpoint", Port: 3},
-		},
+		}}},
 	}
 	loadBalancer.OnUpdate(endpoints)
-	shuffledEndpoints := loadBalancer.services["foo"].endpoints
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[0], nil)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[1], nil)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[2], nil)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[0], nil)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[1], nil)
+	shuffledEndpoints := loadBalancer.services[fooService].endpoints
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[0], nil)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[1], nil)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[2], nil)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[0], nil)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[1], nil)
 	// Then update the configuration with one fewer endpoints, make sure
 	// we start in the beginning again
-	endpoints[0] = api.Endpoints{ObjectMeta: api.ObjectMeta{Name: "foo"},
-		Endpoints: []api.Endpoint{
+	endpoints[0] = api.Endpoints{ObjectMeta: api.ObjectMeta{Name: fooService.Name},
+		Ports: []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{
 			{IP: "endpoint", Port: 8},
 			{IP: "endpoint", Port: 9},
-		},
+		}}},
 	}
 	loadBalancer.OnUpdate(endpoints)
-	shuffledEndpoints = loadBalancer.services["foo"].endpoints
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[0], nil)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[1], nil)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[0], nil)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[1], nil)
+	shuffledEndpoints = loadBalancer.services[fooService].endpoints
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[0], nil)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[1], nil)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[0], nil)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[1], nil)
 	// Clear endpoints
-	endpoints[0] = api.Endpoints{ObjectMeta: api.ObjectMeta{Name: "foo"}, Endpoints: []api.Endpoint{}}
+	endpoints[0] = api.Endpoints{ObjectMeta: api.ObjectMeta{Name: fooService.Name}, Ports: []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{}}}}
 	loadBalancer.OnUpdate(endpoints)
 
-	endpoint, err = loadBalancer.NextEndpoint("foo", nil)
+	endpoint, err = loadBalancer.NextEndpoint(fooService, nil)
 	if err == nil || len(endpoint) != 0 {
 		t.Errorf("Didn't fail with non-existent service")
 	}
 }
 
 func TestLoadBalanceWorksWithServiceRemoval(t *testing.T) {
+	fooService := ServicePortName{Name: "foo", Port: "p"}
+	barService := ServicePortName{Name: "bar", Port: "p"}
 	loadBalancer := NewLoadBalancerRR()
-	endpoint, err := loadBalancer.NextEndpoint("foo", nil)
+	endpoint, err := loadBalancer.NextEndpoint(fooService, nil)
 	if err == nil || len(endpoint) != 0 {
 		t.Errorf("Didn't fail with non-existent service")
 	}
 	endpoints := make([]api.Endpoints, 2)
 	endpoints[0] = api.Endpoints{
-		ObjectMeta: api.ObjectMeta{Name: "foo"},
-		Endpoints: []api.Endpoint{
+		ObjectMeta: api.ObjectMeta{Name: fooService.Name},
+		Ports: []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{
 			{IP: "endpoint", Port: 1},
 			{IP: "endpoint", Port: 2},
 			{IP: "endpoint", Port: 3},
-		},
+		}}},
 	}
 	endpoints[1] = api.Endpoints{
-		ObjectMeta: api.ObjectMeta{Name: "bar"},
-		Endpoints: []api.Endpoint{
+		ObjectMeta: api.ObjectMeta{Name: barService.Name},
+		Ports: []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{
 			{IP: "endpoint", Port: 4},
 			{IP: "endpoint", Port: 5},
-		},
+		}}},
 	}
 	loadBalancer.OnUpdate(endpoints)
-	shuffledFooEndpoints := loadBalancer.services["foo"].endpoints
-	expectEndpoint(t, loadBalancer, "foo", shuffledFooEndpoints[0], nil)
-	expectEndpoint(t, loadBalancer, "foo", shuffledFooEndpoints[1], nil)
-	expectEndpoint(t, loadBalancer, "foo", shuffledFooEndpoints[2], nil)
-	expectEndpoint(t, loadBalancer, "foo", shuffledFooEndpoints[0], nil)
-	expectEndpoint(t, loadBalancer, "foo", shuffledFooEndpoints[1], nil)
-
-	shuffledBarEndpoints := loadBalancer.services["bar"].endpoints
-	expectEndpoint(t, loadBalancer, "bar", shuffledBarEndpoints[0], nil)
-	expectEndpoint(t, loadBalancer, "bar", shuffledBarEndpoints[1], nil)
-	expectEndpoint(t, loadBalancer, "bar", shuffledBarEndpoints[0], nil)
-	expectEndpoint(t, loadBalancer, "bar", shuffledBarEndpoints[1], nil)
-	expectEndpoint(t, loadBalancer, "bar", shuffledBarEndpoints[0], nil)
+	shuffledFooEndpoints := loadBalancer.services[fooService].endpoints
+	expectEndpoint(t, loadBalancer, fooService, shuffledFooEndpoints[0], nil)
+	expectEndpoint(t, loadBalancer, fooService, shuffledFooEndpoints[1], nil)
+	expectEndpoint(t, loadBalancer, fooService, shuffledFooEndpoints[2], nil)
+	expectEndpoint(t, loadBalancer, fooService, shuffledFooEndpoints[0], nil)
+	expectEndpoint(t, loadBalancer, fooService, shuffledFooEndpoints[1], nil)
+
+	shuffledBarEndpoints := loadBalancer.services[barService].endpoints
+	expectEndpoint(t, loadBalancer, barService, shuffledBarEndpoints[0], nil)
+	expectEndpoint(t, loadBalancer, barService, shuffledBarEndpoints[1], nil)
+	expectEndpoint(t, loadBalancer, barService, shuffledBarEndpoints[0], nil)
+	expectEndpoint(t, loadBalancer, barService, shuffledBarEndpoints[1], nil)
+	expectEndpoint(t, loadBalancer, barService, shuffledBarEndpoints[0], nil)
 
 	// Then update the configuration by removing foo
 	loadBalancer.OnUpdate(endpoints[1:])
-	endpoint, err = loadBalancer.NextEndpoint("foo", nil)
+	endpoint, err = loadBalancer.NextEndpoint(fooService, nil)
 	if err == nil || len(endpoint) != 0 {
 		t.Errorf("Didn't fail with non-existent service")
 	}
 
 	// but bar is still there, and we continue RR from where we left off.
-	expectEndpoint(t, loadBalancer, "bar", shuffledBarEndpoints[1], nil)
-	expectEndpoint(t, loadBalancer, "bar", shuffledBarEndpoints[0], nil)
-	expectEndpoint(t, loadBalancer, "bar", shuffledBarEndpoints[1], nil)
-	expectEndpoint(t, loadBalancer, "bar", shuffledBarEndpoints[0], nil)
+	expectEndpoint(t, loadBalancer, barService, shuffledBarEndpoints[1], nil)
+	expectEndpoint(t, loadBalancer, barService, shuffledBarEndpoints[0], nil)
+	expectEndpoint(t, loadBalancer, barService, shuffledBarEndpoints[1], nil)
+	expectEndpoint(t, loadBalancer, barService, shuffledBarEndpoints[0], nil)
 }
 
 func TestStickyLoadBalanceWorksWithSingleEndpoint(t *testing.T) {
+	fooService := ServicePortName{Name: "foo", Port: "p"}
 	client1 := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 0}
 	client2 := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 2), Port: 0}
 	loadBalancer := NewLoadBalancerRR()
-	endpoint, err := loadBalancer.NextEndpoint("foo", nil)
+	endpoint, err := loadBalancer.NextEndpoint(fooService, nil)
 	if err == nil || len(endpoint) != 0 {
 		t.Errorf("Didn't fail with non-existent service")
 	}
-	loadBalancer.NewService("foo", api.AffinityTypeClientIP, 0)
+	loadBalancer.NewService(fooService, api.AffinityTypeClientIP, 0)
 	endpoints := make([]api.Endpoints, 1)
 	endpoints[0] = api.Endpoints{
-		ObjectMeta: api.ObjectMeta{Name: "foo"},
-		Endpoints:  []api.Endpoint{{IP: "endpoint", Port: 1}},
+		ObjectMeta: api.ObjectMeta{Name: fooService.Name},
+		Ports:      []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{{IP: "endpoint", Port: 1}}}},
 	}
 	loadBalancer.OnUpdate(endpoints)
-	expectEndpoint(t, loadBalancer, "foo", "endpoint:1", client1)
-	expectEndpoint(t, loadBalancer, "foo", "endpoint:1", client1)
-	expectEndpoint(t, loadBalancer, "foo", "endpoint:1", client2)
-	expectEndpoint(t, loadBalancer, "foo", "endpoint:1", client2)
+	expectEndpoint(t, loadBalancer, fooService, "endpoint:1", client1)
+	expectEndpoint(t, loadBalancer, fooService, "endpoint:1", client1)
+	expectEndpoint(t, loadBalancer, fooService, "endpoint:1", client2)
+	expectEndpoint(t, loadBalancer, fooService, "endpoint:1", client2)
 }
 
 func TestStickyLoadBalanaceWorksWithMultipleEndpoints(t *testing.T) {
+	fooService := ServicePortName{Name: "foo", Port: "p"}
 	client1 := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 0}
 	client2 := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 2), Port: 0}
 	client3 := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 3), Port: 0}
 	loadBalancer := NewLoadBalancerRR()
-	endpoint, err := loadBalancer.NextEndpoint("foo", nil)
+	endpoint, err := loadBalancer.NextEndpoint(fooService, nil)
 	if err == nil || len(endpoint) != 0 {
 		t.Errorf("Didn't fail with non-existent service")
 	}
 
-	loadBalancer.NewService("foo", api.AffinityTypeClientIP, 0)
+	loadBalancer.NewService(fooService, api.AffinityTypeClientIP, 0)
 	endpoints := make([]api.Endpoints, 1)
 	endpoints[0] = api.Endpoints{
-		ObjectMeta: api.ObjectMeta{Name: "foo"},
-		Endpoints: []api.Endpoint{
+		ObjectMeta: api.ObjectMeta{Name: fooService.Name},
+		Ports: []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{
 			{IP: "endpoint", Port: 1},
 			{IP: "endpoint", Port: 2},
 			{IP: "endpoint", Port: 3},
-		},
+		}}},
 	}
 	loadBalancer.OnUpdate(endpoints)
-	shuffledEndpoints := loadBalancer.services["foo"].endpoints
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[0], client1)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[0], client1)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[1], client2)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[1], client2)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[2], client3)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[2], client3)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[0], client1)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[0], client1)
+	shuffledEndpoints := loadBalancer.services[fooService].endpoints
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[0], client1)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[0], client1)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[1], client2)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[1], client2)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[2], client3)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[2], client3)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[0], client1)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[0], client1)
 }
 
 func TestStickyLoadBalanaceWorksWithMultipleEndpointsStickyNone(t *testing.T) {
+	fooService := ServicePortName{Name: "foo", Port: "p"}
 	client1 := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 0}
 	client2 := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 2), Port: 0}
 	client3 := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 3), Port: 0}
 	loadBalancer := NewLoadBalancerRR()
-	endpoint, err := loadBalancer.NextEndpoint("foo", nil)
+	endpoint, err := loadBalancer.NextEndpoint(fooService, nil)
 	if err == nil || len(endpoint) != 0 {
 		t.Errorf("Didn't fail with non-existent service")
 	}
 
-	loadBalancer.NewService("foo", api.AffinityTypeNone, 0)
+	loadBalancer.NewService(fooService, api.AffinityTypeNone, 0)
 	endpoints := make([]api.Endpoints, 1)
 	endpoints[0] = api.Endpoints{
-		ObjectMeta: api.ObjectMeta{Name: "foo"},
-		Endpoints: []api.Endpoint{
+		ObjectMeta: api.ObjectMeta{Name: fooService.Name},
+		Ports: []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{
 			{IP: "endpoint", Port: 1},
 			{IP: "endpoint", Port: 2},
 			{IP: "endpoint", Port: 3},
-		},
+		}}},
 	}
 	loadBalancer.OnUpdate(endpoints)
-	shuffledEndpoints := loadBalancer.services["foo"].endpoints
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[0], client1)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[1], client1)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[2], client2)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[0], client2)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[1], client3)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[2], client3)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[0], client1)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[1], client1)
+	shuffledEndpoints := loadBalancer.services[fooService].endpoints
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[0], client1)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[1], client1)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[2], client2)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[0], client2)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[1], client3)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[2], client3)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[0], client1)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[1], client1)
 }
 
 func TestStickyLoadBalanaceWorksWithMultipleEndpointsRemoveOne(t *testing.T) {
+	fooService := ServicePortName{Name: "foo", Port: "p"}
 	client1 := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 0}
 	client2 := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 2), Port: 0}
 	client3 := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 3), Port: 0}
@@ -316,41 +362,41 @@ func TestStickyLoadBalanaceWorksWithMultipleEndpointsRemoveOne(t *testing.T) {
 	client5 := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 5), Port: 0}
 	client6 := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 6), Port: 0}
 	loadBalancer := NewLoadBalancerRR()
-	endpoint, err := loadBalancer.NextEndpoint("foo", nil)
+	endpoint, err := loadBalancer.NextEndpoint(fooService, nil)
 	if err == nil || len(endpoint) != 0 {
 		t.Errorf("Didn't fail with non-existent service")
 	}
 
-	loadBalancer.NewService("foo", api.AffinityTypeClientIP, 0)
+	loadBalancer.NewService(fooService, api.AffinityTypeClientIP, 0)
 	endpoints := make([]api.Endpoints, 1)
 	endpoints[0] = api.Endpoints{
-		ObjectMeta: api.ObjectMeta{Name: "foo"},
-		Endpoints: []api.Endpoint{
+		ObjectMeta: api.ObjectMeta{Name: fooService.Name},
+		Ports: []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{
 			{IP: "endpoint", Port: 1},
 			{IP: "endpoint", Port: 2},
 			{IP: "endpoint", Port: 3},
-		},
+		}}},
 	}
 	loadBalancer.OnUpdate(endpoints)
-	shuffledEndpoints := loadBalancer.services["foo"].endpoints
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[0], client1)
+	shuffledEndpoints := loadBalancer.services[fooService].endpoints
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[0], client1)
 	client1Endpoint := shuffledEndpoints[0]
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[0], client1)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[1], client2)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[0], client1)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[1], client2)
 	client2Endpoint := shuffledEndpoints[1]
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[1], client2)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[2], client3)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[1], client2)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[2], client3)
 	client3Endpoint := shuffledEndpoints[2]
 
 	endpoints[0] = api.Endpoints{
-		ObjectMeta: api.ObjectMeta{Name: "foo"},
-		Endpoints: []api.Endpoint{
+		ObjectMeta: api.ObjectMeta{Name: fooService.Name},
+		Ports: []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{
 			{IP: "endpoint", Port: 1},
 			{IP: "endpoint", Port: 2},
-		},
+		}}},
 	}
 	loadBalancer.OnUpdate(endpoints)
-	shuffledEndpoints = loadBalancer.services["foo"].endpoints
+	shuffledEndpoints = loadBalancer.services[fooService].endpoints
 	if client1Endpoint == "endpoint:3" {
 		client1Endpoint = shuffledEndpoints[0]
 	} else if client2Endpoint == "endpoint:3" {
@@ -358,140 +404,143 @@ func TestStickyLoadBalanaceWorksWithMultipleEndpointsRemoveOne(t *testing.T) {
 	} else if client3Endpoint == "endpoint:3" {
 		client3Endpoint = shuffledEndpoints[0]
 	}
-	expectEndpoint(t, loadBalancer, "foo", client1Endpoint, client1)
-	expectEndpoint(t, loadBalancer, "foo", client2Endpoint, client2)
-	expectEndpoint(t, loadBalancer, "foo", client3Endpoint, client3)
+	expectEndpoint(t, loadBalancer, fooService, client1Endpoint, client1)
+	expectEndpoint(t, loadBalancer, fooService, client2Endpoint, client2)
+	expectEndpoint(t, loadBalancer, fooService, client3Endpoint, client3)
 
 	endpoints[0] = api.Endpoints{
-		ObjectMeta: api.ObjectMeta{Name: "foo"},
-		Endpoints: []api.Endpoint{
+		ObjectMeta: api.ObjectMeta{Name: fooService.Name},
+		Ports: []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{
 			{IP: "endpoint", Port: 1},
 			{IP: "endpoint", Port: 2},
 			{IP: "endpoint", Port: 4},
-		},
+		}}},
 	}
 	loadBalancer.OnUpdate(endpoints)
-	shuffledEndpoints = loadBalancer.services["foo"].endpoints
-	expectEndpoint(t, loadBalancer, "foo", client1Endpoint, client1)
-	expectEndpoint(t, loadBalancer, "foo", client2Endpoint, client2)
-	expectEndpoint(t, loadBalancer, "foo", client3Endpoint, client3)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[0], client4)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[1], client5)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[2], client6)
+	shuffledEndpoints = loadBalancer.services[fooService].endpoints
+	expectEndpoint(t, loadBalancer, fooService, client1Endpoint, client1)
+	expectEndpoint(t, loadBalancer, fooService, client2Endpoint, client2)
+	expectEndpoint(t, loadBalancer, fooService, client3Endpoint, client3)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[0], client4)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[1], client5)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[2], client6)
 }
 
 func TestStickyLoadBalanceWorksWithMultipleEndpointsAndUpdates(t *testing.T) {
+	fooService := ServicePortName{Name: "foo", Port: "p"}
 	client1 := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 0}
 	client2 := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 2), Port: 0}
 	client3 := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 3), Port: 0}
 	loadBalancer := NewLoadBalancerRR()
-	endpoint, err := loadBalancer.NextEndpoint("foo", nil)
+	endpoint, err := loadBalancer.NextEndpoint(fooService, nil)
 	if err == nil || len(endpoint) != 0 {
 		t.Errorf("Didn't fail with non-existent service")
 	}
 
-	loadBalancer.NewService("foo", api.AffinityTypeClientIP, 0)
+	loadBalancer.NewService(fooService, api.AffinityTypeClientIP, 0)
 	endpoints := make([]api.Endpoints, 1)
 	endpoints[0] = api.Endpoints{
-		ObjectMeta: api.ObjectMeta{Name: "foo"},
-		Endpoints: []api.Endpoint{
+		ObjectMeta: api.ObjectMeta{Name: fooService.Name},
+		Ports: []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{
 			{IP: "endpoint", Port: 1},
 			{IP: "endpoint", Port: 2},
 			{IP: "endpoint", Port: 3},
-		},
+		}}},
 	}
 	loadBalancer.OnUpdate(endpoints)
-	shuffledEndpoints := loadBalancer.services["foo"].endpoints
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[0], client1)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[0], client1)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[1], client2)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[1], client2)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[2], client3)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[0], client1)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[1], client2)
+	shuffledEndpoints := loadBalancer.services[fooService].endpoints
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[0], client1)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[0], client1)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[1], client2)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[1], client2)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[2], client3)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[0], client1)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[1], client2)
 	// Then update the configuration with one fewer endpoints, make sure
 	// we start in the beginning again
-	endpoints[0] = api.Endpoints{ObjectMeta: api.ObjectMeta{Name: "foo"},
-		Endpoints: []api.Endpoint{
+	endpoints[0] = api.Endpoints{ObjectMeta: api.ObjectMeta{Name: fooService.Name},
+		Ports: []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{
 			{IP: "endpoint", Port: 4},
 			{IP: "endpoint", Port: 5},
-		},
+		}}},
 	}
 	loadBalancer.OnUpdate(endpoints)
-	shuffledEndpoints = loadBalancer.services["foo"].endpoints
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[0], client1)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[1], client2)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[0], client1)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[1], client2)
-	expectEndpoint(t, loadBalancer, "foo", shuffledEndpoints[1], client2)
+	shuffledEndpoints = loadBalancer.services[fooService].endpoints
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[0], client1)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[1], client2)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[0], client1)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[1], client2)
+	expectEndpoint(t, loadBalancer, fooService, shuffledEndpoints[1], client2)
 
 	// Clear endpoints
-	endpoints[0] = api.Endpoints{ObjectMeta: api.ObjectMeta{Name: "foo"}, Endpoints: []api.Endpoint{}}
+	endpoints[0] = api.Endpoints{ObjectMeta: api.ObjectMeta{Name: fooService.Name}, Ports: []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{}}}}
 	loadBalancer.OnUpdate(endpoints)
 
-	endpoint, err = loadBalancer.NextEndpoint("foo", nil)
+	endpoint, err = loadBalancer.NextEndpoint(fooService, nil)
 	if err == nil || len(endpoint) != 0 {
 		t.Errorf("Didn't fail with non-existent service")
 	}
 }
 
 func TestStickyLoadBalanceWorksWithServiceRemoval(t *testing.T) {
+	fooService := ServicePortName{Name: "foo", Port: "p"}
+	barService := ServicePortName{Name: "bar", Port: "p"}
 	client1 := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 0}
 	client2 := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 2), Port: 0}
 	client3 := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 3), Port: 0}
 	loadBalancer := NewLoadBalancerRR()
-	endpoint, err := loadBalancer.NextEndpoint("foo", nil)
+	endpoint, err := loadBalancer.NextEndpoint(fooService, nil)
 	if err == nil || len(endpoint) != 0 {
 		t.Errorf("Didn't fail with non-existent service")
 	}
-	loadBalancer.NewService("foo", api.AffinityTypeClientIP, 0)
+	loadBalancer.NewService(fooService, api.AffinityTypeClientIP, 0)
 	endpoints := make([]api.Endpoints, 2)
 	endpoints[0] = api.Endpoints{
-		ObjectMeta: api.ObjectMeta{Name: "foo"},
-		Endpoints: []api.Endpoint{
+		ObjectMeta: api.ObjectMeta{Name: fooService.Name},
+		Ports: []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{
 			{IP: "endpoint", Port: 1},
 			{IP: "endpoint", Port: 2},
 			{IP: "endpoint", Port: 3},
-		},
+		}}},
 	}
-	loadBalancer.NewService("bar", api.AffinityTypeClientIP, 0)
+	loadBalancer.NewService(barService, api.AffinityTypeClientIP, 0)
 	endpoints[1] = api.Endpoints{
-		ObjectMeta: api.ObjectMeta{Name: "bar"},
-		Endpoints: []api.Endpoint{
+		ObjectMeta: api.ObjectMeta{Name: barService.Name},
+		Ports: []api.EndpointPort{{Name: "p", Endpoints: []api.Endpoint{
 			{IP: "endpoint", Port: 5},
 			{IP: "endpoint", Port: 5},
-		},
+		}}},
 	}
 	loadBalancer.OnUpdate(endpoints)
-	shuffledFooEndpoints := loadBalancer.services["foo"].endpoints
-	expectEndpoint(t, loadBalancer, "foo", shuffledFooEndpoints[0], client1)
-	expectEndpoint(t, loadBalancer, "foo", shuffledFooEndpoints[1], client2)
-	expectEndpoint(t, loadBalancer, "foo", shuffledFooEndpoints[2], client3)
-	expectEndpoint(t, loadBalancer, "foo", shuffledFooEndpoints[2], client3)
-	expectEndpoint(t, loadBalancer, "foo", shuffledFooEndpoints[0], client1)
-	expectEndpoint(t, loadBalancer, "foo", shuffledFooEndpoints[1], client2)
-
-	shuffledBarEndpoints := loadBalancer.services["bar"].endpoints
-	expectEndpoint(t, loadBalancer, "foo", shuffledFooEndpoints[0], client1)
-	expectEndpoint(t, loadBalancer, "foo", shuffledFooEndpoints[1], client2)
-	expectEndpoint(t, loadBalancer, "foo", shuffledFooEndpoints[0], client1)
-	expectEndpoint(t, loadBalancer, "foo", shuffledFooEndpoints[1], client2)
-	expectEndpoint(t, loadBalancer, "foo", shuffledFooEndpoints[0], client1)
-	expectEndpoint(t, loadBalancer, "foo", shuffledFooEndpoints[0], client1)
+	shuffledFooEndpoints := loadBalancer.services[fooService].endpoints
+	expectEndpoint(t, loadBalancer, fooService, shuffledFooEndpoints[0], client1)
+	expectEndpoint(t, loadBalancer, fooService, shuffledFooEndpoints[1], client2)
+	expectEndpoint(t, loadBalancer, fooService, shuffledFooEndpoints[2], client3)
+	expectEndpoint(t, loadBalancer, fooService, shuffledFooEndpoints[2], client3)
+	expectEndpoint(t, loadBalancer, fooService, shuffledFooEndpoints[0], client1)
+	expectEndpoint(t, loadBalancer, fooService, shuffledFooEndpoints[1], client2)
+
+	shuffledBarEndpoints := loadBalancer.services[barService].endpoints
+	expectEndpoint(t, loadBalancer, fooService, shuffledFooEndpoints[0], client1)
+	expectEndpoint(t, loadBalancer, fooService, shuffledFooEndpoints[1], client2)
+	expectEndpoint(t, loadBalancer, fooService, shuffledFooEndpoints[0], client1)
+	expectEndpoint(t, loadBalancer, fooService, shuffledFooEndpoints[1], client2)
+	expectEndpoint(t, loadBalancer, fooService, shuffledFooEndpoints[0], client1)
+	expectEndpoint(t, loadBalancer, fooService, shuffledFooEndpoints[0], client1)
 
 	// Then update the configuration by removing foo
 	loadBalancer.OnUpdate(endpoints[1:])
-	endpoint, err = loadBalancer.NextEndpoint("foo", nil)
+	endpoint, err = loadBalancer.NextEndpoint(fooService, nil)
 	if err == nil || len(endpoint) != 0 {
 		t.Errorf("Didn't fail with non-existent service")
 	}
 
 	// but bar is still there, and we continue RR from where we left off.
-	shuffledBarEndpoints = loadBalancer.services["bar"].endpoints
-	expectEndpoint(t, loadBalancer, "bar", shuffledBarEndpoints[0], client1)
-	expectEndpoint(t, loadBalancer, "bar", shuffledBarEndpoints[1], client2)
-	expectEndpoint(t, loadBalancer, "bar", shuffledBarEndpoints[0], client1)
-	expectEndpoint(t, loadBalancer, "bar", shuffledBarEndpoints[1], client2)
-	expectEndpoint(t, loadBalancer, "bar", shuffledBarEndpoints[0], client1)
-	expectEndpoint(t, loadBalancer, "bar", shuffledBarEndpoints[0], client1)
+	shuffledBarEndpoints = loadBalancer.services[barService].endpoints
+	expectEndpoint(t, loadBalancer, barService, shuffledBarEndpoints[0], client1)
+	expectEndpoint(t, loadBalancer, barService, shuffledBarEndpoints[1], client2)
+	expectEndpoint(t, loadBalancer, barService, shuffledBarEndpoints[0], client1)
+	expectEndpoint(t, loadBalancer, barService, shuffledBarEndpoints[1], client2)
+	expectEndpoint(t, loadBalancer, barService, shuffledBarEndpoints[0], client1)
+	expectEndpoint(t, loadBalancer, barService, shuffledBarEndpoints[0], client1)
 }
@@ -0,0 +1,82 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+)
+
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.EtcdHelper{Client: fakeEtcdClient, Codec: latest.Codec, ResourceVersioner: tools.RuntimeVersionAdapter{Versioner: latest.ResourceVersioner}}
+	return fakeEtcdClient, helper
+}
+
+func validNewClusterRole(name string) *api.ClusterRole {
+	return &api.ClusterRole{
+		ObjectMeta: api.ObjectMeta{
+			Name: name,
+		},
+		Rules: []api.PolicyRule{
+			{Verbs: []string{"*"}, Resources: []string{"*"}},
+		},
+	}
+}
+
+func TestCreateSetsFields(t *testing.T) {
+	_, helper := newHelper(t)
+	storage := NewREST(helper, nil)
+	clusterRole := validNewClusterRole("admin")
+	clusterRole.Namespace = "ignored"
+	if _, err := storage.Create(api.NewDefaultContext(), clusterRole); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actual := &api.ClusterRole{}
+	if err := helper.ExtractObj("/registry/clusterroles/admin", actual, false); err != nil {
+		t.Fatalf("unexpected extraction error: %v", err)
+	}
+	if actual.Name != clusterRole.Name || len(actual.Namespace) != 0 {
+		t.Errorf("unexpected cluster role: %#v", actual)
+	}
+	if len(actual.UID) == 0 {
+		t.Errorf("expected cluster role UID to be set: %#v", actual)
+	}
+	if len(actual.Rules) != 1 || actual.Rules[0].Verbs[0] != "*" {
+		t.Errorf("unexpected cluster role: %#v", actual)
+	}
+}
+
+func TestCreateInvalid(t *testing.T) {
+	_, helper := newHelper(t)
+	storage := NewREST(helper, nil)
+	for _, clusterRole := range []*api.ClusterRole{
+		validNewClusterRole("Admin"),
+		{ObjectMeta: api.ObjectMeta{Name: "admin"}, Rules: []api.PolicyRule{{Verbs: []string{"*"}}}},
+	} {
+		_, err := storage.Create(api.NewDefaultContext(), clusterRole)
+		if !errors.IsInvalid(err) {
+			t.Errorf("expected invalid error for %#v, got %v", clusterRole, err)
+		}
+	}
+}
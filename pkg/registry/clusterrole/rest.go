@@ -0,0 +1,81 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterrole
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+)
+
+// clusterRoleStrategy implements behavior for ClusterRole objects.
+type clusterRoleStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating ClusterRole
+// objects via the REST API.
+var Strategy = clusterRoleStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped is false for cluster roles.
+func (clusterRoleStrategy) NamespaceScoped() bool {
+	return false
+}
+
+// ResetBeforeCreate clears fields that are not allowed to be set by end users on creation.
+func (clusterRoleStrategy) ResetBeforeCreate(obj runtime.Object) {
+}
+
+// Validate validates a new cluster role.
+func (clusterRoleStrategy) Validate(obj runtime.Object) errors.ValidationErrorList {
+	return validation.ValidateClusterRole(obj.(*api.ClusterRole))
+}
+
+// AllowCreateOnUpdate is false for cluster roles.
+func (clusterRoleStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (clusterRoleStrategy) ValidateUpdate(obj, old runtime.Object) errors.ValidationErrorList {
+	return validation.ValidateClusterRoleUpdate(old.(*api.ClusterRole), obj.(*api.ClusterRole))
+}
+
+// MatchClusterRole returns a generic matcher for a given label and field selector.
+func MatchClusterRole(label, field labels.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		clusterRole, ok := obj.(*api.ClusterRole)
+		if !ok {
+			return false, fmt.Errorf("not a cluster role")
+		}
+		fields := ClusterRoleToSelectableFields(clusterRole)
+		return label.Matches(labels.Set(clusterRole.Labels)) && field.Matches(fields), nil
+	})
+}
+
+// ClusterRoleToSelectableFields returns a label set that represents the object.
+func ClusterRoleToSelectableFields(clusterRole *api.ClusterRole) labels.Set {
+	return labels.Set{
+		"name": clusterRole.Name,
+	}
+}
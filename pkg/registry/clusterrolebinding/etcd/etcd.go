@@ -0,0 +1,97 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authorizer"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authorizer/rbac"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/clusterrolebinding"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	etcdgeneric "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic/etcd"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+)
+
+// REST implements a RESTStorage for cluster role bindings against etcd.
+type REST struct {
+	*etcdgeneric.Etcd
+	authorizer   authorizer.Authorizer
+	clusterRoles rbac.Getter
+}
+
+// NewREST returns a RESTStorage object that will work against cluster role bindings.
+// Bindings may only grant cluster roles, read from clusterRoles, whose rules the
+// authorizer allows the requesting user in every namespace.
+func NewREST(h tools.EtcdHelper, a authorizer.Authorizer, clusterRoles rbac.Getter) *REST {
+	prefix := "/registry/clusterrolebindings"
+	store := &etcdgeneric.Etcd{
+		NewFunc:     func() runtime.Object { return &api.ClusterRoleBinding{} },
+		NewListFunc: func() runtime.Object { return &api.ClusterRoleBindingList{} },
+		KeyRootFunc: func(ctx api.Context) string {
+			return prefix
+		},
+		KeyFunc: func(ctx api.Context, name string) (string, error) {
+			return prefix + "/" + name, nil
+		},
+		ObjectNameFunc: func(obj runtime.Object) (string, error) {
+			return obj.(*api.ClusterRoleBinding).Name, nil
+		},
+		PredicateFunc: func(label, field labels.Selector) generic.Matcher {
+			return clusterrolebinding.MatchClusterRoleBinding(label, field)
+		},
+		EndpointName: "clusterrolebindings",
+
+		CreateStrategy:      clusterrolebinding.Strategy,
+		UpdateStrategy:      clusterrolebinding.Strategy,
+		ReturnDeletedObject: true,
+
+		Helper: h,
+	}
+	return &REST{store, a, clusterRoles}
+}
+
+// Create rejects cluster role bindings to cluster roles with rules the requesting user is not allowed itself.
+func (r *REST) Create(ctx api.Context, obj runtime.Object) (runtime.Object, error) {
+	if err := r.confirmNoEscalation(ctx, obj.(*api.ClusterRoleBinding)); err != nil {
+		return nil, err
+	}
+	return r.Etcd.Create(ctx, obj)
+}
+
+// Update rejects cluster role bindings to cluster roles with rules the requesting user is not allowed itself.
+func (r *REST) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool, error) {
+	if err := r.confirmNoEscalation(ctx, obj.(*api.ClusterRoleBinding)); err != nil {
+		return nil, false, err
+	}
+	return r.Etcd.Update(ctx, obj)
+}
+
+// confirmNoEscalation returns a forbidden error unless the requesting user is allowed
+// everything the role of the binding grants.
+func (r *REST) confirmNoEscalation(ctx api.Context, binding *api.ClusterRoleBinding) error {
+	if _, ok := api.UserFrom(ctx); !ok || r.authorizer == nil {
+		return nil
+	}
+	rules, err := rbac.RoleRefRules(ctx, nil, r.clusterRoles, api.NamespaceNone, binding.RoleRef)
+	if err != nil {
+		return errors.NewForbidden("clusterrolebinding", binding.Name, err)
+	}
+	return rbac.ConfirmNoEscalation(ctx, r.authorizer, "clusterrolebinding", binding.Name, api.NamespaceNone, rules)
+}
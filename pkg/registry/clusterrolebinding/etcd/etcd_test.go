@@ -0,0 +1,81 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+)
+
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.EtcdHelper{Client: fakeEtcdClient, Codec: latest.Codec, ResourceVersioner: tools.RuntimeVersionAdapter{Versioner: latest.ResourceVersioner}}
+	return fakeEtcdClient, helper
+}
+
+func validNewClusterRoleBinding(name string) *api.ClusterRoleBinding {
+	return &api.ClusterRoleBinding{
+		ObjectMeta: api.ObjectMeta{
+			Name: name,
+		},
+		Subjects: []api.Subject{{Kind: "Group", Name: "admins"}},
+		RoleRef:  api.RoleRef{Kind: "ClusterRole", Name: "admin"},
+	}
+}
+
+func TestCreateSetsFields(t *testing.T) {
+	_, helper := newHelper(t)
+	storage := NewREST(helper, nil, nil)
+	clusterRoleBinding := validNewClusterRoleBinding("admins")
+	clusterRoleBinding.Namespace = "ignored"
+	if _, err := storage.Create(api.NewDefaultContext(), clusterRoleBinding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actual := &api.ClusterRoleBinding{}
+	if err := helper.ExtractObj("/registry/clusterrolebindings/admins", actual, false); err != nil {
+		t.Fatalf("unexpected extraction error: %v", err)
+	}
+	if actual.Name != clusterRoleBinding.Name || len(actual.Namespace) != 0 {
+		t.Errorf("unexpected cluster role binding: %#v", actual)
+	}
+	if len(actual.UID) == 0 {
+		t.Errorf("expected cluster role binding UID to be set: %#v", actual)
+	}
+	if len(actual.Subjects) != 1 || actual.RoleRef.Name != "admin" {
+		t.Errorf("unexpected cluster role binding: %#v", actual)
+	}
+}
+
+func TestCreateInvalid(t *testing.T) {
+	_, helper := newHelper(t)
+	storage := NewREST(helper, nil, nil)
+	for _, clusterRoleBinding := range []*api.ClusterRoleBinding{
+		validNewClusterRoleBinding("Admins"),
+		{ObjectMeta: api.ObjectMeta{Name: "admins"}, Subjects: []api.Subject{{Kind: "Group", Name: "admins"}}, RoleRef: api.RoleRef{Kind: "Role", Name: "admin"}},
+	} {
+		_, err := storage.Create(api.NewDefaultContext(), clusterRoleBinding)
+		if !errors.IsInvalid(err) {
+			t.Errorf("expected invalid error for %#v, got %v", clusterRoleBinding, err)
+		}
+	}
+}
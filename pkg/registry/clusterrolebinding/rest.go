@@ -0,0 +1,81 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterrolebinding
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+)
+
+// clusterRoleBindingStrategy implements behavior for ClusterRoleBinding objects.
+type clusterRoleBindingStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating ClusterRoleBinding
+// objects via the REST API.
+var Strategy = clusterRoleBindingStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped is false for cluster role bindings.
+func (clusterRoleBindingStrategy) NamespaceScoped() bool {
+	return false
+}
+
+// ResetBeforeCreate clears fields that are not allowed to be set by end users on creation.
+func (clusterRoleBindingStrategy) ResetBeforeCreate(obj runtime.Object) {
+}
+
+// Validate validates a new cluster role binding.
+func (clusterRoleBindingStrategy) Validate(obj runtime.Object) errors.ValidationErrorList {
+	return validation.ValidateClusterRoleBinding(obj.(*api.ClusterRoleBinding))
+}
+
+// AllowCreateOnUpdate is false for cluster role bindings.
+func (clusterRoleBindingStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (clusterRoleBindingStrategy) ValidateUpdate(obj, old runtime.Object) errors.ValidationErrorList {
+	return validation.ValidateClusterRoleBindingUpdate(old.(*api.ClusterRoleBinding), obj.(*api.ClusterRoleBinding))
+}
+
+// MatchClusterRoleBinding returns a generic matcher for a given label and field selector.
+func MatchClusterRoleBinding(label, field labels.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		clusterRoleBinding, ok := obj.(*api.ClusterRoleBinding)
+		if !ok {
+			return false, fmt.Errorf("not a cluster role binding")
+		}
+		fields := ClusterRoleBindingToSelectableFields(clusterRoleBinding)
+		return label.Matches(labels.Set(clusterRoleBinding.Labels)) && field.Matches(fields), nil
+	})
+}
+
+// ClusterRoleBindingToSelectableFields returns a label set that represents the object.
+func ClusterRoleBindingToSelectableFields(clusterRoleBinding *api.ClusterRoleBinding) labels.Set {
+	return labels.Set{
+		"name": clusterRoleBinding.Name,
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest/resttest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+)
+
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.EtcdHelper{Client: fakeEtcdClient, Codec: latest.Codec, ResourceVersioner: tools.RuntimeVersionAdapter{Versioner: latest.ResourceVersioner}}
+	return fakeEtcdClient, helper
+}
+
+func validNewDaemonSet(name, ns string) *api.DaemonSet {
+	selector := map[string]string{"daemon": name}
+	return &api.DaemonSet{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+		},
+		Spec: api.DaemonSetSpec{
+			Selector: selector,
+			Template: api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{Labels: selector},
+				Spec: api.PodSpec{
+					RestartPolicy: api.RestartPolicy{Always: &api.RestartPolicyAlways{}},
+					DNSPolicy:     api.DNSClusterFirst,
+				},
+			},
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	fakeEtcdClient, helper := newHelper(t)
+	storage := NewREST(helper)
+	test := resttest.New(t, storage, fakeEtcdClient.SetError)
+	ds := validNewDaemonSet("foo", api.NamespaceDefault)
+	ds.ObjectMeta = api.ObjectMeta{}
+	test.TestCreate(
+		// valid
+		ds,
+		// invalid
+		&api.DaemonSet{
+			ObjectMeta: api.ObjectMeta{Name: "*BadName!"},
+		},
+	)
+}
+
+func TestCreateSetsFields(t *testing.T) {
+	_, helper := newHelper(t)
+	storage := NewREST(helper)
+	ds := validNewDaemonSet("foo", api.NamespaceDefault)
+	ds.Status.CurrentNumberScheduled = 1
+	ds.Status.DesiredNumberScheduled = 2
+	if _, err := storage.Create(api.NewDefaultContext(), ds); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actual := &api.DaemonSet{}
+	if err := helper.ExtractObj("/registry/daemonsets/default/foo", actual, false); err != nil {
+		t.Fatalf("unexpected extraction error: %v", err)
+	}
+	if actual.Name != ds.Name {
+		t.Errorf("unexpected daemon set: %#v", actual)
+	}
+	if actual.Status.CurrentNumberScheduled != 0 || actual.Status.DesiredNumberScheduled != 0 {
+		t.Errorf("expected status to be reset on create: %#v", actual.Status)
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package daemonset
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+)
+
+// daemonSetStrategy implements behavior for DaemonSet objects.
+type daemonSetStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating DaemonSet
+// objects via the REST API.
+var Strategy = daemonSetStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped is true for daemon sets.
+func (daemonSetStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// ResetBeforeCreate clears fields that are not allowed to be set by end users on creation.
+func (daemonSetStrategy) ResetBeforeCreate(obj runtime.Object) {
+	ds := obj.(*api.DaemonSet)
+	ds.Status = api.DaemonSetStatus{}
+}
+
+// Validate validates a new daemon set.
+func (daemonSetStrategy) Validate(obj runtime.Object) errors.ValidationErrorList {
+	return validation.ValidateDaemonSet(obj.(*api.DaemonSet))
+}
+
+// AllowCreateOnUpdate is false for daemon sets.
+func (daemonSetStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (daemonSetStrategy) ValidateUpdate(obj, old runtime.Object) errors.ValidationErrorList {
+	return validation.ValidateDaemonSetUpdate(old.(*api.DaemonSet), obj.(*api.DaemonSet))
+}
+
+// MatchDaemonSet returns a generic matcher for a given label and field selector.
+func MatchDaemonSet(label, field labels.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		ds, ok := obj.(*api.DaemonSet)
+		if !ok {
+			return false, fmt.Errorf("not a daemon set")
+		}
+		fields := DaemonSetToSelectableFields(ds)
+		return label.Matches(labels.Set(ds.Labels)) && field.Matches(fields), nil
+	})
+}
+
+// DaemonSetToSelectableFields returns a label set that represents the object.
+func DaemonSetToSelectableFields(ds *api.DaemonSet) labels.Set {
+	return labels.Set{
+		"name": ds.Name,
+	}
+}
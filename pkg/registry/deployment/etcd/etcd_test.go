@@ -0,0 +1,93 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest/resttest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+)
+
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.EtcdHelper{Client: fakeEtcdClient, Codec: latest.Codec, ResourceVersioner: tools.RuntimeVersionAdapter{Versioner: latest.ResourceVersioner}}
+	return fakeEtcdClient, helper
+}
+
+func validNewDeployment(name, ns string) *api.Deployment {
+	selector := map[string]string{"app": name}
+	return &api.Deployment{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+		},
+		Spec: api.DeploymentSpec{
+			Replicas: 2,
+			Selector: selector,
+			Template: api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{Labels: selector},
+				Spec: api.PodSpec{
+					RestartPolicy: api.RestartPolicy{Always: &api.RestartPolicyAlways{}},
+					DNSPolicy:     api.DNSClusterFirst,
+				},
+			},
+			Strategy: api.DeploymentStrategy{Type: api.RecreateDeploymentStrategyType},
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	fakeEtcdClient, helper := newHelper(t)
+	storage := NewREST(helper)
+	test := resttest.New(t, storage, fakeEtcdClient.SetError)
+	deployment := validNewDeployment("foo", api.NamespaceDefault)
+	deployment.ObjectMeta = api.ObjectMeta{}
+	test.TestCreate(
+		// valid
+		deployment,
+		// invalid
+		&api.Deployment{
+			ObjectMeta: api.ObjectMeta{Name: "*BadName!"},
+		},
+	)
+}
+
+func TestCreateSetsFields(t *testing.T) {
+	_, helper := newHelper(t)
+	storage := NewREST(helper)
+	deployment := validNewDeployment("foo", api.NamespaceDefault)
+	deployment.Status.Replicas = 1
+	deployment.Status.UpdatedReplicas = 1
+	if _, err := storage.Create(api.NewDefaultContext(), deployment); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actual := &api.Deployment{}
+	if err := helper.ExtractObj("/registry/deployments/default/foo", actual, false); err != nil {
+		t.Fatalf("unexpected extraction error: %v", err)
+	}
+	if actual.Name != deployment.Name {
+		t.Errorf("unexpected deployment: %#v", actual)
+	}
+	if actual.Status != (api.DeploymentStatus{}) {
+		t.Errorf("expected status to be reset on create: %#v", actual.Status)
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+)
+
+// deploymentStrategy implements behavior for Deployment objects.
+type deploymentStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating Deployment
+// objects via the REST API.
+var Strategy = deploymentStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped is true for deployments.
+func (deploymentStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// ResetBeforeCreate clears fields that are not allowed to be set by end users on creation.
+func (deploymentStrategy) ResetBeforeCreate(obj runtime.Object) {
+	deployment := obj.(*api.Deployment)
+	deployment.Status = api.DeploymentStatus{}
+}
+
+// Validate validates a new deployment.
+func (deploymentStrategy) Validate(obj runtime.Object) errors.ValidationErrorList {
+	return validation.ValidateDeployment(obj.(*api.Deployment))
+}
+
+// AllowCreateOnUpdate is false for deployments.
+func (deploymentStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (deploymentStrategy) ValidateUpdate(obj, old runtime.Object) errors.ValidationErrorList {
+	return validation.ValidateDeploymentUpdate(old.(*api.Deployment), obj.(*api.Deployment))
+}
+
+// MatchDeployment returns a generic matcher for a given label and field selector.
+func MatchDeployment(label, field labels.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		deployment, ok := obj.(*api.Deployment)
+		if !ok {
+			return false, fmt.Errorf("not a deployment")
+		}
+		fields := DeploymentToSelectableFields(deployment)
+		return label.Matches(labels.Set(deployment.Labels)) && field.Matches(fields), nil
+	})
+}
+
+// DeploymentToSelectableFields returns a label set that represents the object.
+func DeploymentToSelectableFields(deployment *api.Deployment) labels.Set {
+	return labels.Set{
+		"name": deployment.Name,
+	}
+}
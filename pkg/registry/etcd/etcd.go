@@ -49,6 +49,9 @@ const (
 type Registry struct {
 	tools.EtcdHelper
 	pods pod.Registry
+
+	// caches serve lists and watches of the keys they cover, if the watch cache is enabled.
+	caches []*tools.WatchCache
 }
 
 // NewRegistry creates an etcd registry.
@@ -60,6 +63,34 @@ func NewRegistry(helper tools.EtcdHelper, pods pod.Registry) *Registry {
 	return registry
 }
 
+// EnableWatchCache serves lists and watches of controllers, services, endpoints and nodes
+// from watch caches that remember the last capacity changes to each of them.
+func (r *Registry) EnableWatchCache(capacity int) {
+	for _, key := range []string{ControllerPath, ServicePath, ServiceEndpointPath, NodePath} {
+		r.caches = append(r.caches, tools.NewWatchCache(r.EtcdHelper, key, capacity))
+	}
+}
+
+// ExtractToList reads the items under key from a watch cache covering key, or from etcd.
+func (r *Registry) ExtractToList(key string, listObj runtime.Object) error {
+	for _, cache := range r.caches {
+		if cache.Covers(key) {
+			return cache.ExtractToList(key, listObj)
+		}
+	}
+	return r.EtcdHelper.ExtractToList(key, listObj)
+}
+
+// WatchList watches the items under key through a watch cache covering key, or etcd.
+func (r *Registry) WatchList(key string, resourceVersion uint64, filter tools.FilterFunc) (watch.Interface, error) {
+	for _, cache := range r.caches {
+		if cache.Covers(key) {
+			return cache.WatchList(key, resourceVersion, filter)
+		}
+	}
+	return r.EtcdHelper.WatchList(key, resourceVersion, filter)
+}
+
 // MakeEtcdListKey constructs etcd paths to resource directories enforcing namespace rules
 func MakeEtcdListKey(ctx api.Context, prefix string) string {
 	key := prefix
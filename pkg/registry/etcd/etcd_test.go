@@ -568,7 +568,7 @@ func TestEtcdUpdateService(t *testing.T) {
 			Selector: map[string]string{
 				"baz": "bar",
 			},
-			Protocol:        "TCP",
+			Ports:           []api.ServicePort{{Protocol: "TCP"}},
 			SessionAffinity: "None",
 		},
 	}
@@ -598,10 +598,10 @@ func TestEtcdListEndpoints(t *testing.T) {
 			Node: &etcd.Node{
 				Nodes: []*etcd.Node{
 					{
-						Value: runtime.EncodeOrDie(latest.Codec, &api.Endpoints{ObjectMeta: api.ObjectMeta{Name: "foo"}, Protocol: "TCP", Endpoints: []api.Endpoint{{IP: "127.0.0.1", Port: 8345}}}),
+						Value: runtime.EncodeOrDie(latest.Codec, &api.Endpoints{ObjectMeta: api.ObjectMeta{Name: "foo"}, Ports: []api.EndpointPort{{Protocol: "TCP", Endpoints: []api.Endpoint{{IP: "127.0.0.1", Port: 8345}}}}}),
 					},
 					{
-						Value: runtime.EncodeOrDie(latest.Codec, &api.Endpoints{ObjectMeta: api.ObjectMeta{Name: "bar"}, Protocol: "TCP"}),
+						Value: runtime.EncodeOrDie(latest.Codec, &api.Endpoints{ObjectMeta: api.ObjectMeta{Name: "bar"}, Ports: []api.EndpointPort{{Protocol: "TCP"}}}),
 					},
 				},
 			},
@@ -625,8 +625,7 @@ func TestEtcdGetEndpoints(t *testing.T) {
 	registry := NewTestEtcdRegistry(fakeClient)
 	endpoints := &api.Endpoints{
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
-		Protocol:   "TCP",
-		Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: 34855}},
+		Ports:      []api.EndpointPort{{Protocol: "TCP", Endpoints: []api.Endpoint{{IP: "127.0.0.1", Port: 34855}}}},
 	}
 
 	key, _ := makeServiceEndpointsKey(ctx, "foo")
@@ -649,8 +648,7 @@ func TestEtcdUpdateEndpoints(t *testing.T) {
 	registry := NewTestEtcdRegistry(fakeClient)
 	endpoints := api.Endpoints{
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
-		Protocol:   "TCP",
-		Endpoints:  []api.Endpoint{{IP: "baz"}, {IP: "bar"}},
+		Ports:      []api.EndpointPort{{Protocol: "TCP", Endpoints: []api.Endpoint{{IP: "baz"}, {IP: "bar"}}}},
 	}
 
 	key, _ := makeServiceEndpointsKey(ctx, "foo")
@@ -96,6 +96,9 @@ type Etcd struct {
 
 	// Used for all etcd access functions
 	Helper tools.EtcdHelper
+	// Optional; if set, lists and watches are served from memory instead of etcd
+	// where possible. Must cover the keys returned by KeyRootFunc.
+	WatchCache *tools.WatchCache
 }
 
 // NamespaceKeyRootFunc is the default function for constructing etcd paths to resource directories enforcing namespace rules.
@@ -141,13 +144,46 @@ func (e *Etcd) List(ctx api.Context, label, field labels.Selector) (runtime.Obje
 // ListPredicate returns a list of all the items matching m.
 func (e *Etcd) ListPredicate(ctx api.Context, m generic.Matcher) (runtime.Object, error) {
 	list := e.NewListFunc()
-	err := e.Helper.ExtractToList(e.KeyRootFunc(ctx), list)
+	if page, ok := api.ListPageFrom(ctx); ok {
+		return e.listPage(ctx, list, m, page)
+	}
+	var err error
+	if e.WatchCache != nil {
+		err = e.WatchCache.ExtractToList(e.KeyRootFunc(ctx), list)
+	} else {
+		err = e.Helper.ExtractToList(e.KeyRootFunc(ctx), list)
+	}
 	if err != nil {
 		return nil, err
 	}
 	return generic.FilterList(list, m, generic.DecoratorFunc(e.Decorator))
 }
 
+// listPage returns the chunk of the matching items selected by page.
+func (e *Etcd) listPage(ctx api.Context, list runtime.Object, m generic.Matcher, page api.ListPage) (runtime.Object, error) {
+	var matchErr error
+	filter := func(obj runtime.Object) bool {
+		matches, err := m.Matches(obj)
+		if err != nil && matchErr == nil {
+			matchErr = err
+		}
+		return matches
+	}
+	next, err := e.Helper.ExtractToListPage(e.KeyRootFunc(ctx), list, filter, page.Limit, page.Continue)
+	if err != nil {
+		return nil, etcderr.InterpretListError(err, e.EndpointName)
+	}
+	if matchErr != nil {
+		return nil, matchErr
+	}
+	listMeta, err := api.ListMetaFor(list)
+	if err != nil {
+		return nil, err
+	}
+	listMeta.Continue = next
+	return generic.FilterList(list, m, generic.DecoratorFunc(e.Decorator))
+}
+
 // CreateWithName inserts a new item with the provided name
 // DEPRECATED: use Create instead
 func (e *Etcd) CreateWithName(ctx api.Context, name string, obj runtime.Object) error {
@@ -311,6 +347,56 @@ func (e *Etcd) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool
 	return out, creating, nil
 }
 
+// Patch applies patchFn to the current state of the named item and stores the result. The
+// update is performed atomically; patchFn is invoked again with the latest state when another
+// writer changes the item first.
+func (e *Etcd) Patch(ctx api.Context, name string, patchFn rest.PatchFunc) (runtime.Object, error) {
+	key, err := e.KeyFunc(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	out := e.NewFunc()
+	err = e.Helper.AtomicUpdate(key, out, false, func(existing runtime.Object) (runtime.Object, error) {
+		version, err := e.Helper.ResourceVersioner.ResourceVersion(existing)
+		if err != nil {
+			return nil, err
+		}
+		obj, err := patchFn(existing)
+		if err != nil {
+			return nil, err
+		}
+		// a patch that names a resource version expects to apply to that version only
+		newVersion, err := e.Helper.ResourceVersioner.ResourceVersion(obj)
+		if err != nil {
+			return nil, err
+		}
+		if newVersion != version {
+			return nil, kubeerr.NewConflict(e.EndpointName, name, fmt.Errorf("the resource was updated to %d", version))
+		}
+		if err := rest.BeforeUpdate(e.UpdateStrategy, ctx, obj, existing); err != nil {
+			return nil, err
+		}
+		return obj, nil
+	})
+	if err != nil {
+		if tools.IsEtcdNotFound(err) {
+			return nil, kubeerr.NewNotFound(e.EndpointName, name)
+		}
+		return nil, etcderr.InterpretUpdateError(err, e.EndpointName, name)
+	}
+	if e.AfterUpdate != nil {
+		if err := e.AfterUpdate(out); err != nil {
+			return nil, err
+		}
+	}
+	if e.Decorator != nil {
+		if err := e.Decorator(out); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
 // Get retrieves the item from etcd.
 func (e *Etcd) Get(ctx api.Context, name string) (runtime.Object, error) {
 	obj := e.NewFunc()
@@ -369,7 +455,11 @@ func (e *Etcd) WatchPredicate(ctx api.Context, m generic.Matcher, resourceVersio
 	if err != nil {
 		return nil, err
 	}
-	return e.Helper.WatchList(e.KeyRootFunc(ctx), version, func(obj runtime.Object) bool {
+	watchList := e.Helper.WatchList
+	if e.WatchCache != nil {
+		watchList = e.WatchCache.WatchList
+	}
+	return watchList(e.KeyRootFunc(ctx), version, func(obj runtime.Object) bool {
 		matches, err := m.Matches(obj)
 		if err != nil {
 			glog.Errorf("unable to match watch: %v", err)
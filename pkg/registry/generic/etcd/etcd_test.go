@@ -28,6 +28,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
 
 	"github.com/coreos/go-etcd/etcd"
 )
@@ -184,6 +185,95 @@ func TestEtcdList(t *testing.T) {
 	}
 }
 
+func TestEtcdListPaged(t *testing.T) {
+	fakeClient, registry := NewTestGenericEtcdRegistry(t)
+	nodes := []*etcd.Node{}
+	for i, name := range []string{"bar", "baz", "foo"} {
+		pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: name}}
+		nodes = append(nodes, &etcd.Node{
+			Key:           "/registry/pods/" + name,
+			Value:         runtime.EncodeOrDie(testapi.Codec(), pod),
+			ModifiedIndex: uint64(i + 1),
+		})
+	}
+	fakeClient.Data["/registry/pods"] = tools.EtcdResponseWithError{
+		R: &etcd.Response{EtcdIndex: 5, Node: &etcd.Node{Dir: true, Nodes: nodes}},
+	}
+	m := SetMatcher{util.NewStringSet("baz", "foo")}
+
+	ctx := api.WithListPage(api.NewContext(), api.ListPage{Limit: 1})
+	obj, err := registry.ListPredicate(ctx, m)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	first := obj.(*api.PodList)
+	if len(first.Items) != 1 || first.Items[0].Name != "baz" || len(first.Continue) == 0 {
+		t.Fatalf("unexpected first page: %#v", first)
+	}
+
+	ctx = api.WithListPage(api.NewContext(), api.ListPage{Limit: 1, Continue: first.Continue})
+	obj, err = registry.ListPredicate(ctx, m)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second := obj.(*api.PodList)
+	if len(second.Items) != 1 || second.Items[0].Name != "foo" || len(second.Continue) != 0 {
+		t.Errorf("unexpected last page: %#v", second)
+	}
+	if second.ResourceVersion != "5" {
+		t.Errorf("expected the resource version of the first page, got %q", second.ResourceVersion)
+	}
+
+	// foo is modified before the client asks for the second page
+	nodes[2].ModifiedIndex = 6
+	ctx = api.WithListPage(api.NewContext(), api.ListPage{Limit: 1, Continue: first.Continue})
+	if _, err := registry.ListPredicate(ctx, m); !errors.IsExpired(err) {
+		t.Errorf("expected the continue token to expire, got %v", err)
+	}
+
+	ctx = api.WithListPage(api.NewContext(), api.ListPage{Limit: 1, Continue: "invalid"})
+	if _, err := registry.ListPredicate(ctx, m); !errors.IsBadRequest(err) {
+		t.Errorf("expected a bad request for an invalid continue token, got %v", err)
+	}
+}
+
+func TestEtcdListWatchCache(t *testing.T) {
+	fakeClient, registry := NewTestGenericEtcdRegistry(t)
+	podA := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}
+	fakeClient.Data["/registry/pods"] = tools.EtcdResponseWithError{
+		R: &etcd.Response{
+			EtcdIndex: 3,
+			Node: &etcd.Node{
+				Dir: true,
+				Nodes: []*etcd.Node{
+					{Key: "/registry/pods/foo", Value: runtime.EncodeOrDie(testapi.Codec(), podA), CreatedIndex: 1, ModifiedIndex: 1},
+				},
+			},
+		},
+	}
+	registry.WatchCache = tools.NewWatchCache(registry.Helper, "/registry/pods", 10)
+	defer registry.WatchCache.Stop()
+	fakeClient.WaitForWatchCompletion()
+
+	// Lists and watches no longer read from etcd.
+	fakeClient.Data["/registry/pods"] = tools.EtcdResponseWithError{E: tools.EtcdErrorNotFound}
+	obj, err := registry.ListPredicate(api.NewContext(), EverythingMatcher{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if list := obj.(*api.PodList); len(list.Items) != 1 || list.Items[0].Name != "foo" || list.ResourceVersion != "3" {
+		t.Errorf("unexpected list: %#v", list)
+	}
+	w, err := registry.WatchPredicate(api.NewContext(), EverythingMatcher{}, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer w.Stop()
+	if event := <-w.ResultChan(); event.Type != watch.Added || event.Object.(*api.Pod).Name != "foo" {
+		t.Errorf("unexpected event: %#v", event)
+	}
+}
+
 func TestEtcdCreate(t *testing.T) {
 	podA := &api.Pod{
 		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault},
@@ -455,6 +545,105 @@ func TestEtcdUpdate(t *testing.T) {
 }
 
 // DEPRECATED
+func TestEtcdPatch(t *testing.T) {
+	podA := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault},
+		Status:     api.PodStatus{Host: "machine"},
+	}
+
+	nodeWithPodA := tools.EtcdResponseWithError{
+		R: &etcd.Response{
+			Node: &etcd.Node{
+				Value:         runtime.EncodeOrDie(testapi.Codec(), podA),
+				ModifiedIndex: 1,
+				CreatedIndex:  1,
+			},
+		},
+		E: nil,
+	}
+
+	emptyNode := tools.EtcdResponseWithError{
+		R: &etcd.Response{},
+		E: tools.EtcdErrorNotFound,
+	}
+
+	path := "/registry/pods/foo"
+
+	table := map[string]struct {
+		existing tools.EtcdResponseWithError
+		patchFn  func(obj runtime.Object) (runtime.Object, error)
+		host     string
+		errOK    func(error) bool
+	}{
+		"normal": {
+			existing: nodeWithPodA,
+			patchFn: func(obj runtime.Object) (runtime.Object, error) {
+				obj.(*api.Pod).Status.Host = "machine2"
+				return obj, nil
+			},
+			host:  "machine2",
+			errOK: func(err error) bool { return err == nil },
+		},
+		"unchanged": {
+			existing: nodeWithPodA,
+			patchFn: func(obj runtime.Object) (runtime.Object, error) {
+				return obj, nil
+			},
+			host:  "machine",
+			errOK: func(err error) bool { return err == nil },
+		},
+		"notExisting": {
+			existing: emptyNode,
+			patchFn: func(obj runtime.Object) (runtime.Object, error) {
+				return obj, nil
+			},
+			errOK: func(err error) bool { return errors.IsNotFound(err) },
+		},
+		"outOfDate": {
+			existing: nodeWithPodA,
+			patchFn: func(obj runtime.Object) (runtime.Object, error) {
+				obj.(*api.Pod).ResourceVersion = "5"
+				return obj, nil
+			},
+			host:  "machine",
+			errOK: func(err error) bool { return errors.IsConflict(err) },
+		},
+		"patchFailed": {
+			existing: nodeWithPodA,
+			patchFn: func(obj runtime.Object) (runtime.Object, error) {
+				return nil, errors.NewBadRequest("invalid patch")
+			},
+			host:  "machine",
+			errOK: func(err error) bool { return errors.IsBadRequest(err) },
+		},
+	}
+
+	for name, item := range table {
+		fakeClient, registry := NewTestGenericEtcdRegistry(t)
+		fakeClient.Data[path] = item.existing
+		obj, err := registry.Patch(api.NewDefaultContext(), "foo", item.patchFn)
+		if !item.errOK(err) {
+			t.Errorf("%v: unexpected error: %v", name, err)
+		}
+		if len(item.host) == 0 {
+			continue
+		}
+
+		actual := fakeClient.Data[path]
+		actualObj, err := api.Scheme.Decode([]byte(actual.R.Node.Value))
+		if err != nil {
+			t.Errorf("%v: unable to decode stored value for %#v", name, actual)
+			continue
+		}
+		if e, a := item.host, actualObj.(*api.Pod).Status.Host; e != a {
+			t.Errorf("%v: expected stored host %q, got %q", name, e, a)
+		}
+		if obj != nil && obj.(*api.Pod).Status.Host != item.host {
+			t.Errorf("%v: unexpected returned: %#v", name, obj)
+		}
+	}
+}
+
 func TestEtcdUpdateWithName(t *testing.T) {
 	podA := &api.Pod{
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
@@ -0,0 +1,86 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest/resttest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+)
+
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.EtcdHelper{Client: fakeEtcdClient, Codec: latest.Codec, ResourceVersioner: tools.RuntimeVersionAdapter{Versioner: latest.ResourceVersioner}}
+	return fakeEtcdClient, helper
+}
+
+func validNewHorizontalPodAutoscaler(name, ns string) *api.HorizontalPodAutoscaler {
+	return &api.HorizontalPodAutoscaler{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+		},
+		Spec: api.HorizontalPodAutoscalerSpec{
+			ScaleRef:                       api.ObjectReference{Kind: "ReplicationController", Name: name},
+			MinReplicas:                    1,
+			MaxReplicas:                    5,
+			TargetCPUUtilizationPercentage: 70,
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	fakeEtcdClient, helper := newHelper(t)
+	storage := NewREST(helper)
+	test := resttest.New(t, storage, fakeEtcdClient.SetError)
+	autoscaler := validNewHorizontalPodAutoscaler("foo", api.NamespaceDefault)
+	autoscaler.ObjectMeta = api.ObjectMeta{}
+	test.TestCreate(
+		// valid
+		autoscaler,
+		// invalid
+		&api.HorizontalPodAutoscaler{
+			ObjectMeta: api.ObjectMeta{Name: "*BadName!"},
+		},
+	)
+}
+
+func TestCreateSetsFields(t *testing.T) {
+	_, helper := newHelper(t)
+	storage := NewREST(helper)
+	autoscaler := validNewHorizontalPodAutoscaler("foo", api.NamespaceDefault)
+	autoscaler.Status.CurrentReplicas = 1
+	autoscaler.Status.DesiredReplicas = 2
+	if _, err := storage.Create(api.NewDefaultContext(), autoscaler); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actual := &api.HorizontalPodAutoscaler{}
+	if err := helper.ExtractObj("/registry/horizontalpodautoscalers/default/foo", actual, false); err != nil {
+		t.Fatalf("unexpected extraction error: %v", err)
+	}
+	if actual.Name != autoscaler.Name {
+		t.Errorf("unexpected autoscaler: %#v", actual)
+	}
+	if actual.Status != (api.HorizontalPodAutoscalerStatus{}) {
+		t.Errorf("expected status to be reset on create: %#v", actual.Status)
+	}
+}
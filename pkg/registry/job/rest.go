@@ -0,0 +1,83 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+)
+
+// jobStrategy implements behavior for Job objects.
+type jobStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating Job
+// objects via the REST API.
+var Strategy = jobStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped is true for jobs.
+func (jobStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// ResetBeforeCreate clears fields that are not allowed to be set by end users on creation.
+func (jobStrategy) ResetBeforeCreate(obj runtime.Object) {
+	job := obj.(*api.Job)
+	job.Status = api.JobStatus{}
+}
+
+// Validate validates a new job.
+func (jobStrategy) Validate(obj runtime.Object) errors.ValidationErrorList {
+	return validation.ValidateJob(obj.(*api.Job))
+}
+
+// AllowCreateOnUpdate is false for jobs.
+func (jobStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (jobStrategy) ValidateUpdate(obj, old runtime.Object) errors.ValidationErrorList {
+	return validation.ValidateJobUpdate(old.(*api.Job), obj.(*api.Job))
+}
+
+// MatchJob returns a generic matcher for a given label and field selector.
+func MatchJob(label, field labels.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		job, ok := obj.(*api.Job)
+		if !ok {
+			return false, fmt.Errorf("not a job")
+		}
+		fields := JobToSelectableFields(job)
+		return label.Matches(labels.Set(job.Labels)) && field.Matches(fields), nil
+	})
+}
+
+// JobToSelectableFields returns a label set that represents the object.
+func JobToSelectableFields(job *api.Job) labels.Set {
+	return labels.Set{
+		"name": job.Name,
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
 )
 
@@ -68,6 +69,11 @@ func (rs *REST) Update(ctx api.Context, obj runtime.Object) (runtime.Object, boo
 	}
 
 	oldNamespace := oldObj.(*api.Namespace)
+
+	// Finalizers and status are managed by the system, through the finalize
+	// subresource and graceful deletion respectively.
+	namespace.Spec.Finalizers = oldNamespace.Spec.Finalizers
+	namespace.Status = oldNamespace.Status
 	if errs := validation.ValidateNamespaceUpdate(oldNamespace, namespace); len(errs) > 0 {
 		return nil, false, kerrors.NewInvalid("namespace", namespace.Name, errs)
 	}
@@ -79,16 +85,35 @@ func (rs *REST) Update(ctx api.Context, obj runtime.Object) (runtime.Object, boo
 	return out, false, err
 }
 
-// Delete deletes the Namespace with the specified name
+// Delete enforces life-cycle rules for namespace termination. The first request
+// moves the namespace into the Terminating phase; the namespace is only removed
+// from storage once every finalizer has released it.
 func (rs *REST) Delete(ctx api.Context, name string) (runtime.Object, error) {
 	obj, err := rs.registry.Get(ctx, name)
 	if err != nil {
 		return nil, err
 	}
-	_, ok := obj.(*api.Namespace)
+	namespace, ok := obj.(*api.Namespace)
 	if !ok {
 		return nil, fmt.Errorf("invalid object type")
 	}
+
+	// upon first request to delete, we switch the phase to start namespace termination
+	if namespace.DeletionTimestamp == nil {
+		now := util.Now()
+		namespace.DeletionTimestamp = &now
+		namespace.Status.Phase = api.NamespaceTerminating
+		if err := rs.registry.UpdateWithName(ctx, namespace.Name, namespace); err != nil {
+			return nil, err
+		}
+		return rs.registry.Get(ctx, namespace.Name)
+	}
+
+	// prior to final deletion, we must ensure that finalizers is empty
+	if len(namespace.Spec.Finalizers) != 0 {
+		err = kerrors.NewConflict("namespace", namespace.Name, fmt.Errorf("namespace %v termination is in progress, waiting for %v", namespace.Name, namespace.Spec.Finalizers))
+		return nil, err
+	}
 	return rs.registry.Delete(ctx, name)
 }
 
@@ -124,3 +149,45 @@ func (*REST) New() runtime.Object {
 func (*REST) NewList() runtime.Object {
 	return &api.NamespaceList{}
 }
+
+// FinalizeREST implements the REST endpoint for finalizing a namespace.
+type FinalizeREST struct {
+	registry generic.Registry
+}
+
+// NewFinalizeREST returns a FinalizeREST backed by the same registry as NewREST.
+func NewFinalizeREST(registry generic.Registry) *FinalizeREST {
+	return &FinalizeREST{
+		registry: registry,
+	}
+}
+
+// New returns a new api.Namespace
+func (*FinalizeREST) New() runtime.Object {
+	return &api.Namespace{}
+}
+
+// Update alters the finalizers of a namespace, leaving the rest of it untouched.
+func (r *FinalizeREST) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool, error) {
+	namespace, ok := obj.(*api.Namespace)
+	if !ok {
+		return nil, false, fmt.Errorf("not a namespace: %#v", obj)
+	}
+
+	oldObj, err := r.registry.Get(ctx, namespace.Name)
+	if err != nil {
+		return nil, false, err
+	}
+
+	oldNamespace := oldObj.(*api.Namespace)
+	if errs := validation.ValidateNamespaceFinalizeUpdate(oldNamespace, namespace); len(errs) > 0 {
+		return nil, false, kerrors.NewInvalid("namespace", namespace.Name, errs)
+	}
+	oldNamespace.Spec.Finalizers = namespace.Spec.Finalizers
+
+	if err := r.registry.UpdateWithName(ctx, oldNamespace.Name, oldNamespace); err != nil {
+		return nil, false, err
+	}
+	out, err := r.registry.Get(ctx, oldNamespace.Name)
+	return out, false, err
+}
@@ -122,15 +122,66 @@ func TestRESTDelete(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Unexpected error %v", err)
 	}
+	if namespaceA.Status.Phase != api.NamespaceActive {
+		t.Errorf("expected a new namespace to be active, got %v", namespaceA.Status.Phase)
+	}
+	if e, a := []api.FinalizerName{api.FinalizerKubernetes}, namespaceA.Spec.Finalizers; !reflect.DeepEqual(e, a) {
+		t.Errorf("expected finalizers %v, got %v", e, a)
+	}
+
+	// the first delete marks the namespace as terminating
 	c, err := rest.Delete(api.NewContext(), namespaceA.Name)
 	if err != nil {
 		t.Fatalf("Unexpected error %v", err)
 	}
+	terminating := c.(*api.Namespace)
+	if terminating.Status.Phase != api.NamespaceTerminating {
+		t.Errorf("expected namespace to be terminating, got %v", terminating.Status.Phase)
+	}
+	if terminating.DeletionTimestamp == nil {
+		t.Errorf("expected a deletion timestamp to be set")
+	}
+
+	// it may not be removed while finalizers remain
+	if _, err := rest.Delete(api.NewContext(), namespaceA.Name); err == nil {
+		t.Errorf("expected a conflict while finalizers are pending")
+	}
+
+	finalized := testNamespace("foo")
+	if _, _, err := NewFinalizeREST(rest.registry).Update(api.NewContext(), finalized); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	c, err = rest.Delete(api.NewContext(), namespaceA.Name)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
 	if stat := c.(*api.Status); stat.Status != api.StatusSuccess {
 		t.Errorf("unexpected status: %v", stat)
 	}
 }
 
+func TestRESTUpdateIgnoresFinalizersAndStatus(t *testing.T) {
+	_, rest := NewTestREST()
+	namespaceA := testNamespace("foo")
+	if _, err := rest.Create(api.NewContext(), namespaceA); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	namespaceB := testNamespace("foo")
+	namespaceB.Spec.Finalizers = []api.FinalizerName{"example.com/other"}
+	namespaceB.Status.Phase = api.NamespaceTerminating
+	got, _, err := rest.Update(api.NewContext(), namespaceB)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	updated := got.(*api.Namespace)
+	if e, a := []api.FinalizerName{api.FinalizerKubernetes}, updated.Spec.Finalizers; !reflect.DeepEqual(e, a) {
+		t.Errorf("expected finalizers %v, got %v", e, a)
+	}
+	if updated.Status.Phase != api.NamespaceActive {
+		t.Errorf("expected namespace to remain active, got %v", updated.Status.Phase)
+	}
+}
+
 func TestRESTGet(t *testing.T) {
 	_, rest := NewTestREST()
 	namespaceA := testNamespace("foo")
@@ -0,0 +1,142 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+
+	"github.com/coreos/go-etcd/etcd"
+)
+
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.EtcdHelper{Client: fakeEtcdClient, Codec: latest.Codec, ResourceVersioner: tools.RuntimeVersionAdapter{Versioner: latest.ResourceVersioner}}
+	return fakeEtcdClient, helper
+}
+
+func validNewPersistentVolume(name string) *api.PersistentVolume {
+	return &api.PersistentVolume{
+		ObjectMeta: api.ObjectMeta{
+			Name: name,
+		},
+		Spec: api.PersistentVolumeSpec{
+			Capacity: api.ResourceList{
+				api.ResourceStorage: resource.MustParse("10G"),
+			},
+			AccessModes: []api.AccessModeType{api.ReadWriteOnce},
+			PersistentVolumeSource: api.PersistentVolumeSource{
+				HostPath: &api.HostPathVolumeSource{Path: "/foo"},
+			},
+		},
+	}
+}
+
+func TestCreateSetsFields(t *testing.T) {
+	_, helper := newHelper(t)
+	storage := NewREST(helper)
+	pv := validNewPersistentVolume("foo")
+	pv.Namespace = "ignored"
+	pv.Status.Phase = api.VolumeBound
+	if _, err := storage.Create(api.NewDefaultContext(), pv); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actual := &api.PersistentVolume{}
+	if err := helper.ExtractObj("/registry/persistentvolumes/foo", actual, false); err != nil {
+		t.Fatalf("unexpected extraction error: %v", err)
+	}
+	if actual.Name != pv.Name || len(actual.Namespace) != 0 {
+		t.Errorf("unexpected persistent volume: %#v", actual)
+	}
+	if len(actual.UID) == 0 {
+		t.Errorf("expected persistent volume UID to be set: %#v", actual)
+	}
+	if actual.Status.Phase != api.VolumePending {
+		t.Errorf("expected status to be reset on create: %#v", actual.Status)
+	}
+}
+
+func TestCreateInvalid(t *testing.T) {
+	_, helper := newHelper(t)
+	storage := NewREST(helper)
+	pv := validNewPersistentVolume("foo")
+	pv.Spec.AccessModes = nil
+	_, err := storage.Create(api.NewDefaultContext(), pv)
+	if !errors.IsInvalid(err) {
+		t.Errorf("expected invalid error, got %v", err)
+	}
+}
+
+func TestUpdateBindsClaim(t *testing.T) {
+	_, helper := newHelper(t)
+	storage := NewREST(helper)
+	ctx := api.NewContext()
+	obj, err := storage.Create(ctx, validNewPersistentVolume("foo"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pv := obj.(*api.PersistentVolume)
+	pv.Spec.ClaimRef = &api.ObjectReference{Namespace: "default", Name: "claim"}
+	pv.Status.Phase = api.VolumeBound
+	if _, _, err := storage.Update(ctx, pv); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	obj, err = storage.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	actual := obj.(*api.PersistentVolume)
+	if actual.Spec.ClaimRef == nil || actual.Spec.ClaimRef.Name != "claim" || actual.Status.Phase != api.VolumeBound {
+		t.Errorf("unexpected persistent volume: %#v", actual)
+	}
+}
+
+func TestListFiltersByPhase(t *testing.T) {
+	fakeEtcdClient, helper := newHelper(t)
+	storage := NewREST(helper)
+	available := validNewPersistentVolume("bar")
+	available.Status.Phase = api.VolumeAvailable
+	fakeEtcdClient.Data["/registry/persistentvolumes"] = tools.EtcdResponseWithError{
+		R: &etcd.Response{
+			Node: &etcd.Node{
+				Nodes: []*etcd.Node{
+					{Value: runtime.EncodeOrDie(latest.Codec, validNewPersistentVolume("foo"))},
+					{Value: runtime.EncodeOrDie(latest.Codec, available)},
+				},
+			},
+		},
+	}
+
+	obj, err := storage.List(api.NewContext(), labels.Everything(), labels.SelectorFromSet(labels.Set{"Status.Phase": string(api.VolumeAvailable)}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	list := obj.(*api.PersistentVolumeList)
+	if len(list.Items) != 1 || list.Items[0].Name != "bar" {
+		t.Errorf("unexpected list: %#v", list)
+	}
+}
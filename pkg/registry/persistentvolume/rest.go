@@ -0,0 +1,86 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistentvolume
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+)
+
+// persistentVolumeStrategy implements behavior for PersistentVolume objects.
+type persistentVolumeStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating PersistentVolume
+// objects via the REST API.
+var Strategy = persistentVolumeStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped is false for persistent volumes.
+func (persistentVolumeStrategy) NamespaceScoped() bool {
+	return false
+}
+
+// ResetBeforeCreate clears fields that are not allowed to be set by end users on creation.
+func (persistentVolumeStrategy) ResetBeforeCreate(obj runtime.Object) {
+	pv := obj.(*api.PersistentVolume)
+	pv.Status = api.PersistentVolumeStatus{
+		Phase: api.VolumePending,
+	}
+}
+
+// Validate validates a new persistent volume.
+func (persistentVolumeStrategy) Validate(obj runtime.Object) errors.ValidationErrorList {
+	return validation.ValidatePersistentVolume(obj.(*api.PersistentVolume))
+}
+
+// AllowCreateOnUpdate is false for persistent volumes.
+func (persistentVolumeStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (persistentVolumeStrategy) ValidateUpdate(obj, old runtime.Object) errors.ValidationErrorList {
+	return validation.ValidatePersistentVolumeUpdate(obj.(*api.PersistentVolume), old.(*api.PersistentVolume))
+}
+
+// MatchPersistentVolume returns a generic matcher for a given label and field selector.
+func MatchPersistentVolume(label, field labels.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		pv, ok := obj.(*api.PersistentVolume)
+		if !ok {
+			return false, fmt.Errorf("not a persistent volume")
+		}
+		fields := PersistentVolumeToSelectableFields(pv)
+		return label.Matches(labels.Set(pv.Labels)) && field.Matches(fields), nil
+	})
+}
+
+// PersistentVolumeToSelectableFields returns a label set that represents the object.
+func PersistentVolumeToSelectableFields(pv *api.PersistentVolume) labels.Set {
+	return labels.Set{
+		"name":         pv.Name,
+		"Status.Phase": string(pv.Status.Phase),
+	}
+}
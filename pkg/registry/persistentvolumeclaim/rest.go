@@ -0,0 +1,86 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistentvolumeclaim
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+)
+
+// persistentVolumeClaimStrategy implements behavior for PersistentVolumeClaim objects.
+type persistentVolumeClaimStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating PersistentVolumeClaim
+// objects via the REST API.
+var Strategy = persistentVolumeClaimStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped is true for persistent volume claims.
+func (persistentVolumeClaimStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// ResetBeforeCreate clears fields that are not allowed to be set by end users on creation.
+func (persistentVolumeClaimStrategy) ResetBeforeCreate(obj runtime.Object) {
+	pvc := obj.(*api.PersistentVolumeClaim)
+	pvc.Status = api.PersistentVolumeClaimStatus{
+		Phase: api.ClaimPending,
+	}
+}
+
+// Validate validates a new persistent volume claim.
+func (persistentVolumeClaimStrategy) Validate(obj runtime.Object) errors.ValidationErrorList {
+	return validation.ValidatePersistentVolumeClaim(obj.(*api.PersistentVolumeClaim))
+}
+
+// AllowCreateOnUpdate is false for persistent volume claims.
+func (persistentVolumeClaimStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (persistentVolumeClaimStrategy) ValidateUpdate(obj, old runtime.Object) errors.ValidationErrorList {
+	return validation.ValidatePersistentVolumeClaimUpdate(obj.(*api.PersistentVolumeClaim), old.(*api.PersistentVolumeClaim))
+}
+
+// MatchPersistentVolumeClaim returns a generic matcher for a given label and field selector.
+func MatchPersistentVolumeClaim(label, field labels.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		pvc, ok := obj.(*api.PersistentVolumeClaim)
+		if !ok {
+			return false, fmt.Errorf("not a persistent volume claim")
+		}
+		fields := PersistentVolumeClaimToSelectableFields(pvc)
+		return label.Matches(labels.Set(pvc.Labels)) && field.Matches(fields), nil
+	})
+}
+
+// PersistentVolumeClaimToSelectableFields returns a label set that represents the object.
+func PersistentVolumeClaimToSelectableFields(pvc *api.PersistentVolumeClaim) labels.Set {
+	return labels.Set{
+		"name":         pvc.Name,
+		"Status.Phase": string(pvc.Status.Phase),
+	}
+}
@@ -18,6 +18,7 @@ package etcd
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
@@ -30,6 +31,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/pod"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
 
 	"github.com/golang/glog"
@@ -85,6 +87,14 @@ func (r *REST) WithPodStatus(cache pod.PodStatusGetter) *REST {
 	return &REST{store: &store}
 }
 
+// WithWatchCache returns a rest object that serves lists and watches of pods from a watch
+// cache remembering the last capacity changes to them.
+func (r *REST) WithWatchCache(capacity int) *REST {
+	store := *r.store
+	store.WatchCache = tools.NewWatchCache(store.Helper, store.KeyRootFunc(api.NewContext()), capacity)
+	return &REST{store: &store}
+}
+
 // New returns a new object
 func (r *REST) New() runtime.Object {
 	return r.store.NewFunc()
@@ -120,9 +130,58 @@ func (r *REST) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool
 	return r.store.Update(ctx, obj)
 }
 
-// Delete deletes an existing pod specified by its ID.
-func (r *REST) Delete(ctx api.Context, name string) (runtime.Object, error) {
-	return r.store.Delete(ctx, name)
+// Patch applies a change to the current pod specification.
+func (r *REST) Patch(ctx api.Context, name string, patchFn rest.PatchFunc) (runtime.Object, error) {
+	return r.store.Patch(ctx, name, patchFn)
+}
+
+// Delete marks an existing pod specified by its ID for deletion. Pods that are
+// running on a host are given a grace period to terminate, after which the
+// kubelet removes them; all other pods are removed immediately.
+func (r *REST) Delete(ctx api.Context, name string, options *api.DeleteOptions) (runtime.Object, error) {
+	if options != nil && options.GracePeriodSeconds != nil && *options.GracePeriodSeconds < 0 {
+		return nil, errors.NewInvalid("pod", name, errors.ValidationErrorList{errors.NewFieldInvalid("gracePeriodSeconds", *options.GracePeriodSeconds, "must be non-negative")})
+	}
+	key, err := r.store.KeyFunc(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	current := &api.Pod{}
+	if err := r.store.Helper.ExtractObj(key, current, false); err != nil {
+		return nil, etcderr.InterpretGetError(err, r.store.EndpointName, name)
+	}
+	if pod.GracePeriod(current, options) == 0 {
+		return r.store.Delete(ctx, name)
+	}
+
+	var out *api.Pod
+	err = r.store.Helper.AtomicUpdate(key, &api.Pod{}, false, func(obj runtime.Object) (runtime.Object, error) {
+		existing, ok := obj.(*api.Pod)
+		if !ok {
+			return nil, fmt.Errorf("unexpected object: %#v", obj)
+		}
+		deadline := util.NewTime(util.Now().Add(time.Duration(pod.GracePeriod(existing, options)) * time.Second))
+		// Only ever shorten the time remaining for a pod that is already terminating.
+		if existing.DeletionTimestamp == nil || deadline.Before(*existing.DeletionTimestamp) {
+			existing.DeletionTimestamp = &deadline
+		}
+		out = existing
+		return existing, nil
+	})
+	if err != nil {
+		return nil, etcderr.InterpretUpdateError(err, r.store.EndpointName, name)
+	}
+	if r.store.AfterUpdate != nil {
+		if err := r.store.AfterUpdate(out); err != nil {
+			return nil, err
+		}
+	}
+	if r.store.Decorator != nil {
+		if err := r.store.Decorator(out); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
 }
 
 // ResourceLocation returns a pods location from its HostIP
@@ -228,6 +287,9 @@ func (h *podLifecycle) AfterUpdate(obj runtime.Object) error {
 		for ix := range boundPods.Items {
 			if boundPods.Items[ix].Name == pod.Name && boundPods.Items[ix].Namespace == pod.Namespace {
 				boundPods.Items[ix].Spec = pod.Spec
+				boundPods.Items[ix].DeletionTimestamp = pod.DeletionTimestamp
+				boundPods.Items[ix].Labels = pod.Labels
+				boundPods.Items[ix].Annotations = pod.Annotations
 				return boundPods, nil
 			}
 		}
@@ -711,7 +711,7 @@ func TestDeletePod(t *testing.T) {
 	cache := &fakeCache{statusToReturn: &api.PodStatus{}}
 	storage = storage.WithPodStatus(cache)
 
-	result, err := storage.Delete(api.NewDefaultContext(), "foo")
+	result, err := storage.Delete(api.NewDefaultContext(), "foo", api.NewDeleteOptions(0))
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -1377,7 +1377,7 @@ func TestEtcdDeletePod(t *testing.T) {
 			{ObjectMeta: api.ObjectMeta{Name: "foo"}},
 		},
 	}), 0)
-	_, err := registry.Delete(ctx, "foo")
+	_, err := registry.Delete(ctx, "foo", api.NewDeleteOptions(0))
 	if err != nil {
 		t.Errorf("unexpected error: %v", err)
 	}
@@ -1413,7 +1413,7 @@ func TestEtcdDeletePodMultipleContainers(t *testing.T) {
 			{ObjectMeta: api.ObjectMeta{Name: "bar"}},
 		},
 	}), 0)
-	_, err := registry.Delete(ctx, "foo")
+	_, err := registry.Delete(ctx, "foo", api.NewDeleteOptions(0))
 	if err != nil {
 		t.Errorf("unexpected error: %v", err)
 	}
@@ -1438,6 +1438,69 @@ func TestEtcdDeletePodMultipleContainers(t *testing.T) {
 	}
 }
 
+func TestEtcdDeletePodGraceful(t *testing.T) {
+	registry, _, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	fakeClient.TestIndex = true
+
+	key, _ := registry.store.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo"},
+		Status:     api.PodStatus{Host: "machine"},
+	}), 0)
+	fakeClient.Set("/registry/nodes/machine/boundpods", runtime.EncodeOrDie(latest.Codec, &api.BoundPods{
+		Items: []api.BoundPod{
+			{ObjectMeta: api.ObjectMeta{Name: "foo"}},
+		},
+	}), 0)
+	obj, err := registry.Delete(ctx, "foo", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fakeClient.DeletedKeys) != 0 {
+		t.Errorf("Expected no deletes, found %#v", fakeClient.DeletedKeys)
+	}
+	pod := obj.(*api.Pod)
+	if pod.DeletionTimestamp == nil {
+		t.Fatalf("Expected deletion timestamp to be set: %#v", pod)
+	}
+	if pod.DeletionTimestamp.Before(util.Now()) {
+		t.Errorf("Expected deletion timestamp in the future: %v", pod.DeletionTimestamp)
+	}
+
+	var boundPods api.BoundPods
+	response, err := fakeClient.Get("/registry/nodes/machine/boundpods", false, false)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	latest.Codec.DecodeInto([]byte(response.Node.Value), &boundPods)
+	if len(boundPods.Items) != 1 || boundPods.Items[0].DeletionTimestamp == nil {
+		t.Errorf("Expected bound pod to be marked for deletion: %s", response.Node.Value)
+	}
+
+	// a second delete with a shorter grace period removes the pod at once
+	if _, err := registry.Delete(ctx, "foo", api.NewDeleteOptions(0)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fakeClient.DeletedKeys) != 1 || fakeClient.DeletedKeys[0] != key {
+		t.Errorf("Expected %s to be deleted, found %#v", key, fakeClient.DeletedKeys)
+	}
+}
+
+func TestEtcdDeletePodNegativeGracePeriod(t *testing.T) {
+	registry, _, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	key, _ := registry.store.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo"},
+		Status:     api.PodStatus{Host: "machine"},
+	}), 0)
+	_, err := registry.Delete(ctx, "foo", api.NewDeleteOptions(-1))
+	if !errors.IsInvalid(err) {
+		t.Errorf("Expected invalid error, got %v", err)
+	}
+}
+
 func TestEtcdEmptyList(t *testing.T) {
 	registry, _, _, fakeClient, _ := newStorage(t)
 	ctx := api.NewDefaultContext()
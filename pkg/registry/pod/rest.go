@@ -81,6 +81,22 @@ func (podStatusStrategy) ValidateUpdate(obj, old runtime.Object) errors.Validati
 	return validation.ValidatePodStatusUpdate(obj.(*api.Pod), old.(*api.Pod))
 }
 
+// GracePeriod returns the number of seconds a pod should be given to shut down
+// before it is removed from storage. Pods that have not been scheduled have no
+// running containers to stop and are deleted immediately.
+func GracePeriod(pod *api.Pod, options *api.DeleteOptions) int64 {
+	if len(pod.Status.Host) == 0 {
+		return 0
+	}
+	if options != nil && options.GracePeriodSeconds != nil {
+		return *options.GracePeriodSeconds
+	}
+	if pod.Spec.TerminationGracePeriodSeconds != nil {
+		return *pod.Spec.TerminationGracePeriodSeconds
+	}
+	return api.DefaultTerminationGracePeriodSeconds
+}
+
 // PodStatusGetter is an interface used by Pods to fetch and retrieve status info.
 type PodStatusGetter interface {
 	GetPodStatus(namespace, name string) (*api.PodStatus, error)
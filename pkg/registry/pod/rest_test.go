@@ -76,3 +76,24 @@ func TestPodStatusDecoratorError(t *testing.T) {
 		t.Errorf("unexpected pod: %#v", pod)
 	}
 }
+
+func TestGracePeriod(t *testing.T) {
+	specGrace := int64(60)
+	optionsGrace := int64(5)
+	tests := []struct {
+		pod      *api.Pod
+		options  *api.DeleteOptions
+		expected int64
+	}{
+		{&api.Pod{}, nil, 0},
+		{&api.Pod{}, &api.DeleteOptions{GracePeriodSeconds: &optionsGrace}, 0},
+		{&api.Pod{Status: api.PodStatus{Host: "machine"}}, nil, api.DefaultTerminationGracePeriodSeconds},
+		{&api.Pod{Spec: api.PodSpec{TerminationGracePeriodSeconds: &specGrace}, Status: api.PodStatus{Host: "machine"}}, nil, 60},
+		{&api.Pod{Spec: api.PodSpec{TerminationGracePeriodSeconds: &specGrace}, Status: api.PodStatus{Host: "machine"}}, &api.DeleteOptions{GracePeriodSeconds: &optionsGrace}, 5},
+	}
+	for i, test := range tests {
+		if actual := GracePeriod(test.pod, test.options); actual != test.expected {
+			t.Errorf("%d: expected %d, got %d", i, test.expected, actual)
+		}
+	}
+}
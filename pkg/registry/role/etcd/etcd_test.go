@@ -0,0 +1,119 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authorizer"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/user"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+)
+
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.EtcdHelper{Client: fakeEtcdClient, Codec: latest.Codec, ResourceVersioner: tools.RuntimeVersionAdapter{Versioner: latest.ResourceVersioner}}
+	return fakeEtcdClient, helper
+}
+
+// verbAuthorizer allows requests with its verb only.
+type verbAuthorizer string
+
+func (v verbAuthorizer) Authorize(a authorizer.Attributes) error {
+	if a.GetVerb() != string(v) {
+		return fmt.Errorf("%s is not allowed", a.GetVerb())
+	}
+	return nil
+}
+
+func validNewRole(name string) *api.Role {
+	return &api.Role{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: api.NamespaceDefault,
+		},
+		Rules: []api.PolicyRule{
+			{Verbs: []string{"get", "list", "watch"}, Resources: []string{"pods"}},
+		},
+	}
+}
+
+func TestCreateSetsFields(t *testing.T) {
+	_, helper := newHelper(t)
+	storage := NewREST(helper, nil)
+	role := validNewRole("reader")
+	if _, err := storage.Create(api.NewDefaultContext(), role); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actual := &api.Role{}
+	if err := helper.ExtractObj("/registry/roles/default/reader", actual, false); err != nil {
+		t.Fatalf("unexpected extraction error: %v", err)
+	}
+	if actual.Name != role.Name || actual.Namespace != api.NamespaceDefault {
+		t.Errorf("unexpected role: %#v", actual)
+	}
+	if len(actual.UID) == 0 {
+		t.Errorf("expected role UID to be set: %#v", actual)
+	}
+	if len(actual.Rules) != 1 || actual.Rules[0].Resources[0] != "pods" {
+		t.Errorf("unexpected role: %#v", actual)
+	}
+}
+
+func TestCreateInvalid(t *testing.T) {
+	_, helper := newHelper(t)
+	storage := NewREST(helper, nil)
+	for _, role := range []*api.Role{
+		validNewRole("Reader"),
+		{ObjectMeta: api.ObjectMeta{Name: "reader", Namespace: api.NamespaceDefault}, Rules: []api.PolicyRule{{Resources: []string{"pods"}}}},
+	} {
+		_, err := storage.Create(api.NewDefaultContext(), role)
+		if !errors.IsInvalid(err) {
+			t.Errorf("expected invalid error for %#v, got %v", role, err)
+		}
+	}
+}
+
+func TestCreateEscalation(t *testing.T) {
+	fakeEtcdClient, helper := newHelper(t)
+	storage := NewREST(helper, verbAuthorizer("get"))
+	ctx := api.WithUser(api.NewDefaultContext(), &user.DefaultInfo{Name: "alice"})
+
+	_, err := storage.Create(ctx, validNewRole("reader"))
+	if !errors.IsForbidden(err) {
+		t.Fatalf("expected forbidden error, got %v", err)
+	}
+	if len(fakeEtcdClient.Data) != 0 {
+		t.Errorf("unexpected stored data: %#v", fakeEtcdClient.Data)
+	}
+
+	role := validNewRole("getter")
+	role.Rules[0].Verbs = []string{"get"}
+	if _, err := storage.Create(ctx, role); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	role.Rules[0].Verbs = []string{"get", "delete"}
+	if _, _, err := storage.Update(ctx, role); !errors.IsForbidden(err) {
+		t.Errorf("expected forbidden error, got %v", err)
+	}
+}
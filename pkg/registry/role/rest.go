@@ -0,0 +1,81 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package role
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+)
+
+// roleStrategy implements behavior for Role objects.
+type roleStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating Role
+// objects via the REST API.
+var Strategy = roleStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped is true for roles.
+func (roleStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// ResetBeforeCreate clears fields that are not allowed to be set by end users on creation.
+func (roleStrategy) ResetBeforeCreate(obj runtime.Object) {
+}
+
+// Validate validates a new role.
+func (roleStrategy) Validate(obj runtime.Object) errors.ValidationErrorList {
+	return validation.ValidateRole(obj.(*api.Role))
+}
+
+// AllowCreateOnUpdate is false for roles.
+func (roleStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (roleStrategy) ValidateUpdate(obj, old runtime.Object) errors.ValidationErrorList {
+	return validation.ValidateRoleUpdate(old.(*api.Role), obj.(*api.Role))
+}
+
+// MatchRole returns a generic matcher for a given label and field selector.
+func MatchRole(label, field labels.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		role, ok := obj.(*api.Role)
+		if !ok {
+			return false, fmt.Errorf("not a role")
+		}
+		fields := RoleToSelectableFields(role)
+		return label.Matches(labels.Set(role.Labels)) && field.Matches(fields), nil
+	})
+}
+
+// RoleToSelectableFields returns a label set that represents the object.
+func RoleToSelectableFields(role *api.Role) labels.Set {
+	return labels.Set{
+		"name": role.Name,
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authorizer"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/user"
+	clusterroleetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/clusterrole/etcd"
+	roleetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/role/etcd"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+)
+
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.EtcdHelper{Client: fakeEtcdClient, Codec: latest.Codec, ResourceVersioner: tools.RuntimeVersionAdapter{Versioner: latest.ResourceVersioner}}
+	return fakeEtcdClient, helper
+}
+
+// verbAuthorizer allows requests with its verb only.
+type verbAuthorizer string
+
+func (v verbAuthorizer) Authorize(a authorizer.Attributes) error {
+	if a.GetVerb() != string(v) {
+		return fmt.Errorf("%s is not allowed", a.GetVerb())
+	}
+	return nil
+}
+
+func validNewRoleBinding(name string) *api.RoleBinding {
+	return &api.RoleBinding{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: api.NamespaceDefault,
+		},
+		Subjects: []api.Subject{{Kind: "User", Name: "alice"}},
+		RoleRef:  api.RoleRef{Kind: "Role", Name: "reader"},
+	}
+}
+
+func TestCreateSetsFields(t *testing.T) {
+	_, helper := newHelper(t)
+	storage := NewREST(helper, nil, nil, nil)
+	roleBinding := validNewRoleBinding("read-pods")
+	if _, err := storage.Create(api.NewDefaultContext(), roleBinding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actual := &api.RoleBinding{}
+	if err := helper.ExtractObj("/registry/rolebindings/default/read-pods", actual, false); err != nil {
+		t.Fatalf("unexpected extraction error: %v", err)
+	}
+	if actual.Name != roleBinding.Name || actual.Namespace != api.NamespaceDefault {
+		t.Errorf("unexpected role binding: %#v", actual)
+	}
+	if len(actual.UID) == 0 {
+		t.Errorf("expected role binding UID to be set: %#v", actual)
+	}
+	if len(actual.Subjects) != 1 || actual.RoleRef.Name != "reader" {
+		t.Errorf("unexpected role binding: %#v", actual)
+	}
+}
+
+func TestCreateInvalid(t *testing.T) {
+	_, helper := newHelper(t)
+	storage := NewREST(helper, nil, nil, nil)
+	for _, roleBinding := range []*api.RoleBinding{
+		validNewRoleBinding("Read-pods"),
+		{ObjectMeta: api.ObjectMeta{Name: "read-pods", Namespace: api.NamespaceDefault}, RoleRef: api.RoleRef{Kind: "Role", Name: "reader"}},
+	} {
+		_, err := storage.Create(api.NewDefaultContext(), roleBinding)
+		if !errors.IsInvalid(err) {
+			t.Errorf("expected invalid error for %#v, got %v", roleBinding, err)
+		}
+	}
+}
+
+func TestCreateEscalation(t *testing.T) {
+	fakeEtcdClient, helper := newHelper(t)
+	fakeEtcdClient.ExpectNotFoundGet("/registry/roles/default/reader")
+	roles := roleetcd.NewREST(helper, nil)
+	storage := NewREST(helper, verbAuthorizer("get"), roles, clusterroleetcd.NewREST(helper, nil))
+	ctx := api.WithUser(api.NewDefaultContext(), &user.DefaultInfo{Name: "alice"})
+
+	if _, err := storage.Create(ctx, validNewRoleBinding("read-pods")); !errors.IsForbidden(err) {
+		t.Fatalf("expected forbidden error for a missing role, got %v", err)
+	}
+
+	role := &api.Role{
+		ObjectMeta: api.ObjectMeta{Name: "reader", Namespace: api.NamespaceDefault},
+		Rules:      []api.PolicyRule{{Verbs: []string{"get", "list"}, Resources: []string{"pods"}}},
+	}
+	if _, err := roles.Create(api.NewDefaultContext(), role); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := storage.Create(ctx, validNewRoleBinding("read-pods")); !errors.IsForbidden(err) {
+		t.Fatalf("expected forbidden error, got %v", err)
+	}
+
+	role.Name = "getter"
+	role.Rules[0].Verbs = []string{"get"}
+	if _, err := roles.Create(api.NewDefaultContext(), role); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	roleBinding := validNewRoleBinding("get-pods")
+	roleBinding.RoleRef.Name = "getter"
+	if _, err := storage.Create(ctx, roleBinding); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
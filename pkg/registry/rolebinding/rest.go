@@ -0,0 +1,81 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rolebinding
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+)
+
+// roleBindingStrategy implements behavior for RoleBinding objects.
+type roleBindingStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating RoleBinding
+// objects via the REST API.
+var Strategy = roleBindingStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped is true for role bindings.
+func (roleBindingStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// ResetBeforeCreate clears fields that are not allowed to be set by end users on creation.
+func (roleBindingStrategy) ResetBeforeCreate(obj runtime.Object) {
+}
+
+// Validate validates a new role binding.
+func (roleBindingStrategy) Validate(obj runtime.Object) errors.ValidationErrorList {
+	return validation.ValidateRoleBinding(obj.(*api.RoleBinding))
+}
+
+// AllowCreateOnUpdate is false for role bindings.
+func (roleBindingStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (roleBindingStrategy) ValidateUpdate(obj, old runtime.Object) errors.ValidationErrorList {
+	return validation.ValidateRoleBindingUpdate(old.(*api.RoleBinding), obj.(*api.RoleBinding))
+}
+
+// MatchRoleBinding returns a generic matcher for a given label and field selector.
+func MatchRoleBinding(label, field labels.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		roleBinding, ok := obj.(*api.RoleBinding)
+		if !ok {
+			return false, fmt.Errorf("not a role binding")
+		}
+		fields := RoleBindingToSelectableFields(roleBinding)
+		return label.Matches(labels.Set(roleBinding.Labels)) && field.Matches(fields), nil
+	})
+}
+
+// RoleBindingToSelectableFields returns a label set that represents the object.
+func RoleBindingToSelectableFields(roleBinding *api.RoleBinding) labels.Set {
+	return labels.Set{
+		"name": roleBinding.Name,
+	}
+}
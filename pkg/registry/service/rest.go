@@ -223,12 +223,14 @@ func (rs *REST) ResourceLocation(ctx api.Context, id string) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	if len(eps.Endpoints) == 0 {
+	// TODO: Allow the caller to pick a port by name; until then, use the first one.
+	if len(eps.Ports) == 0 || len(eps.Ports[0].Endpoints) == 0 {
 		return "", fmt.Errorf("no endpoints available for %v", id)
 	}
+	endpoints := eps.Ports[0].Endpoints
 	// We leave off the scheme ('http://') because we have no idea what sort of server
 	// is listening at this endpoint.
-	ep := &eps.Endpoints[rand.Intn(len(eps.Endpoints))]
+	ep := &endpoints[rand.Intn(len(endpoints))]
 	return net.JoinHostPort(ep.IP, strconv.Itoa(ep.Port)), nil
 }
 
@@ -240,9 +242,14 @@ func (rs *REST) createExternalLoadBalancer(ctx api.Context, service *api.Service
 	if rs.cloud == nil {
 		return fmt.Errorf("requested an external service, but no cloud provider supplied.")
 	}
-	if service.Spec.Protocol != api.ProtocolTCP {
-		// TODO: Support UDP here too.
-		return fmt.Errorf("external load balancers for non TCP services are not currently supported.")
+	ports := []int{}
+	for i := range service.Spec.Ports {
+		sp := &service.Spec.Ports[i]
+		if sp.Protocol != api.ProtocolTCP {
+			// TODO: Support UDP here too.
+			return fmt.Errorf("external load balancers for non TCP services are not currently supported.")
+		}
+		ports = append(ports, sp.Port)
 	}
 	balancer, ok := rs.cloud.TCPLoadBalancer()
 	if !ok {
@@ -265,14 +272,14 @@ func (rs *REST) createExternalLoadBalancer(ctx api.Context, service *api.Service
 	var affinityType api.AffinityType = service.Spec.SessionAffinity
 	if len(service.Spec.PublicIPs) > 0 {
 		for _, publicIP := range service.Spec.PublicIPs {
-			_, err = balancer.CreateTCPLoadBalancer(name, zone.Region, net.ParseIP(publicIP), service.Spec.Port, hostsFromMinionList(hosts), affinityType)
+			_, err = balancer.CreateTCPLoadBalancer(name, zone.Region, net.ParseIP(publicIP), ports, hostsFromMinionList(hosts), affinityType)
 			if err != nil {
 				// TODO: have to roll-back any successful calls.
 				return err
 			}
 		}
 	} else {
-		ip, err := balancer.CreateTCPLoadBalancer(name, zone.Region, nil, service.Spec.Port, hostsFromMinionList(hosts), affinityType)
+		ip, err := balancer.CreateTCPLoadBalancer(name, zone.Region, nil, ports, hostsFromMinionList(hosts), affinityType)
 		if err != nil {
 			return err
 		}
@@ -312,11 +319,18 @@ func externalLoadBalancerNeedsUpdate(old, new *api.Service) bool {
 		return false
 	}
 	if old.Spec.CreateExternalLoadBalancer != new.Spec.CreateExternalLoadBalancer ||
-		old.Spec.Port != new.Spec.Port ||
-		old.Spec.SessionAffinity != new.Spec.SessionAffinity ||
-		old.Spec.Protocol != new.Spec.Protocol {
+		old.Spec.SessionAffinity != new.Spec.SessionAffinity {
+		return true
+	}
+	if len(old.Spec.Ports) != len(new.Spec.Ports) {
 		return true
 	}
+	for i := range old.Spec.Ports {
+		if old.Spec.Ports[i].Port != new.Spec.Ports[i].Port ||
+			old.Spec.Ports[i].Protocol != new.Spec.Ports[i].Protocol {
+			return true
+		}
+	}
 	if len(old.Spec.PublicIPs) != len(new.Spec.PublicIPs) {
 		return true
 	}
@@ -49,9 +49,8 @@ func TestServiceRegistryCreate(t *testing.T) {
 	svc := &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
-			Port:            6502,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			Selector:        map[string]string{"bar": "baz"},
-			Protocol:        api.ProtocolTCP,
 			SessionAffinity: api.AffinityTypeNone,
 		},
 	}
@@ -89,9 +88,8 @@ func TestServiceStorageValidatesCreate(t *testing.T) {
 		"empty ID": {
 			ObjectMeta: api.ObjectMeta{Name: ""},
 			Spec: api.ServiceSpec{
-				Port:            6502,
+				Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 				Selector:        map[string]string{"bar": "baz"},
-				Protocol:        api.ProtocolTCP,
 				SessionAffinity: api.AffinityTypeNone,
 			},
 		},
@@ -99,7 +97,7 @@ func TestServiceStorageValidatesCreate(t *testing.T) {
 			ObjectMeta: api.ObjectMeta{Name: "foo"},
 			Spec: api.ServiceSpec{
 				Selector:        map[string]string{"bar": "baz"},
-				Protocol:        api.ProtocolTCP,
+				Ports:           []api.ServicePort{{Protocol: api.ProtocolTCP}},
 				SessionAffinity: api.AffinityTypeNone,
 			},
 		},
@@ -123,7 +121,7 @@ func TestServiceRegistryUpdate(t *testing.T) {
 	registry.CreateService(ctx, &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault},
 		Spec: api.ServiceSpec{
-			Port:     6502,
+			Ports:    []api.ServicePort{{Port: 6502}},
 			Selector: map[string]string{"bar": "baz1"},
 		},
 	})
@@ -131,9 +129,8 @@ func TestServiceRegistryUpdate(t *testing.T) {
 	updated_svc, created, err := storage.Update(ctx, &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
-			Port:            6502,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			Selector:        map[string]string{"bar": "baz2"},
-			Protocol:        api.ProtocolTCP,
 			SessionAffinity: api.AffinityTypeNone,
 		},
 	})
@@ -161,7 +158,7 @@ func TestServiceStorageValidatesUpdate(t *testing.T) {
 	registry.CreateService(ctx, &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
-			Port:     6502,
+			Ports:    []api.ServicePort{{Port: 6502}},
 			Selector: map[string]string{"bar": "baz"},
 		},
 	})
@@ -170,18 +167,16 @@ func TestServiceStorageValidatesUpdate(t *testing.T) {
 		"empty ID": {
 			ObjectMeta: api.ObjectMeta{Name: ""},
 			Spec: api.ServiceSpec{
-				Port:            6502,
+				Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 				Selector:        map[string]string{"bar": "baz"},
-				Protocol:        api.ProtocolTCP,
 				SessionAffinity: api.AffinityTypeNone,
 			},
 		},
 		"invalid selector": {
 			ObjectMeta: api.ObjectMeta{Name: "foo"},
 			Spec: api.ServiceSpec{
-				Port:            6502,
+				Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 				Selector:        map[string]string{"ThisSelectorFailsValidation": "ok"},
-				Protocol:        api.ProtocolTCP,
 				SessionAffinity: api.AffinityTypeNone,
 			},
 		},
@@ -206,10 +201,9 @@ func TestServiceRegistryExternalService(t *testing.T) {
 	svc := &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
-			Port:                       6502,
+			Ports:                      []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			Selector:                   map[string]string{"bar": "baz"},
 			CreateExternalLoadBalancer: true,
-			Protocol:                   api.ProtocolTCP,
 			SessionAffinity:            api.AffinityTypeNone,
 		},
 	}
@@ -224,7 +218,7 @@ func TestServiceRegistryExternalService(t *testing.T) {
 	if srv == nil {
 		t.Errorf("Failed to find service: %s", svc.Name)
 	}
-	if len(fakeCloud.Balancers) != 1 || fakeCloud.Balancers[0].Name != "kubernetes-default-foo" || fakeCloud.Balancers[0].Port != 6502 {
+	if len(fakeCloud.Balancers) != 1 || fakeCloud.Balancers[0].Name != "kubernetes-default-foo" || len(fakeCloud.Balancers[0].Ports) != 1 || fakeCloud.Balancers[0].Ports[0] != 6502 {
 		t.Errorf("Unexpected balancer created: %v", fakeCloud.Balancers)
 	}
 }
@@ -239,10 +233,9 @@ func TestServiceRegistryExternalServiceError(t *testing.T) {
 	svc := &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
-			Port:                       6502,
+			Ports:                      []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			Selector:                   map[string]string{"bar": "baz"},
 			CreateExternalLoadBalancer: true,
-			Protocol:                   api.ProtocolTCP,
 			SessionAffinity:            api.AffinityTypeNone,
 		},
 	}
@@ -266,7 +259,7 @@ func TestServiceRegistryDelete(t *testing.T) {
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
 			Selector:        map[string]string{"bar": "baz"},
-			Protocol:        api.ProtocolTCP,
+			Ports:           []api.ServicePort{{Protocol: api.ProtocolTCP}},
 			SessionAffinity: api.AffinityTypeNone,
 		},
 	}
@@ -291,7 +284,7 @@ func TestServiceRegistryDeleteExternal(t *testing.T) {
 		Spec: api.ServiceSpec{
 			Selector:                   map[string]string{"bar": "baz"},
 			CreateExternalLoadBalancer: true,
-			Protocol:                   api.ProtocolTCP,
+			Ports:                      []api.ServicePort{{Protocol: api.ProtocolTCP}},
 			SessionAffinity:            api.AffinityTypeNone,
 		},
 	}
@@ -316,10 +309,9 @@ func TestServiceRegistryUpdateExternalService(t *testing.T) {
 	svc1 := &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
-			Port:                       6502,
+			Ports:                      []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			Selector:                   map[string]string{"bar": "baz"},
 			CreateExternalLoadBalancer: false,
-			Protocol:                   api.ProtocolTCP,
 			SessionAffinity:            api.AffinityTypeNone,
 		},
 	}
@@ -340,7 +332,8 @@ func TestServiceRegistryUpdateExternalService(t *testing.T) {
 	// Change port.
 	svc3 := new(api.Service)
 	*svc3 = *svc2
-	svc3.Spec.Port = 6504
+	svc3.Spec.Ports = []api.ServicePort{svc2.Spec.Ports[0]}
+	svc3.Spec.Ports[0].Port = 6504
 	storage.Update(ctx, svc3)
 	if len(fakeCloud.Calls) != 6 || fakeCloud.Calls[0] != "get-zone" || fakeCloud.Calls[1] != "create" ||
 		fakeCloud.Calls[2] != "get-zone" || fakeCloud.Calls[3] != "delete" ||
@@ -373,7 +366,7 @@ func TestServiceRegistryGet(t *testing.T) {
 func TestServiceRegistryResourceLocation(t *testing.T) {
 	ctx := api.NewDefaultContext()
 	registry := registrytest.NewServiceRegistry()
-	registry.Endpoints = api.Endpoints{Endpoints: []api.Endpoint{{IP: "foo", Port: 80}}}
+	registry.Endpoints = api.Endpoints{Ports: []api.EndpointPort{{Endpoints: []api.Endpoint{{IP: "foo", Port: 80}}}}}
 	fakeCloud := &cloud.FakeCloud{}
 	machines := []string{"foo", "bar", "baz"}
 	storage := NewREST(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), "kubernetes")
@@ -451,8 +444,7 @@ func TestServiceRegistryIPAllocation(t *testing.T) {
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
 			Selector:        map[string]string{"bar": "baz"},
-			Port:            6502,
-			Protocol:        api.ProtocolTCP,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			SessionAffinity: api.AffinityTypeNone,
 		},
 	}
@@ -470,8 +462,7 @@ func TestServiceRegistryIPAllocation(t *testing.T) {
 		ObjectMeta: api.ObjectMeta{Name: "bar"},
 		Spec: api.ServiceSpec{
 			Selector:        map[string]string{"bar": "baz"},
-			Port:            6502,
-			Protocol:        api.ProtocolTCP,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			SessionAffinity: api.AffinityTypeNone,
 		}}
 	ctx = api.NewDefaultContext()
@@ -489,8 +480,7 @@ func TestServiceRegistryIPAllocation(t *testing.T) {
 		Spec: api.ServiceSpec{
 			Selector:        map[string]string{"bar": "baz"},
 			PortalIP:        "1.2.3.93",
-			Port:            6502,
-			Protocol:        api.ProtocolTCP,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			SessionAffinity: api.AffinityTypeNone,
 		},
 	}
@@ -513,8 +503,7 @@ func TestServiceRegistryIPReallocation(t *testing.T) {
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
 			Selector:        map[string]string{"bar": "baz"},
-			Port:            6502,
-			Protocol:        api.ProtocolTCP,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			SessionAffinity: api.AffinityTypeNone,
 		},
 	}
@@ -534,8 +523,7 @@ func TestServiceRegistryIPReallocation(t *testing.T) {
 		ObjectMeta: api.ObjectMeta{Name: "bar"},
 		Spec: api.ServiceSpec{
 			Selector:        map[string]string{"bar": "baz"},
-			Port:            6502,
-			Protocol:        api.ProtocolTCP,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			SessionAffinity: api.AffinityTypeNone,
 		},
 	}
@@ -561,16 +549,15 @@ func TestServiceRegistryIPUpdate(t *testing.T) {
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
 			Selector:        map[string]string{"bar": "baz"},
-			Port:            6502,
-			Protocol:        api.ProtocolTCP,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			SessionAffinity: api.AffinityTypeNone,
 		},
 	}
 	ctx := api.NewDefaultContext()
 	created_svc, _ := rest.Create(ctx, svc)
 	created_service := created_svc.(*api.Service)
-	if created_service.Spec.Port != 6502 {
-		t.Errorf("Expected port 6502, but got %v", created_service.Spec.Port)
+	if created_service.Spec.Ports[0].Port != 6502 {
+		t.Errorf("Expected port 6502, but got %v", created_service.Spec.Ports[0].Port)
 	}
 	if created_service.Spec.PortalIP != "1.2.3.1" {
 		t.Errorf("Unexpected PortalIP: %s", created_service.Spec.PortalIP)
@@ -578,16 +565,16 @@ func TestServiceRegistryIPUpdate(t *testing.T) {
 
 	update := new(api.Service)
 	*update = *created_service
-	update.Spec.Port = 6503
+	update.Spec.Ports[0].Port = 6503
 
 	updated_svc, _, _ := rest.Update(ctx, update)
 	updated_service := updated_svc.(*api.Service)
-	if updated_service.Spec.Port != 6503 {
-		t.Errorf("Expected port 6503, but got %v", updated_service.Spec.Port)
+	if updated_service.Spec.Ports[0].Port != 6503 {
+		t.Errorf("Expected port 6503, but got %v", updated_service.Spec.Ports[0].Port)
 	}
 
 	*update = *created_service
-	update.Spec.Port = 6503
+	update.Spec.Ports[0].Port = 6503
 	update.Spec.PortalIP = "1.2.3.76" // error
 
 	_, _, err := rest.Update(ctx, update)
@@ -606,18 +593,17 @@ func TestServiceRegistryIPExternalLoadBalancer(t *testing.T) {
 	svc := &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
-			Selector: map[string]string{"bar": "baz"},
-			Port:     6502,
+			Selector:                   map[string]string{"bar": "baz"},
+			Ports:                      []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			CreateExternalLoadBalancer: true,
-			Protocol:                   api.ProtocolTCP,
 			SessionAffinity:            api.AffinityTypeNone,
 		},
 	}
 	ctx := api.NewDefaultContext()
 	created_svc, _ := rest.Create(ctx, svc)
 	created_service := created_svc.(*api.Service)
-	if created_service.Spec.Port != 6502 {
-		t.Errorf("Expected port 6502, but got %v", created_service.Spec.Port)
+	if created_service.Spec.Ports[0].Port != 6502 {
+		t.Errorf("Expected port 6502, but got %v", created_service.Spec.Ports[0].Port)
 	}
 	if created_service.Spec.PortalIP != "1.2.3.1" {
 		t.Errorf("Unexpected PortalIP: %s", created_service.Spec.PortalIP)
@@ -630,7 +616,7 @@ func TestServiceRegistryIPExternalLoadBalancer(t *testing.T) {
 	if err != nil {
 		t.Errorf("Unexpected error %v", err)
 	}
-	if len(fakeCloud.Balancers) != 1 || fakeCloud.Balancers[0].Name != "kubernetes-default-foo" || fakeCloud.Balancers[0].Port != 6502 {
+	if len(fakeCloud.Balancers) != 1 || fakeCloud.Balancers[0].Name != "kubernetes-default-foo" || len(fakeCloud.Balancers[0].Ports) != 1 || fakeCloud.Balancers[0].Ports[0] != 6502 {
 		t.Errorf("Unexpected balancer created: %v", fakeCloud.Balancers)
 	}
 }
@@ -646,8 +632,7 @@ func TestServiceRegistryIPReloadFromStorage(t *testing.T) {
 		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault},
 		Spec: api.ServiceSpec{
 			Selector:        map[string]string{"bar": "baz"},
-			Port:            6502,
-			Protocol:        api.ProtocolTCP,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			SessionAffinity: api.AffinityTypeNone,
 		},
 	}
@@ -657,8 +642,7 @@ func TestServiceRegistryIPReloadFromStorage(t *testing.T) {
 		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault},
 		Spec: api.ServiceSpec{
 			Selector:        map[string]string{"bar": "baz"},
-			Port:            6502,
-			Protocol:        api.ProtocolTCP,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			SessionAffinity: api.AffinityTypeNone,
 		},
 	}
@@ -672,8 +656,7 @@ func TestServiceRegistryIPReloadFromStorage(t *testing.T) {
 		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault},
 		Spec: api.ServiceSpec{
 			Selector:        map[string]string{"bar": "baz"},
-			Port:            6502,
-			Protocol:        api.ProtocolTCP,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			SessionAffinity: api.AffinityTypeNone,
 		},
 	}
@@ -734,8 +717,7 @@ func TestCreate(t *testing.T) {
 		&api.Service{
 			Spec: api.ServiceSpec{
 				Selector:        map[string]string{"bar": "baz"},
-				Port:            6502,
-				Protocol:        "TCP",
+				Ports:           []api.ServicePort{{Port: 6502, Protocol: "TCP"}},
 				SessionAffinity: "None",
 			},
 		},
@@ -0,0 +1,156 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccount
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// REST provides the RESTStorage access patterns to work with ServiceAccount objects.
+type REST struct {
+	registry generic.Registry
+}
+
+// NewREST returns a new REST. You must use a registry created by
+// NewEtcdRegistry unless you're testing.
+func NewREST(registry generic.Registry) *REST {
+	return &REST{
+		registry: registry,
+	}
+}
+
+// Create a ServiceAccount object
+func (rs *REST) Create(ctx api.Context, obj runtime.Object) (runtime.Object, error) {
+	serviceAccount, ok := obj.(*api.ServiceAccount)
+	if !ok {
+		return nil, fmt.Errorf("invalid object type")
+	}
+
+	if !api.ValidNamespace(ctx, &serviceAccount.ObjectMeta) {
+		return nil, errors.NewConflict("serviceAccount", serviceAccount.Namespace, fmt.Errorf("ServiceAccount.Namespace does not match the provided context"))
+	}
+
+	if errs := validation.ValidateServiceAccount(serviceAccount); len(errs) > 0 {
+		return nil, errors.NewInvalid("serviceAccount", serviceAccount.Name, errs)
+	}
+	api.FillObjectMetaSystemFields(ctx, &serviceAccount.ObjectMeta)
+
+	err := rs.registry.CreateWithName(ctx, serviceAccount.Name, serviceAccount)
+	if err != nil {
+		return nil, err
+	}
+	return rs.registry.Get(ctx, serviceAccount.Name)
+}
+
+// Update updates a ServiceAccount object.
+func (rs *REST) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool, error) {
+	serviceAccount, ok := obj.(*api.ServiceAccount)
+	if !ok {
+		return nil, false, fmt.Errorf("not a serviceAccount: %#v", obj)
+	}
+
+	if !api.ValidNamespace(ctx, &serviceAccount.ObjectMeta) {
+		return nil, false, errors.NewConflict("serviceAccount", serviceAccount.Namespace, fmt.Errorf("ServiceAccount.Namespace does not match the provided context"))
+	}
+
+	oldObj, err := rs.registry.Get(ctx, serviceAccount.Name)
+	if err != nil {
+		return nil, false, err
+	}
+
+	editServiceAccount := oldObj.(*api.ServiceAccount)
+
+	// set the editable fields on the existing object
+	editServiceAccount.Labels = serviceAccount.Labels
+	editServiceAccount.ResourceVersion = serviceAccount.ResourceVersion
+	editServiceAccount.Annotations = serviceAccount.Annotations
+	editServiceAccount.Secrets = serviceAccount.Secrets
+
+	if errs := validation.ValidateServiceAccount(editServiceAccount); len(errs) > 0 {
+		return nil, false, errors.NewInvalid("serviceAccount", editServiceAccount.Name, errs)
+	}
+
+	err = rs.registry.UpdateWithName(ctx, editServiceAccount.Name, editServiceAccount)
+	if err != nil {
+		return nil, false, err
+	}
+	out, err := rs.registry.Get(ctx, editServiceAccount.Name)
+	return out, false, err
+}
+
+// Delete deletes the ServiceAccount with the specified name
+func (rs *REST) Delete(ctx api.Context, name string) (runtime.Object, error) {
+	obj, err := rs.registry.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	_, ok := obj.(*api.ServiceAccount)
+	if !ok {
+		return nil, fmt.Errorf("invalid object type")
+	}
+
+	return rs.registry.Delete(ctx, name)
+}
+
+// Get gets a ServiceAccount with the specified name
+func (rs *REST) Get(ctx api.Context, name string) (runtime.Object, error) {
+	obj, err := rs.registry.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	serviceAccount, ok := obj.(*api.ServiceAccount)
+	if !ok {
+		return nil, fmt.Errorf("invalid object type")
+	}
+	return serviceAccount, err
+}
+
+func (rs *REST) getAttrs(obj runtime.Object) (objLabels, objFields labels.Set, err error) {
+	serviceAccount, ok := obj.(*api.ServiceAccount)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid object type")
+	}
+
+	return labels.Set(serviceAccount.Labels), labels.Set{
+		"name": serviceAccount.Name,
+	}, nil
+}
+
+func (rs *REST) List(ctx api.Context, label, field labels.Selector) (runtime.Object, error) {
+	return rs.registry.ListPredicate(ctx, &generic.SelectionPredicate{Label: label, Field: field, GetAttrs: rs.getAttrs})
+}
+
+func (rs *REST) Watch(ctx api.Context, label, field labels.Selector, resourceVersion string) (watch.Interface, error) {
+	return rs.registry.WatchPredicate(ctx, &generic.SelectionPredicate{Label: label, Field: field, GetAttrs: rs.getAttrs}, resourceVersion)
+}
+
+// New returns a new api.ServiceAccount
+func (*REST) New() runtime.Object {
+	return &api.ServiceAccount{}
+}
+
+func (*REST) NewList() runtime.Object {
+	return &api.ServiceAccountList{}
+}
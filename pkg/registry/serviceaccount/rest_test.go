@@ -0,0 +1,210 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccount
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/apiserver"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/registrytest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+type testRegistry struct {
+	*registrytest.GenericRegistry
+}
+
+func NewTestREST() (testRegistry, *REST) {
+	reg := testRegistry{registrytest.NewGeneric(nil)}
+	return reg, NewREST(reg)
+}
+
+func testServiceAccount(name string) *api.ServiceAccount {
+	return &api.ServiceAccount{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Secrets: []api.ObjectReference{{Name: name + "-token"}},
+	}
+}
+
+func TestRESTCreate(t *testing.T) {
+	table := []struct {
+		ctx            api.Context
+		serviceAccount *api.ServiceAccount
+		valid          bool
+	}{
+		{
+			ctx:            api.NewDefaultContext(),
+			serviceAccount: testServiceAccount("foo"),
+			valid:          true,
+		}, {
+			ctx:            api.NewContext(),
+			serviceAccount: testServiceAccount("bar"),
+			valid:          false,
+		}, {
+			ctx:            api.WithNamespace(api.NewContext(), "nondefault"),
+			serviceAccount: testServiceAccount("bazzzz"),
+			valid:          false,
+		},
+	}
+
+	for _, item := range table {
+		_, rest := NewTestREST()
+		c, err := rest.Create(item.ctx, item.serviceAccount)
+		if !item.valid {
+			if err == nil {
+				ctxNS := api.NamespaceValue(item.ctx)
+				t.Errorf("%v: Unexpected non-error: (%v, %v)", item.serviceAccount.Name, ctxNS, item.serviceAccount.Namespace)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: Unexpected error: %v", item.serviceAccount.Name, err)
+			continue
+		}
+		if !api.HasObjectMetaSystemFieldValues(&item.serviceAccount.ObjectMeta) {
+			t.Errorf("storage did not populate object meta field values")
+		}
+		if e, a := item.serviceAccount, c; !reflect.DeepEqual(e, a) {
+			t.Errorf("diff: %s", util.ObjectDiff(e, a))
+		}
+		// Ensure we implement the interface
+		_ = apiserver.ResourceWatcher(rest)
+	}
+}
+
+func TestRESTUpdate(t *testing.T) {
+	ctx := api.NewDefaultContext()
+	registry, rest := NewTestREST()
+	registry.CreateWithName(ctx, "foo", testServiceAccount("foo"))
+	modifiedServiceAccount := testServiceAccount("foo")
+	modifiedServiceAccount.Secrets = []api.ObjectReference{{Name: "foo-token-2"}}
+
+	updatedObj, created, err := rest.Update(ctx, modifiedServiceAccount)
+	if err != nil {
+		t.Fatalf("Expected no error: %v", err)
+	}
+	if updatedObj == nil {
+		t.Errorf("Expected non-nil object")
+	}
+	if created {
+		t.Errorf("expected not created")
+	}
+	updatedServiceAccount := updatedObj.(*api.ServiceAccount)
+	if updatedServiceAccount.Name != "foo" {
+		t.Errorf("Expected foo, but got %v", updatedServiceAccount.Name)
+	}
+	if e, a := modifiedServiceAccount.Secrets, updatedServiceAccount.Secrets; !reflect.DeepEqual(e, a) {
+		t.Errorf("diff: %s", util.ObjectDiff(e, a))
+	}
+}
+
+func TestRESTDelete(t *testing.T) {
+	_, rest := NewTestREST()
+	serviceAccountA := testServiceAccount("foo")
+	_, err := rest.Create(api.NewDefaultContext(), serviceAccountA)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	c, err := rest.Delete(api.NewDefaultContext(), serviceAccountA.Name)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if stat := c.(*api.Status); stat.Status != api.StatusSuccess {
+		t.Errorf("unexpected status: %v", stat)
+	}
+}
+
+func TestRESTGet(t *testing.T) {
+	_, rest := NewTestREST()
+	serviceAccountA := testServiceAccount("foo")
+	_, err := rest.Create(api.NewDefaultContext(), serviceAccountA)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	got, err := rest.Get(api.NewDefaultContext(), serviceAccountA.Name)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if e, a := serviceAccountA, got; !reflect.DeepEqual(e, a) {
+		t.Errorf("diff: %s", util.ObjectDiff(e, a))
+	}
+}
+
+func TestRESTgetAttrs(t *testing.T) {
+	_, rest := NewTestREST()
+	serviceAccountA := testServiceAccount("foo")
+	label, field, err := rest.getAttrs(serviceAccountA)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if e, a := label, labels.Set(serviceAccountA.Labels); !reflect.DeepEqual(e, a) {
+		t.Errorf("diff: %s", util.ObjectDiff(e, a))
+	}
+	expect := labels.Set{
+		"name": "foo",
+	}
+	if e, a := expect, field; !reflect.DeepEqual(e, a) {
+		t.Errorf("diff: %s", util.ObjectDiff(e, a))
+	}
+}
+
+func TestRESTList(t *testing.T) {
+	reg, rest := NewTestREST()
+
+	var (
+		serviceAccountA = testServiceAccount("a")
+		serviceAccountB = testServiceAccount("b")
+		serviceAccountC = testServiceAccount("c")
+	)
+
+	reg.ObjectList = &api.ServiceAccountList{
+		Items: []api.ServiceAccount{*serviceAccountA, *serviceAccountB, *serviceAccountC},
+	}
+	got, err := rest.List(api.NewContext(), labels.Everything(), labels.Everything())
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	expect := &api.ServiceAccountList{
+		Items: []api.ServiceAccount{*serviceAccountA, *serviceAccountB, *serviceAccountC},
+	}
+	if e, a := expect, got; !reflect.DeepEqual(e, a) {
+		t.Errorf("diff: %s", util.ObjectDiff(e, a))
+	}
+}
+
+func TestRESTWatch(t *testing.T) {
+	serviceAccountA := testServiceAccount("a")
+	reg, rest := NewTestREST()
+	wi, err := rest.Watch(api.NewContext(), labels.Everything(), labels.Everything(), "0")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	go func() {
+		reg.Broadcaster.Action(watch.Added, serviceAccountA)
+	}()
+	got := <-wi.ResultChan()
+	if e, a := serviceAccountA, got.Object; !reflect.DeepEqual(e, a) {
+		t.Errorf("diff: %s", util.ObjectDiff(e, a))
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/meta"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	etcdgeneric "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic/etcd"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/thirdpartyresource"
+	tprdataetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/thirdpartyresourcedata/etcd"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+)
+
+// REST implements a RESTStorage for third party resources against etcd.
+type REST struct {
+	*etcdgeneric.Etcd
+}
+
+// NewREST returns a RESTStorage object that will work against third party resources.
+func NewREST(h tools.EtcdHelper) *REST {
+	prefix := "/registry/thirdpartyresources"
+	store := &etcdgeneric.Etcd{
+		NewFunc:     func() runtime.Object { return &api.ThirdPartyResource{} },
+		NewListFunc: func() runtime.Object { return &api.ThirdPartyResourceList{} },
+		KeyRootFunc: func(ctx api.Context) string {
+			return prefix
+		},
+		KeyFunc: func(ctx api.Context, name string) (string, error) {
+			return prefix + "/" + name, nil
+		},
+		ObjectNameFunc: func(obj runtime.Object) (string, error) {
+			return obj.(*api.ThirdPartyResource).Name, nil
+		},
+		PredicateFunc: func(label, field labels.Selector) generic.Matcher {
+			return thirdpartyresource.MatchThirdPartyResource(label, field)
+		},
+		EndpointName: "thirdpartyresources",
+
+		CreateStrategy:      thirdpartyresource.Strategy,
+		UpdateStrategy:      thirdpartyresource.Strategy,
+		ReturnDeletedObject: true,
+		AfterDelete: func(obj runtime.Object) error {
+			return deleteThirdPartyResourceData(h, obj.(*api.ThirdPartyResource))
+		},
+
+		Helper: h,
+	}
+	return &REST{store}
+}
+
+// deleteThirdPartyResourceData removes the objects of the kind declared by resource, in
+// all versions and namespaces.
+func deleteThirdPartyResourceData(h tools.EtcdHelper, resource *api.ThirdPartyResource) error {
+	kind, group, err := api.ThirdPartyResourceKindAndGroup(resource.Name)
+	if err != nil {
+		// the kind was never served, so no objects were stored
+		return nil
+	}
+	plural, _ := meta.KindToResource(kind, false)
+	if err := h.Delete(tprdataetcd.KeyRoot(group, plural), true); err != nil && !tools.IsEtcdNotFound(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+)
+
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.EtcdHelper{Client: fakeEtcdClient, Codec: latest.Codec, ResourceVersioner: tools.RuntimeVersionAdapter{Versioner: latest.ResourceVersioner}}
+	return fakeEtcdClient, helper
+}
+
+func validNewThirdPartyResource(name string) *api.ThirdPartyResource {
+	return &api.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{
+			Name: name,
+		},
+		Description: "a database cluster",
+		Versions:    []api.APIVersion{{Name: "v1"}},
+	}
+}
+
+func TestCreateSetsFields(t *testing.T) {
+	_, helper := newHelper(t)
+	storage := NewREST(helper)
+	resource := validNewThirdPartyResource("database-cluster.example.com")
+	resource.Namespace = "ignored"
+	if _, err := storage.Create(api.NewDefaultContext(), resource); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actual := &api.ThirdPartyResource{}
+	if err := helper.ExtractObj("/registry/thirdpartyresources/database-cluster.example.com", actual, false); err != nil {
+		t.Fatalf("unexpected extraction error: %v", err)
+	}
+	if actual.Name != resource.Name || len(actual.Namespace) != 0 {
+		t.Errorf("unexpected third party resource: %#v", actual)
+	}
+	if len(actual.UID) == 0 {
+		t.Errorf("expected third party resource UID to be set: %#v", actual)
+	}
+	if actual.Description != resource.Description || len(actual.Versions) != 1 || actual.Versions[0].Name != "v1" {
+		t.Errorf("unexpected third party resource: %#v", actual)
+	}
+}
+
+func TestCreateInvalid(t *testing.T) {
+	_, helper := newHelper(t)
+	storage := NewREST(helper)
+	for _, resource := range []*api.ThirdPartyResource{
+		validNewThirdPartyResource("database-cluster"),
+		{ObjectMeta: api.ObjectMeta{Name: "database-cluster.example.com"}},
+	} {
+		_, err := storage.Create(api.NewDefaultContext(), resource)
+		if !errors.IsInvalid(err) {
+			t.Errorf("expected invalid error for %#v, got %v", resource, err)
+		}
+	}
+}
+
+func TestDeleteRemovesData(t *testing.T) {
+	fakeEtcdClient, helper := newHelper(t)
+	storage := NewREST(helper)
+	resource := validNewThirdPartyResource("database-cluster.example.com")
+	if _, err := storage.Create(api.NewDefaultContext(), resource); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := storage.Delete(api.NewDefaultContext(), resource.Name); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{
+		"/registry/thirdpartyresources/database-cluster.example.com",
+		"/registry/thirdparty/example.com/databaseclusters",
+	}
+	if !reflect.DeepEqual(expected, fakeEtcdClient.DeletedKeys) {
+		t.Errorf("expected deleted keys %v, got %v", expected, fakeEtcdClient.DeletedKeys)
+	}
+}
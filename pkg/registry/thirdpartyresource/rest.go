@@ -0,0 +1,81 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thirdpartyresource
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+)
+
+// thirdPartyResourceStrategy implements behavior for ThirdPartyResource objects.
+type thirdPartyResourceStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating ThirdPartyResource
+// objects via the REST API.
+var Strategy = thirdPartyResourceStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped is false for third party resources.
+func (thirdPartyResourceStrategy) NamespaceScoped() bool {
+	return false
+}
+
+// ResetBeforeCreate clears fields that are not allowed to be set by end users on creation.
+func (thirdPartyResourceStrategy) ResetBeforeCreate(obj runtime.Object) {
+}
+
+// Validate validates a new third party resource.
+func (thirdPartyResourceStrategy) Validate(obj runtime.Object) errors.ValidationErrorList {
+	return validation.ValidateThirdPartyResource(obj.(*api.ThirdPartyResource))
+}
+
+// AllowCreateOnUpdate is false for third party resources.
+func (thirdPartyResourceStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (thirdPartyResourceStrategy) ValidateUpdate(obj, old runtime.Object) errors.ValidationErrorList {
+	return validation.ValidateThirdPartyResourceUpdate(old.(*api.ThirdPartyResource), obj.(*api.ThirdPartyResource))
+}
+
+// MatchThirdPartyResource returns a generic matcher for a given label and field selector.
+func MatchThirdPartyResource(label, field labels.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		resource, ok := obj.(*api.ThirdPartyResource)
+		if !ok {
+			return false, fmt.Errorf("not a third party resource")
+		}
+		fields := ThirdPartyResourceToSelectableFields(resource)
+		return label.Matches(labels.Set(resource.Labels)) && field.Matches(fields), nil
+	})
+}
+
+// ThirdPartyResourceToSelectableFields returns a label set that represents the object.
+func ThirdPartyResourceToSelectableFields(resource *api.ThirdPartyResource) labels.Set {
+	return labels.Set{
+		"name": resource.Name,
+	}
+}
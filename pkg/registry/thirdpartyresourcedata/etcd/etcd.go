@@ -0,0 +1,71 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	etcdgeneric "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic/etcd"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/thirdpartyresourcedata"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+)
+
+// REST implements a RESTStorage for the objects of a third party kind against etcd.
+type REST struct {
+	*etcdgeneric.Etcd
+}
+
+// NewREST returns a RESTStorage object that will work against the objects of a third
+// party kind, stored under the given resource name of the given API group. The helper
+// must encode with a codec for the kind (see thirdparty.NewCodec), and the
+// typer must report the kind of its objects.
+func NewREST(h tools.EtcdHelper, group, resource string, typer runtime.ObjectTyper) *REST {
+	prefix := KeyRoot(group, resource)
+	strategy := thirdpartyresourcedata.NewStrategy(typer)
+	store := &etcdgeneric.Etcd{
+		NewFunc:     func() runtime.Object { return &api.ThirdPartyResourceData{} },
+		NewListFunc: func() runtime.Object { return &api.ThirdPartyResourceDataList{} },
+		KeyRootFunc: func(ctx api.Context) string {
+			return etcdgeneric.NamespaceKeyRootFunc(ctx, prefix)
+		},
+		KeyFunc: func(ctx api.Context, name string) (string, error) {
+			return etcdgeneric.NamespaceKeyFunc(ctx, prefix, name)
+		},
+		ObjectNameFunc: func(obj runtime.Object) (string, error) {
+			return obj.(*api.ThirdPartyResourceData).Name, nil
+		},
+		PredicateFunc: func(label, field labels.Selector) generic.Matcher {
+			return thirdpartyresourcedata.MatchThirdPartyResourceData(label, field)
+		},
+		EndpointName: resource,
+
+		CreateStrategy:      strategy,
+		UpdateStrategy:      strategy,
+		ReturnDeletedObject: true,
+
+		Helper: h,
+	}
+	return &REST{store}
+}
+
+// KeyRoot returns the etcd key under which the objects stored under the given resource
+// name of the given API group live, in all namespaces.
+func KeyRoot(group, resource string) string {
+	return "/registry/thirdparty/" + group + "/" + resource
+}
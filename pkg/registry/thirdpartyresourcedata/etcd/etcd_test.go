@@ -0,0 +1,111 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/thirdparty"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+
+	"github.com/coreos/go-etcd/etcd"
+)
+
+func newStorage(t *testing.T) (*tools.FakeEtcdClient, *REST) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	codec := thirdparty.NewCodec(latest.Codec, "DatabaseCluster", "example.com/v1")
+	helper := tools.EtcdHelper{Client: fakeEtcdClient, Codec: codec, ResourceVersioner: tools.RuntimeVersionAdapter{Versioner: latest.ResourceVersioner}}
+	typer := thirdparty.NewObjectTyper(api.Scheme, "DatabaseCluster", "example.com/v1")
+	return fakeEtcdClient, NewREST(helper, "example.com", "databaseclusters", typer)
+}
+
+func validNewThirdPartyResourceData(name string) *api.ThirdPartyResourceData {
+	return &api.ThirdPartyResourceData{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: api.NamespaceDefault,
+			Labels:    map[string]string{"app": "db"},
+		},
+		Data: []byte(`{"replicas":3}`),
+	}
+}
+
+func TestCreateStoresData(t *testing.T) {
+	fakeEtcdClient, storage := newStorage(t)
+	ctx := api.NewDefaultContext()
+	if _, err := storage.Create(ctx, validNewThirdPartyResourceData("db")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := fakeEtcdClient.Data["/registry/thirdparty/example.com/databaseclusters/default/db"]; !ok {
+		t.Fatalf("expected the object to be stored under the group and resource: %#v", fakeEtcdClient.Data)
+	}
+
+	obj, err := storage.Get(ctx, "db")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	actual := obj.(*api.ThirdPartyResourceData)
+	if actual.Name != "db" || len(actual.UID) == 0 || actual.Labels["app"] != "db" {
+		t.Errorf("unexpected object: %#v", actual)
+	}
+	object := map[string]interface{}{}
+	if err := json.Unmarshal(actual.Data, &object); err != nil || object["replicas"] != float64(3) {
+		t.Errorf("expected data to be preserved: %s", string(actual.Data))
+	}
+}
+
+func TestCreateInvalid(t *testing.T) {
+	_, storage := newStorage(t)
+	obj := validNewThirdPartyResourceData("*BadName!")
+	_, err := storage.Create(api.NewDefaultContext(), obj)
+	if !errors.IsInvalid(err) {
+		t.Errorf("expected invalid error, got %v", err)
+	}
+}
+
+func TestListFiltersByLabel(t *testing.T) {
+	fakeEtcdClient, storage := newStorage(t)
+	codec := thirdparty.NewCodec(latest.Codec, "DatabaseCluster", "example.com/v1")
+	other := validNewThirdPartyResourceData("other")
+	other.Labels = nil
+	fakeEtcdClient.Data["/registry/thirdparty/example.com/databaseclusters/default"] = tools.EtcdResponseWithError{
+		R: &etcd.Response{
+			Node: &etcd.Node{
+				Nodes: []*etcd.Node{
+					{Value: runtime.EncodeOrDie(codec, validNewThirdPartyResourceData("db"))},
+					{Value: runtime.EncodeOrDie(codec, other)},
+				},
+			},
+		},
+	}
+
+	obj, err := storage.List(api.NewDefaultContext(), labels.SelectorFromSet(labels.Set{"app": "db"}), labels.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	list := obj.(*api.ThirdPartyResourceDataList)
+	if len(list.Items) != 1 || list.Items[0].Name != "db" || string(list.Items[0].Data) == "" {
+		t.Errorf("unexpected list: %#v", list)
+	}
+}
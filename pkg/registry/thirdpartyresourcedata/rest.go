@@ -0,0 +1,83 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thirdpartyresourcedata
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+)
+
+// thirdPartyResourceDataStrategy implements behavior for the objects of a third party kind.
+type thirdPartyResourceDataStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// NewStrategy returns the logic that applies when creating and updating the objects of
+// a third party kind via the REST API. The typer reports the kind of the objects.
+func NewStrategy(typer runtime.ObjectTyper) thirdPartyResourceDataStrategy {
+	return thirdPartyResourceDataStrategy{typer, api.SimpleNameGenerator}
+}
+
+// NamespaceScoped is true for third party objects.
+func (thirdPartyResourceDataStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// ResetBeforeCreate clears fields that are not allowed to be set by end users on creation.
+func (thirdPartyResourceDataStrategy) ResetBeforeCreate(obj runtime.Object) {
+}
+
+// Validate validates a new third party object.
+func (thirdPartyResourceDataStrategy) Validate(obj runtime.Object) errors.ValidationErrorList {
+	return validation.ValidateThirdPartyResourceData(obj.(*api.ThirdPartyResourceData))
+}
+
+// AllowCreateOnUpdate is false for third party objects.
+func (thirdPartyResourceDataStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (thirdPartyResourceDataStrategy) ValidateUpdate(obj, old runtime.Object) errors.ValidationErrorList {
+	return validation.ValidateThirdPartyResourceDataUpdate(old.(*api.ThirdPartyResourceData), obj.(*api.ThirdPartyResourceData))
+}
+
+// MatchThirdPartyResourceData returns a generic matcher for a given label and field selector.
+func MatchThirdPartyResourceData(label, field labels.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		data, ok := obj.(*api.ThirdPartyResourceData)
+		if !ok {
+			return false, fmt.Errorf("not a third party object")
+		}
+		fields := ThirdPartyResourceDataToSelectableFields(data)
+		return label.Matches(labels.Set(data.Labels)) && field.Matches(fields), nil
+	})
+}
+
+// ThirdPartyResourceDataToSelectableFields returns a label set that represents the object.
+func ThirdPartyResourceDataToSelectableFields(data *api.ThirdPartyResourceData) labels.Set {
+	return labels.Set{
+		"name": data.Name,
+	}
+}
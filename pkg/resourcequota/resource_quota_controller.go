@@ -140,7 +140,7 @@ func (rm *ResourceQuotaManager) syncResourceQuota(quota api.ResourceQuota) (err
 	}
 
 	pods := &api.PodList{}
-	if set[api.ResourcePods] || set[api.ResourceMemory] || set[api.ResourceCPU] {
+	if set[api.ResourcePods] || set[api.ResourceMemory] || set[api.ResourceCPU] || set[api.ResourceLimitsMemory] || set[api.ResourceLimitsCPU] {
 		pods, err = rm.kubeClient.Pods(usage.Namespace).List(labels.Everything())
 		if err != nil {
 			return err
@@ -175,6 +175,18 @@ func (rm *ResourceQuotaManager) syncResourceQuota(quota api.ResourceQuota) (err
 				val = val + PodCPU(&filteredPods[i]).MilliValue()
 			}
 			value = resource.NewMilliQuantity(int64(val), resource.DecimalSI)
+		case api.ResourceLimitsMemory:
+			val := int64(0)
+			for i := range filteredPods {
+				val = val + PodMemoryLimit(&filteredPods[i]).Value()
+			}
+			value = resource.NewQuantity(int64(val), resource.DecimalSI)
+		case api.ResourceLimitsCPU:
+			val := int64(0)
+			for i := range filteredPods {
+				val = val + PodCPULimit(&filteredPods[i]).MilliValue()
+			}
+			value = resource.NewMilliQuantity(int64(val), resource.DecimalSI)
 		case api.ResourceServices:
 			items, err := rm.kubeClient.Services(usage.Namespace).List(labels.Everything())
 			if err != nil {
@@ -211,17 +223,35 @@ func (rm *ResourceQuotaManager) syncResourceQuota(quota api.ResourceQuota) (err
 	return nil
 }
 
-// PodCPU computes total cpu usage of a pod
+// PodCPU computes the total cpu requested by a pod
 func PodCPU(pod *api.Pod) *resource.Quantity {
 	val := int64(0)
 	for j := range pod.Spec.Containers {
-		val = val + pod.Spec.Containers[j].Resources.Limits.Cpu().MilliValue()
+		val = val + pod.Spec.Containers[j].Resources.Requests.Cpu().MilliValue()
 	}
 	return resource.NewMilliQuantity(int64(val), resource.DecimalSI)
 }
 
-// PodMemory computes the memory usage of a pod
+// PodMemory computes the total memory requested by a pod
 func PodMemory(pod *api.Pod) *resource.Quantity {
+	val := int64(0)
+	for j := range pod.Spec.Containers {
+		val = val + pod.Spec.Containers[j].Resources.Requests.Memory().Value()
+	}
+	return resource.NewQuantity(int64(val), resource.DecimalSI)
+}
+
+// PodCPULimit computes the total cpu limit of a pod
+func PodCPULimit(pod *api.Pod) *resource.Quantity {
+	val := int64(0)
+	for j := range pod.Spec.Containers {
+		val = val + pod.Spec.Containers[j].Resources.Limits.Cpu().MilliValue()
+	}
+	return resource.NewMilliQuantity(int64(val), resource.DecimalSI)
+}
+
+// PodMemoryLimit computes the total memory limit of a pod
+func PodMemoryLimit(pod *api.Pod) *resource.Quantity {
 	val := int64(0)
 	for j := range pod.Spec.Containers {
 		val = val + pod.Spec.Containers[j].Resources.Limits.Memory().Value()
@@ -25,19 +25,21 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 )
 
-func getResourceRequirements(cpu, memory string) api.ResourceRequirements {
-	res := api.ResourceRequirements{}
-	res.Limits = api.ResourceList{}
+func getResourceList(cpu, memory string) api.ResourceList {
+	res := api.ResourceList{}
 	if cpu != "" {
-		res.Limits[api.ResourceCPU] = resource.MustParse(cpu)
+		res[api.ResourceCPU] = resource.MustParse(cpu)
 	}
 	if memory != "" {
-		res.Limits[api.ResourceMemory] = resource.MustParse(memory)
+		res[api.ResourceMemory] = resource.MustParse(memory)
 	}
-
 	return res
 }
 
+func getResourceRequirements(requests, limits api.ResourceList) api.ResourceRequirements {
+	return api.ResourceRequirements{Requests: requests, Limits: limits}
+}
+
 func TestFilterQuotaPods(t *testing.T) {
 	pods := []api.Pod{
 		{
@@ -111,7 +113,7 @@ func TestSyncResourceQuota(t *testing.T) {
 				Status:     api.PodStatus{Phase: api.PodRunning},
 				Spec: api.PodSpec{
 					Volumes:    []api.Volume{{Name: "vol"}},
-					Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements("100m", "1Gi")}},
+					Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements(getResourceList("100m", "1Gi"), getResourceList("200m", "2Gi"))}},
 				},
 			},
 			{
@@ -119,7 +121,7 @@ func TestSyncResourceQuota(t *testing.T) {
 				Status:     api.PodStatus{Phase: api.PodRunning},
 				Spec: api.PodSpec{
 					Volumes:    []api.Volume{{Name: "vol"}},
-					Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements("100m", "1Gi")}},
+					Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements(getResourceList("100m", "1Gi"), getResourceList("200m", "2Gi"))}},
 				},
 			},
 			{
@@ -127,7 +129,7 @@ func TestSyncResourceQuota(t *testing.T) {
 				Status:     api.PodStatus{Phase: api.PodFailed},
 				Spec: api.PodSpec{
 					Volumes:    []api.Volume{{Name: "vol"}},
-					Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements("100m", "1Gi")}},
+					Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements(getResourceList("100m", "1Gi"), getResourceList("200m", "2Gi"))}},
 				},
 			},
 		},
@@ -135,23 +137,29 @@ func TestSyncResourceQuota(t *testing.T) {
 	quota := api.ResourceQuota{
 		Spec: api.ResourceQuotaSpec{
 			Hard: api.ResourceList{
-				api.ResourceCPU:    resource.MustParse("3"),
-				api.ResourceMemory: resource.MustParse("100Gi"),
-				api.ResourcePods:   resource.MustParse("5"),
+				api.ResourceCPU:          resource.MustParse("3"),
+				api.ResourceMemory:       resource.MustParse("100Gi"),
+				api.ResourceLimitsCPU:    resource.MustParse("4"),
+				api.ResourceLimitsMemory: resource.MustParse("200Gi"),
+				api.ResourcePods:         resource.MustParse("5"),
 			},
 		},
 	}
 	expectedUsage := api.ResourceQuotaUsage{
 		Status: api.ResourceQuotaStatus{
 			Hard: api.ResourceList{
-				api.ResourceCPU:    resource.MustParse("3"),
-				api.ResourceMemory: resource.MustParse("100Gi"),
-				api.ResourcePods:   resource.MustParse("5"),
+				api.ResourceCPU:          resource.MustParse("3"),
+				api.ResourceMemory:       resource.MustParse("100Gi"),
+				api.ResourceLimitsCPU:    resource.MustParse("4"),
+				api.ResourceLimitsMemory: resource.MustParse("200Gi"),
+				api.ResourcePods:         resource.MustParse("5"),
 			},
 			Used: api.ResourceList{
-				api.ResourceCPU:    resource.MustParse("200m"),
-				api.ResourceMemory: resource.MustParse("2147483648"),
-				api.ResourcePods:   resource.MustParse("2"),
+				api.ResourceCPU:          resource.MustParse("200m"),
+				api.ResourceMemory:       resource.MustParse("2147483648"),
+				api.ResourceLimitsCPU:    resource.MustParse("400m"),
+				api.ResourceLimitsMemory: resource.MustParse("4294967296"),
+				api.ResourcePods:         resource.MustParse("2"),
 			},
 		},
 	}
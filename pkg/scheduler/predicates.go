@@ -92,16 +92,42 @@ type resourceRequest struct {
 	memory   int64
 }
 
+// getResourceRequest returns the resources the pod asks the node for. Pods are
+// fit by their requests rather than their limits, so a node may be
+// overcommitted with respect to limits.
 func getResourceRequest(pod *api.Pod) resourceRequest {
 	result := resourceRequest{}
 	for ix := range pod.Spec.Containers {
-		limits := pod.Spec.Containers[ix].Resources.Limits
-		result.memory += limits.Memory().Value()
-		result.milliCPU += limits.Cpu().MilliValue()
+		requests := pod.Spec.Containers[ix].Resources.Requests
+		result.memory += requests.Memory().Value()
+		result.milliCPU += requests.Cpu().MilliValue()
 	}
+	result.memory += getMemoryVolumeRequest(pod)
 	return result
 }
 
+// defaultMemoryVolumeSize is the memory charged for a memory-backed EmptyDir
+// that does not set a sizeLimit.
+const defaultMemoryVolumeSize int64 = 64 * 1024 * 1024 // 64 MiB
+
+// getMemoryVolumeRequest returns the memory claimed by the pod's memory-backed
+// EmptyDir volumes, which live in tmpfs on the node.
+func getMemoryVolumeRequest(pod *api.Pod) int64 {
+	memory := int64(0)
+	for ix := range pod.Spec.Volumes {
+		emptyDir := pod.Spec.Volumes[ix].EmptyDir
+		if emptyDir == nil || emptyDir.Medium != api.StorageTypeMemory {
+			continue
+		}
+		if emptyDir.SizeLimit == nil {
+			memory += defaultMemoryVolumeSize
+			continue
+		}
+		memory += emptyDir.SizeLimit.Value()
+	}
+	return memory
+}
+
 // PodFitsResources calculates fit based on requested, rather than used resources
 func (r *ResourceFit) PodFitsResources(pod api.Pod, existingPods []api.Pod, node string) (bool, error) {
 	podRequest := getResourceRequest(&pod)
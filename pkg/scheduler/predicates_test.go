@@ -57,7 +57,7 @@ func newResourcePod(usage ...resourceRequest) api.Pod {
 	for _, req := range usage {
 		containers = append(containers, api.Container{
 			Resources: api.ResourceRequirements{
-				Limits: api.ResourceList{
+				Requests: api.ResourceList{
 					"cpu":    *resource.NewMilliQuantity(req.milliCPU, resource.DecimalSI),
 					"memory": *resource.NewQuantity(req.memory, resource.BinarySI),
 				},
@@ -71,6 +71,29 @@ func newResourcePod(usage ...resourceRequest) api.Pod {
 	}
 }
 
+// withLimits sets the limits of every container of pod.
+func withLimits(pod api.Pod, milliCPU, memory int64) api.Pod {
+	for ix := range pod.Spec.Containers {
+		pod.Spec.Containers[ix].Resources.Limits = api.ResourceList{
+			"cpu":    *resource.NewMilliQuantity(milliCPU, resource.DecimalSI),
+			"memory": *resource.NewQuantity(memory, resource.BinarySI),
+		}
+	}
+	return pod
+}
+
+// withMemoryVolume adds a memory-backed EmptyDir of the given size to pod.
+func withMemoryVolume(pod api.Pod, size int64) api.Pod {
+	sizeLimit := resource.NewQuantity(size, resource.BinarySI)
+	pod.Spec.Volumes = append(pod.Spec.Volumes, api.Volume{
+		Name: "tmpfs",
+		VolumeSource: api.VolumeSource{
+			EmptyDir: &api.EmptyDirVolumeSource{Medium: api.StorageTypeMemory, SizeLimit: sizeLimit},
+		},
+	})
+	return pod
+}
+
 func TestPodFitsResources(t *testing.T) {
 	tests := []struct {
 		pod          api.Pod
@@ -118,6 +141,30 @@ func TestPodFitsResources(t *testing.T) {
 			fits: true,
 			test: "equal edge case",
 		},
+		{
+			pod: withMemoryVolume(newResourcePod(resourceRequest{milliCPU: 1, memory: 1}), 5),
+			existingPods: []api.Pod{
+				newResourcePod(resourceRequest{milliCPU: 5, memory: 15}),
+			},
+			fits: false,
+			test: "memory volume counts against the pod",
+		},
+		{
+			pod: newResourcePod(resourceRequest{milliCPU: 1, memory: 1}),
+			existingPods: []api.Pod{
+				withMemoryVolume(newResourcePod(resourceRequest{milliCPU: 5, memory: 10}), 10),
+			},
+			fits: false,
+			test: "memory volume counts against existing pods",
+		},
+		{
+			pod: withLimits(newResourcePod(resourceRequest{milliCPU: 1, memory: 1}), 10, 20),
+			existingPods: []api.Pod{
+				withLimits(newResourcePod(resourceRequest{milliCPU: 5, memory: 10}), 10, 20),
+			},
+			fits: true,
+			test: "limits may overcommit the node",
+		},
 	}
 	for _, test := range tests {
 		node := api.Node{Spec: api.NodeSpec{Capacity: makeResources(10, 20).Capacity}}
@@ -133,6 +180,40 @@ func TestPodFitsResources(t *testing.T) {
 	}
 }
 
+func TestGetMemoryVolumeRequest(t *testing.T) {
+	unlimited := newResourcePod()
+	unlimited.Spec.Volumes = []api.Volume{
+		{Name: "tmpfs", VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{Medium: api.StorageTypeMemory}}},
+		{Name: "disk", VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}}},
+	}
+	tests := []struct {
+		pod      api.Pod
+		expected int64
+		test     string
+	}{
+		{
+			pod:      newResourcePod(),
+			expected: 0,
+			test:     "no volumes",
+		},
+		{
+			pod:      withMemoryVolume(newResourcePod(), 5),
+			expected: 5,
+			test:     "size limit",
+		},
+		{
+			pod:      unlimited,
+			expected: defaultMemoryVolumeSize,
+			test:     "no size limit",
+		},
+	}
+	for _, test := range tests {
+		if memory := getMemoryVolumeRequest(&test.pod); memory != test.expected {
+			t.Errorf("%s: expected %d, got %d", test.test, test.expected, memory)
+		}
+	}
+}
+
 func TestPodFitsHost(t *testing.T) {
 	tests := []struct {
 		pod  api.Pod
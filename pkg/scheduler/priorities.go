@@ -40,18 +40,16 @@ func calculateScore(requested, capacity int64, node string) int {
 func calculateOccupancy(pod api.Pod, node api.Node, pods []api.Pod) HostPriority {
 	totalMilliCPU := int64(0)
 	totalMemory := int64(0)
-	for _, existingPod := range pods {
-		for _, container := range existingPod.Spec.Containers {
-			totalMilliCPU += container.Resources.Limits.Cpu().MilliValue()
-			totalMemory += container.Resources.Limits.Memory().Value()
-		}
+	for ix := range pods {
+		existingRequest := getResourceRequest(&pods[ix])
+		totalMilliCPU += existingRequest.milliCPU
+		totalMemory += existingRequest.memory
 	}
 	// Add the resources requested by the current pod being scheduled.
 	// This also helps differentiate between differently sized, but empty, minions.
-	for _, container := range pod.Spec.Containers {
-		totalMilliCPU += container.Resources.Limits.Cpu().MilliValue()
-		totalMemory += container.Resources.Limits.Memory().Value()
-	}
+	podRequest := getResourceRequest(&pod)
+	totalMilliCPU += podRequest.milliCPU
+	totalMemory += podRequest.memory
 
 	capacityMilliCPU := node.Spec.Capacity.Cpu().MilliValue()
 	capacityMemory := node.Spec.Capacity.Memory().Value()
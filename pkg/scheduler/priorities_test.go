@@ -59,14 +59,14 @@ func TestLeastRequested(t *testing.T) {
 		Containers: []api.Container{
 			{
 				Resources: api.ResourceRequirements{
-					Limits: api.ResourceList{
+					Requests: api.ResourceList{
 						"cpu": resource.MustParse("1000m"),
 					},
 				},
 			},
 			{
 				Resources: api.ResourceRequirements{
-					Limits: api.ResourceList{
+					Requests: api.ResourceList{
 						"cpu": resource.MustParse("2000m"),
 					},
 				},
@@ -77,7 +77,7 @@ func TestLeastRequested(t *testing.T) {
 		Containers: []api.Container{
 			{
 				Resources: api.ResourceRequirements{
-					Limits: api.ResourceList{
+					Requests: api.ResourceList{
 						"cpu":    resource.MustParse("1000m"),
 						"memory": resource.MustParse("2000"),
 					},
@@ -85,7 +85,7 @@ func TestLeastRequested(t *testing.T) {
 			},
 			{
 				Resources: api.ResourceRequirements{
-					Limits: api.ResourceList{
+					Requests: api.ResourceList{
 						"cpu":    resource.MustParse("2000m"),
 						"memory": resource.MustParse("3000"),
 					},
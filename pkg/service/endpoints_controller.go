@@ -64,18 +64,9 @@ func (e *EndpointController) SyncServiceEndpoints() error {
 			resultErr = err
 			continue
 		}
-		endpoints := []api.Endpoint{}
-
-		for _, pod := range pods.Items {
-			// TODO: Once v1beta1 and v1beta2 are EOL'ed, this can
-			// assume that service.Spec.ContainerPort is populated.
-			_ = v1beta1.Dependency
-			_ = v1beta2.Dependency
-			port, err := findPort(&pod, &service)
-			if err != nil {
-				glog.Errorf("Failed to find port for service %s/%s: %v", service.Namespace, service.Name, err)
-				continue
-			}
+		readyPods := []*api.Pod{}
+		for i := range pods.Items {
+			pod := &pods.Items[i]
 			if len(pod.Status.PodIP) == 0 {
 				glog.Errorf("Failed to find an IP for pod %s/%s", pod.Namespace, pod.Name)
 				continue
@@ -92,9 +83,32 @@ func (e *EndpointController) SyncServiceEndpoints() error {
 				glog.V(5).Infof("Pod is out of service: %v/%v", pod.Namespace, pod.Name)
 				continue
 			}
+			readyPods = append(readyPods, pod)
+		}
 
-			endpoints = append(endpoints, api.Endpoint{IP: pod.Status.PodIP, Port: port})
+		ports := []api.EndpointPort{}
+		for i := range service.Spec.Ports {
+			servicePort := &service.Spec.Ports[i]
+			endpoints := []api.Endpoint{}
+			for _, pod := range readyPods {
+				// TODO: Once v1beta1 and v1beta2 are EOL'ed, this can
+				// assume that servicePort.ContainerPort is populated.
+				_ = v1beta1.Dependency
+				_ = v1beta2.Dependency
+				port, err := findPort(pod, servicePort)
+				if err != nil {
+					glog.Errorf("Failed to find port %q for service %s/%s: %v", servicePort.Name, service.Namespace, service.Name, err)
+					continue
+				}
+				endpoints = append(endpoints, api.Endpoint{IP: pod.Status.PodIP, Port: port})
+			}
+			ports = append(ports, api.EndpointPort{
+				Name:      servicePort.Name,
+				Protocol:  servicePort.Protocol,
+				Endpoints: endpoints,
+			})
 		}
+
 		currentEndpoints, err := e.client.Endpoints(service.Namespace).Get(service.Name)
 		if err != nil {
 			if errors.IsNotFound(err) {
@@ -102,7 +116,6 @@ func (e *EndpointController) SyncServiceEndpoints() error {
 					ObjectMeta: api.ObjectMeta{
 						Name: service.Name,
 					},
-					Protocol: service.Spec.Protocol,
 				}
 			} else {
 				glog.Errorf("Error getting endpoints: %v", err)
@@ -111,15 +124,15 @@ func (e *EndpointController) SyncServiceEndpoints() error {
 		}
 		newEndpoints := &api.Endpoints{}
 		*newEndpoints = *currentEndpoints
-		newEndpoints.Endpoints = endpoints
+		newEndpoints.Ports = ports
 
 		if len(currentEndpoints.ResourceVersion) == 0 {
 			// No previous endpoints, create them
 			_, err = e.client.Endpoints(service.Namespace).Create(newEndpoints)
 		} else {
 			// Pre-existing
-			if currentEndpoints.Protocol == service.Spec.Protocol && endpointsEqual(currentEndpoints, endpoints) {
-				glog.V(5).Infof("protocol and endpoints are equal for %s/%s, skipping update", service.Namespace, service.Name)
+			if endpointsEqual(currentEndpoints, ports) {
+				glog.V(5).Infof("ports and endpoints are equal for %s/%s, skipping update", service.Namespace, service.Name)
 				continue
 			}
 			_, err = e.client.Endpoints(service.Namespace).Update(newEndpoints)
@@ -132,7 +145,7 @@ func (e *EndpointController) SyncServiceEndpoints() error {
 	return resultErr
 }
 
-func containsEndpoint(haystack *api.Endpoints, needle *api.Endpoint) bool {
+func containsEndpoint(haystack *api.EndpointPort, needle *api.Endpoint) bool {
 	if haystack == nil || needle == nil {
 		return false
 	}
@@ -144,12 +157,24 @@ func containsEndpoint(haystack *api.Endpoints, needle *api.Endpoint) bool {
 	return false
 }
 
-func endpointsEqual(eps *api.Endpoints, endpoints []api.Endpoint) bool {
-	if len(eps.Endpoints) != len(endpoints) {
+func endpointPortEqual(ep *api.EndpointPort, other *api.EndpointPort) bool {
+	if ep.Name != other.Name || ep.Protocol != other.Protocol || len(ep.Endpoints) != len(other.Endpoints) {
+		return false
+	}
+	for i := range other.Endpoints {
+		if !containsEndpoint(ep, &other.Endpoints[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func endpointsEqual(eps *api.Endpoints, ports []api.EndpointPort) bool {
+	if len(eps.Ports) != len(ports) {
 		return false
 	}
-	for i := range endpoints {
-		if !containsEndpoint(eps, &endpoints[i]) {
+	for i := range ports {
+		if !endpointPortEqual(&eps.Ports[i], &ports[i]) {
 			return false
 		}
 	}
@@ -172,13 +197,13 @@ func findDefaultPort(pod *api.Pod, servicePort int) (int, bool) {
 	return 0, false
 }
 
-// findPort locates the container port for the given manifest and portName.
-func findPort(pod *api.Pod, service *api.Service) (int, error) {
-	portName := service.Spec.ContainerPort
+// findPort locates the container port for the given pod and service port.
+func findPort(pod *api.Pod, servicePort *api.ServicePort) (int, error) {
+	portName := servicePort.ContainerPort
 	switch portName.Kind {
 	case util.IntstrString:
 		if len(portName.StrVal) == 0 {
-			if port, found := findDefaultPort(pod, service.Spec.Port); found {
+			if port, found := findDefaultPort(pod, servicePort.Port); found {
 				return port, nil
 			}
 			break
@@ -193,7 +218,7 @@ func findPort(pod *api.Pod, service *api.Service) (int, error) {
 		}
 	case util.IntstrInt:
 		if portName.IntVal == 0 {
-			if port, found := findDefaultPort(pod, service.Spec.Port); found {
+			if port, found := findDefaultPort(pod, servicePort.Port); found {
 				return port, nil
 			}
 			break
@@ -213,7 +213,7 @@ func TestFindPort(t *testing.T) {
 		},
 	}
 	for _, test := range tests {
-		port, err := findPort(&test.pod, &api.Service{Spec: api.ServiceSpec{Port: servicePort, ContainerPort: test.portName}})
+		port, err := findPort(&test.pod, &api.ServicePort{Port: servicePort, ContainerPort: test.portName})
 		if port != test.wport {
 			t.Errorf("Expected port %d, Got %d", test.wport, port)
 		}
@@ -299,8 +299,7 @@ func TestSyncEndpointsItemsPreserveNoSelector(t *testing.T) {
 				Name:            "foo",
 				ResourceVersion: "1",
 			},
-			Protocol:  api.ProtocolTCP,
-			Endpoints: []api.Endpoint{{IP: "6.7.8.9", Port: 1000}},
+			Ports: []api.EndpointPort{{Protocol: api.ProtocolTCP, Endpoints: []api.Endpoint{{IP: "6.7.8.9", Port: 1000}}}},
 		}})
 	defer testServer.Close()
 	client := client.NewOrDie(&client.Config{Host: testServer.URL, Version: testapi.Version()})
@@ -318,7 +317,7 @@ func TestSyncEndpointsProtocolTCP(t *testing.T) {
 				ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "other"},
 				Spec: api.ServiceSpec{
 					Selector: map[string]string{},
-					Protocol: api.ProtocolTCP,
+					Ports:    []api.ServicePort{{Port: 80, Protocol: api.ProtocolTCP}},
 				},
 			},
 		},
@@ -331,8 +330,7 @@ func TestSyncEndpointsProtocolTCP(t *testing.T) {
 				Name:            "foo",
 				ResourceVersion: "1",
 			},
-			Protocol:  api.ProtocolTCP,
-			Endpoints: []api.Endpoint{{IP: "6.7.8.9", Port: 1000}},
+			Ports: []api.EndpointPort{{Protocol: api.ProtocolTCP, Endpoints: []api.Endpoint{{IP: "6.7.8.9", Port: 1000}}}},
 		}})
 	defer testServer.Close()
 	client := client.NewOrDie(&client.Config{Host: testServer.URL, Version: testapi.Version()})
@@ -350,7 +348,7 @@ func TestSyncEndpointsProtocolUDP(t *testing.T) {
 				ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "other"},
 				Spec: api.ServiceSpec{
 					Selector: map[string]string{},
-					Protocol: api.ProtocolUDP,
+					Ports:    []api.ServicePort{{Port: 80, Protocol: api.ProtocolUDP}},
 				},
 			},
 		},
@@ -363,8 +361,7 @@ func TestSyncEndpointsProtocolUDP(t *testing.T) {
 				Name:            "foo",
 				ResourceVersion: "1",
 			},
-			Protocol:  api.ProtocolUDP,
-			Endpoints: []api.Endpoint{{IP: "6.7.8.9", Port: 1000}},
+			Ports: []api.EndpointPort{{Protocol: api.ProtocolUDP, Endpoints: []api.Endpoint{{IP: "6.7.8.9", Port: 1000}}}},
 		}})
 	defer testServer.Close()
 	client := client.NewOrDie(&client.Config{Host: testServer.URL, Version: testapi.Version()})
@@ -382,6 +379,7 @@ func TestSyncEndpointsItemsEmptySelectorSelectsAll(t *testing.T) {
 				ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "other"},
 				Spec: api.ServiceSpec{
 					Selector: map[string]string{},
+					Ports:    []api.ServicePort{{Port: 80, Protocol: api.ProtocolTCP}},
 				},
 			},
 		},
@@ -394,8 +392,7 @@ func TestSyncEndpointsItemsEmptySelectorSelectsAll(t *testing.T) {
 				Name:            "foo",
 				ResourceVersion: "1",
 			},
-			Protocol:  api.ProtocolTCP,
-			Endpoints: []api.Endpoint{},
+			Ports: []api.EndpointPort{{Protocol: api.ProtocolTCP, Endpoints: []api.Endpoint{}}},
 		}})
 	defer testServer.Close()
 	client := client.NewOrDie(&client.Config{Host: testServer.URL, Version: testapi.Version()})
@@ -408,8 +405,7 @@ func TestSyncEndpointsItemsEmptySelectorSelectsAll(t *testing.T) {
 			Name:            "foo",
 			ResourceVersion: "1",
 		},
-		Protocol:  api.ProtocolTCP,
-		Endpoints: []api.Endpoint{{IP: "1.2.3.4", Port: 8080}},
+		Ports: []api.EndpointPort{{Protocol: api.ProtocolTCP, Endpoints: []api.Endpoint{{IP: "1.2.3.4", Port: 8080}}}},
 	})
 	endpointsHandler.ValidateRequest(t, "/api/"+testapi.Version()+"/endpoints/foo?namespace=other", "PUT", &data)
 }
@@ -423,6 +419,7 @@ func TestSyncEndpointsItemsPreexisting(t *testing.T) {
 					Selector: map[string]string{
 						"foo": "bar",
 					},
+					Ports: []api.ServicePort{{Port: 80, Protocol: api.ProtocolTCP}},
 				},
 			},
 		},
@@ -435,8 +432,7 @@ func TestSyncEndpointsItemsPreexisting(t *testing.T) {
 				Name:            "foo",
 				ResourceVersion: "1",
 			},
-			Protocol:  api.ProtocolTCP,
-			Endpoints: []api.Endpoint{{IP: "6.7.8.9", Port: 1000}},
+			Ports: []api.EndpointPort{{Protocol: api.ProtocolTCP, Endpoints: []api.Endpoint{{IP: "6.7.8.9", Port: 1000}}}},
 		}})
 	defer testServer.Close()
 	client := client.NewOrDie(&client.Config{Host: testServer.URL, Version: testapi.Version()})
@@ -449,8 +445,7 @@ func TestSyncEndpointsItemsPreexisting(t *testing.T) {
 			Name:            "foo",
 			ResourceVersion: "1",
 		},
-		Protocol:  api.ProtocolTCP,
-		Endpoints: []api.Endpoint{{IP: "1.2.3.4", Port: 8080}},
+		Ports: []api.EndpointPort{{Protocol: api.ProtocolTCP, Endpoints: []api.Endpoint{{IP: "1.2.3.4", Port: 8080}}}},
 	})
 	endpointsHandler.ValidateRequest(t, "/api/"+testapi.Version()+"/endpoints/foo?namespace=bar", "PUT", &data)
 }
@@ -464,6 +459,7 @@ func TestSyncEndpointsItemsPreexistingIdentical(t *testing.T) {
 					Selector: map[string]string{
 						"foo": "bar",
 					},
+					Ports: []api.ServicePort{{Port: 80, Protocol: api.ProtocolTCP}},
 				},
 			},
 		},
@@ -475,8 +471,7 @@ func TestSyncEndpointsItemsPreexistingIdentical(t *testing.T) {
 			ObjectMeta: api.ObjectMeta{
 				ResourceVersion: "1",
 			},
-			Protocol:  api.ProtocolTCP,
-			Endpoints: []api.Endpoint{{IP: "1.2.3.4", Port: 8080}},
+			Ports: []api.EndpointPort{{Protocol: api.ProtocolTCP, Endpoints: []api.Endpoint{{IP: "1.2.3.4", Port: 8080}}}},
 		}})
 	defer testServer.Close()
 	client := client.NewOrDie(&client.Config{Host: testServer.URL, Version: testapi.Version()})
@@ -496,6 +491,7 @@ func TestSyncEndpointsItems(t *testing.T) {
 					Selector: map[string]string{
 						"foo": "bar",
 					},
+					Ports: []api.ServicePort{{Port: 80, Protocol: api.ProtocolTCP}},
 				},
 			},
 		},
@@ -514,8 +510,46 @@ func TestSyncEndpointsItems(t *testing.T) {
 		ObjectMeta: api.ObjectMeta{
 			ResourceVersion: "",
 		},
-		Protocol:  api.ProtocolTCP,
-		Endpoints: []api.Endpoint{{IP: "1.2.3.4", Port: 8080}},
+		Ports: []api.EndpointPort{{Protocol: api.ProtocolTCP, Endpoints: []api.Endpoint{{IP: "1.2.3.4", Port: 8080}}}},
+	})
+	endpointsHandler.ValidateRequest(t, "/api/"+testapi.Version()+"/endpoints?namespace=other", "POST", &data)
+}
+
+func TestSyncEndpointsItemsMultiplePorts(t *testing.T) {
+	serviceList := api.ServiceList{
+		Items: []api.Service{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "other"},
+				Spec: api.ServiceSpec{
+					Selector: map[string]string{
+						"foo": "bar",
+					},
+					Ports: []api.ServicePort{
+						{Name: "http", Port: 80, Protocol: api.ProtocolTCP},
+						{Name: "dns", Port: 53, Protocol: api.ProtocolUDP, ContainerPort: util.NewIntOrStringFromInt(5353)},
+					},
+				},
+			},
+		},
+	}
+	testServer, endpointsHandler := makeTestServer(t,
+		serverResponse{http.StatusOK, newPodList(1)},
+		serverResponse{http.StatusOK, &serviceList},
+		serverResponse{http.StatusOK, &api.Endpoints{}})
+	defer testServer.Close()
+	client := client.NewOrDie(&client.Config{Host: testServer.URL, Version: testapi.Version()})
+	endpoints := NewEndpointController(client)
+	if err := endpoints.SyncServiceEndpoints(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	data := runtime.EncodeOrDie(testapi.Codec(), &api.Endpoints{
+		ObjectMeta: api.ObjectMeta{
+			ResourceVersion: "",
+		},
+		Ports: []api.EndpointPort{
+			{Name: "http", Protocol: api.ProtocolTCP, Endpoints: []api.Endpoint{{IP: "1.2.3.4", Port: 8080}}},
+			{Name: "dns", Protocol: api.ProtocolUDP, Endpoints: []api.Endpoint{{IP: "1.2.3.4", Port: 5353}}},
+		},
 	})
 	endpointsHandler.ValidateRequest(t, "/api/"+testapi.Version()+"/endpoints?namespace=other", "POST", &data)
 }
@@ -0,0 +1,217 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccount
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/golang/glog"
+)
+
+// tokenLength is the number of random bytes in a generated API token.
+const tokenLength = 32
+
+// ServiceAccountManager makes sure every active namespace has a default service
+// account, and that every service account references an API token secret.
+type ServiceAccountManager struct {
+	kubeClient client.Interface
+
+	// To allow injection of syncServiceAccount for testing.
+	syncHandler func(serviceAccount api.ServiceAccount) error
+	// To allow injection of a deterministic token generator for testing.
+	generateToken func() (string, error)
+}
+
+// NewServiceAccountManager creates a new ServiceAccountManager
+func NewServiceAccountManager(kubeClient client.Interface) *ServiceAccountManager {
+	sm := &ServiceAccountManager{
+		kubeClient:    kubeClient,
+		generateToken: randomToken,
+	}
+
+	// set the synchronization handler
+	sm.syncHandler = sm.syncServiceAccount
+	return sm
+}
+
+// Run begins syncing service accounts and their tokens.
+func (sm *ServiceAccountManager) Run(period time.Duration) {
+	go util.Forever(func() { sm.synchronize() }, period)
+}
+
+func (sm *ServiceAccountManager) synchronize() {
+	namespaces, err := sm.kubeClient.Namespaces().List(labels.Everything())
+	if err != nil {
+		glog.Errorf("Synchronization error: %v (%#v)", err, err)
+		return
+	}
+	for ix := range namespaces.Items {
+		namespace := namespaces.Items[ix]
+		if namespace.DeletionTimestamp != nil || namespace.Status.Phase == api.NamespaceTerminating {
+			continue
+		}
+		if err := sm.ensureDefaultServiceAccount(namespace.Name); err != nil {
+			glog.Errorf("Error creating default service account in namespace %v: %v", namespace.Name, err)
+		}
+	}
+
+	serviceAccounts, err := sm.kubeClient.ServiceAccounts(api.NamespaceAll).List(labels.Everything(), labels.Everything())
+	if err != nil {
+		glog.Errorf("Synchronization error: %v (%#v)", err, err)
+		return
+	}
+	for ix := range serviceAccounts.Items {
+		serviceAccount := serviceAccounts.Items[ix]
+		glog.V(4).Infof("periodic sync of service account %v/%v", serviceAccount.Namespace, serviceAccount.Name)
+		if err := sm.syncHandler(serviceAccount); err != nil {
+			glog.Errorf("Error synchronizing service account %v/%v: %v", serviceAccount.Namespace, serviceAccount.Name, err)
+		}
+	}
+
+	if err := sm.deleteOrphanedTokens(serviceAccounts); err != nil {
+		glog.Errorf("Error deleting orphaned service account tokens: %v", err)
+	}
+}
+
+// ensureDefaultServiceAccount creates the default service account in the namespace if it is missing.
+func (sm *ServiceAccountManager) ensureDefaultServiceAccount(namespace string) error {
+	_, err := sm.kubeClient.ServiceAccounts(namespace).Get(DefaultServiceAccountName)
+	if err == nil || !errors.IsNotFound(err) {
+		return err
+	}
+	serviceAccount := &api.ServiceAccount{
+		ObjectMeta: api.ObjectMeta{
+			Name:      DefaultServiceAccountName,
+			Namespace: namespace,
+		},
+	}
+	if _, err := sm.kubeClient.ServiceAccounts(namespace).Create(serviceAccount); err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// syncServiceAccount creates an API token secret for the service account if
+// none of the secrets it references is one.
+func (sm *ServiceAccountManager) syncServiceAccount(serviceAccount api.ServiceAccount) error {
+	for _, ref := range serviceAccount.Secrets {
+		secret, err := sm.kubeClient.Secrets(serviceAccount.Namespace).Get(ref.Name)
+		if errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if IsServiceAccountToken(secret, &serviceAccount) {
+			return nil
+		}
+	}
+	return sm.createToken(serviceAccount)
+}
+
+// createToken creates a new API token secret for the service account and adds
+// a reference to it to the service account.
+func (sm *ServiceAccountManager) createToken(serviceAccount api.ServiceAccount) error {
+	token, err := sm.generateToken()
+	if err != nil {
+		return err
+	}
+	secret := &api.Secret{
+		ObjectMeta: api.ObjectMeta{
+			Name:      api.SimpleNameGenerator.GenerateName(serviceAccount.Name + "-token-"),
+			Namespace: serviceAccount.Namespace,
+			Annotations: map[string]string{
+				api.ServiceAccountNameKey: serviceAccount.Name,
+				api.ServiceAccountUIDKey:  string(serviceAccount.UID),
+			},
+		},
+		Type: api.SecretTypeServiceAccountToken,
+		Data: map[string][]byte{
+			api.ServiceAccountTokenKey: []byte(token),
+		},
+	}
+	if _, err := sm.kubeClient.Secrets(serviceAccount.Namespace).Create(secret); err != nil {
+		return err
+	}
+
+	// If this update fails the token is left unreferenced, and a new one is created on
+	// the next sync. The unreferenced token is not accepted by the token authenticator.
+	serviceAccount.Secrets = append(serviceAccount.Secrets, api.ObjectReference{
+		Kind:      "Secret",
+		Namespace: secret.Namespace,
+		Name:      secret.Name,
+	})
+	_, err = sm.kubeClient.ServiceAccounts(serviceAccount.Namespace).Update(&serviceAccount)
+	return err
+}
+
+// deleteOrphanedTokens removes API token secrets whose service account no longer exists.
+func (sm *ServiceAccountManager) deleteOrphanedTokens(serviceAccounts *api.ServiceAccountList) error {
+	existing := map[string]*api.ServiceAccount{}
+	for ix := range serviceAccounts.Items {
+		serviceAccount := &serviceAccounts.Items[ix]
+		existing[serviceAccount.Namespace+"/"+serviceAccount.Name] = serviceAccount
+	}
+
+	tokenSelector := labels.Set{"type": string(api.SecretTypeServiceAccountToken)}.AsSelector()
+	secrets, err := sm.kubeClient.Secrets(api.NamespaceAll).List(labels.Everything(), tokenSelector)
+	if err != nil {
+		return err
+	}
+	for ix := range secrets.Items {
+		secret := &secrets.Items[ix]
+		if secret.Type != api.SecretTypeServiceAccountToken {
+			continue
+		}
+		name := secret.Annotations[api.ServiceAccountNameKey]
+		if serviceAccount, ok := existing[secret.Namespace+"/"+name]; ok {
+			if uid := secret.Annotations[api.ServiceAccountUIDKey]; len(uid) == 0 || uid == string(serviceAccount.UID) {
+				continue
+			}
+		} else {
+			// the account may have been created after it was listed
+			serviceAccount, err := sm.kubeClient.ServiceAccounts(secret.Namespace).Get(name)
+			if err == nil && IsServiceAccountToken(secret, serviceAccount) {
+				continue
+			}
+			if err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+		}
+		glog.V(2).Infof("deleting token %v/%v of deleted service account %v", secret.Namespace, secret.Name, name)
+		if err := sm.kubeClient.Secrets(secret.Namespace).Delete(secret.Name); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// randomToken returns a random, URL-safe API token.
+func randomToken() (string, error) {
+	b := make([]byte, tokenLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccount
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+)
+
+func TestEnsureDefaultServiceAccount(t *testing.T) {
+	mockClient := &client.Fake{Err: errors.NewNotFound("serviceAccount", DefaultServiceAccountName)}
+	sm := NewServiceAccountManager(mockClient)
+	if err := sm.ensureDefaultServiceAccount("test"); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if len(mockClient.Actions) != 2 {
+		t.Fatalf("Expected 2 mock client actions, but got %v", mockClient.Actions)
+	}
+	if mockClient.Actions[1].Action != "create-serviceaccount" {
+		t.Fatalf("Expected create-serviceaccount action, got %v", mockClient.Actions[1].Action)
+	}
+	serviceAccount := mockClient.Actions[1].Value.(*api.ServiceAccount)
+	if serviceAccount.Name != DefaultServiceAccountName || serviceAccount.Namespace != "test" {
+		t.Errorf("Unexpected service account %#v", serviceAccount)
+	}
+}
+
+func TestEnsureDefaultServiceAccountExists(t *testing.T) {
+	mockClient := &client.Fake{}
+	sm := NewServiceAccountManager(mockClient)
+	if err := sm.ensureDefaultServiceAccount("test"); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	for _, action := range mockClient.Actions {
+		if action.Action == "create-serviceaccount" {
+			t.Errorf("Unexpected create of existing service account")
+		}
+	}
+}
+
+func TestSyncServiceAccountCreatesToken(t *testing.T) {
+	mockClient := &client.Fake{}
+	sm := NewServiceAccountManager(mockClient)
+	sm.generateToken = func() (string, error) { return "token", nil }
+	serviceAccount := api.ServiceAccount{
+		ObjectMeta: api.ObjectMeta{Name: "default", Namespace: "test", UID: "12345"},
+	}
+	if err := sm.syncServiceAccount(serviceAccount); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	var secret *api.Secret
+	var updated *api.ServiceAccount
+	for _, action := range mockClient.Actions {
+		switch action.Action {
+		case "create-secret":
+			secret = action.Value.(*api.Secret)
+		case "update-serviceaccount":
+			updated = action.Value.(*api.ServiceAccount)
+		}
+	}
+	if secret == nil {
+		t.Fatalf("Expected a token secret to be created: %v", mockClient.Actions)
+	}
+	if !IsServiceAccountToken(secret, &serviceAccount) {
+		t.Errorf("Expected a token for the service account, got %#v", secret)
+	}
+	if string(secret.Data[api.ServiceAccountTokenKey]) != "token" {
+		t.Errorf("Unexpected token data %#v", secret.Data)
+	}
+	if updated == nil || len(updated.Secrets) != 1 || updated.Secrets[0].Name != secret.Name {
+		t.Errorf("Expected the service account to reference %v, got %#v", secret.Name, updated)
+	}
+}
+
+func TestSyncServiceAccountWithToken(t *testing.T) {
+	mockClient := &client.Fake{
+		Secret: api.Secret{
+			ObjectMeta: api.ObjectMeta{
+				Name:        "default-token-abcde",
+				Namespace:   "test",
+				Annotations: map[string]string{api.ServiceAccountNameKey: "default"},
+			},
+			Type: api.SecretTypeServiceAccountToken,
+			Data: map[string][]byte{api.ServiceAccountTokenKey: []byte("token")},
+		},
+	}
+	sm := NewServiceAccountManager(mockClient)
+	serviceAccount := api.ServiceAccount{
+		ObjectMeta: api.ObjectMeta{Name: "default", Namespace: "test"},
+		Secrets:    []api.ObjectReference{{Name: "default-token-abcde"}},
+	}
+	if err := sm.syncServiceAccount(serviceAccount); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	for _, action := range mockClient.Actions {
+		if action.Action == "create-secret" || action.Action == "update-serviceaccount" {
+			t.Errorf("Unexpected action %v", action.Action)
+		}
+	}
+}
+
+func TestDeleteOrphanedTokens(t *testing.T) {
+	token := func(name, serviceAccount, uid string) api.Secret {
+		return api.Secret{
+			ObjectMeta: api.ObjectMeta{
+				Name:      name,
+				Namespace: "test",
+				Annotations: map[string]string{
+					api.ServiceAccountNameKey: serviceAccount,
+					api.ServiceAccountUIDKey:  uid,
+				},
+			},
+			Type: api.SecretTypeServiceAccountToken,
+			Data: map[string][]byte{api.ServiceAccountTokenKey: []byte("token")},
+		}
+	}
+	mockClient := &client.Fake{
+		SecretList: api.SecretList{
+			Items: []api.Secret{
+				token("valid", "default", "1"),
+				token("recreated", "default", "0"),
+				token("deleted", "other", "2"),
+			},
+		},
+	}
+	sm := NewServiceAccountManager(mockClient)
+	serviceAccounts := &api.ServiceAccountList{
+		Items: []api.ServiceAccount{
+			{ObjectMeta: api.ObjectMeta{Name: "default", Namespace: "test", UID: "1"}},
+		},
+	}
+	if err := sm.deleteOrphanedTokens(serviceAccounts); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	deleted := []string{}
+	for _, action := range mockClient.Actions {
+		if action.Action == "delete-secret" {
+			deleted = append(deleted, action.Value.(string))
+		}
+	}
+	if len(deleted) != 2 || deleted[0] != "recreated" || deleted[1] != "deleted" {
+		t.Errorf("Expected recreated and deleted to be removed, got %v", deleted)
+	}
+}
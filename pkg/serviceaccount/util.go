@@ -0,0 +1,69 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccount
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+)
+
+const (
+	// DefaultServiceAccountName is the name of the service account created in
+	// every namespace and assigned to pods that do not name one.
+	DefaultServiceAccountName = "default"
+
+	// ServiceAccountUsernamePrefix is the prefix of the usernames service accounts authenticate as.
+	ServiceAccountUsernamePrefix = "system:serviceaccount:"
+)
+
+// MakeUsername generates a username from the given namespace and ServiceAccount name.
+// The resulting username can be passed to SplitUsername to extract the original namespace and ServiceAccount name.
+func MakeUsername(namespace, name string) string {
+	return ServiceAccountUsernamePrefix + namespace + ":" + name
+}
+
+// SplitUsername returns the namespace and ServiceAccount name embedded in the given username,
+// or an error if the username is not a valid name produced by MakeUsername
+func SplitUsername(username string) (string, string, error) {
+	if !strings.HasPrefix(username, ServiceAccountUsernamePrefix) {
+		return "", "", fmt.Errorf("username %q does not start with %q", username, ServiceAccountUsernamePrefix)
+	}
+	parts := strings.Split(strings.TrimPrefix(username, ServiceAccountUsernamePrefix), ":")
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return "", "", fmt.Errorf("username %q is not a valid service account username", username)
+	}
+	return parts[0], parts[1], nil
+}
+
+// IsServiceAccountToken returns true if the secret is a valid api token for the service account
+func IsServiceAccountToken(secret *api.Secret, serviceAccount *api.ServiceAccount) bool {
+	if secret.Type != api.SecretTypeServiceAccountToken {
+		return false
+	}
+	if secret.Namespace != serviceAccount.Namespace {
+		return false
+	}
+	if secret.Annotations[api.ServiceAccountNameKey] != serviceAccount.Name {
+		return false
+	}
+	if uid := secret.Annotations[api.ServiceAccountUIDKey]; len(uid) > 0 && uid != string(serviceAccount.UID) {
+		return false
+	}
+	return len(secret.Data[api.ServiceAccountTokenKey]) > 0
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccount
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+)
+
+func TestMakeSplitUsername(t *testing.T) {
+	username := MakeUsername("ns", "name")
+	if username != "system:serviceaccount:ns:name" {
+		t.Errorf("Unexpected username %q", username)
+	}
+	ns, name, err := SplitUsername(username)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if ns != "ns" || name != "name" {
+		t.Errorf("Expected ns/name, got %s/%s", ns, name)
+	}
+
+	invalid := []string{"test", "system:serviceaccount", "system:serviceaccount:", "system:serviceaccount:ns", "system:serviceaccount:ns:", "system:serviceaccount:ns:name:extra"}
+	for _, n := range invalid {
+		if _, _, err := SplitUsername(n); err == nil {
+			t.Errorf("Expected error for %q", n)
+		}
+	}
+}
+
+func TestIsServiceAccountToken(t *testing.T) {
+	serviceAccount := &api.ServiceAccount{
+		ObjectMeta: api.ObjectMeta{Name: "default", Namespace: "ns", UID: "12345"},
+	}
+	validSecret := func() *api.Secret {
+		return &api.Secret{
+			ObjectMeta: api.ObjectMeta{
+				Name:      "default-token-abcde",
+				Namespace: "ns",
+				Annotations: map[string]string{
+					api.ServiceAccountNameKey: "default",
+					api.ServiceAccountUIDKey:  "12345",
+				},
+			},
+			Type: api.SecretTypeServiceAccountToken,
+			Data: map[string][]byte{api.ServiceAccountTokenKey: []byte("token")},
+		}
+	}
+
+	var (
+		wrongType      = validSecret()
+		wrongNamespace = validSecret()
+		wrongName      = validSecret()
+		wrongUID       = validSecret()
+		noUID          = validSecret()
+		noToken        = validSecret()
+	)
+	wrongType.Type = api.SecretTypeOpaque
+	wrongNamespace.Namespace = "other"
+	wrongName.Annotations[api.ServiceAccountNameKey] = "other"
+	wrongUID.Annotations[api.ServiceAccountUIDKey] = "67890"
+	delete(noUID.Annotations, api.ServiceAccountUIDKey)
+	noToken.Data = nil
+
+	tests := map[string]struct {
+		secret *api.Secret
+		valid  bool
+	}{
+		"valid":           {validSecret(), true},
+		"without uid":     {noUID, true},
+		"wrong type":      {wrongType, false},
+		"wrong namespace": {wrongNamespace, false},
+		"wrong name":      {wrongName, false},
+		"wrong uid":       {wrongUID, false},
+		"without token":   {noToken, false},
+	}
+	for k, tc := range tests {
+		if e, a := tc.valid, IsServiceAccountToken(tc.secret, serviceAccount); e != a {
+			t.Errorf("%s: expected %v, got %v", k, e, a)
+		}
+	}
+}
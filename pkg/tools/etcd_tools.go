@@ -412,6 +412,8 @@ func (h *EtcdHelper) AtomicUpdate(key string, ptrToType runtime.Object, ignoreNo
 		}
 
 		if string(data) == origBody {
+			// nothing to write; hand back the current state so callers see the stored object
+			v.Set(reflect.ValueOf(obj).Elem())
 			return nil
 		}
 
@@ -0,0 +1,356 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tools
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+
+	"github.com/coreos/go-etcd/etcd"
+	"github.com/golang/glog"
+)
+
+// watchCacheQueueLength is the number of changes queued for each watcher of a WatchCache
+// before further changes are dropped for it.
+const watchCacheQueueLength = 100
+
+// errWatchCacheLagging ends a watch served by a WatchCache that missed changes because it
+// did not keep up with them.
+var errWatchCacheLagging = errors.New("the watch fell too far behind the watch cache; list again")
+
+// WatchCache keeps the items below a single etcd key in memory, along with a sliding window
+// of the most recent changes to them, and serves lists and watches of that key (or of any
+// key below it) without going to etcd. A single etcd watch keeps the cache current, and
+// changes are fanned out to watchers with a watch.Broadcaster. A watcher that does not keep
+// up with the changes is ended with an error rather than holding up the others. Requests the
+// cache cannot answer, such as a watch from a resource version older than the window, are
+// passed on to the EtcdHelper.
+type WatchCache struct {
+	helper   EtcdHelper
+	key      string
+	capacity int
+
+	lock sync.RWMutex
+	// ready is true while the cache holds a current copy of key.
+	ready bool
+	// nodes holds the current items by their etcd key.
+	nodes map[string]*etcd.Node
+	// window holds the most recent changes, oldest first.
+	window []*etcd.Response
+	// windowStart is the etcd index after which every change is in window.
+	windowStart uint64
+	// resourceVersion is the etcd index the cache is current to.
+	resourceVersion uint64
+	// sequence counts the changes handed to broadcaster, so that a watcher can tell when
+	// one was dropped for it.
+	sequence uint64
+	// broadcaster distributes each change to the watchers of the cache.
+	broadcaster *watch.Broadcaster
+
+	stop chan struct{}
+}
+
+// NewWatchCache creates a WatchCache of the items below key that remembers the last capacity
+// changes to them, and starts filling it from etcd.
+func NewWatchCache(helper EtcdHelper, key string, capacity int) *WatchCache {
+	c := &WatchCache{
+		helper:      helper,
+		key:         key,
+		capacity:    capacity,
+		broadcaster: watch.NewBroadcaster(watchCacheQueueLength, watch.DropIfChannelFull),
+		stop:        make(chan struct{}),
+	}
+	go util.Until(func() {
+		if err := c.listAndWatch(); err != nil {
+			glog.Errorf("Watch cache of %q is out of date: %v", c.key, err)
+		}
+	}, time.Second, c.stop)
+	return c
+}
+
+// Stop stops updating the cache and ends the watches it serves.
+func (c *WatchCache) Stop() {
+	close(c.stop)
+}
+
+// Covers returns true if the items below key are held by the cache.
+func (c *WatchCache) Covers(key string) bool {
+	return key == c.key || strings.HasPrefix(key, c.key+"/")
+}
+
+// ExtractToList is like EtcdHelper.ExtractToList, but reads the items from memory when the
+// cache is current. The list carries the resource version the cache is current to.
+func (c *WatchCache) ExtractToList(key string, listObj runtime.Object) error {
+	c.lock.RLock()
+	if !c.ready || !c.Covers(key) {
+		c.lock.RUnlock()
+		return c.helper.ExtractToList(key, listObj)
+	}
+	nodes := c.sortedNodes(key)
+	resourceVersion := c.resourceVersion
+	c.lock.RUnlock()
+
+	listPtr, err := runtime.GetItemsPtr(listObj)
+	if err != nil {
+		return err
+	}
+	if err := c.helper.decodeNodeList(nodes, listPtr); err != nil {
+		return err
+	}
+	if c.helper.ResourceVersioner != nil {
+		if err := c.helper.ResourceVersioner.SetResourceVersion(listObj, resourceVersion); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WatchList is like EtcdHelper.WatchList, but serves the watch from memory when the cache is
+// current and still remembers the changes since resourceVersion.
+func (c *WatchCache) WatchList(key string, resourceVersion uint64, filter FilterFunc) (watch.Interface, error) {
+	// The broadcaster is watched before the cache is read, so that no change after the read
+	// can be missed. Changes before it that are still being delivered are skipped by sequence.
+	c.lock.RLock()
+	broadcaster := c.broadcaster
+	c.lock.RUnlock()
+	input := broadcaster.Watch()
+
+	c.lock.RLock()
+	if !c.ready || !c.Covers(key) || (resourceVersion != 0 && resourceVersion <= c.windowStart) || broadcaster != c.broadcaster {
+		c.lock.RUnlock()
+		input.Stop()
+		return c.helper.WatchList(key, resourceVersion, filter)
+	}
+
+	// Changes up to the current resource version are sent from the cache; later ones arrive
+	// through the broadcaster.
+	initial := []*etcd.Response{}
+	if resourceVersion == 0 {
+		for _, node := range c.sortedNodes(key) {
+			initial = append(initial, &etcd.Response{Action: "get", Node: node, EtcdIndex: c.resourceVersion})
+		}
+	} else {
+		for _, res := range c.window {
+			if res.Node.ModifiedIndex >= resourceVersion {
+				initial = append(initial, res)
+			}
+		}
+	}
+	since := c.resourceVersion + 1
+	if resourceVersion > since {
+		since = resourceVersion
+	}
+	sequence := c.sequence
+	c.lock.RUnlock()
+
+	include := func(k string) bool {
+		return strings.HasPrefix(k, key+"/")
+	}
+	w := newEtcdWatcher(true, include, filter, c.helper.Codec, c.helper.ResourceVersioner, nil)
+	go feedEtcdWatcher(w, initial, input, sequence, since)
+	return w, nil
+}
+
+// sortedNodes returns the items below key in the order etcd lists them. Must be called with
+// the lock held.
+func (c *WatchCache) sortedNodes(key string) []*etcd.Node {
+	nodes := []*etcd.Node{}
+	for k, node := range c.nodes {
+		if strings.HasPrefix(k, key+"/") {
+			nodes = append(nodes, node)
+		}
+	}
+	sort.Sort(byKey(nodes))
+	return nodes
+}
+
+// listAndWatch fills the cache with the current items and keeps it current until the etcd
+// watch fails or the cache is stopped.
+func (c *WatchCache) listAndWatch() error {
+	nodes, index, err := c.helper.listEtcdNode(c.key)
+	if err != nil {
+		return err
+	}
+	c.replace(flattenNodes(nodes, nil), index)
+	defer c.invalidate()
+
+	incoming := make(chan *etcd.Response)
+	stop := make(chan bool, 1)
+	done := make(chan error, 1)
+	go func() {
+		defer util.HandleCrash()
+		_, err := c.helper.Client.Watch(c.key, index+1, true, incoming, stop)
+		done <- err
+	}()
+	defer func() {
+		stop <- true
+		// Unblock the etcd watch if it is waiting to hand over a change.
+		go func() {
+			for _ = range incoming {
+			}
+		}()
+	}()
+
+	for {
+		select {
+		case res, ok := <-incoming:
+			if !ok {
+				// Wait for the etcd watch to report why it ended.
+				incoming = nil
+				continue
+			}
+			c.add(res)
+		case err := <-done:
+			if err == nil || err == etcd.ErrWatchStoppedByUser {
+				return nil
+			}
+			return err
+		case <-c.stop:
+			return nil
+		}
+	}
+}
+
+// replace makes nodes, listed at index, the contents of the cache.
+func (c *WatchCache) replace(nodes []*etcd.Node, index uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.nodes = make(map[string]*etcd.Node, len(nodes))
+	for _, node := range nodes {
+		c.nodes[node.Key] = node
+	}
+	c.window = nil
+	c.windowStart = index
+	c.resourceVersion = index
+	c.ready = true
+}
+
+// invalidate stops serving requests from the cache and ends the watches it serves, whose
+// clients have to start over from etcd or from a refilled cache.
+func (c *WatchCache) invalidate() {
+	c.lock.Lock()
+	c.ready = false
+	c.nodes = nil
+	c.window = nil
+	broadcaster := c.broadcaster
+	c.broadcaster = watch.NewBroadcaster(watchCacheQueueLength, watch.DropIfChannelFull)
+	c.lock.Unlock()
+	broadcaster.Shutdown()
+}
+
+// add applies a change reported by etcd to the cache and hands it to the watchers. It is only
+// called from listAndWatch, which keeps the changes in order and never calls it after
+// invalidate has shut the broadcaster down.
+func (c *WatchCache) add(res *etcd.Response) {
+	if res.Node == nil || res.Node.Dir {
+		return
+	}
+	c.lock.Lock()
+	switch res.Action {
+	case "delete", "compareAndDelete", "expire":
+		delete(c.nodes, res.Node.Key)
+	default:
+		c.nodes[res.Node.Key] = res.Node
+	}
+	if c.capacity > 0 {
+		if len(c.window) == c.capacity {
+			c.windowStart = c.window[0].Node.ModifiedIndex
+			c.window = c.window[1:]
+		}
+		c.window = append(c.window, res)
+	} else {
+		c.windowStart = res.Node.ModifiedIndex
+	}
+	c.resourceVersion = res.Node.ModifiedIndex
+	c.sequence++
+	event := &watchCacheEvent{res, c.sequence}
+	broadcaster := c.broadcaster
+	c.lock.Unlock()
+	broadcaster.Action(watch.Modified, event)
+}
+
+// feedEtcdWatcher hands w the initial changes, followed by the changes from input after the
+// sequence number sequence that happened at or after since, in place of an etcd watch. Changes
+// are dropped for input once its queue is full, so w is ended with errWatchCacheLagging as soon
+// as the queue is found full or a change is missing. Meant to be called as a goroutine.
+func feedEtcdWatcher(w *etcdWatcher, initial []*etcd.Response, input watch.Interface, sequence, since uint64) {
+	defer util.HandleCrash()
+	defer close(w.etcdError)
+	defer input.Stop()
+
+	for _, res := range initial {
+		select {
+		case w.etcdIncoming <- res:
+		case <-w.etcdStop:
+			return
+		}
+	}
+	for {
+		if queue := input.ResultChan(); len(queue) == cap(queue) {
+			w.etcdError <- errWatchCacheLagging
+			return
+		}
+		select {
+		case event, ok := <-input.ResultChan():
+			if !ok {
+				return
+			}
+			change := event.Object.(*watchCacheEvent)
+			if change.sequence <= sequence {
+				continue
+			}
+			if change.sequence != sequence+1 {
+				w.etcdError <- errWatchCacheLagging
+				return
+			}
+			sequence = change.sequence
+			res := change.response
+			if res.Node.ModifiedIndex < since {
+				continue
+			}
+			select {
+			case w.etcdIncoming <- res:
+			case <-w.etcdStop:
+				return
+			}
+		case <-w.etcdStop:
+			return
+		}
+	}
+}
+
+// watchCacheEvent carries a change through the broadcaster of a WatchCache.
+type watchCacheEvent struct {
+	response *etcd.Response
+	sequence uint64
+}
+
+func (*watchCacheEvent) IsAnAPIObject() {}
+
+// byKey sorts nodes the way a sorted recursive get returns them.
+type byKey []*etcd.Node
+
+func (n byKey) Len() int           { return len(n) }
+func (n byKey) Swap(i, j int)      { n[i], n[j] = n[j], n[i] }
+func (n byKey) Less(i, j int) bool { return keyLess(n[i].Key, n[j].Key) }
@@ -0,0 +1,231 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tools
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+	"github.com/coreos/go-etcd/etcd"
+)
+
+func expectPodEvent(t *testing.T, w watch.Interface, eventType watch.EventType, name, resourceVersion string) {
+	event, ok := <-w.ResultChan()
+	if !ok {
+		t.Fatalf("expected %s of %s, watch was closed", eventType, name)
+	}
+	pod, ok := event.Object.(*api.Pod)
+	if !ok || event.Type != eventType || pod.Name != name || pod.ResourceVersion != resourceVersion {
+		t.Fatalf("expected %s of %s at %s, got %#v", eventType, name, resourceVersion, event)
+	}
+}
+
+func newTestWatchCache(t *testing.T, capacity int) (*FakeEtcdClient, *WatchCache) {
+	fakeClient := NewFakeEtcdClient(t)
+	fakeClient.Data["/registry/pods"] = EtcdResponseWithError{
+		R: &etcd.Response{
+			EtcdIndex: 5,
+			Node: &etcd.Node{
+				Dir: true,
+				Nodes: []*etcd.Node{
+					{Key: "/registry/pods/ns1", Dir: true, Nodes: []*etcd.Node{podNode("/registry/pods/ns1/foo", "foo", 1)}},
+					{Key: "/registry/pods/ns2", Dir: true, Nodes: []*etcd.Node{podNode("/registry/pods/ns2/baz", "baz", 2)}},
+				},
+			},
+		},
+	}
+	c := NewWatchCache(EtcdHelper{fakeClient, latest.Codec, versioner}, "/registry/pods", capacity)
+	fakeClient.WaitForWatchCompletion()
+	if fakeClient.WatchIndex != 6 {
+		t.Fatalf("expected the cache to watch from index 6, got %d", fakeClient.WatchIndex)
+	}
+	return fakeClient, c
+}
+
+func TestWatchCacheList(t *testing.T) {
+	fakeClient, c := newTestWatchCache(t, 10)
+	defer c.Stop()
+
+	// The cache answers without asking etcd again.
+	fakeClient.Data["/registry/pods"] = EtcdResponseWithError{E: fmt.Errorf("unexpected read")}
+	list := &api.PodList{}
+	if err := c.ExtractToList("/registry/pods", list); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Items) != 2 || list.Items[0].Name != "foo" || list.Items[1].Name != "baz" || list.ResourceVersion != "5" {
+		t.Errorf("unexpected list: %#v", list)
+	}
+	list = &api.PodList{}
+	if err := c.ExtractToList("/registry/pods/ns2", list); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "baz" || list.Items[0].ResourceVersion != "2" {
+		t.Errorf("unexpected list: %#v", list)
+	}
+
+	// Changes are reflected in later lists.
+	w, err := c.WatchList("/registry/pods/ns1", 0, Everything)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer w.Stop()
+	expectPodEvent(t, w, watch.Added, "foo", "1")
+	fakeClient.WatchResponse <- &etcd.Response{Action: "delete", Node: &etcd.Node{Key: "/registry/pods/ns1/foo", ModifiedIndex: 6}, PrevNode: podNode("/registry/pods/ns1/foo", "foo", 1)}
+	expectPodEvent(t, w, watch.Deleted, "foo", "6")
+	list = &api.PodList{}
+	if err := c.ExtractToList("/registry/pods", list); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "baz" || list.ResourceVersion != "6" {
+		t.Errorf("unexpected list: %#v", list)
+	}
+}
+
+func TestWatchCacheWatch(t *testing.T) {
+	fakeClient, c := newTestWatchCache(t, 2)
+	defer c.Stop()
+
+	all, err := c.WatchList("/registry/pods", 0, Everything)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer all.Stop()
+	onlyB, err := c.WatchList("/registry/pods/ns1", 0, func(obj runtime.Object) bool {
+		return obj.(*api.Pod).Name[0] == 'b'
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer onlyB.Stop()
+	expectPodEvent(t, all, watch.Added, "foo", "1")
+	expectPodEvent(t, all, watch.Added, "baz", "2")
+
+	fakeClient.WatchResponse <- &etcd.Response{Action: "create", Node: podNode("/registry/pods/ns1/bar", "bar", 6)}
+	fakeClient.WatchResponse <- &etcd.Response{Action: "set", Node: podNode("/registry/pods/ns2/baz", "baz", 7), PrevNode: podNode("/registry/pods/ns2/baz", "baz", 2)}
+	fakeClient.WatchResponse <- &etcd.Response{Action: "set", Node: podNode("/registry/pods/ns1/foo", "foo", 8), PrevNode: podNode("/registry/pods/ns1/foo", "foo", 1)}
+	expectPodEvent(t, all, watch.Added, "bar", "6")
+	expectPodEvent(t, all, watch.Modified, "baz", "7")
+	expectPodEvent(t, all, watch.Modified, "foo", "8")
+	expectPodEvent(t, onlyB, watch.Added, "bar", "6")
+
+	// A watch from a resource version in the window replays the changes since then.
+	resumed, err := c.WatchList("/registry/pods", 7, Everything)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resumed.Stop()
+	expectPodEvent(t, resumed, watch.Modified, "baz", "7")
+	expectPodEvent(t, resumed, watch.Modified, "foo", "8")
+	fakeClient.WatchResponse <- &etcd.Response{Action: "create", Node: podNode("/registry/pods/ns1/bop", "bop", 9)}
+	expectPodEvent(t, resumed, watch.Added, "bop", "9")
+	expectPodEvent(t, onlyB, watch.Added, "bop", "9")
+
+	// Older changes have left the window, so the watch goes to etcd.
+	old, err := c.WatchList("/registry/pods", 7, Everything)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer old.Stop()
+	fakeClient.WaitForWatchCompletion()
+	if fakeClient.WatchIndex != 7 {
+		t.Errorf("expected a watch of etcd from index 7, got %d", fakeClient.WatchIndex)
+	}
+}
+
+func TestWatchCacheInvalidate(t *testing.T) {
+	fakeClient, c := newTestWatchCache(t, 10)
+	defer c.Stop()
+
+	w, err := c.WatchList("/registry/pods", 0, Everything)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectPodEvent(t, w, watch.Added, "foo", "1")
+	expectPodEvent(t, w, watch.Added, "baz", "2")
+
+	fakeClient.WatchInjectError <- fmt.Errorf("injected error")
+	if event, open := <-w.ResultChan(); open {
+		t.Errorf("expected the watch to end when the cache is out of date, got %#v", event)
+	}
+
+	// Until the cache is filled again, lists are read from etcd.
+	fakeClient.Data["/registry/pods"] = EtcdResponseWithError{
+		R: &etcd.Response{
+			EtcdIndex: 20,
+			Node:      &etcd.Node{Dir: true, Nodes: []*etcd.Node{podNode("/registry/pods/ns1/bar", "bar", 12)}},
+		},
+	}
+	list := &api.PodList{}
+	if err := c.ExtractToList("/registry/pods", list); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "bar" || list.ResourceVersion != "20" {
+		t.Errorf("unexpected list: %#v", list)
+	}
+}
+
+func TestWatchCacheLaggingWatcher(t *testing.T) {
+	fakeClient, c := newTestWatchCache(t, 10)
+	defer c.Stop()
+
+	lagging, err := c.WatchList("/registry/pods", 0, Everything)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer lagging.Stop()
+
+	// The lagging watcher reads nothing while more changes arrive than it can queue.
+	changes := watchCacheQueueLength + 10
+	for i := 0; i < changes; i++ {
+		name := fmt.Sprintf("pod%d", i)
+		fakeClient.WatchResponse <- &etcd.Response{Action: "create", Node: podNode("/registry/pods/ns1/"+name, name, uint64(6+i))}
+	}
+
+	// Other clients are not held up by it.
+	list := &api.PodList{}
+	if err := c.ExtractToList("/registry/pods/ns2", list); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "baz" {
+		t.Errorf("unexpected list: %#v", list)
+	}
+	w, err := c.WatchList("/registry/pods/ns2", 0, Everything)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer w.Stop()
+	expectPodEvent(t, w, watch.Added, "baz", "2")
+	fakeClient.WatchResponse <- &etcd.Response{Action: "create", Node: podNode("/registry/pods/ns2/bar", "bar", uint64(6+changes))}
+	expectPodEvent(t, w, watch.Added, "bar", fmt.Sprintf("%d", 6+changes))
+
+	// The lagging watcher is told to start over once it has read what was queued for it.
+	events := 0
+	for event := range lagging.ResultChan() {
+		if event.Type == watch.Error {
+			if _, open := <-lagging.ResultChan(); open {
+				t.Errorf("expected the lagging watch to end after the error")
+			}
+			return
+		}
+		events++
+	}
+	t.Errorf("expected an error after %d events, the watch was closed without one", events)
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tools
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/conversion"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/coreos/go-etcd/etcd"
+)
+
+var (
+	// ErrInvalidContinue is returned when a continue token cannot be understood.
+	ErrInvalidContinue = errors.New("the continue token is not valid")
+	// ErrContinueExpired is returned when items that a continue token refers to changed after the
+	// token was issued, so the rest of the list can no longer be returned consistently with the
+	// chunks before it.
+	ErrContinueExpired = errors.New("the list has changed since the continue token was issued; restart the list without a continue token")
+)
+
+// continueToken records where a chunked list stopped and the etcd index of its first chunk.
+type continueToken struct {
+	ResourceVersion uint64 `json:"resourceVersion"`
+	StartAfter      string `json:"startAfter"`
+}
+
+func encodeContinue(resourceVersion uint64, startAfter string) (string, error) {
+	data, err := json.Marshal(continueToken{resourceVersion, startAfter})
+	if err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(data), nil
+}
+
+func decodeContinue(s string) (*continueToken, error) {
+	data, err := base64.URLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidContinue
+	}
+	token := &continueToken{}
+	if err := json.Unmarshal(data, token); err != nil || token.ResourceVersion == 0 || len(token.StartAfter) == 0 {
+		return nil, ErrInvalidContinue
+	}
+	return token, nil
+}
+
+// ExtractToListPage is like ExtractToList, but returns only a chunk of the list: at most limit
+// items (all of them if limit is zero) accepted by filter, following the item the continue token
+// stopped at. It returns the token for the next chunk, or an empty string when the list is complete.
+// Every chunk of a list carries the resource version of the first chunk; ErrContinueExpired is
+// returned if an item that has not been returned yet was created or modified after it. The whole
+// directory is read from etcd for every chunk, since etcd cannot serve a directory as it was at
+// an earlier index, and items deleted after the first chunk are silently left out.
+func (h *EtcdHelper) ExtractToListPage(key string, listObj runtime.Object, filter FilterFunc, limit int, continueToken string) (string, error) {
+	listPtr, err := runtime.GetItemsPtr(listObj)
+	if err != nil {
+		return "", err
+	}
+	v, err := conversion.EnforcePtr(listPtr)
+	if err != nil || v.Kind() != reflect.Slice {
+		// This should not happen at runtime.
+		panic("need ptr to slice")
+	}
+
+	var startAfter string
+	nodes, resourceVersion, err := h.listEtcdNode(key)
+	if err != nil {
+		return "", err
+	}
+	if len(continueToken) > 0 {
+		token, err := decodeContinue(continueToken)
+		if err != nil {
+			return "", err
+		}
+		resourceVersion, startAfter = token.ResourceVersion, token.StartAfter
+	}
+
+	next := ""
+	last := ""
+	for _, node := range flattenNodes(nodes, nil) {
+		if len(startAfter) > 0 && !keyLess(startAfter, node.Key) {
+			continue
+		}
+		if node.ModifiedIndex > resourceVersion {
+			return "", ErrContinueExpired
+		}
+		if limit > 0 && v.Len() == limit {
+			if next, err = encodeContinue(resourceVersion, last); err != nil {
+				return "", err
+			}
+			break
+		}
+		obj := reflect.New(v.Type().Elem())
+		if err := h.Codec.DecodeInto([]byte(node.Value), obj.Interface().(runtime.Object)); err != nil {
+			return "", err
+		}
+		if h.ResourceVersioner != nil {
+			_ = h.ResourceVersioner.SetResourceVersion(obj.Interface().(runtime.Object), node.ModifiedIndex)
+			// being unable to set the version does not prevent the object from being extracted
+		}
+		last = node.Key
+		if filter != nil && !filter(obj.Interface().(runtime.Object)) {
+			continue
+		}
+		v.Set(reflect.Append(v, obj.Elem()))
+	}
+
+	if h.ResourceVersioner != nil {
+		if err := h.ResourceVersioner.SetResourceVersion(listObj, resourceVersion); err != nil {
+			return "", err
+		}
+	}
+	return next, nil
+}
+
+// flattenNodes appends the leaves below nodes to list in the order etcd returned them.
+func flattenNodes(nodes []*etcd.Node, list []*etcd.Node) []*etcd.Node {
+	for _, node := range nodes {
+		if node.Dir {
+			list = flattenNodes(node.Nodes, list)
+			continue
+		}
+		list = append(list, node)
+	}
+	return list
+}
+
+// keyLess orders keys the way a sorted recursive get returns them, one path segment at a time.
+func keyLess(a, b string) bool {
+	aParts, bParts := strings.Split(a, "/"), strings.Split(b, "/")
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		if aParts[i] != bParts[i] {
+			return aParts[i] < bParts[i]
+		}
+	}
+	return len(aParts) < len(bParts)
+}
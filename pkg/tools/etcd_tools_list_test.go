@@ -0,0 +1,197 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tools
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/coreos/go-etcd/etcd"
+)
+
+func podNode(key, name string, index uint64) *etcd.Node {
+	return &etcd.Node{
+		Key:           key,
+		Value:         `{"id":"` + name + `","kind":"Pod","apiVersion":"v1beta1"}`,
+		CreatedIndex:  index,
+		ModifiedIndex: index,
+	}
+}
+
+// newPagedEtcdClient returns a client holding pods in two directories whose names sort
+// differently as whole keys than segment by segment.
+func newPagedEtcdClient(t *testing.T, barIndex uint64) *FakeEtcdClient {
+	fakeClient := NewFakeEtcdClient(t)
+	fakeClient.Data["/some/key"] = EtcdResponseWithError{
+		R: &etcd.Response{
+			EtcdIndex: 10,
+			Node: &etcd.Node{
+				Dir: true,
+				Nodes: []*etcd.Node{
+					{
+						Key: "/some/key/a",
+						Dir: true,
+						Nodes: []*etcd.Node{
+							podNode("/some/key/a/baz", "baz", 1),
+							podNode("/some/key/a/foo", "foo", 2),
+						},
+					},
+					{
+						Key: "/some/key/a-b",
+						Dir: true,
+						Nodes: []*etcd.Node{
+							podNode("/some/key/a-b/bar", "bar", barIndex),
+						},
+					},
+				},
+			},
+		},
+	}
+	return fakeClient
+}
+
+func podNames(list *api.PodList) []string {
+	names := []string{}
+	for _, pod := range list.Items {
+		names = append(names, pod.Name)
+	}
+	return names
+}
+
+func TestExtractToListPage(t *testing.T) {
+	helper := EtcdHelper{newPagedEtcdClient(t, 3), testapi.Codec(), versioner}
+
+	var first api.PodList
+	next, err := helper.ExtractToListPage("/some/key", &first, nil, 2, "")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if e, a := []string{"baz", "foo"}, podNames(&first); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if first.ResourceVersion != "10" || len(next) == 0 {
+		t.Errorf("Unexpected first page %#v, continue %q", first.ListMeta, next)
+	}
+
+	var second api.PodList
+	next, err = helper.ExtractToListPage("/some/key", &second, nil, 2, next)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if e, a := []string{"bar"}, podNames(&second); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if second.ResourceVersion != "10" || len(next) != 0 {
+		t.Errorf("Unexpected last page %#v, continue %q", second.ListMeta, next)
+	}
+}
+
+func TestExtractToListPageFilter(t *testing.T) {
+	helper := EtcdHelper{newPagedEtcdClient(t, 3), testapi.Codec(), versioner}
+	filter := func(obj runtime.Object) bool {
+		return obj.(*api.Pod).Name != "foo"
+	}
+
+	var first api.PodList
+	next, err := helper.ExtractToListPage("/some/key", &first, filter, 1, "")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if e, a := []string{"baz"}, podNames(&first); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+
+	var second api.PodList
+	next, err = helper.ExtractToListPage("/some/key", &second, filter, 1, next)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if e, a := []string{"bar"}, podNames(&second); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if len(next) != 0 {
+		t.Errorf("Unexpected continue %q", next)
+	}
+}
+
+func TestExtractToListPageUnlimited(t *testing.T) {
+	helper := EtcdHelper{newPagedEtcdClient(t, 3), testapi.Codec(), versioner}
+	var list api.PodList
+	next, err := helper.ExtractToListPage("/some/key", &list, nil, 0, "")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if e, a := []string{"baz", "foo", "bar"}, podNames(&list); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if len(next) != 0 {
+		t.Errorf("Unexpected continue %q", next)
+	}
+}
+
+func TestExtractToListPageExpired(t *testing.T) {
+	var list api.PodList
+	helper := EtcdHelper{newPagedEtcdClient(t, 3), testapi.Codec(), versioner}
+	next, err := helper.ExtractToListPage("/some/key", &list, nil, 2, "")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	// bar is modified after the first page was returned
+	helper = EtcdHelper{newPagedEtcdClient(t, 11), testapi.Codec(), versioner}
+	if _, err := helper.ExtractToListPage("/some/key", &api.PodList{}, nil, 2, next); err != ErrContinueExpired {
+		t.Errorf("Expected the continue token to expire, got %v", err)
+	}
+}
+
+func TestExtractToListPageReturnedOrDeleted(t *testing.T) {
+	var first api.PodList
+	helper := EtcdHelper{newPagedEtcdClient(t, 3), testapi.Codec(), versioner}
+	next, err := helper.ExtractToListPage("/some/key", &first, nil, 1, "")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	// baz, already returned, is modified and foo is deleted after the first page
+	fakeClient := newPagedEtcdClient(t, 3)
+	dir := fakeClient.Data["/some/key"].R.Node.Nodes[0]
+	dir.Nodes = []*etcd.Node{podNode("/some/key/a/baz", "baz", 11)}
+	helper = EtcdHelper{fakeClient, testapi.Codec(), versioner}
+	var second api.PodList
+	next, err = helper.ExtractToListPage("/some/key", &second, nil, 1, next)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if e, a := []string{"bar"}, podNames(&second); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if second.ResourceVersion != "10" || len(next) != 0 {
+		t.Errorf("Unexpected last page %#v, continue %q", second.ListMeta, next)
+	}
+}
+
+func TestExtractToListPageInvalidContinue(t *testing.T) {
+	helper := EtcdHelper{newPagedEtcdClient(t, 3), testapi.Codec(), versioner}
+	for _, token := range []string{"not base64!", "bm90IGpzb24=", "e30="} {
+		if _, err := helper.ExtractToListPage("/some/key", &api.PodList{}, nil, 2, token); err != ErrInvalidContinue {
+			t.Errorf("%q: expected an invalid continue error, got %v", token, err)
+		}
+	}
+}
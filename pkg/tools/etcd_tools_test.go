@@ -569,7 +569,8 @@ func TestAtomicUpdateNoChange(t *testing.T) {
 	// Update an existing node with the same data
 	callbackCalled := false
 	objUpdate := &TestResource{ObjectMeta: api.ObjectMeta{Name: "foo"}, Value: 1}
-	err = helper.AtomicUpdate("/some/key", &TestResource{}, true, func(in runtime.Object) (runtime.Object, error) {
+	out := &TestResource{}
+	err = helper.AtomicUpdate("/some/key", out, true, func(in runtime.Object) (runtime.Object, error) {
 		fakeClient.Err = errors.New("should not be called")
 		callbackCalled = true
 		return objUpdate, nil
@@ -580,6 +581,9 @@ func TestAtomicUpdateNoChange(t *testing.T) {
 	if !callbackCalled {
 		t.Errorf("tryUpdate callback should have been called.")
 	}
+	if out.Name != "foo" || out.Value != 1 || out.ResourceVersion == "" {
+		t.Errorf("expected the stored object to be returned, got %#v", out)
+	}
 }
 
 func TestAtomicUpdateKeyNotFound(t *testing.T) {
@@ -147,7 +147,7 @@ func newEtcdWatcher(list bool, include includeFunc, filter FilterFunc, encoding
 		filter:       filter,
 		etcdIncoming: make(chan *etcd.Response),
 		etcdError:    make(chan error, 1),
-		etcdStop:     make(chan bool),
+		etcdStop:     make(chan bool, 1),
 		outgoing:     make(chan watch.Event),
 		userStop:     make(chan struct{}),
 	}
@@ -0,0 +1,89 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cache provides in-memory caches for use within the server components.
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+type expiringEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// ExpiringCache is a bounded map whose entries are forgotten once their time to
+// live has passed. It is safe for concurrent use.
+type ExpiringCache struct {
+	maxEntries int
+	clock      util.Clock
+
+	lock    sync.Mutex
+	entries map[string]expiringEntry
+}
+
+// NewExpiringCache returns an ExpiringCache that holds at most maxEntries entries.
+func NewExpiringCache(maxEntries int, clock util.Clock) *ExpiringCache {
+	return &ExpiringCache{
+		maxEntries: maxEntries,
+		clock:      clock,
+		entries:    make(map[string]expiringEntry),
+	}
+}
+
+// Get returns the value stored under key, or false if there is none or it has expired.
+func (c *ExpiringCache) Get(key string) (interface{}, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !c.clock.Now().Before(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// Add stores value under key for ttl. When the cache is full, expired entries are
+// dropped first, and then arbitrary ones until there is room.
+func (c *ExpiringCache) Add(key string, value interface{}, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	now := c.clock.Now()
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.maxEntries {
+		for k, entry := range c.entries {
+			if !now.Before(entry.expires) {
+				delete(c.entries, k)
+			}
+		}
+		for k := range c.entries {
+			if len(c.entries) < c.maxEntries {
+				break
+			}
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = expiringEntry{value, now.Add(ttl)}
+}
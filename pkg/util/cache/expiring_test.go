@@ -0,0 +1,76 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+func TestExpiringCache(t *testing.T) {
+	clock := &util.FakeClock{Time: time.Now()}
+	c := NewExpiringCache(10, clock)
+	c.Add("short", 1, time.Second)
+	c.Add("long", 2, time.Minute)
+	c.Add("never", 3, 0)
+
+	if value, ok := c.Get("short"); !ok || value != 1 {
+		t.Errorf("unexpected value: %v %v", value, ok)
+	}
+	if _, ok := c.Get("never"); ok {
+		t.Errorf("expected entries without a ttl not to be stored")
+	}
+
+	clock.Time = clock.Time.Add(time.Second)
+	if _, ok := c.Get("short"); ok {
+		t.Errorf("expected short to expire")
+	}
+	if value, ok := c.Get("long"); !ok || value != 2 {
+		t.Errorf("unexpected value: %v %v", value, ok)
+	}
+}
+
+func TestExpiringCacheIsBounded(t *testing.T) {
+	clock := &util.FakeClock{Time: time.Now()}
+	c := NewExpiringCache(2, clock)
+	c.Add("expired", 1, time.Second)
+	c.Add("live", 2, time.Minute)
+	clock.Time = clock.Time.Add(time.Second)
+
+	// The expired entry makes room.
+	c.Add("new", 3, time.Minute)
+	if len(c.entries) != 2 {
+		t.Fatalf("expected two entries, got %#v", c.entries)
+	}
+	if _, ok := c.Get("live"); !ok {
+		t.Errorf("expected live entries to be kept while expired ones could be dropped")
+	}
+
+	c.Add("another", 4, time.Minute)
+	if len(c.entries) != 2 {
+		t.Errorf("expected the cache to stay bounded, got %#v", c.entries)
+	}
+	if value, ok := c.Get("another"); !ok || value != 4 {
+		t.Errorf("unexpected value: %v %v", value, ok)
+	}
+	c.Add("another", 5, time.Minute)
+	if value, ok := c.Get("another"); !ok || value != 5 || len(c.entries) != 2 {
+		t.Errorf("expected replacing an entry to keep the other: %v %v %#v", value, ok, c.entries)
+	}
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package strategicpatch applies JSON merge patches that understand how the lists of an API
+// type should be combined.
+package strategicpatch
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const (
+	// directiveMarker is the key a patch uses to ask for special handling of a list element.
+	directiveMarker = "$patch"
+	// deleteDirective removes the element with the same merge key from the list.
+	deleteDirective = "delete"
+	// mergeStrategy is the value of the patchStrategy struct tag for lists that are merged by key.
+	mergeStrategy = "merge"
+)
+
+// StrategicMergePatch applies patch to original, both JSON documents describing an object of the
+// same type as dataStruct. The patch follows JSON merge patch rules - objects are merged, null
+// deletes a field and other values replace the original - except for list fields tagged with
+// `patchStrategy:"merge"`. Elements of those lists are matched by the field named in the
+// `patchMergeKey` tag and merged with the original element, or appended if no element matches;
+// an element carrying `"$patch": "delete"` removes the matching element instead. Lists of
+// primitives tagged for merging are combined as a union.
+func StrategicMergePatch(original, patch []byte, dataStruct interface{}) ([]byte, error) {
+	if dataStruct == nil {
+		return nil, fmt.Errorf("a data struct is required to apply a strategic merge patch")
+	}
+	originalMap := map[string]interface{}{}
+	if len(original) > 0 {
+		if err := json.Unmarshal(original, &originalMap); err != nil {
+			return nil, err
+		}
+	}
+	patchMap := map[string]interface{}{}
+	if err := json.Unmarshal(patch, &patchMap); err != nil {
+		return nil, err
+	}
+	result, err := mergeMap(originalMap, patchMap, reflect.TypeOf(dataStruct))
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(result)
+}
+
+// mergeMap merges patch into original, which may be nil, using t to find the patch strategy of
+// each field. A nil t means nothing is known about the fields.
+func mergeMap(original, patch map[string]interface{}, t reflect.Type) (map[string]interface{}, error) {
+	if original == nil {
+		original = map[string]interface{}{}
+	}
+	for k, patchV := range patch {
+		if k == directiveMarker {
+			return nil, fmt.Errorf("the %s directive is only supported on elements of merged lists", directiveMarker)
+		}
+		if patchV == nil {
+			delete(original, k)
+			continue
+		}
+		fieldType, strategy, mergeKey := lookupField(t, k)
+		merged, err := mergeValue(original[k], patchV, fieldType, strategy, mergeKey)
+		if err != nil {
+			return nil, err
+		}
+		original[k] = merged
+	}
+	return original, nil
+}
+
+func mergeValue(original, patch interface{}, t reflect.Type, strategy, mergeKey string) (interface{}, error) {
+	switch typedPatch := patch.(type) {
+	case map[string]interface{}:
+		originalMap, _ := original.(map[string]interface{})
+		return mergeMap(originalMap, typedPatch, t)
+	case []interface{}:
+		if strategy != mergeStrategy {
+			return typedPatch, nil
+		}
+		originalSlice, _ := original.([]interface{})
+		return mergeSlice(originalSlice, typedPatch, elemType(t), mergeKey)
+	default:
+		return patch, nil
+	}
+}
+
+// mergeSlice merges the elements of patch into original. Without a merge key the lists are
+// treated as sets of primitive values.
+func mergeSlice(original, patch []interface{}, t reflect.Type, mergeKey string) ([]interface{}, error) {
+	if len(mergeKey) == 0 {
+		for _, v := range patch {
+			if findValue(original, v) < 0 {
+				original = append(original, v)
+			}
+		}
+		return original, nil
+	}
+
+	for _, v := range patch {
+		patchElem, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("elements of a list merged by %q must be objects: %v", mergeKey, v)
+		}
+		key, ok := patchElem[mergeKey]
+		if !ok {
+			return nil, fmt.Errorf("element of a list merged by %q does not have that key: %v", mergeKey, patchElem)
+		}
+		i := findElement(original, mergeKey, key)
+		if directive, ok := patchElem[directiveMarker]; ok {
+			if directive != deleteDirective {
+				return nil, fmt.Errorf("unknown patch directive %v", directive)
+			}
+			if i >= 0 {
+				original = append(original[:i], original[i+1:]...)
+			}
+			continue
+		}
+		if i < 0 {
+			merged, err := mergeMap(nil, patchElem, t)
+			if err != nil {
+				return nil, err
+			}
+			original = append(original, merged)
+			continue
+		}
+		originalElem, _ := original[i].(map[string]interface{})
+		merged, err := mergeMap(originalElem, patchElem, t)
+		if err != nil {
+			return nil, err
+		}
+		original[i] = merged
+	}
+	return original, nil
+}
+
+func findValue(list []interface{}, value interface{}) int {
+	for i := range list {
+		if reflect.DeepEqual(list[i], value) {
+			return i
+		}
+	}
+	return -1
+}
+
+func findElement(list []interface{}, mergeKey string, key interface{}) int {
+	for i := range list {
+		if elem, ok := list[i].(map[string]interface{}); ok && reflect.DeepEqual(elem[mergeKey], key) {
+			return i
+		}
+	}
+	return -1
+}
+
+// lookupField returns the type and patch tags of the field serialized as name in t. Fields of
+// maps have the map's element type and no patch strategy.
+func lookupField(t reflect.Type, name string) (fieldType reflect.Type, strategy, mergeKey string) {
+	t = indirect(t)
+	if t == nil {
+		return nil, "", ""
+	}
+	switch t.Kind() {
+	case reflect.Map:
+		return t.Elem(), "", ""
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			tag := field.Tag.Get("json")
+			fieldName := strings.Split(tag, ",")[0]
+			if len(fieldName) == 0 && field.Anonymous {
+				// inlined structs contribute their fields to the parent
+				if fieldType, strategy, mergeKey := lookupField(field.Type, name); fieldType != nil {
+					return fieldType, strategy, mergeKey
+				}
+				continue
+			}
+			if len(fieldName) == 0 {
+				fieldName = field.Name
+			}
+			if fieldName == name {
+				return field.Type, field.Tag.Get("patchStrategy"), field.Tag.Get("patchMergeKey")
+			}
+		}
+	}
+	return nil, "", ""
+}
+
+func elemType(t reflect.Type) reflect.Type {
+	t = indirect(t)
+	if t == nil || (t.Kind() != reflect.Slice && t.Kind() != reflect.Array) {
+		return nil
+	}
+	return t.Elem()
+}
+
+func indirect(t reflect.Type) reflect.Type {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package strategicpatch
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type testContainer struct {
+	Name  string   `json:"name"`
+	Image string   `json:"image,omitempty"`
+	Ports []int    `json:"ports,omitempty" patchStrategy:"merge"`
+	Args  []string `json:"args,omitempty"`
+}
+
+type testSpec struct {
+	Containers []testContainer `json:"containers" patchStrategy:"merge" patchMergeKey:"name"`
+}
+
+type testMeta struct {
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+type testObject struct {
+	testMeta `json:",inline"`
+	Spec     *testSpec `json:"spec,omitempty"`
+	Replicas int       `json:"replicas,omitempty"`
+}
+
+func TestStrategicMergePatch(t *testing.T) {
+	testCases := []struct {
+		name     string
+		original string
+		patch    string
+		expected string
+	}{
+		{
+			name:     "replace a field",
+			original: `{"replicas":1}`,
+			patch:    `{"replicas":2}`,
+			expected: `{"replicas":2}`,
+		},
+		{
+			name:     "merge and delete map entries",
+			original: `{"labels":{"a":"1","b":"2"}}`,
+			patch:    `{"labels":{"b":null,"c":"3"}}`,
+			expected: `{"labels":{"a":"1","c":"3"}}`,
+		},
+		{
+			name:     "add to missing map ignores deletions",
+			original: `{}`,
+			patch:    `{"labels":{"a":"1","b":null}}`,
+			expected: `{"labels":{"a":"1"}}`,
+		},
+		{
+			name:     "merge list elements by key",
+			original: `{"spec":{"containers":[{"name":"a","image":"a:1"},{"name":"b","image":"b:1"}]}}`,
+			patch:    `{"spec":{"containers":[{"name":"b","image":"b:2"}]}}`,
+			expected: `{"spec":{"containers":[{"name":"a","image":"a:1"},{"name":"b","image":"b:2"}]}}`,
+		},
+		{
+			name:     "append list element with a new key",
+			original: `{"spec":{"containers":[{"name":"a","image":"a:1"}]}}`,
+			patch:    `{"spec":{"containers":[{"name":"b","image":"b:1"}]}}`,
+			expected: `{"spec":{"containers":[{"name":"a","image":"a:1"},{"name":"b","image":"b:1"}]}}`,
+		},
+		{
+			name:     "delete list element by key",
+			original: `{"spec":{"containers":[{"name":"a","image":"a:1"},{"name":"b","image":"b:1"}]}}`,
+			patch:    `{"spec":{"containers":[{"name":"a","$patch":"delete"}]}}`,
+			expected: `{"spec":{"containers":[{"name":"b","image":"b:1"}]}}`,
+		},
+		{
+			name:     "merge primitive lists as a union",
+			original: `{"spec":{"containers":[{"name":"a","ports":[80]}]}}`,
+			patch:    `{"spec":{"containers":[{"name":"a","ports":[80,443]}]}}`,
+			expected: `{"spec":{"containers":[{"name":"a","ports":[80,443]}]}}`,
+		},
+		{
+			name:     "replace lists without a strategy",
+			original: `{"spec":{"containers":[{"name":"a","args":["x","y"]}]}}`,
+			patch:    `{"spec":{"containers":[{"name":"a","args":["z"]}]}}`,
+			expected: `{"spec":{"containers":[{"name":"a","args":["z"]}]}}`,
+		},
+		{
+			name:     "unknown fields follow merge patch rules",
+			original: `{"other":[1,2],"nested":{"a":1}}`,
+			patch:    `{"other":[3],"nested":{"b":2}}`,
+			expected: `{"other":[3],"nested":{"a":1,"b":2}}`,
+		},
+	}
+	for _, testCase := range testCases {
+		result, err := StrategicMergePatch([]byte(testCase.original), []byte(testCase.patch), testObject{})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", testCase.name, err)
+			continue
+		}
+		var actual, expected interface{}
+		if err := json.Unmarshal(result, &actual); err != nil {
+			t.Fatalf("%s: unexpected error: %v", testCase.name, err)
+		}
+		if err := json.Unmarshal([]byte(testCase.expected), &expected); err != nil {
+			t.Fatalf("%s: unexpected error: %v", testCase.name, err)
+		}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("%s: expected %s, got %s", testCase.name, testCase.expected, string(result))
+		}
+	}
+}
+
+func TestStrategicMergePatchErrors(t *testing.T) {
+	testCases := []struct {
+		name  string
+		patch string
+	}{
+		{"invalid json", `{`},
+		{"missing merge key", `{"spec":{"containers":[{"image":"a:2"}]}}`},
+		{"non-object element", `{"spec":{"containers":["a"]}}`},
+		{"unknown directive", `{"spec":{"containers":[{"name":"a","$patch":"replace"}]}}`},
+		{"directive outside a list", `{"spec":{"$patch":"delete"}}`},
+	}
+	original := []byte(`{"spec":{"containers":[{"name":"a","image":"a:1"}]}}`)
+	for _, testCase := range testCases {
+		if _, err := StrategicMergePatch(original, []byte(testCase.patch), &testObject{}); err == nil {
+			t.Errorf("%s: expected an error", testCase.name)
+		}
+	}
+}
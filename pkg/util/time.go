@@ -94,6 +94,9 @@ func (t Time) MarshalJSON() ([]byte, error) {
 
 // Fuzz satisfies fuzz.Interface.
 func (t *Time) Fuzz(c fuzz.Continue) {
+	if t == nil {
+		return
+	}
 	// Allow for about 1000 years of randomness.  Leave off nanoseconds
 	// because JSON doesn't represent them so they can't round-trip
 	// properly.
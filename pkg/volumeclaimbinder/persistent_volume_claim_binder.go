@@ -0,0 +1,214 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumeclaimbinder
+
+import (
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/golang/glog"
+)
+
+// PersistentVolumeClaimBinder binds pending PersistentVolumeClaims to available
+// PersistentVolumes and keeps the phases of both in step with their bindings.
+type PersistentVolumeClaimBinder struct {
+	kubeClient client.Interface
+
+	// To allow injection of syncClaim for testing.
+	syncHandler func(claim *api.PersistentVolumeClaim, volumes []*api.PersistentVolume) error
+}
+
+// NewPersistentVolumeClaimBinder creates a new PersistentVolumeClaimBinder
+func NewPersistentVolumeClaimBinder(kubeClient client.Interface) *PersistentVolumeClaimBinder {
+	binder := &PersistentVolumeClaimBinder{
+		kubeClient: kubeClient,
+	}
+
+	// set the synchronization handler
+	binder.syncHandler = binder.syncClaim
+	return binder
+}
+
+// Run begins binding claims to volumes.
+func (binder *PersistentVolumeClaimBinder) Run(period time.Duration) {
+	go util.Forever(func() { binder.synchronize() }, period)
+}
+
+func (binder *PersistentVolumeClaimBinder) synchronize() {
+	volumeList, err := binder.kubeClient.PersistentVolumes().List(labels.Everything(), labels.Everything())
+	if err != nil {
+		glog.Errorf("Synchronization error: %v (%#v)", err, err)
+		return
+	}
+	claimList, err := binder.kubeClient.PersistentVolumeClaims(api.NamespaceAll).List(labels.Everything(), labels.Everything())
+	if err != nil {
+		glog.Errorf("Synchronization error: %v (%#v)", err, err)
+		return
+	}
+
+	claims := map[string]*api.PersistentVolumeClaim{}
+	for ix := range claimList.Items {
+		claim := &claimList.Items[ix]
+		claims[claimKey(claim.Namespace, claim.Name)] = claim
+	}
+
+	volumes := []*api.PersistentVolume{}
+	for ix := range volumeList.Items {
+		volume := &volumeList.Items[ix]
+		if err := binder.syncVolume(volume, claims); err != nil {
+			glog.Errorf("Error synchronizing persistent volume %v: %v", volume.Name, err)
+			continue
+		}
+		volumes = append(volumes, volume)
+	}
+
+	for ix := range claimList.Items {
+		claim := &claimList.Items[ix]
+		if claim.Status.VolumeRef != nil {
+			continue
+		}
+		glog.V(4).Infof("binding persistent volume claim %v/%v", claim.Namespace, claim.Name)
+		if err := binder.syncHandler(claim, volumes); err != nil {
+			glog.Errorf("Error binding persistent volume claim %v/%v: %v", claim.Namespace, claim.Name, err)
+		}
+	}
+}
+
+// syncVolume moves the volume to the phase that matches its binding. A volume
+// whose claim no longer exists is released and is not bound again.
+func (binder *PersistentVolumeClaimBinder) syncVolume(volume *api.PersistentVolume, claims map[string]*api.PersistentVolumeClaim) error {
+	phase := volume.Status.Phase
+	if ref := volume.Spec.ClaimRef; ref == nil {
+		if phase != api.VolumeReleased {
+			phase = api.VolumeAvailable
+		}
+	} else if claim := claims[claimKey(ref.Namespace, ref.Name)]; claim != nil && isBoundTo(ref, claim) {
+		phase = api.VolumeBound
+	} else if phase == api.VolumeBound {
+		phase = api.VolumeReleased
+	}
+	if phase == volume.Status.Phase {
+		return nil
+	}
+
+	glog.V(2).Infof("persistent volume %v changed phase from %q to %q", volume.Name, volume.Status.Phase, phase)
+	volume.Status.Phase = phase
+	updated, err := binder.kubeClient.PersistentVolumes().Update(volume)
+	if err != nil {
+		return err
+	}
+	// carry the new resource version forward so the volume can be bound in this pass
+	volume.ResourceVersion = updated.ResourceVersion
+	return nil
+}
+
+// syncClaim binds a pending claim to the smallest volume that satisfies it.
+// Volumes that are bound by this call are marked as such in volumes.
+func (binder *PersistentVolumeClaimBinder) syncClaim(claim *api.PersistentVolumeClaim, volumes []*api.PersistentVolume) error {
+	volume := findBestMatch(claim, volumes)
+	if volume == nil {
+		glog.V(4).Infof("no persistent volume matches claim %v/%v", claim.Namespace, claim.Name)
+		return nil
+	}
+
+	if volume.Spec.ClaimRef == nil {
+		volume.Spec.ClaimRef = &api.ObjectReference{
+			Kind:            "PersistentVolumeClaim",
+			Namespace:       claim.Namespace,
+			Name:            claim.Name,
+			UID:             claim.UID,
+			ResourceVersion: claim.ResourceVersion,
+		}
+		volume.Status.Phase = api.VolumeBound
+		updated, err := binder.kubeClient.PersistentVolumes().Update(volume)
+		if err != nil {
+			volume.Spec.ClaimRef = nil
+			return err
+		}
+		volume.ResourceVersion = updated.ResourceVersion
+	}
+
+	claim.Status.Phase = api.ClaimBound
+	claim.Status.AccessModes = volume.Spec.AccessModes
+	claim.Status.Capacity = volume.Spec.Capacity
+	claim.Status.VolumeRef = &api.ObjectReference{
+		Kind:            "PersistentVolume",
+		Name:            volume.Name,
+		UID:             volume.UID,
+		ResourceVersion: volume.ResourceVersion,
+	}
+	_, err := binder.kubeClient.PersistentVolumeClaims(claim.Namespace).Update(claim)
+	return err
+}
+
+// findBestMatch returns the volume already bound to the claim if there is one,
+// otherwise the unbound volume with the least capacity that offers every access
+// mode and at least the storage requested by the claim.
+func findBestMatch(claim *api.PersistentVolumeClaim, volumes []*api.PersistentVolume) *api.PersistentVolume {
+	requested := claim.Spec.Resources.Limits[api.ResourceStorage]
+	var best *api.PersistentVolume
+	for _, volume := range volumes {
+		if ref := volume.Spec.ClaimRef; ref != nil {
+			if isBoundTo(ref, claim) {
+				return volume
+			}
+			continue
+		}
+		if volume.Status.Phase != api.VolumeAvailable || !hasAccessModes(volume, claim.Spec.AccessModes) {
+			continue
+		}
+		capacity := volume.Spec.Capacity[api.ResourceStorage]
+		if capacity.Value() < requested.Value() {
+			continue
+		}
+		if best != nil {
+			bestCapacity := best.Spec.Capacity[api.ResourceStorage]
+			if bestCapacity.Value() <= capacity.Value() {
+				continue
+			}
+		}
+		best = volume
+	}
+	return best
+}
+
+// hasAccessModes returns true if the volume can be mounted with every one of the given modes.
+func hasAccessModes(volume *api.PersistentVolume, modes []api.AccessModeType) bool {
+	offered := util.NewStringSet()
+	for _, mode := range volume.Spec.AccessModes {
+		offered.Insert(string(mode))
+	}
+	for _, mode := range modes {
+		if !offered.Has(string(mode)) {
+			return false
+		}
+	}
+	return true
+}
+
+// isBoundTo returns true if ref refers to the claim. A reference without a UID,
+// as set by an administrator binding a volume ahead of time, matches by name.
+func isBoundTo(ref *api.ObjectReference, claim *api.PersistentVolumeClaim) bool {
+	return ref.Namespace == claim.Namespace && ref.Name == claim.Name && (len(ref.UID) == 0 || ref.UID == claim.UID)
+}
+
+func claimKey(namespace, name string) string {
+	return namespace + "/" + name
+}
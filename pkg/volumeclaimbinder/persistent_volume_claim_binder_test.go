@@ -0,0 +1,188 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumeclaimbinder
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+)
+
+func newVolume(name, capacity string, phase api.PersistentVolumePhase, modes ...api.AccessModeType) api.PersistentVolume {
+	return api.PersistentVolume{
+		ObjectMeta: api.ObjectMeta{Name: name, UID: types.UID("uid-" + name)},
+		Spec: api.PersistentVolumeSpec{
+			Capacity: api.ResourceList{
+				api.ResourceStorage: resource.MustParse(capacity),
+			},
+			AccessModes: modes,
+			PersistentVolumeSource: api.PersistentVolumeSource{
+				HostPath: &api.HostPathVolumeSource{Path: "/tmp/" + name},
+			},
+		},
+		Status: api.PersistentVolumeStatus{Phase: phase},
+	}
+}
+
+func newClaim(name, request string, modes ...api.AccessModeType) api.PersistentVolumeClaim {
+	return api.PersistentVolumeClaim{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: "test", UID: types.UID("uid-" + name)},
+		Spec: api.PersistentVolumeClaimSpec{
+			AccessModes: modes,
+			Resources: api.ResourceRequirements{
+				Limits: api.ResourceList{
+					api.ResourceStorage: resource.MustParse(request),
+				},
+			},
+		},
+		Status: api.PersistentVolumeClaimStatus{Phase: api.ClaimPending},
+	}
+}
+
+func TestFindBestMatch(t *testing.T) {
+	small := newVolume("small", "1G", api.VolumeAvailable, api.ReadWriteOnce)
+	medium := newVolume("medium", "5G", api.VolumeAvailable, api.ReadWriteOnce)
+	large := newVolume("large", "10G", api.VolumeAvailable, api.ReadWriteOnce, api.ReadOnlyMany)
+	bound := newVolume("bound", "5G", api.VolumeBound, api.ReadWriteOnce)
+	bound.Spec.ClaimRef = &api.ObjectReference{Namespace: "other", Name: "claim"}
+	volumes := []*api.PersistentVolume{&large, &bound, &small, &medium}
+
+	tests := map[string]struct {
+		claim    api.PersistentVolumeClaim
+		expected string
+	}{
+		"smallest that fits":     {newClaim("a", "3G", api.ReadWriteOnce), "medium"},
+		"exact fit":              {newClaim("b", "1G", api.ReadWriteOnce), "small"},
+		"requires access modes":  {newClaim("c", "1G", api.ReadOnlyMany), "large"},
+		"too large":              {newClaim("d", "20G", api.ReadWriteOnce), ""},
+		"unsupported accessmode": {newClaim("e", "1G", api.ReadWriteMany), ""},
+	}
+	for name, tc := range tests {
+		volume := findBestMatch(&tc.claim, volumes)
+		if len(tc.expected) == 0 {
+			if volume != nil {
+				t.Errorf("%s: expected no match, got %s", name, volume.Name)
+			}
+			continue
+		}
+		if volume == nil || volume.Name != tc.expected {
+			t.Errorf("%s: expected %s, got %#v", name, tc.expected, volume)
+		}
+	}
+}
+
+func TestFindBestMatchPrefersBoundVolume(t *testing.T) {
+	claim := newClaim("claim", "1G", api.ReadWriteOnce)
+	small := newVolume("small", "1G", api.VolumeAvailable, api.ReadWriteOnce)
+	prebound := newVolume("prebound", "10G", api.VolumeBound, api.ReadWriteOnce)
+	prebound.Spec.ClaimRef = &api.ObjectReference{Namespace: "test", Name: "claim"}
+
+	volume := findBestMatch(&claim, []*api.PersistentVolume{&small, &prebound})
+	if volume == nil || volume.Name != "prebound" {
+		t.Errorf("expected the volume bound to the claim, got %#v", volume)
+	}
+}
+
+func TestSynchronizeBindsClaim(t *testing.T) {
+	mockClient := &client.Fake{
+		PersistentVolumeList: api.PersistentVolumeList{
+			Items: []api.PersistentVolume{
+				newVolume("large", "10G", api.VolumeAvailable, api.ReadWriteOnce),
+				newVolume("small", "5G", api.VolumeAvailable, api.ReadWriteOnce),
+			},
+		},
+		PersistentVolumeClaimList: api.PersistentVolumeClaimList{
+			Items: []api.PersistentVolumeClaim{newClaim("claim", "3G", api.ReadWriteOnce)},
+		},
+	}
+	binder := NewPersistentVolumeClaimBinder(mockClient)
+	binder.synchronize()
+
+	var volume *api.PersistentVolume
+	var claim *api.PersistentVolumeClaim
+	for _, action := range mockClient.Actions {
+		switch action.Action {
+		case "update-persistentvolume":
+			volume = action.Value.(*api.PersistentVolume)
+		case "update-persistentvolumeclaim":
+			claim = action.Value.(*api.PersistentVolumeClaim)
+		}
+	}
+	if volume == nil || claim == nil {
+		t.Fatalf("expected volume and claim to be updated: %v", mockClient.Actions)
+	}
+	if volume.Name != "small" || volume.Status.Phase != api.VolumeBound {
+		t.Errorf("unexpected volume: %#v", volume)
+	}
+	if ref := volume.Spec.ClaimRef; ref == nil || ref.Namespace != "test" || ref.Name != "claim" || ref.UID != "uid-claim" {
+		t.Errorf("unexpected claim reference: %#v", volume.Spec.ClaimRef)
+	}
+	if claim.Status.Phase != api.ClaimBound {
+		t.Errorf("unexpected claim phase: %v", claim.Status.Phase)
+	}
+	if ref := claim.Status.VolumeRef; ref == nil || ref.Name != "small" || ref.UID != "uid-small" {
+		t.Errorf("unexpected volume reference: %#v", claim.Status.VolumeRef)
+	}
+	if capacity := claim.Status.Capacity[api.ResourceStorage]; capacity.String() != "5G" {
+		t.Errorf("unexpected claim capacity: %v", claim.Status.Capacity)
+	}
+}
+
+func TestSynchronizeMarksVolumesAvailable(t *testing.T) {
+	mockClient := &client.Fake{
+		PersistentVolumeList: api.PersistentVolumeList{
+			Items: []api.PersistentVolume{newVolume("new", "10G", api.VolumePending, api.ReadWriteOnce)},
+		},
+	}
+	binder := NewPersistentVolumeClaimBinder(mockClient)
+	binder.synchronize()
+
+	if len(mockClient.Actions) != 3 || mockClient.Actions[2].Action != "update-persistentvolume" {
+		t.Fatalf("expected the volume to be updated: %v", mockClient.Actions)
+	}
+	if volume := mockClient.Actions[2].Value.(*api.PersistentVolume); volume.Status.Phase != api.VolumeAvailable {
+		t.Errorf("expected volume to be available, got %v", volume.Status.Phase)
+	}
+}
+
+func TestSynchronizeReleasesVolume(t *testing.T) {
+	volume := newVolume("volume", "10G", api.VolumeBound, api.ReadWriteOnce)
+	volume.Spec.ClaimRef = &api.ObjectReference{Namespace: "test", Name: "claim", UID: "uid-claim"}
+	// a claim with the same name that was created after the original was deleted
+	claim := newClaim("claim", "1G", api.ReadWriteOnce)
+	claim.UID = "uid-newclaim"
+	mockClient := &client.Fake{
+		PersistentVolumeList:      api.PersistentVolumeList{Items: []api.PersistentVolume{volume}},
+		PersistentVolumeClaimList: api.PersistentVolumeClaimList{Items: []api.PersistentVolumeClaim{claim}},
+	}
+	binder := NewPersistentVolumeClaimBinder(mockClient)
+	binder.synchronize()
+
+	for _, action := range mockClient.Actions {
+		switch action.Action {
+		case "update-persistentvolume":
+			if phase := action.Value.(*api.PersistentVolume).Status.Phase; phase != api.VolumeReleased {
+				t.Errorf("expected volume to be released, got %v", phase)
+			}
+		case "update-persistentvolumeclaim":
+			t.Errorf("unexpected binding of a released volume: %#v", action.Value)
+		}
+	}
+}
@@ -113,14 +113,28 @@ func Max(a int64, b int64) int64 {
 	return b
 }
 
-// PodLimitFunc enforces that a pod spec does not exceed any limits specified on the supplied limit range
+// PodLimitFunc enforces that a pod spec does not exceed any limits specified on the supplied limit range.
+// The min and max of a limit range constrain both the requests and the limits of the pod.
 func PodLimitFunc(limitRange *api.LimitRange, resourceName string, obj runtime.Object) error {
 	if resourceName != "pods" {
 		return nil
 	}
 
 	pod := obj.(*api.Pod)
+	requests := func(resources api.ResourceRequirements) api.ResourceList { return resources.Requests }
+	if err := podResourceLimitFunc(limitRange, pod, "request", requests); err != nil {
+		return apierrors.NewForbidden(resourceName, pod.Name, err)
+	}
+	limits := func(resources api.ResourceRequirements) api.ResourceList { return resources.Limits }
+	if err := podResourceLimitFunc(limitRange, pod, "limit", limits); err != nil {
+		return apierrors.NewForbidden(resourceName, pod.Name, err)
+	}
+	return nil
+}
 
+// podResourceLimitFunc checks the resources selected from each container of the pod,
+// described as kind, against the min and max of the limit range.
+func podResourceLimitFunc(limitRange *api.LimitRange, pod *api.Pod, kind string, resources func(api.ResourceRequirements) api.ResourceList) error {
 	podCPU := int64(0)
 	podMem := int64(0)
 
@@ -130,9 +144,9 @@ func PodLimitFunc(limitRange *api.LimitRange, resourceName string, obj runtime.O
 	maxContainerMem := int64(0)
 
 	for i := range pod.Spec.Containers {
-		container := pod.Spec.Containers[i]
-		containerCPU := container.Resources.Limits.Cpu().MilliValue()
-		containerMem := container.Resources.Limits.Memory().Value()
+		containerResources := resources(pod.Spec.Containers[i].Resources)
+		containerCPU := containerResources.Cpu().MilliValue()
+		containerMem := containerResources.Memory().Value()
 
 		if i == 0 {
 			minContainerCPU = containerCPU
@@ -141,8 +155,8 @@ func PodLimitFunc(limitRange *api.LimitRange, resourceName string, obj runtime.O
 			maxContainerMem = containerMem
 		}
 
-		podCPU = podCPU + container.Resources.Limits.Cpu().MilliValue()
-		podMem = podMem + container.Resources.Limits.Memory().Value()
+		podCPU = podCPU + containerCPU
+		podMem = podMem + containerMem
 
 		minContainerCPU = Min(containerCPU, minContainerCPU)
 		minContainerMem = Min(containerMem, minContainerMem)
@@ -170,30 +184,36 @@ func PodLimitFunc(limitRange *api.LimitRange, resourceName string, obj runtime.O
 					switch limit.Type {
 					case api.LimitTypePod:
 						observed = podMem
-						err = fmt.Errorf("%simum memory usage per pod is %s", minOrMax, v.String())
+						err = fmt.Errorf("%simum memory %s per pod is %s", minOrMax, kind, v.String())
 					case api.LimitTypeContainer:
 						observed = maxContainerMem
-						err = fmt.Errorf("%simum memory usage per container is %s", minOrMax, v.String())
+						if minOrMax == "Min" {
+							observed = minContainerMem
+						}
+						err = fmt.Errorf("%simum memory %s per container is %s", minOrMax, kind, v.String())
 					}
 				case api.ResourceCPU:
 					enforced = v.MilliValue()
 					switch limit.Type {
 					case api.LimitTypePod:
 						observed = podCPU
-						err = fmt.Errorf("%simum CPU usage per pod is %s, but requested %s", minOrMax, v.String(), resource.NewMilliQuantity(observed, resource.DecimalSI))
+						err = fmt.Errorf("%simum CPU %s per pod is %s, but requested %s", minOrMax, kind, v.String(), resource.NewMilliQuantity(observed, resource.DecimalSI))
 					case api.LimitTypeContainer:
 						observed = maxContainerCPU
-						err = fmt.Errorf("%simum CPU usage per container is %s", minOrMax, v.String())
+						if minOrMax == "Min" {
+							observed = minContainerCPU
+						}
+						err = fmt.Errorf("%simum CPU %s per container is %s", minOrMax, kind, v.String())
 					}
 				}
 				switch minOrMax {
 				case "Min":
 					if observed < enforced {
-						return apierrors.NewForbidden(resourceName, pod.Name, err)
+						return err
 					}
 				case "Max":
 					if observed > enforced {
-						return apierrors.NewForbidden(resourceName, pod.Name, err)
+						return err
 					}
 				}
 			}
@@ -23,19 +23,26 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
 )
 
-func getResourceRequirements(cpu, memory string) api.ResourceRequirements {
-	res := api.ResourceRequirements{}
-	res.Limits = api.ResourceList{}
+func getResourceList(cpu, memory string) api.ResourceList {
+	res := api.ResourceList{}
 	if cpu != "" {
-		res.Limits[api.ResourceCPU] = resource.MustParse(cpu)
+		res[api.ResourceCPU] = resource.MustParse(cpu)
 	}
 	if memory != "" {
-		res.Limits[api.ResourceMemory] = resource.MustParse(memory)
+		res[api.ResourceMemory] = resource.MustParse(memory)
 	}
-
 	return res
 }
 
+// getResourceRequirements returns requirements whose requests equal their
+// limits, as they are defaulted for a container that only sets limits.
+func getResourceRequirements(cpu, memory string) api.ResourceRequirements {
+	return api.ResourceRequirements{
+		Requests: getResourceList(cpu, memory),
+		Limits:   getResourceList(cpu, memory),
+	}
+}
+
 func TestPodLimitFunc(t *testing.T) {
 	limitRange := &api.LimitRange{
 		ObjectMeta: api.ObjectMeta{
@@ -205,6 +212,50 @@ func TestPodLimitFunc(t *testing.T) {
 		},
 	}
 
+	// Requests and limits are constrained separately.
+	errorCases["min-container-cpu-request"] = api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo"},
+		Spec: api.PodSpec{
+			Containers: []api.Container{
+				{
+					Image: "boo:V1",
+					Resources: api.ResourceRequirements{
+						Requests: getResourceList("10m", "1Gi"),
+						Limits:   getResourceList("60m", "1Gi"),
+					},
+				},
+			},
+		},
+	}
+	errorCases["max-container-memory-limit"] = api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo"},
+		Spec: api.PodSpec{
+			Containers: []api.Container{
+				{
+					Image: "boo:V1",
+					Resources: api.ResourceRequirements{
+						Requests: getResourceList("60m", "1Gi"),
+						Limits:   getResourceList("60m", "3Gi"),
+					},
+				},
+			},
+		},
+	}
+	successCases = append(successCases, api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "overcommitted"},
+		Spec: api.PodSpec{
+			Containers: []api.Container{
+				{
+					Image: "boo:V1",
+					Resources: api.ResourceRequirements{
+						Requests: getResourceList("50m", "1Gi"),
+						Limits:   getResourceList("100m", "2Gi"),
+					},
+				},
+			},
+		},
+	})
+
 	for i := range successCases {
 		err := PodLimitFunc(limitRange, "pods", &successCases[i])
 		if err != nil {
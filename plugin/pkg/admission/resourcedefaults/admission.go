@@ -64,6 +64,16 @@ func (resourceDefaults) Admit(a admission.Attributes) (err error) {
 		if pod.Spec.Containers[index].Resources.Limits.Cpu().Value() == 0 {
 			pod.Spec.Containers[index].Resources.Limits[api.ResourceCPU] = resource.MustParse(defaultCPU)
 		}
+		// requests that are not set default to the limits
+		if pod.Spec.Containers[index].Resources.Requests == nil {
+			pod.Spec.Containers[index].Resources.Requests = api.ResourceList{}
+		}
+		for _, name := range []api.ResourceName{api.ResourceMemory, api.ResourceCPU} {
+			if _, found := pod.Spec.Containers[index].Resources.Requests[name]; !found {
+				limit := pod.Spec.Containers[index].Resources.Limits[name]
+				pod.Spec.Containers[index].Resources.Requests[name] = *limit.Copy()
+			}
+		}
 	}
 	return nil
 }
@@ -50,6 +50,14 @@ func TestAdmission(t *testing.T) {
 		if cpu != "1" {
 			t.Errorf("Unexpected cpu value %s", cpu)
 		}
+		requestedMemory := pod.Spec.Containers[i].Resources.Requests.Memory().String()
+		requestedCPU := pod.Spec.Containers[i].Resources.Requests.Cpu().String()
+		if requestedMemory != "512Mi" {
+			t.Errorf("Unexpected memory request %s", requestedMemory)
+		}
+		if requestedCPU != "1" {
+			t.Errorf("Unexpected cpu request %s", requestedCPU)
+		}
 	}
 }
 
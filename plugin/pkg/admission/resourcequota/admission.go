@@ -65,6 +65,29 @@ var resourceToResourceName = map[string]api.ResourceName{
 	"resourceQuotas":         api.ResourceQuotas,
 }
 
+// computeResources are the resources of a quota that constrain the requests
+// and limits of pods, with the function that computes the usage of a pod.
+var computeResources = []struct {
+	name        api.ResourceName
+	description string
+	usage       func(*api.Pod) *resource.Quantity
+}{
+	{api.ResourceMemory, "memory", resourcequota.PodMemory},
+	{api.ResourceCPU, "CPU", resourcequota.PodCPU},
+	{api.ResourceLimitsMemory, "memory limits", resourcequota.PodMemoryLimit},
+	{api.ResourceLimitsCPU, "CPU limits", resourcequota.PodCPULimit},
+}
+
+// hasComputeResource returns true if any of the compute resources is in set.
+func hasComputeResource(set map[api.ResourceName]bool) bool {
+	for _, computeResource := range computeResources {
+		if set[computeResource.name] {
+			return true
+		}
+	}
+	return false
+}
+
 func (q *quota) Admit(a admission.Attributes) (err error) {
 	if a.GetOperation() == "DELETE" {
 		return nil
@@ -161,48 +184,36 @@ func IncrementUsage(a admission.Attributes, status *api.ResourceQuotaStatus, cli
 			}
 		}
 	}
-	// handle memory/cpu constraints, and any diff of usage based on memory/cpu on updates
-	if a.GetResource() == "pods" && (set[api.ResourceMemory] || set[api.ResourceCPU]) {
+	// handle compute resource constraints, and any diff of compute usage on updates
+	if a.GetResource() == "pods" && hasComputeResource(set) {
 		pod := obj.(*api.Pod)
-		deltaCPU := resourcequota.PodCPU(pod)
-		deltaMemory := resourcequota.PodMemory(pod)
-		// if this is an update, we need to find the delta cpu/memory usage from previous state
+		// if this is an update, we need to find the delta usage from previous state
+		var oldPod *api.Pod
 		if a.GetOperation() == "UPDATE" {
-			oldPod, err := client.Pods(a.GetNamespace()).Get(pod.Name)
+			var err error
+			oldPod, err = client.Pods(a.GetNamespace()).Get(pod.Name)
 			if err != nil {
 				return false, apierrors.NewForbidden(resourceName, name, err)
 			}
-			oldCPU := resourcequota.PodCPU(oldPod)
-			oldMemory := resourcequota.PodMemory(oldPod)
-			deltaCPU = resource.NewMilliQuantity(deltaCPU.MilliValue()-oldCPU.MilliValue(), resource.DecimalSI)
-			deltaMemory = resource.NewQuantity(deltaMemory.Value()-oldMemory.Value(), resource.DecimalSI)
 		}
-
-		hardMem, hardMemFound := status.Hard[api.ResourceMemory]
-		if hardMemFound {
-			used, usedFound := status.Used[api.ResourceMemory]
-			if !usedFound {
-				return false, apierrors.NewForbidden(resourceName, name, fmt.Errorf("Quota usage stats are not yet known, unable to admit resource until an accurate count is completed."))
-			}
-			if used.Value()+deltaMemory.Value() > hardMem.Value() {
-				return false, apierrors.NewForbidden(resourceName, name, fmt.Errorf("Limited to %s memory", hardMem.String()))
-			} else {
-				status.Used[api.ResourceMemory] = *resource.NewQuantity(used.Value()+deltaMemory.Value(), resource.DecimalSI)
-				dirty = true
+		for _, computeResource := range computeResources {
+			hard, hardFound := status.Hard[computeResource.name]
+			if !hardFound {
+				continue
 			}
-		}
-		hardCPU, hardCPUFound := status.Hard[api.ResourceCPU]
-		if hardCPUFound {
-			used, usedFound := status.Used[api.ResourceCPU]
+			used, usedFound := status.Used[computeResource.name]
 			if !usedFound {
 				return false, apierrors.NewForbidden(resourceName, name, fmt.Errorf("Quota usage stats are not yet known, unable to admit resource until an accurate count is completed."))
 			}
-			if used.MilliValue()+deltaCPU.MilliValue() > hardCPU.MilliValue() {
-				return false, apierrors.NewForbidden(resourceName, name, fmt.Errorf("Limited to %s CPU", hardCPU.String()))
-			} else {
-				status.Used[api.ResourceCPU] = *resource.NewMilliQuantity(used.MilliValue()+deltaCPU.MilliValue(), resource.DecimalSI)
-				dirty = true
+			delta := computeResource.usage(pod).MilliValue()
+			if oldPod != nil {
+				delta = delta - computeResource.usage(oldPod).MilliValue()
+			}
+			if used.MilliValue()+delta > hard.MilliValue() {
+				return false, apierrors.NewForbidden(resourceName, name, fmt.Errorf("Limited to %s %s", hard.String(), computeResource.description))
 			}
+			status.Used[computeResource.name] = *resource.NewMilliQuantity(used.MilliValue()+delta, resource.DecimalSI)
+			dirty = true
 		}
 	}
 	return dirty, nil
@@ -25,19 +25,21 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
 )
 
-func getResourceRequirements(cpu, memory string) api.ResourceRequirements {
-	res := api.ResourceRequirements{}
-	res.Limits = api.ResourceList{}
+func getResourceList(cpu, memory string) api.ResourceList {
+	res := api.ResourceList{}
 	if cpu != "" {
-		res.Limits[api.ResourceCPU] = resource.MustParse(cpu)
+		res[api.ResourceCPU] = resource.MustParse(cpu)
 	}
 	if memory != "" {
-		res.Limits[api.ResourceMemory] = resource.MustParse(memory)
+		res[api.ResourceMemory] = resource.MustParse(memory)
 	}
-
 	return res
 }
 
+func getResourceRequirements(requests, limits api.ResourceList) api.ResourceRequirements {
+	return api.ResourceRequirements{Requests: requests, Limits: limits}
+}
+
 func TestAdmissionIgnoresDelete(t *testing.T) {
 	namespace := "default"
 	handler := NewResourceQuota(&client.Fake{})
@@ -56,7 +58,7 @@ func TestIncrementUsagePods(t *testing.T) {
 					ObjectMeta: api.ObjectMeta{Name: "123", Namespace: namespace},
 					Spec: api.PodSpec{
 						Volumes:    []api.Volume{{Name: "vol"}},
-						Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements("100m", "1Gi")}},
+						Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements(getResourceList("100m", "1Gi"), getResourceList("", ""))}},
 					},
 				},
 			},
@@ -91,7 +93,7 @@ func TestIncrementUsageMemory(t *testing.T) {
 					ObjectMeta: api.ObjectMeta{Name: "123", Namespace: namespace},
 					Spec: api.PodSpec{
 						Volumes:    []api.Volume{{Name: "vol"}},
-						Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements("100m", "1Gi")}},
+						Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements(getResourceList("100m", "1Gi"), getResourceList("", ""))}},
 					},
 				},
 			},
@@ -109,7 +111,7 @@ func TestIncrementUsageMemory(t *testing.T) {
 		ObjectMeta: api.ObjectMeta{Name: "123", Namespace: namespace},
 		Spec: api.PodSpec{
 			Volumes:    []api.Volume{{Name: "vol"}},
-			Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements("100m", "1Gi")}},
+			Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements(getResourceList("100m", "1Gi"), getResourceList("", ""))}},
 		}}
 	dirty, err := IncrementUsage(admission.NewAttributesRecord(newPod, namespace, "pods", "CREATE"), status, client)
 	if err != nil {
@@ -134,7 +136,7 @@ func TestExceedUsageMemory(t *testing.T) {
 					ObjectMeta: api.ObjectMeta{Name: "123", Namespace: namespace},
 					Spec: api.PodSpec{
 						Volumes:    []api.Volume{{Name: "vol"}},
-						Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements("100m", "1Gi")}},
+						Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements(getResourceList("100m", "1Gi"), getResourceList("", ""))}},
 					},
 				},
 			},
@@ -152,7 +154,7 @@ func TestExceedUsageMemory(t *testing.T) {
 		ObjectMeta: api.ObjectMeta{Name: "123", Namespace: namespace},
 		Spec: api.PodSpec{
 			Volumes:    []api.Volume{{Name: "vol"}},
-			Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements("100m", "3Gi")}},
+			Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements(getResourceList("100m", "3Gi"), getResourceList("", ""))}},
 		}}
 	_, err := IncrementUsage(admission.NewAttributesRecord(newPod, namespace, "pods", "CREATE"), status, client)
 	if err == nil {
@@ -169,7 +171,7 @@ func TestIncrementUsageCPU(t *testing.T) {
 					ObjectMeta: api.ObjectMeta{Name: "123", Namespace: namespace},
 					Spec: api.PodSpec{
 						Volumes:    []api.Volume{{Name: "vol"}},
-						Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements("100m", "1Gi")}},
+						Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements(getResourceList("100m", "1Gi"), getResourceList("", ""))}},
 					},
 				},
 			},
@@ -187,7 +189,7 @@ func TestIncrementUsageCPU(t *testing.T) {
 		ObjectMeta: api.ObjectMeta{Name: "123", Namespace: namespace},
 		Spec: api.PodSpec{
 			Volumes:    []api.Volume{{Name: "vol"}},
-			Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements("100m", "1Gi")}},
+			Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements(getResourceList("100m", "1Gi"), getResourceList("", ""))}},
 		}}
 	dirty, err := IncrementUsage(admission.NewAttributesRecord(newPod, namespace, "pods", "CREATE"), status, client)
 	if err != nil {
@@ -212,7 +214,7 @@ func TestExceedUsageCPU(t *testing.T) {
 					ObjectMeta: api.ObjectMeta{Name: "123", Namespace: namespace},
 					Spec: api.PodSpec{
 						Volumes:    []api.Volume{{Name: "vol"}},
-						Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements("100m", "1Gi")}},
+						Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements(getResourceList("100m", "1Gi"), getResourceList("", ""))}},
 					},
 				},
 			},
@@ -230,7 +232,7 @@ func TestExceedUsageCPU(t *testing.T) {
 		ObjectMeta: api.ObjectMeta{Name: "123", Namespace: namespace},
 		Spec: api.PodSpec{
 			Volumes:    []api.Volume{{Name: "vol"}},
-			Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements("500m", "1Gi")}},
+			Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements(getResourceList("500m", "1Gi"), getResourceList("", ""))}},
 		}}
 	_, err := IncrementUsage(admission.NewAttributesRecord(newPod, namespace, "pods", "CREATE"), status, client)
 	if err == nil {
@@ -238,6 +240,72 @@ func TestExceedUsageCPU(t *testing.T) {
 	}
 }
 
+func TestIncrementUsageLimits(t *testing.T) {
+	namespace := "default"
+	client := &client.Fake{}
+	status := &api.ResourceQuotaStatus{
+		Hard: api.ResourceList{
+			api.ResourceCPU:          resource.MustParse("1"),
+			api.ResourceLimitsCPU:    resource.MustParse("2"),
+			api.ResourceLimitsMemory: resource.MustParse("4Gi"),
+		},
+		Used: api.ResourceList{
+			api.ResourceCPU:          resource.MustParse("100m"),
+			api.ResourceLimitsCPU:    resource.MustParse("200m"),
+			api.ResourceLimitsMemory: resource.MustParse("1Gi"),
+		},
+	}
+
+	newPod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "123", Namespace: namespace},
+		Spec: api.PodSpec{
+			Volumes:    []api.Volume{{Name: "vol"}},
+			Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements(getResourceList("100m", "1Gi"), getResourceList("500m", "2Gi"))}},
+		}}
+	dirty, err := IncrementUsage(admission.NewAttributesRecord(newPod, namespace, "pods", "CREATE"), status, client)
+	if err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if !dirty {
+		t.Errorf("Expected the status to get incremented, therefore should have been dirty")
+	}
+	expected := api.ResourceList{
+		api.ResourceCPU:          resource.MustParse("200m"),
+		api.ResourceLimitsCPU:    resource.MustParse("700m"),
+		api.ResourceLimitsMemory: resource.MustParse("3Gi"),
+	}
+	for k, v := range expected {
+		quantity := status.Used[k]
+		if quantity.MilliValue() != v.MilliValue() {
+			t.Errorf("Expected %v for %v was %v", v.String(), k, quantity.String())
+		}
+	}
+}
+
+func TestExceedUsageCPULimit(t *testing.T) {
+	namespace := "default"
+	client := &client.Fake{}
+	status := &api.ResourceQuotaStatus{
+		Hard: api.ResourceList{},
+		Used: api.ResourceList{},
+	}
+	r := api.ResourceLimitsCPU
+	status.Hard[r] = resource.MustParse("1")
+	status.Used[r] = resource.MustParse("500m")
+
+	// The request fits in the quota, but the limit does not.
+	newPod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "123", Namespace: namespace},
+		Spec: api.PodSpec{
+			Volumes:    []api.Volume{{Name: "vol"}},
+			Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements(getResourceList("100m", "1Gi"), getResourceList("600m", "1Gi"))}},
+		}}
+	_, err := IncrementUsage(admission.NewAttributesRecord(newPod, namespace, "pods", "CREATE"), status, client)
+	if err == nil {
+		t.Errorf("Expected CPU limit usage exceeded error")
+	}
+}
+
 func TestExceedUsagePods(t *testing.T) {
 	namespace := "default"
 	client := &client.Fake{
@@ -247,7 +315,7 @@ func TestExceedUsagePods(t *testing.T) {
 					ObjectMeta: api.ObjectMeta{Name: "123", Namespace: namespace},
 					Spec: api.PodSpec{
 						Volumes:    []api.Volume{{Name: "vol"}},
-						Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements("100m", "1Gi")}},
+						Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements(getResourceList("100m", "1Gi"), getResourceList("", ""))}},
 					},
 				},
 			},
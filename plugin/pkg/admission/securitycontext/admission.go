@@ -0,0 +1,133 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitycontext
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/admission"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	apierrors "github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+const (
+	// AllowedAnnotation is the namespace annotation listing, comma separated,
+	// the security context settings pods in the namespace may request.
+	AllowedAnnotation = "kubernetes.io/allowed-security-context"
+
+	// AllowRunAsUser allows containers to choose the UID they run as.
+	AllowRunAsUser = "runAsUser"
+	// AllowSELinuxOptions allows containers to choose their SELinux labels.
+	AllowSELinuxOptions = "seLinuxOptions"
+)
+
+func init() {
+	admission.RegisterPlugin("NamespaceSecurityContext", func(client client.Interface, config io.Reader) (admission.Interface, error) {
+		return NewSecurityContext(client), nil
+	})
+}
+
+// securityContext is an implementation of admission.Interface.
+// It rejects pods whose containers set a run-as UID or SELinux options unless
+// their namespace allows it. Settings that only restrict a container, such as
+// running as non-root or with a read-only root filesystem, are always allowed.
+type securityContext struct {
+	client client.Interface
+	store  cache.Store
+}
+
+func (s *securityContext) Admit(a admission.Attributes) (err error) {
+	if a.GetOperation() != "CREATE" || a.GetResource() != "pods" {
+		return nil
+	}
+	pod, ok := a.GetObject().(*api.Pod)
+	if !ok {
+		return nil
+	}
+
+	allowed := util.NewStringSet()
+	namespaceObj, exists, err := s.store.Get(&api.Namespace{
+		ObjectMeta: api.ObjectMeta{
+			Name:      a.GetNamespace(),
+			Namespace: "",
+		},
+	})
+	if err != nil {
+		return err
+	}
+	// a namespace we do not know about is not allowed anything
+	if exists {
+		allowed = allowedSettings(namespaceObj.(*api.Namespace))
+	}
+
+	for _, container := range pod.Spec.Containers {
+		sc := container.SecurityContext
+		if sc == nil {
+			continue
+		}
+		if sc.RunAsUser != nil && !allowed.Has(AllowRunAsUser) {
+			return apierrors.NewForbidden("pods", pod.Name, fmt.Errorf("container %s may not set runAsUser in namespace %s", container.Name, a.GetNamespace()))
+		}
+		if sc.SELinuxOptions != nil && !allowed.Has(AllowSELinuxOptions) {
+			return apierrors.NewForbidden("pods", pod.Name, fmt.Errorf("container %s may not set seLinuxOptions in namespace %s", container.Name, a.GetNamespace()))
+		}
+	}
+	return nil
+}
+
+// allowedSettings returns the security context settings the namespace allows.
+func allowedSettings(namespace *api.Namespace) util.StringSet {
+	allowed := util.NewStringSet()
+	for _, setting := range strings.Split(namespace.Annotations[AllowedAnnotation], ",") {
+		if setting = strings.TrimSpace(setting); len(setting) > 0 {
+			allowed.Insert(setting)
+		}
+	}
+	return allowed
+}
+
+// NewSecurityContext returns an admission.Interface that limits the security
+// contexts of pods to those allowed by their namespace.
+func NewSecurityContext(c client.Interface) admission.Interface {
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	reflector := cache.NewReflector(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return c.Namespaces().List(labels.Everything())
+			},
+			WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+				return c.Namespaces().Watch(labels.Everything(), labels.Everything(), resourceVersion)
+			},
+		},
+		&api.Namespace{},
+		store,
+		0,
+	)
+	reflector.Run()
+	return &securityContext{
+		client: c,
+		store:  store,
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitycontext
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/admission"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+)
+
+func TestAdmission(t *testing.T) {
+	uid := int64(1001)
+	namespaces := []*api.Namespace{
+		{ObjectMeta: api.ObjectMeta{Name: "none"}},
+		{ObjectMeta: api.ObjectMeta{Name: "uid", Annotations: map[string]string{AllowedAnnotation: AllowRunAsUser}}},
+		{ObjectMeta: api.ObjectMeta{Name: "all", Annotations: map[string]string{AllowedAnnotation: "runAsUser, seLinuxOptions"}}},
+	}
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	for _, namespace := range namespaces {
+		store.Add(namespace)
+	}
+	handler := &securityContext{
+		client: &client.Fake{},
+		store:  store,
+	}
+
+	tests := []struct {
+		name      string
+		namespace string
+		operation string
+		sc        *api.SecurityContext
+		expectErr bool
+	}{
+		{"no security context", "none", "CREATE", nil, false},
+		{"restricting settings", "none", "CREATE", &api.SecurityContext{RunAsNonRoot: true, ReadOnlyRootFilesystem: true}, false},
+		{"run as user denied", "none", "CREATE", &api.SecurityContext{RunAsUser: &uid}, true},
+		{"run as user allowed", "uid", "CREATE", &api.SecurityContext{RunAsUser: &uid}, false},
+		{"selinux denied", "uid", "CREATE", &api.SecurityContext{SELinuxOptions: &api.SELinuxOptions{Level: "s0"}}, true},
+		{"selinux allowed", "all", "CREATE", &api.SecurityContext{RunAsUser: &uid, SELinuxOptions: &api.SELinuxOptions{Level: "s0"}}, false},
+		{"unknown namespace", "missing", "CREATE", &api.SecurityContext{RunAsUser: &uid}, true},
+		{"update", "none", "UPDATE", &api.SecurityContext{RunAsUser: &uid}, false},
+	}
+	for _, test := range tests {
+		pod := api.Pod{
+			ObjectMeta: api.ObjectMeta{Name: "123", Namespace: test.namespace},
+			Spec: api.PodSpec{
+				Containers: []api.Container{{Name: "ctr", Image: "image", SecurityContext: test.sc}},
+			},
+		}
+		err := handler.Admit(admission.NewAttributesRecord(&pod, test.namespace, "pods", test.operation))
+		if test.expectErr && err == nil {
+			t.Errorf("%s: expected an error", test.name)
+		}
+		if !test.expectErr && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+	}
+}
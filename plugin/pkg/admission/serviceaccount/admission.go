@@ -0,0 +1,145 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccount
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/admission"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	apierrors "github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/serviceaccount"
+)
+
+// DefaultAPITokenMountPath is the path the API token of a pod's service account is mounted at.
+const DefaultAPITokenMountPath = "/var/run/secrets/kubernetes.io/serviceaccount"
+
+func init() {
+	admission.RegisterPlugin("ServiceAccount", func(client client.Interface, config io.Reader) (admission.Interface, error) {
+		return NewServiceAccount(client), nil
+	})
+}
+
+// serviceAccount is an implementation of admission.Interface.
+// It assigns the default service account to pods that do not name one, rejects pods
+// whose service account does not exist, and mounts the service account's API token
+// into every container of the pod.
+type serviceAccount struct {
+	client client.Interface
+}
+
+// NewServiceAccount returns an admission.Interface that manages the service accounts of pods.
+func NewServiceAccount(c client.Interface) admission.Interface {
+	return &serviceAccount{client: c}
+}
+
+func (s *serviceAccount) Admit(a admission.Attributes) (err error) {
+	if a.GetOperation() != "CREATE" || a.GetResource() != "pods" {
+		return nil
+	}
+	pod, ok := a.GetObject().(*api.Pod)
+	if !ok {
+		return nil
+	}
+
+	if len(pod.Spec.ServiceAccount) == 0 {
+		pod.Spec.ServiceAccount = serviceaccount.DefaultServiceAccountName
+	}
+	namespace := a.GetNamespace()
+	account, err := s.client.ServiceAccounts(namespace).Get(pod.Spec.ServiceAccount)
+	if apierrors.IsNotFound(err) {
+		return apierrors.NewForbidden("pods", pod.Name, fmt.Errorf("service account %s/%s was not found, retry after the service account is created", namespace, pod.Spec.ServiceAccount))
+	}
+	if err != nil {
+		return err
+	}
+
+	token, err := s.findToken(account)
+	if err != nil {
+		return err
+	}
+	if len(token) == 0 {
+		return apierrors.NewForbidden("pods", pod.Name, fmt.Errorf("no API token found for service account %s/%s, retry after the token is created", namespace, pod.Spec.ServiceAccount))
+	}
+	mountToken(pod, namespace, token)
+	return nil
+}
+
+// findToken returns the name of the first API token secret referenced by the
+// service account, or "" if it does not reference one.
+func (s *serviceAccount) findToken(account *api.ServiceAccount) (string, error) {
+	for _, ref := range account.Secrets {
+		secret, err := s.client.Secrets(account.Namespace).Get(ref.Name)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		if serviceaccount.IsServiceAccountToken(secret, account) {
+			return secret.Name, nil
+		}
+	}
+	return "", nil
+}
+
+// mountToken adds a volume for the token secret to the pod, and mounts it at
+// DefaultAPITokenMountPath in every container that does not mount something else there.
+func mountToken(pod *api.Pod, namespace, token string) {
+	volumeName := ""
+	for _, volume := range pod.Spec.Volumes {
+		if volume.Secret != nil && volume.Secret.Target.Name == token {
+			volumeName = volume.Name
+			break
+		}
+	}
+	if len(volumeName) == 0 {
+		volumeName = token
+		pod.Spec.Volumes = append(pod.Spec.Volumes, api.Volume{
+			Name: volumeName,
+			VolumeSource: api.VolumeSource{
+				Secret: &api.SecretVolumeSource{
+					Target: api.ObjectReference{
+						Kind:      "Secret",
+						Namespace: namespace,
+						Name:      token,
+					},
+				},
+			},
+		})
+	}
+
+	for i := range pod.Spec.Containers {
+		container := &pod.Spec.Containers[i]
+		mounted := false
+		for _, mount := range container.VolumeMounts {
+			if mount.MountPath == DefaultAPITokenMountPath {
+				mounted = true
+				break
+			}
+		}
+		if !mounted {
+			container.VolumeMounts = append(container.VolumeMounts, api.VolumeMount{
+				Name:      volumeName,
+				ReadOnly:  true,
+				MountPath: DefaultAPITokenMountPath,
+			})
+		}
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccount
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/admission"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+)
+
+func newFakeClient() *client.Fake {
+	return &client.Fake{
+		ServiceAccount: api.ServiceAccount{
+			ObjectMeta: api.ObjectMeta{Name: "default", Namespace: "test", UID: "12345"},
+			Secrets:    []api.ObjectReference{{Name: "default-token-abcde"}},
+		},
+		Secret: api.Secret{
+			ObjectMeta: api.ObjectMeta{
+				Name:      "default-token-abcde",
+				Namespace: "test",
+				Annotations: map[string]string{
+					api.ServiceAccountNameKey: "default",
+					api.ServiceAccountUIDKey:  "12345",
+				},
+			},
+			Type: api.SecretTypeServiceAccountToken,
+			Data: map[string][]byte{api.ServiceAccountTokenKey: []byte("token")},
+		},
+	}
+}
+
+func TestAdmitAssignsDefaultServiceAccountAndMountsToken(t *testing.T) {
+	handler := NewServiceAccount(newFakeClient())
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "pod", Namespace: "test"},
+		Spec: api.PodSpec{
+			Containers: []api.Container{{Name: "a"}, {Name: "b"}},
+		},
+	}
+	if err := handler.Admit(admission.NewAttributesRecord(pod, "test", "pods", "CREATE")); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if pod.Spec.ServiceAccount != "default" {
+		t.Errorf("Expected the default service account, got %q", pod.Spec.ServiceAccount)
+	}
+	if len(pod.Spec.Volumes) != 1 {
+		t.Fatalf("Expected a token volume, got %#v", pod.Spec.Volumes)
+	}
+	volume := pod.Spec.Volumes[0]
+	if volume.Secret == nil || volume.Secret.Target.Name != "default-token-abcde" || volume.Secret.Target.Namespace != "test" || volume.Secret.Target.Kind != "Secret" {
+		t.Errorf("Unexpected token volume %#v", volume)
+	}
+	for _, container := range pod.Spec.Containers {
+		if len(container.VolumeMounts) != 1 {
+			t.Errorf("Expected a token mount in container %s, got %#v", container.Name, container.VolumeMounts)
+			continue
+		}
+		mount := container.VolumeMounts[0]
+		if mount.Name != volume.Name || mount.MountPath != DefaultAPITokenMountPath || !mount.ReadOnly {
+			t.Errorf("Unexpected token mount in container %s: %#v", container.Name, mount)
+		}
+	}
+}
+
+func TestAdmitKeepsExistingMounts(t *testing.T) {
+	handler := NewServiceAccount(newFakeClient())
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "pod", Namespace: "test"},
+		Spec: api.PodSpec{
+			ServiceAccount: "default",
+			Volumes:        []api.Volume{{Name: "custom"}},
+			Containers: []api.Container{{
+				Name:         "a",
+				VolumeMounts: []api.VolumeMount{{Name: "custom", MountPath: DefaultAPITokenMountPath}},
+			}},
+		},
+	}
+	if err := handler.Admit(admission.NewAttributesRecord(pod, "test", "pods", "CREATE")); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	mounts := pod.Spec.Containers[0].VolumeMounts
+	if len(mounts) != 1 || mounts[0].Name != "custom" {
+		t.Errorf("Expected the existing mount to be kept, got %#v", mounts)
+	}
+}
+
+func TestAdmitRejectsMissingServiceAccount(t *testing.T) {
+	mockClient := newFakeClient()
+	mockClient.Err = errors.NewNotFound("serviceAccount", "missing")
+	handler := NewServiceAccount(mockClient)
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "pod", Namespace: "test"},
+		Spec:       api.PodSpec{ServiceAccount: "missing"},
+	}
+	err := handler.Admit(admission.NewAttributesRecord(pod, "test", "pods", "CREATE"))
+	if err == nil || !errors.IsForbidden(err) {
+		t.Errorf("Expected a forbidden error, got %v", err)
+	}
+}
+
+func TestAdmitRejectsServiceAccountWithoutToken(t *testing.T) {
+	mockClient := newFakeClient()
+	mockClient.Secret.Type = api.SecretTypeOpaque
+	handler := NewServiceAccount(mockClient)
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "pod", Namespace: "test"},
+	}
+	err := handler.Admit(admission.NewAttributesRecord(pod, "test", "pods", "CREATE"))
+	if err == nil || !errors.IsForbidden(err) {
+		t.Errorf("Expected a forbidden error, got %v", err)
+	}
+}
+
+func TestAdmitIgnoresOtherOperations(t *testing.T) {
+	mockClient := newFakeClient()
+	handler := NewServiceAccount(mockClient)
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "pod", Namespace: "test"}}
+	if err := handler.Admit(admission.NewAttributesRecord(pod, "test", "pods", "UPDATE")); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if err := handler.Admit(admission.NewAttributesRecord(&api.Service{}, "test", "services", "CREATE")); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if len(mockClient.Actions) != 0 {
+		t.Errorf("Unexpected client actions %v", mockClient.Actions)
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package passwordfile
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/user"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+
+	"github.com/golang/glog"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// userPassword is a user and the bcrypt hash of the password it logs in with.
+type userPassword struct {
+	info *user.DefaultInfo
+	hash string
+}
+
+// PasswordAuthenticator authenticates the users listed in a CSV file.
+type PasswordAuthenticator struct {
+	path string
+
+	lock    sync.RWMutex
+	users   map[string]*userPassword
+	modTime time.Time
+	size    int64
+}
+
+// NewCSV returns a PasswordAuthenticator, populated from a CSV file.
+// The CSV file must contain records in the format "password,username,useruid", optionally
+// followed by a quoted, comma separated list of groups. Passwords must be bcrypt hashes,
+// starting with "$2a$", "$2b$" or "$2y$"; files with other passwords are rejected.
+func NewCSV(path string) (*PasswordAuthenticator, error) {
+	a := &PasswordAuthenticator{path: path}
+	if err := a.Reload(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Reload reads the file again if it changed since it was last read. The users
+// read before are kept if the file cannot be read.
+func (a *PasswordAuthenticator) Reload() error {
+	info, err := os.Stat(a.path)
+	if err != nil {
+		return err
+	}
+	a.lock.RLock()
+	unchanged := a.users != nil && info.ModTime().Equal(a.modTime) && info.Size() == a.size
+	a.lock.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	users, err := readCSV(a.path)
+	if err != nil {
+		return err
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.users = users
+	a.modTime = info.ModTime()
+	a.size = info.Size()
+	return nil
+}
+
+// ReloadEvery checks the file for changes every period, forever.
+func (a *PasswordAuthenticator) ReloadEvery(period time.Duration) {
+	go util.Forever(func() {
+		if err := a.Reload(); err != nil {
+			glog.Errorf("Unable to reload password file %s: %v", a.path, err)
+		}
+	}, period)
+}
+
+func readCSV(path string) (map[string]*userPassword, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	users := make(map[string]*userPassword)
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) < 3 {
+			return nil, fmt.Errorf("password file '%s' must have at least 3 columns (password, user name, user uid), found %d", path, len(record))
+		}
+		if !isBcryptHash(record[0]) {
+			return nil, fmt.Errorf("password file '%s' must hold bcrypt hashes of passwords, the password of user %q is not one", path, record[1])
+		}
+		obj := &user.DefaultInfo{
+			Name: record[1],
+			UID:  record[2],
+		}
+		if len(record) > 3 && len(record[3]) != 0 {
+			for _, group := range strings.Split(record[3], ",") {
+				obj.Groups = append(obj.Groups, strings.TrimSpace(group))
+			}
+		}
+		users[record[1]] = &userPassword{info: obj, hash: record[0]}
+	}
+	return users, nil
+}
+
+// AuthenticatePassword implements authenticator.Password
+func (a *PasswordAuthenticator) AuthenticatePassword(username, password string) (user.Info, bool, error) {
+	a.lock.RLock()
+	entry, ok := a.users[username]
+	a.lock.RUnlock()
+	if !ok || len(password) == 0 || bcrypt.CompareHashAndPassword([]byte(entry.hash), []byte(password)) != nil {
+		return nil, false, nil
+	}
+	return entry.info, true, nil
+}
+
+func isBcryptHash(value string) bool {
+	for _, prefix := range []string{"$2a$", "$2b$", "$2y$"} {
+		if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+	return false
+}
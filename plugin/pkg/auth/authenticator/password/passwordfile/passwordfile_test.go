@@ -0,0 +1,186 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package passwordfile
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/user"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestPasswordFile(t *testing.T) {
+	hash := hashPassword(t, "secret2")
+	auth, cleanup := newWithContents(t, `
+`+hashPassword(t, "password1")+`,user1,uid1
+`+hash+`,user2,uid2,"group1, group2"
+`)
+	defer cleanup()
+
+	testCases := []struct {
+		Username string
+		Password string
+		User     *user.DefaultInfo
+		Ok       bool
+	}{
+		{
+			Username: "user1",
+			Password: "password1",
+			User:     &user.DefaultInfo{Name: "user1", UID: "uid1"},
+			Ok:       true,
+		},
+		{
+			Username: "user1",
+			Password: "password2",
+		},
+		{
+			Username: "user2",
+			Password: "secret2",
+			User:     &user.DefaultInfo{Name: "user2", UID: "uid2", Groups: []string{"group1", "group2"}},
+			Ok:       true,
+		},
+		{
+			Username: "user2",
+			Password: hash,
+		},
+		{
+			Username: "user2",
+			Password: "",
+		},
+		{
+			Username: "user4",
+			Password: "password1",
+		},
+	}
+	for i, testCase := range testCases {
+		user, ok, err := auth.AuthenticatePassword(testCase.Username, testCase.Password)
+		if testCase.User == nil {
+			if user != nil {
+				t.Errorf("%d: unexpected non-nil user %#v", i, user)
+			}
+		} else if !reflect.DeepEqual(testCase.User, user) {
+			t.Errorf("%d: expected user %#v, got %#v", i, testCase.User, user)
+		}
+		if testCase.Ok != ok {
+			t.Errorf("%d: expected auth %v, got %v", i, testCase.Ok, ok)
+		}
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+		}
+	}
+}
+
+func TestReload(t *testing.T) {
+	auth, cleanup := newWithContents(t, hashPassword(t, "password1")+",user1,uid1\n")
+	defer cleanup()
+
+	hash := hashPassword(t, "password2")
+	if err := ioutil.WriteFile(auth.path, []byte(hash+",user1,uid1\npassword2,user2,uid2\n"), 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(auth.path, later, later); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := auth.Reload(); err == nil {
+		t.Errorf("expected an error reloading an invalid file")
+	}
+	if _, ok, _ := auth.AuthenticatePassword("user1", "password1"); !ok {
+		t.Errorf("expected the previous users to be kept after a failed reload")
+	}
+
+	if err := ioutil.WriteFile(auth.path, []byte(hash+",user1,uid1\n"), 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	later = later.Add(time.Minute)
+	if err := os.Chtimes(auth.path, later, later); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := auth.Reload(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok, _ := auth.AuthenticatePassword("user1", "password1"); ok {
+		t.Errorf("expected the old password to be rejected after a reload")
+	}
+	if _, ok, _ := auth.AuthenticatePassword("user1", "password2"); !ok {
+		t.Errorf("expected the new password to be accepted after a reload")
+	}
+}
+
+func TestInsufficientColumnsPasswordFile(t *testing.T) {
+	f, err := ioutil.TempFile("", "passwordfile_test")
+	if err != nil {
+		t.Fatalf("unexpected error creating passwordfile: %v", err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+
+	if err := ioutil.WriteFile(f.Name(), []byte("password4,user4\n"), 0600); err != nil {
+		t.Fatalf("unexpected error writing passwordfile: %v", err)
+	}
+	if _, err := NewCSV(f.Name()); err == nil {
+		t.Fatalf("unexpected non error")
+	}
+}
+
+func TestPlainTextPasswordFile(t *testing.T) {
+	f, err := ioutil.TempFile("", "passwordfile_test")
+	if err != nil {
+		t.Fatalf("unexpected error creating passwordfile: %v", err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+
+	for _, contents := range []string{"password1,user1,uid1\n", ",user1,uid1\n"} {
+		if err := ioutil.WriteFile(f.Name(), []byte(contents), 0600); err != nil {
+			t.Fatalf("unexpected error writing passwordfile: %v", err)
+		}
+		if _, err := NewCSV(f.Name()); err == nil {
+			t.Errorf("expected an error reading %q", contents)
+		}
+	}
+}
+
+func hashPassword(t *testing.T, password string) string {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return string(hash)
+}
+
+func newWithContents(t *testing.T, contents string) (*PasswordAuthenticator, func()) {
+	f, err := ioutil.TempFile("", "passwordfile_test")
+	if err != nil {
+		t.Fatalf("unexpected error creating passwordfile: %v", err)
+	}
+	f.Close()
+
+	if err := ioutil.WriteFile(f.Name(), []byte(contents), 0600); err != nil {
+		t.Fatalf("unexpected error writing passwordfile: %v", err)
+	}
+	auth, err := NewCSV(f.Name())
+	if err != nil {
+		t.Fatalf("unable to read passwordfile: %v", err)
+	}
+	return auth, func() { os.Remove(f.Name()) }
+}
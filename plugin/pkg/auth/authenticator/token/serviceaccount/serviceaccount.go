@@ -0,0 +1,152 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccount
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/user"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/serviceaccount"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// tokenIndex is the name of the index of secrets by their token.
+const tokenIndex = "token"
+
+// TokenAuthenticator authenticates the API tokens stored in service account
+// token secrets, identifying the caller as the service account the token belongs to.
+// A token is only accepted while the service account it names exists, has the UID
+// recorded in the secret, and references the secret.
+type TokenAuthenticator struct {
+	secrets         cache.Indexer
+	serviceAccounts cache.Store
+}
+
+// NewTokenAuthenticator returns a TokenAuthenticator that watches service account
+// token secrets and service accounts in all namespaces through the given client.
+func NewTokenAuthenticator(c client.Interface) *TokenAuthenticator {
+	tokenSelector := labels.Set{"type": string(api.SecretTypeServiceAccountToken)}.AsSelector()
+	secrets := NewTokenIndexer()
+	reflector := cache.NewReflector(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return c.Secrets(api.NamespaceAll).List(labels.Everything(), tokenSelector)
+			},
+			WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+				return c.Secrets(api.NamespaceAll).Watch(labels.Everything(), tokenSelector, resourceVersion)
+			},
+		},
+		&api.Secret{},
+		secrets,
+		0,
+	)
+	reflector.Run()
+
+	serviceAccounts := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return c.ServiceAccounts(api.NamespaceAll).List(labels.Everything(), labels.Everything())
+			},
+			WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+				return c.ServiceAccounts(api.NamespaceAll).Watch(labels.Everything(), labels.Everything(), resourceVersion)
+			},
+		},
+		&api.ServiceAccount{},
+		serviceAccounts,
+		0,
+	).Run()
+	return NewTokenAuthenticatorFromIndexer(secrets, serviceAccounts)
+}
+
+// NewTokenAuthenticatorFromIndexer returns a TokenAuthenticator that looks tokens up in
+// an indexer created by NewTokenIndexer, and service accounts up in a store keyed by
+// cache.MetaNamespaceKeyFunc.
+func NewTokenAuthenticatorFromIndexer(secrets cache.Indexer, serviceAccounts cache.Store) *TokenAuthenticator {
+	return &TokenAuthenticator{secrets: secrets, serviceAccounts: serviceAccounts}
+}
+
+// NewTokenIndexer returns an indexer of secrets that can be searched by token.
+func NewTokenIndexer() cache.Indexer {
+	return cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{tokenIndex: indexSecretByToken})
+}
+
+func indexSecretByToken(obj interface{}) (string, error) {
+	secret, ok := obj.(*api.Secret)
+	if !ok {
+		return "", fmt.Errorf("expected a secret, got %#v", obj)
+	}
+	return string(secret.Data[api.ServiceAccountTokenKey]), nil
+}
+
+// AuthenticateToken implements authenticator.Token
+func (a *TokenAuthenticator) AuthenticateToken(value string) (user.Info, bool, error) {
+	if len(value) == 0 {
+		return nil, false, nil
+	}
+	matches, err := a.secrets.Index(tokenIndex, &api.Secret{
+		Data: map[string][]byte{api.ServiceAccountTokenKey: []byte(value)},
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	for _, obj := range matches {
+		secret := obj.(*api.Secret)
+		name := secret.Annotations[api.ServiceAccountNameKey]
+		if secret.Type != api.SecretTypeServiceAccountToken || len(name) == 0 {
+			continue
+		}
+		serviceAccount, err := a.serviceAccountFor(secret)
+		if err != nil {
+			return nil, false, err
+		}
+		if serviceAccount == nil {
+			continue
+		}
+		return &user.DefaultInfo{
+			Name: serviceaccount.MakeUsername(secret.Namespace, name),
+			UID:  string(serviceAccount.UID),
+		}, true, nil
+	}
+	return nil, false, nil
+}
+
+// serviceAccountFor returns the service account a token secret belongs to, or nil if
+// the account does not exist, has a different UID, or does not reference the secret.
+// Anyone able to create secrets could otherwise mint tokens for its service accounts.
+func (a *TokenAuthenticator) serviceAccountFor(secret *api.Secret) (*api.ServiceAccount, error) {
+	obj, exists, err := a.serviceAccounts.GetByKey(secret.Namespace + "/" + secret.Annotations[api.ServiceAccountNameKey])
+	if err != nil || !exists {
+		return nil, err
+	}
+	serviceAccount := obj.(*api.ServiceAccount)
+	uid := secret.Annotations[api.ServiceAccountUIDKey]
+	if len(uid) == 0 || uid != string(serviceAccount.UID) || !serviceaccount.IsServiceAccountToken(secret, serviceAccount) {
+		return nil, nil
+	}
+	for _, ref := range serviceAccount.Secrets {
+		if ref.Name == secret.Name {
+			return serviceAccount, nil
+		}
+	}
+	return nil, nil
+}
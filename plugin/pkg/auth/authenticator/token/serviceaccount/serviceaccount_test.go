@@ -0,0 +1,128 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccount
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/user"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+)
+
+func tokenSecret(name, namespace, serviceAccount, token string) *api.Secret {
+	return &api.Secret{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Annotations: map[string]string{
+				api.ServiceAccountNameKey: serviceAccount,
+				api.ServiceAccountUIDKey:  "uid-" + serviceAccount,
+			},
+		},
+		Type: api.SecretTypeServiceAccountToken,
+		Data: map[string][]byte{api.ServiceAccountTokenKey: []byte(token)},
+	}
+}
+
+func serviceAccount(name, namespace string, secrets ...string) *api.ServiceAccount {
+	serviceAccount := &api.ServiceAccount{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: namespace, UID: types.UID("uid-" + name)},
+	}
+	for _, secret := range secrets {
+		serviceAccount.Secrets = append(serviceAccount.Secrets, api.ObjectReference{Name: secret})
+	}
+	return serviceAccount
+}
+
+func TestAuthenticateToken(t *testing.T) {
+	secrets := NewTokenIndexer()
+	secrets.Add(tokenSecret("default-token", "ns", "default", "token1"))
+	secrets.Add(tokenSecret("builder-token", "other", "builder", "token2"))
+	opaque := tokenSecret("opaque", "ns", "default", "token3")
+	opaque.Type = api.SecretTypeOpaque
+	secrets.Add(opaque)
+	secrets.Add(tokenSecret("nameless", "ns", "", "token4"))
+	// a secret created by hand for an account that does not reference it
+	secrets.Add(tokenSecret("forged-token", "ns", "default", "token6"))
+	secrets.Add(tokenSecret("missing-token", "ns", "missing", "token7"))
+	stale := tokenSecret("stale-token", "other", "builder", "token8")
+	stale.Annotations[api.ServiceAccountUIDKey] = "uid-old"
+	secrets.Add(stale)
+	uidless := tokenSecret("uidless-token", "other", "builder", "token9")
+	delete(uidless.Annotations, api.ServiceAccountUIDKey)
+	secrets.Add(uidless)
+	serviceAccounts := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	serviceAccounts.Add(serviceAccount("default", "ns", "default-token", "opaque", "nameless"))
+	serviceAccounts.Add(serviceAccount("builder", "other", "builder-token", "stale-token", "uidless-token"))
+
+	auth := NewTokenAuthenticatorFromIndexer(secrets, serviceAccounts)
+	testCases := map[string]struct {
+		token string
+		user  user.Info
+		ok    bool
+	}{
+		"default":         {"token1", &user.DefaultInfo{Name: "system:serviceaccount:ns:default", UID: "uid-default"}, true},
+		"other namespace": {"token2", &user.DefaultInfo{Name: "system:serviceaccount:other:builder", UID: "uid-builder"}, true},
+		"opaque secret":   {"token3", nil, false},
+		"no account name": {"token4", nil, false},
+		"unknown token":   {"token5", nil, false},
+		"empty token":     {"", nil, false},
+		"unreferenced":    {"token6", nil, false},
+		"missing account": {"token7", nil, false},
+		"other uid":       {"token8", nil, false},
+		"no uid":          {"token9", nil, false},
+	}
+	for k, tc := range testCases {
+		info, ok, err := auth.AuthenticateToken(tc.token)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", k, err)
+			continue
+		}
+		if ok != tc.ok {
+			t.Errorf("%s: expected ok=%v, got %v", k, tc.ok, ok)
+			continue
+		}
+		if ok && (info.GetName() != tc.user.GetName() || info.GetUID() != tc.user.GetUID()) {
+			t.Errorf("%s: expected %#v, got %#v", k, tc.user, info)
+		}
+	}
+}
+
+func TestAuthenticateTokenAfterDelete(t *testing.T) {
+	secrets := NewTokenIndexer()
+	secret := tokenSecret("default-token", "ns", "default", "token1")
+	secrets.Add(secret)
+	serviceAccounts := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	account := serviceAccount("default", "ns", "default-token")
+	serviceAccounts.Add(account)
+	auth := NewTokenAuthenticatorFromIndexer(secrets, serviceAccounts)
+	if _, ok, _ := auth.AuthenticateToken("token1"); !ok {
+		t.Fatalf("Expected token to authenticate")
+	}
+	secrets.Delete(secret)
+	if _, ok, _ := auth.AuthenticateToken("token1"); ok {
+		t.Errorf("Expected deleted token not to authenticate")
+	}
+
+	secrets.Add(secret)
+	serviceAccounts.Delete(account)
+	if _, ok, _ := auth.AuthenticateToken("token1"); ok {
+		t.Errorf("Expected the token of a deleted service account not to authenticate")
+	}
+}
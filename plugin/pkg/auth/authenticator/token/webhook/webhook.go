@@ -0,0 +1,150 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook implements a token authenticator that asks a remote service
+// who a bearer token belongs to.
+package webhook
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/user"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/cache"
+)
+
+// maxCacheEntries bounds the number of tokens whose results are remembered.
+const maxCacheEntries = 4096
+
+// requestTimeout bounds how long a request waits for the remote service.
+const requestTimeout = 10 * time.Second
+
+// TokenReview is the body POSTed to the remote service, and the body it responds
+// with. The service is sent the token in Spec, and answers by filling in Status.
+type TokenReview struct {
+	Spec   TokenReviewSpec   `json:"spec"`
+	Status TokenReviewStatus `json:"status"`
+}
+
+// TokenReviewSpec holds the token to be authenticated.
+type TokenReviewSpec struct {
+	Token string `json:"token"`
+}
+
+// TokenReviewStatus is the result of authenticating a token.
+type TokenReviewStatus struct {
+	// Authenticated is true if the token belongs to a known user.
+	Authenticated bool `json:"authenticated"`
+	// User is the user the token belongs to, if Authenticated is true.
+	User UserInfo `json:"user,omitempty"`
+}
+
+// UserInfo describes the user a token belongs to.
+type UserInfo struct {
+	Username string   `json:"username"`
+	UID      string   `json:"uid,omitempty"`
+	Groups   []string `json:"groups,omitempty"`
+}
+
+// cacheEntry is the remembered result of authenticating one token.
+type cacheEntry struct {
+	user user.Info
+	ok   bool
+}
+
+// TokenAuthenticator authenticates bearer tokens by POSTing them to a remote
+// service. Results are cached, for positiveTTL when the token was accepted and
+// for negativeTTL when it was rejected. Failures to reach the service are not
+// cached.
+type TokenAuthenticator struct {
+	url         string
+	client      *http.Client
+	positiveTTL time.Duration
+	negativeTTL time.Duration
+	cache       *cache.ExpiringCache
+}
+
+// New returns a TokenAuthenticator that POSTs tokens to url through transport.
+func New(url string, transport http.RoundTripper, positiveTTL, negativeTTL time.Duration) *TokenAuthenticator {
+	return &TokenAuthenticator{
+		url:         url,
+		client:      &http.Client{Transport: transport, Timeout: requestTimeout},
+		positiveTTL: positiveTTL,
+		negativeTTL: negativeTTL,
+		cache:       cache.NewExpiringCache(maxCacheEntries, util.RealClock{}),
+	}
+}
+
+// AuthenticateToken implements authenticator.Token
+func (a *TokenAuthenticator) AuthenticateToken(value string) (user.Info, bool, error) {
+	if len(value) == 0 {
+		return nil, false, nil
+	}
+	// Only a hash of the token is kept in memory.
+	hash := sha256.Sum256([]byte(value))
+	key := string(hash[:])
+	if cached, ok := a.cache.Get(key); ok {
+		entry := cached.(cacheEntry)
+		return entry.user, entry.ok, nil
+	}
+
+	info, ok, err := a.review(value)
+	if err != nil {
+		return nil, false, err
+	}
+	ttl := a.negativeTTL
+	if ok {
+		ttl = a.positiveTTL
+	}
+	a.cache.Add(key, cacheEntry{info, ok}, ttl)
+	return info, ok, nil
+}
+
+// review asks the remote service who value belongs to.
+func (a *TokenAuthenticator) review(value string) (user.Info, bool, error) {
+	body, err := json.Marshal(&TokenReview{Spec: TokenReviewSpec{Token: value}})
+	if err != nil {
+		return nil, false, err
+	}
+	resp, err := a.client.Post(a.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, false, fmt.Errorf("token webhook returned status %d", resp.StatusCode)
+	}
+	review := TokenReview{}
+	if err := json.NewDecoder(resp.Body).Decode(&review); err != nil {
+		return nil, false, fmt.Errorf("unable to decode token webhook response: %v", err)
+	}
+	if !review.Status.Authenticated {
+		return nil, false, nil
+	}
+	if len(review.Status.User.Username) == 0 {
+		return nil, false, fmt.Errorf("token webhook authenticated a token without returning a user name")
+	}
+	return &user.DefaultInfo{
+		Name:   review.Status.User.Username,
+		UID:    review.Status.User.UID,
+		Groups: review.Status.User.Groups,
+	}, true, nil
+}
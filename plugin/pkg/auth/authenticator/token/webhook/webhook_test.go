@@ -0,0 +1,153 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/user"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/cache"
+)
+
+// fakeService authenticates the tokens in users, and counts the reviews it receives.
+type fakeService struct {
+	users   map[string]UserInfo
+	status  int
+	reviews int
+}
+
+func (s *fakeService) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	s.reviews++
+	if req.Method != "POST" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if s.status != 0 {
+		w.WriteHeader(s.status)
+		return
+	}
+	review := TokenReview{}
+	if err := json.NewDecoder(req.Body).Decode(&review); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if info, ok := s.users[review.Spec.Token]; ok {
+		review.Status = TokenReviewStatus{Authenticated: true, User: info}
+	}
+	json.NewEncoder(w).Encode(&review)
+}
+
+func newTestAuthenticator(service *fakeService) (*TokenAuthenticator, *util.FakeClock, func()) {
+	server := httptest.NewTLSServer(service)
+	transport := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+	auth := New(server.URL, transport, 2*time.Minute, 30*time.Second)
+	clock := &util.FakeClock{Time: time.Now()}
+	auth.cache = cache.NewExpiringCache(maxCacheEntries, clock)
+	return auth, clock, server.Close
+}
+
+func TestAuthenticateToken(t *testing.T) {
+	service := &fakeService{users: map[string]UserInfo{
+		"token1": {Username: "alice", UID: "1", Groups: []string{"admins", "devs"}},
+	}}
+	auth, _, stop := newTestAuthenticator(service)
+	defer stop()
+
+	testCases := map[string]struct {
+		token string
+		user  user.Info
+		ok    bool
+	}{
+		"known token":   {"token1", &user.DefaultInfo{Name: "alice", UID: "1", Groups: []string{"admins", "devs"}}, true},
+		"unknown token": {"token2", nil, false},
+		"empty token":   {"", nil, false},
+	}
+	for k, tc := range testCases {
+		info, ok, err := auth.AuthenticateToken(tc.token)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", k, err)
+			continue
+		}
+		if ok != tc.ok {
+			t.Errorf("%s: expected ok=%v, got %v", k, tc.ok, ok)
+			continue
+		}
+		if ok && !reflect.DeepEqual(info, tc.user) {
+			t.Errorf("%s: expected %#v, got %#v", k, tc.user, info)
+		}
+	}
+	if service.reviews != 2 {
+		t.Errorf("expected empty tokens not to be reviewed, got %d reviews", service.reviews)
+	}
+}
+
+func TestAuthenticateTokenCaches(t *testing.T) {
+	service := &fakeService{users: map[string]UserInfo{"token1": {Username: "alice"}}}
+	auth, clock, stop := newTestAuthenticator(service)
+	defer stop()
+
+	authenticate := func(token string, expectedOK bool, expectedReviews int) {
+		_, ok, err := auth.AuthenticateToken(token)
+		if err != nil || ok != expectedOK {
+			t.Errorf("%s: expected ok=%v, got %v %v", token, expectedOK, ok, err)
+		}
+		if service.reviews != expectedReviews {
+			t.Errorf("%s: expected %d reviews, got %d", token, expectedReviews, service.reviews)
+		}
+	}
+
+	authenticate("token1", true, 1)
+	authenticate("token2", false, 2)
+	authenticate("token1", true, 2)
+	authenticate("token2", false, 2)
+
+	// Rejections expire before acceptances.
+	clock.Time = clock.Time.Add(time.Minute)
+	authenticate("token1", true, 2)
+	authenticate("token2", false, 3)
+
+	clock.Time = clock.Time.Add(2 * time.Minute)
+	authenticate("token1", true, 4)
+}
+
+func TestAuthenticateTokenErrors(t *testing.T) {
+	service := &fakeService{status: http.StatusInternalServerError}
+	auth, _, stop := newTestAuthenticator(service)
+	defer stop()
+
+	for i := 0; i < 2; i++ {
+		if _, ok, err := auth.AuthenticateToken("token1"); err == nil || ok {
+			t.Errorf("expected an error, got ok=%v %v", ok, err)
+		}
+	}
+	if service.reviews != 2 {
+		t.Errorf("expected errors not to be cached, got %d reviews", service.reviews)
+	}
+
+	service.status = 0
+	service.users = map[string]UserInfo{"token1": {}}
+	if _, ok, err := auth.AuthenticateToken("token1"); err == nil || ok {
+		t.Errorf("expected a user without a name to be an error, got ok=%v %v", ok, err)
+	}
+}
@@ -67,8 +67,7 @@ var _ = Describe("Networking", func() {
 				},
 			},
 			Spec: api.ServiceSpec{
-				Port:          8080,
-				ContainerPort: util.NewIntOrStringFromInt(8080),
+				Ports: []api.ServicePort{{Port: 8080, ContainerPort: util.NewIntOrStringFromInt(8080)}},
 				Selector: map[string]string{
 					"name": name,
 				},
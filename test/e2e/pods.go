@@ -41,7 +41,7 @@ func runLivenessTest(c *client.Client, podDescr *api.Pod) {
 	// At the end of the test, clean up by removing the pod.
 	defer func() {
 		By("deleting the pod")
-		c.Pods(ns).Delete(podDescr.Name)
+		c.Pods(ns).Delete(podDescr.Name, nil)
 	}()
 
 	// Wait until the pod is not pending. (Here we need to check for something other than
@@ -126,7 +126,7 @@ var _ = Describe("Pods", func() {
 		// We call defer here in case there is a problem with
 		// the test so we can ensure that we clean up after
 		// ourselves
-		defer podClient.Delete(pod.Name)
+		defer podClient.Delete(pod.Name, nil)
 		_, err := podClient.Create(pod)
 		if err != nil {
 			Fail(fmt.Sprintf("Failed to create pod: %v", err))
@@ -140,7 +140,7 @@ var _ = Describe("Pods", func() {
 		Expect(len(pods.Items)).To(Equal(1))
 
 		By("deleting the pod")
-		podClient.Delete(pod.Name)
+		podClient.Delete(pod.Name, nil)
 		pods, err = podClient.List(labels.SelectorFromSet(labels.Set(map[string]string{"time": value})))
 		Expect(len(pods.Items)).To(Equal(0))
 	})
@@ -182,7 +182,7 @@ var _ = Describe("Pods", func() {
 		By("submitting the pod to kubernetes")
 		defer func() {
 			By("deleting the pod")
-			podClient.Delete(pod.Name)
+			podClient.Delete(pod.Name, nil)
 		}()
 		_, err := podClient.Create(pod)
 		if err != nil {
@@ -238,7 +238,7 @@ var _ = Describe("Pods", func() {
 				},
 			},
 		}
-		defer c.Pods(api.NamespaceDefault).Delete(serverPod.Name)
+		defer c.Pods(api.NamespaceDefault).Delete(serverPod.Name, nil)
 		_, err := c.Pods(api.NamespaceDefault).Create(serverPod)
 		if err != nil {
 			Fail(fmt.Sprintf("Failed to create serverPod: %v", err))
@@ -261,8 +261,7 @@ var _ = Describe("Pods", func() {
 				},
 			},
 			Spec: api.ServiceSpec{
-				Port:          8765,
-				ContainerPort: util.NewIntOrStringFromInt(8080),
+				Ports: []api.ServicePort{{Port: 8765, ContainerPort: util.NewIntOrStringFromInt(8080)}},
 				Selector: map[string]string{
 					"name": serverName,
 				},
@@ -296,7 +295,7 @@ var _ = Describe("Pods", func() {
 				},
 			},
 		}
-		defer c.Pods(api.NamespaceDefault).Delete(clientPod.Name)
+		defer c.Pods(api.NamespaceDefault).Delete(clientPod.Name, nil)
 		_, err = c.Pods(api.NamespaceDefault).Create(clientPod)
 		if err != nil {
 			Fail(fmt.Sprintf("Failed to create pod: %v", err))
@@ -448,7 +447,7 @@ var _ = Describe("Pods", func() {
 				// We call defer here in case there is a problem with
 				// the test so we can ensure that we clean up after
 				// ourselves
-				podClient.Delete(pod.Name)
+				podClient.Delete(pod.Name, nil)
 			}()
 
 			By("waiting for the pod to start running")
@@ -521,7 +520,7 @@ var _ = Describe("Pods", func() {
 				// We call defer here in case there is a problem with
 				// the test so we can ensure that we clean up after
 				// ourselves
-				podClient.Delete(pod.Name)
+				podClient.Delete(pod.Name, nil)
 			}()
 
 			By("waiting for the pod to start running")
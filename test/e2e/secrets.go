@@ -110,7 +110,7 @@ var _ = Describe("Secrets", func() {
 			},
 		}
 
-		defer c.Pods(ns).Delete(clientPod.Name)
+		defer c.Pods(ns).Delete(clientPod.Name, nil)
 		if _, err := c.Pods(ns).Create(clientPod); err != nil {
 			Failf("Failed to create pod: %v", err)
 		}
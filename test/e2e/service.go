@@ -110,7 +110,7 @@ var _ = Describe("Services", func() {
 		defer func() {
 			By("deleting the pod")
 			defer GinkgoRecover()
-			podClient.Delete(pod.Name)
+			podClient.Delete(pod.Name, nil)
 		}()
 		if _, err := podClient.Create(pod); err != nil {
 			Failf("Failed to create %s pod: %v", pod.Name, err)
@@ -196,9 +196,8 @@ var _ = Describe("Services", func() {
 				Name: serviceName,
 			},
 			Spec: api.ServiceSpec{
-				Port:          80,
-				Selector:      labels,
-				ContainerPort: util.NewIntOrStringFromInt(80),
+				Ports:    []api.ServicePort{{Port: 80, ContainerPort: util.NewIntOrStringFromInt(80)}},
+				Selector: labels,
 			},
 		}
 		_, err := c.Services(ns).Create(service)
@@ -210,7 +209,7 @@ var _ = Describe("Services", func() {
 		var names []string
 		defer func() {
 			for _, name := range names {
-				err := c.Pods(ns).Delete(name)
+				err := c.Pods(ns).Delete(name, nil)
 				Expect(err).NotTo(HaveOccurred())
 			}
 		}()
@@ -227,13 +226,13 @@ var _ = Describe("Services", func() {
 
 		validateEndpointsOrFail(c, ns, serviceName, expectedPort, names)
 
-		err = c.Pods(ns).Delete(name1)
+		err = c.Pods(ns).Delete(name1, nil)
 		Expect(err).NotTo(HaveOccurred())
 		names = []string{name2}
 
 		validateEndpointsOrFail(c, ns, serviceName, expectedPort, names)
 
-		err = c.Pods(ns).Delete(name2)
+		err = c.Pods(ns).Delete(name2, nil)
 		Expect(err).NotTo(HaveOccurred())
 		names = []string{}
 
@@ -248,11 +247,13 @@ var _ = Describe("Services", func() {
 
 func validateIPsOrFail(c *client.Client, ns string, expectedPort int, expectedEndpoints []string, endpoints *api.Endpoints) {
 	ips := util.StringSet{}
-	for _, ep := range endpoints.Endpoints {
-		if ep.Port != expectedPort {
-			Failf("invalid port, expected %d, got %d", expectedPort, ep.Port)
+	for _, port := range endpoints.Ports {
+		for _, ep := range port.Endpoints {
+			if ep.Port != expectedPort {
+				Failf("invalid port, expected %d, got %d", expectedPort, ep.Port)
+			}
+			ips.Insert(ep.IP)
 		}
-		ips.Insert(ep.IP)
 	}
 
 	for _, name := range expectedEndpoints {
@@ -270,11 +271,11 @@ func validateEndpointsOrFail(c *client.Client, ns, serviceName string, expectedP
 	for {
 		endpoints, err := c.Endpoints(ns).Get(serviceName)
 		if err == nil {
-			if len(endpoints.Endpoints) == len(expectedEndpoints) {
+			if len(endpoints.Ports) == 1 && len(endpoints.Ports[0].Endpoints) == len(expectedEndpoints) {
 				validateIPsOrFail(c, ns, expectedPort, expectedEndpoints, endpoints)
 				return
 			} else {
-				By(fmt.Sprintf("Unexpected endpoints: %v, expected %v", endpoints.Endpoints, expectedEndpoints))
+				By(fmt.Sprintf("Unexpected endpoints: %v, expected %v", endpoints.Ports, expectedEndpoints))
 			}
 		} else {
 			By(fmt.Sprintf("Failed to get endpoints: %v (ignoring for 1s)", err))